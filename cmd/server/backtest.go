@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hahahamid/broker-backend/config"
+	"github.com/hahahamid/broker-backend/internal/backtest"
+	"github.com/hahahamid/broker-backend/internal/calendar"
+	"github.com/hahahamid/broker-backend/internal/charges"
+	"github.com/hahahamid/broker-backend/internal/instruments"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/strategy"
+)
+
+// paramFlag collects repeated -param key=value flags.
+type paramFlag strategy.Params
+
+func (p paramFlag) String() string {
+	parts := make([]string, 0, len(p))
+	for k, v := range p {
+		parts = append(parts, k+"="+v)
+	}
+	return strings.Join(parts, ",")
+}
+
+func (p paramFlag) Set(s string) error {
+	k, v, ok := strings.Cut(s, "=")
+	if !ok || k == "" {
+		return fmt.Errorf("want key=value, got %q", s)
+	}
+	p[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	return nil
+}
+
+// runBacktest is the backtest subcommand. It needs no database: the
+// instruments, calendar and charges come from files, defaulting to the
+// server's configuration. The summary statistics are printed as JSON and,
+// with -out, the fills log, equity curve and statistics are written there.
+func runBacktest(args []string) error {
+	cfg := config.Load()
+	fs := flag.NewFlagSet("backtest", flag.ContinueOnError)
+	ticks := fs.String("ticks", cfg.MarketDataFile, "tick file to replay; the seeded simulator runs without one")
+	instFile := fs.String("instruments", cfg.InstrumentsFile, "instruments file")
+	calFile := fs.String("calendar", cfg.CalendarFile, "exchange calendar; every exchange is always open without one")
+	chargesFile := fs.String("charges", cfg.ChargesFile, "charges schedule; nothing is charged without one")
+	name := fs.String("strategy", "", "strategy to run: "+strings.Join(strategy.Names(), ", "))
+	params := paramFlag{}
+	fs.Var(params, "param", "strategy parameter as key=value, repeatable")
+	seed := fs.Int64("seed", 1, "seed for the simulator and the strategy's random numbers")
+	cash := fs.Float64("cash", 100000, "starting cash")
+	interval := fs.Duration("interval", time.Minute, "timer and equity curve interval, also the simulator step")
+	steps := fs.Int("steps", 1000, "simulator steps")
+	start := fs.String("start", "", "simulator start time, RFC 3339")
+	out := fs.String("out", "", "directory to write fills.csv, curve.csv and stats.json to")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *name == "" {
+		return fmt.Errorf("-strategy is required")
+	}
+	if *instFile == "" {
+		return fmt.Errorf("-instruments is required")
+	}
+
+	strat, err := strategy.New(*name, strategy.Params(params))
+	if err != nil {
+		return err
+	}
+	bt := backtest.Config{
+		Strategy:  *name,
+		TickFile:  *ticks,
+		Seed:      *seed,
+		Cash:      *cash,
+		Interval:  *interval,
+		Steps:     *steps,
+		LotMethod: cfg.LotMethod,
+	}
+	if *start != "" {
+		if bt.Start, err = time.Parse(time.RFC3339, *start); err != nil {
+			return fmt.Errorf("-start: %w", err)
+		}
+	}
+	if bt.Instruments, err = instruments.LoadFile(*instFile); err != nil {
+		return fmt.Errorf("instruments: %w", err)
+	}
+	if *calFile != "" {
+		if bt.Calendar, err = calendar.Load(*calFile); err != nil {
+			return fmt.Errorf("calendar: %w", err)
+		}
+	}
+	if *chargesFile != "" {
+		if bt.Charges, err = charges.Load(*chargesFile); err != nil {
+			return fmt.Errorf("charges: %w", err)
+		}
+	}
+
+	res, err := backtest.Run(context.Background(), bt, strat)
+	if err != nil {
+		return err
+	}
+	if *out != "" {
+		if err := writeBacktest(*out, res); err != nil {
+			return err
+		}
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Strategy  string                    `json:"strategy"`
+		Seed      int64                     `json:"seed"`
+		Start     time.Time                 `json:"start"`
+		End       time.Time                 `json:"end"`
+		Stats     models.BacktestStats      `json:"stats"`
+		Positions []models.BacktestPosition `json:"positions"`
+	}{res.Strategy, res.Seed, res.Start, res.End, res.Stats, res.Positions})
+}
+
+func writeBacktest(dir string, res *models.BacktestResult) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	num := func(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) }
+
+	fills := [][]string{{"time", "id", "order_id", "symbol", "side", "quantity", "price", "charges", "realized_pnl", "cash"}}
+	for _, f := range res.Fills {
+		fills = append(fills, []string{
+			f.Time.Format(time.RFC3339Nano), f.ID, f.OrderID, f.Symbol, f.Side,
+			num(f.Quantity), num(f.Price), num(f.Charges), num(f.RealizedPNL), num(f.Cash),
+		})
+	}
+	if err := writeCSV(filepath.Join(dir, "fills.csv"), fills); err != nil {
+		return err
+	}
+
+	curve := [][]string{{"time", "cash", "value", "equity", "pnl"}}
+	for _, p := range res.Curve {
+		curve = append(curve, []string{p.Time.Format(time.RFC3339Nano), num(p.Cash), num(p.Value), num(p.Equity), num(p.PNL)})
+	}
+	if err := writeCSV(filepath.Join(dir, "curve.csv"), curve); err != nil {
+		return err
+	}
+
+	stats, err := json.MarshalIndent(res.Stats, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "stats.json"), append(stats, '\n'), 0o644)
+}
+
+func writeCSV(path string, rows [][]string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	w := csv.NewWriter(f)
+	if err := w.WriteAll(rows); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -7,20 +7,34 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"os"
+	"strings"
 
 	"encoding/json"
 	"time"
 
-	"github.com/gin-gonic/gin"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	grpcLib "google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/hahahamid/broker-backend/config"
+	"github.com/hahahamid/broker-backend/internal/alerts"
+	"github.com/hahahamid/broker-backend/internal/calendar"
+	"github.com/hahahamid/broker-backend/internal/candles"
+	"github.com/hahahamid/broker-backend/internal/charges"
+	"github.com/hahahamid/broker-backend/internal/corpactions"
+	"github.com/hahahamid/broker-backend/internal/funds"
+	"github.com/hahahamid/broker-backend/internal/fx"
 	grpcService "github.com/hahahamid/broker-backend/internal/grpcservice"
-	"github.com/hahahamid/broker-backend/internal/handlers"
+	"github.com/hahahamid/broker-backend/internal/instruments"
+	"github.com/hahahamid/broker-backend/internal/marketdata"
 	"github.com/hahahamid/broker-backend/internal/middleware"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/reports"
 	"github.com/hahahamid/broker-backend/internal/repository"
+	"github.com/hahahamid/broker-backend/internal/shorts"
+	"github.com/hahahamid/broker-backend/internal/strategy"
+	"github.com/hahahamid/broker-backend/internal/watchlists"
 	pb "github.com/hahahamid/broker-backend/proto"
 )
 
@@ -71,21 +85,185 @@ func getPublicIP(ctx context.Context) (ip, source string, err error) {
 	return "", "", errors.New("could not get public IP (both ipv6 & ipv4 failed)")
 }
 
+// newFeed builds the configured market data source, or nil when disabled.
+func newFeed(cfg *config.Config, repo repository.InstrumentRepo) (marketdata.MarketDataFeed, error) {
+	switch cfg.MarketDataSource {
+	case "off":
+		return nil, nil
+	case "replay":
+		return marketdata.NewReplayer(cfg.MarketDataFile, 1), nil
+	case "sim":
+		all, err := repo.ListInstruments(context.Background(), "", "")
+		if err != nil {
+			return nil, err
+		}
+		// Funds are priced once a day at their NAV, not traded.
+		var list []models.Instrument
+		for _, inst := range all {
+			if inst.AssetClass != models.AssetClassFund {
+				list = append(list, inst)
+			}
+		}
+		return marketdata.NewSimulator(marketdata.SimConfig{
+			Seed:     cfg.MarketDataSeed,
+			Interval: time.Duration(cfg.MarketDataInterval) * time.Millisecond,
+			Realtime: true,
+		}, list), nil
+	default:
+		return nil, fmt.Errorf("unknown market data source %q", cfg.MarketDataSource)
+	}
+}
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "backtest" {
+		if err := runBacktest(os.Args[2:]); err != nil {
+			log.Fatalf("backtest: %v", err)
+		}
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == strategy.WorkerCommand {
+		if err := strategy.ServeWorker(); err != nil {
+			log.Fatalf("strategy worker: %v", err)
+		}
+		return
+	}
+
 	cfg := config.Load()
 	repo, err := repository.NewMongoRepo(cfg)
 	if err != nil {
 		log.Fatalf("mongo connect: %v", err)
 	}
 
+	if cfg.InstrumentsFile != "" {
+		list, err := instruments.LoadFile(cfg.InstrumentsFile)
+		if err != nil {
+			log.Fatalf("instruments load: %v", err)
+		}
+		if err := repo.UpsertInstruments(context.Background(), list); err != nil {
+			log.Fatalf("instruments store: %v", err)
+		}
+		log.Printf("loaded %d instruments from %s", len(list), cfg.InstrumentsFile)
+	}
+
+	// Without a calendar file every exchange is treated as always open.
+	var cal *calendar.Calendar
+	if cfg.CalendarFile != "" {
+		if cal, err = calendar.Load(cfg.CalendarFile); err != nil {
+			log.Fatalf("calendar load: %v", err)
+		}
+	}
+
+	// Market data: every consumer reads marks from the price cache.
+	prices := marketdata.NewPriceCache()
+	bars := candles.NewAggregator(repo)
+	go bars.Run(context.Background())
+
+	// Without a rates file only same-currency amounts convert.
+	var rates fx.Provider
+	if cfg.FXRatesFile != "" {
+		static, err := fx.LoadStatic(cfg.FXRatesFile)
+		if err != nil {
+			log.Fatalf("fx rates load: %v", err)
+		}
+		rates = static
+	}
+	conv := fx.NewConverter(rates, cfg.BaseCurrency)
+
+	// Without a locate file nothing can be borrowed, so short sells are
+	// rejected until an admin sets the list.
+	var locates []models.Locate
+	if cfg.LocateFile != "" {
+		if locates, err = shorts.LoadFile(cfg.LocateFile); err != nil {
+			log.Fatalf("locates load: %v", err)
+		}
+	}
+
+	// Without a charges file trading is free.
+	var schedule *charges.Schedule
+	if cfg.ChargesFile != "" {
+		if schedule, err = charges.Load(cfg.ChargesFile); err != nil {
+			log.Fatalf("charges load: %v", err)
+		}
+	}
+
+	var actions []models.CorporateAction
+	if cfg.CorporateActionsFile != "" {
+		if actions, err = corpactions.LoadFile(cfg.CorporateActionsFile, corpactions.Locator(context.Background(), repo, cal)); err != nil {
+			log.Fatalf("corporate actions load: %v", err)
+		}
+	}
+
+	var fundList []models.Fund
+	if cfg.FundsFile != "" {
+		if fundList, err = funds.LoadFile(cfg.FundsFile); err != nil {
+			log.Fatalf("funds load: %v", err)
+		}
+		log.Printf("loaded %d funds from %s", len(fundList), cfg.FundsFile)
+	}
+
+	taxRules, err := reports.ParseTaxRules(cfg.FinancialYearStart, cfg.LongTermDays, cfg.LongTermDaysByClass)
+	if err != nil {
+		log.Fatalf("tax rules: %v", err)
+	}
+
+	watchSvc := watchlists.NewService(repo, prices, cfg.MaxWatchlists, cfg.MaxWatchlistSymbols)
+
+	alertSvc := alerts.NewService(repo, cal, map[string]alerts.Notifier{
+		models.ChannelInbox:   alerts.NewInboxNotifier(repo),
+		models.ChannelEmail:   alerts.NewEmailNotifier(cfg.SMTPAddr, cfg.SMTPFrom, cfg.SMTPUser, cfg.SMTPPass),
+		models.ChannelWebhook: alerts.NewWebhookNotifier(),
+	})
+	if err := alertSvc.Load(context.Background()); err != nil {
+		log.Fatalf("load alerts: %v", err)
+	}
+	go alertSvc.Run(context.Background())
+
+	// Live and paper accounts each get their own database, matching engine
+	// and services; market data, instruments and users are shared.
+	sd := &shared{
+		cfg:      cfg,
+		cal:      cal,
+		prices:   prices,
+		bars:     bars,
+		conv:     conv,
+		locates:  locates,
+		schedule: schedule,
+		taxRules: taxRules,
+		actions:  actions,
+		funds:    fundList,
+		watch:    watchSvc,
+		alerts:   alertSvc,
+	}
+	live := newStack(models.AccountLive, repo, sd)
+	paper := newStack(models.AccountPaper, repo.Database(cfg.PaperDBName), sd)
+	live.pair(paper)
+
+	feed, err := newFeed(cfg, repo)
+	if err != nil {
+		log.Fatalf("market data: %v", err)
+	}
+	if feed != nil {
+		feed.Subscribe(prices)
+		feed.Subscribe(bars)
+		feed.Subscribe(alertSvc)
+		live.subscribe(feed)
+		paper.subscribe(feed)
+		go func() {
+			if err := feed.Run(context.Background()); err != nil {
+				log.Printf("market data feed stopped: %v", err)
+			}
+		}()
+	}
+
 	// 1️⃣ Start gRPC server
 	go func() {
 		lis, err := net.Listen("tcp", ":50051")
 		if err != nil {
 			log.Fatalf("gRPC listen: %v", err)
 		}
-		grpcServer := grpcLib.NewServer()
-		pb.RegisterBrokerServer(grpcServer, grpcService.NewBrokerService(repo, cfg))
+		modes := grpcService.NewModes(cfg, paper.broker(sd))
+		grpcServer := grpcLib.NewServer(grpcLib.UnaryInterceptor(modes.Unary), grpcLib.StreamInterceptor(modes.Stream))
+		pb.RegisterBrokerServer(grpcServer, live.broker(sd))
 		log.Println("gRPC server @ :50051")
 		if err := grpcServer.Serve(lis); err != nil {
 			log.Fatalf("gRPC serve: %v", err)
@@ -95,7 +273,15 @@ func main() {
 	// 2️⃣ Start HTTP→gRPC gateway
 	go func() {
 		ctx := context.Background()
-		mux := runtime.NewServeMux()
+		mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			if strings.EqualFold(key, "X-Admin-Key") {
+				return "x-admin-key", true
+			}
+			if strings.EqualFold(key, "X-Account-Mode") {
+				return "x-account-mode", true
+			}
+			return runtime.DefaultHeaderMatcher(key)
+		}))
 		opts := []grpcLib.DialOption{grpcLib.WithTransportCredentials(insecure.NewCredentials())}
 		if err := pb.RegisterBrokerHandlerFromEndpoint(ctx, mux, "localhost:50051", opts); err != nil {
 			log.Fatalf("gateway register: %v", err)
@@ -106,42 +292,9 @@ func main() {
 		}
 	}()
 
-	// 3️⃣ Existing HTTP+Gin server
-	r := gin.Default()
-	ah := handlers.NewAuthHandler(repo, cfg)
-	hh := handlers.NewHoldingsHandler()
-	ob := handlers.NewOrderbookHandler()
-	ph := handlers.NewPositionsHandler()
-
-	r.GET("/health", func(c *gin.Context) { c.Status(200) })
-	r.POST("/signup", ah.Signup)
-	r.POST("/login", ah.Login)
-	r.POST("/refresh", ah.Refresh)
-
-	auth := r.Group("/", middleware.JWTAuth(cfg))
-	{
-		auth.GET("/holdings", hh.Get)
-		auth.GET("/orderbook", ob.Get)
-		auth.GET("/positions", ph.Get)
-	}
-
-	// POST API AS REQUESTED
-
-	r.POST("/push-data", func(c *gin.Context) {
-		ip, source, err := getPublicIP(c.Request.Context())
-		if err != nil {
-			c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
-			return
-		}
-
-		c.JSON(http.StatusOK, gin.H{
-			"message": fmt.Sprintf("welcome to the server from %s", ip),
-			"source":  source,
-		})
-	})
-
+	// 3️⃣ Existing HTTP+Gin server, one router per account mode
 	log.Println("HTTP server @ :8080")
-	if err := r.Run(":8080"); err != nil {
-		log.Fatalf("Gin run: %v", err)
+	if err := http.ListenAndServe(":8080", middleware.AccountModes(cfg, live.router(sd), paper.router(sd))); err != nil {
+		log.Fatalf("HTTP serve: %v", err)
 	}
 }
@@ -0,0 +1,387 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/hahahamid/broker-backend/config"
+	"github.com/hahahamid/broker-backend/internal/accounts"
+	"github.com/hahahamid/broker-backend/internal/alerts"
+	"github.com/hahahamid/broker-backend/internal/algos"
+	"github.com/hahahamid/broker-backend/internal/baskets"
+	"github.com/hahahamid/broker-backend/internal/calendar"
+	"github.com/hahahamid/broker-backend/internal/candles"
+	"github.com/hahahamid/broker-backend/internal/cash"
+	"github.com/hahahamid/broker-backend/internal/charges"
+	"github.com/hahahamid/broker-backend/internal/corpactions"
+	"github.com/hahahamid/broker-backend/internal/derivatives"
+	"github.com/hahahamid/broker-backend/internal/fractional"
+	"github.com/hahahamid/broker-backend/internal/funds"
+	"github.com/hahahamid/broker-backend/internal/fx"
+	grpcService "github.com/hahahamid/broker-backend/internal/grpcservice"
+	"github.com/hahahamid/broker-backend/internal/handlers"
+	"github.com/hahahamid/broker-backend/internal/lots"
+	"github.com/hahahamid/broker-backend/internal/marketdata"
+	"github.com/hahahamid/broker-backend/internal/matching"
+	"github.com/hahahamid/broker-backend/internal/middleware"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/orders"
+	"github.com/hahahamid/broker-backend/internal/portfolio"
+	"github.com/hahahamid/broker-backend/internal/reports"
+	"github.com/hahahamid/broker-backend/internal/repository"
+	"github.com/hahahamid/broker-backend/internal/settlement"
+	"github.com/hahahamid/broker-backend/internal/shorts"
+	"github.com/hahahamid/broker-backend/internal/strategy"
+	"github.com/hahahamid/broker-backend/internal/watchlists"
+)
+
+// shared is what both account modes are built on: reference data loaded
+// from files, market data, and the per-user services that do not hold
+// money (watchlists and alerts).
+type shared struct {
+	cfg      *config.Config
+	cal      *calendar.Calendar
+	prices   *marketdata.PriceCache
+	bars     *candles.Aggregator
+	conv     *fx.Converter
+	locates  []models.Locate
+	schedule *charges.Schedule
+	taxRules reports.TaxRules
+	actions  []models.CorporateAction
+	funds    []models.Fund
+	watch    *watchlists.Service
+	alerts   *alerts.Service
+}
+
+// stack is one account mode: its database, its own matching engine and
+// every service that keeps orders, holdings or cash. Nothing in one
+// stack sees the other's, so the modes never mix.
+type stack struct {
+	mode string
+	repo repository.Repo
+
+	engine      *matching.Engine
+	lots        *lots.Service
+	house       *fractional.Service
+	cash        *cash.Service
+	shorts      *shorts.Service
+	orders      *orders.Service
+	algos       *algos.Service
+	strategies  *strategy.Service
+	charges     *charges.Service
+	actions     *corpactions.Service
+	settlement  *settlement.Service
+	derivatives *derivatives.Service
+	funds       *funds.Service
+	portfolio   *portfolio.Service
+	reports     *reports.Service
+	baskets     *baskets.Service
+	accounts    *accounts.Service
+}
+
+// newStack builds and starts the services of one account mode.
+func newStack(mode string, repo repository.Repo, sh *shared) *stack {
+	cfg := sh.cfg
+	fatal := func(what string, err error) {
+		log.Fatalf("%s %s: %v", mode, what, err)
+	}
+	st := &stack{mode: mode, repo: repo, engine: matching.NewEngine()}
+
+	st.lots = lots.NewService(repo, sh.prices, sh.cal, sh.conv, cfg.LotMethod, time.Duration(cfg.LongTermDays)*24*time.Hour)
+	if err := st.lots.Load(context.Background()); err != nil {
+		fatal("load lots", err)
+	}
+	st.engine.AddListener(st.lots)
+	go st.lots.Run(context.Background())
+
+	// Fractional orders are batched into whole shares for the house account.
+	st.house = fractional.NewService(st.engine, st.lots, sh.prices, sh.cal, cfg.HouseAccountID, time.Duration(cfg.FractionalBatchMS)*time.Millisecond)
+	go st.house.Run(context.Background())
+
+	st.cash = cash.NewService(repo, sh.conv)
+	st.engine.AddListener(st.cash)
+	go st.cash.Run(context.Background())
+
+	st.shorts = shorts.NewService(repo, st.lots, st.cash, sh.prices, sh.cal, sh.locates)
+
+	// Buy orders hold their cost, charges included, on the cash ledger.
+	st.charges = charges.NewService(repo, st.cash, sh.prices, sh.schedule)
+	st.charges.Exempt(cfg.HouseAccountID)
+
+	st.orders = orders.NewService(repo, st.engine, sh.cal, st.lots, st.house, st.shorts)
+	st.orders.SetFunds(st.charges)
+	if err := st.orders.Restore(context.Background()); err != nil {
+		fatal("restore open orders", err)
+	}
+	go st.orders.Run(context.Background())
+
+	st.shorts.SetOrders(st.orders)
+	if err := st.shorts.Restore(context.Background()); err != nil {
+		fatal("restore buy-ins", err)
+	}
+	st.engine.AddListener(st.shorts)
+	go st.shorts.Run(context.Background())
+
+	// TWAP/VWAP parents slice into children placed through the order service.
+	st.algos = algos.NewService(repo, st.orders, st.lots, sh.prices, sh.cal, time.Duration(cfg.AlgoSliceSeconds)*time.Second, cfg.AlgoProfileDays)
+	if err := st.algos.Restore(context.Background()); err != nil {
+		fatal("restore algo orders", err)
+	}
+	st.orders.SetParents(st.algos)
+	st.engine.AddListener(st.algos)
+	go st.algos.Run(context.Background())
+
+	// Hosted strategies trade through the order service on their own goroutines.
+	st.strategies = strategy.NewService(repo, st.orders, st.cash, sh.prices, cfg.MaxStrategies, strategy.WorkerConfig{
+		MemoryMB:   cfg.StrategyMemoryMB,
+		CPUPercent: cfg.StrategyCPUPercent,
+	})
+	st.engine.AddListener(st.strategies)
+	if err := st.strategies.Restore(context.Background()); err != nil {
+		fatal("restore strategies", err)
+	}
+	go st.strategies.Run(context.Background())
+
+	st.charges.AddCharged(st.strategies)
+	st.engine.AddListener(st.charges)
+	go st.charges.Run(context.Background())
+
+	st.actions = corpactions.NewService(repo, sh.cal, st.lots, st.orders, st.cash)
+	if len(sh.actions) > 0 {
+		if _, err := st.actions.Ingest(context.Background(), sh.actions); err != nil {
+			fatal("corporate actions store", err)
+		}
+	}
+	go st.actions.Run(context.Background())
+
+	st.settlement = settlement.NewService(repo, st.lots, sh.cal)
+	go st.settlement.Run(context.Background())
+
+	// Futures and options settle in cash after their expiry session.
+	st.derivatives = derivatives.NewService(repo, st.engine, st.orders, st.lots, sh.prices, sh.cal, cfg.RiskFreeRate)
+	if err := st.derivatives.Load(context.Background()); err != nil {
+		fatal("load contracts", err)
+	}
+	go st.derivatives.Run(context.Background())
+
+	// Mutual funds are bought and redeemed at the NAV of their trade date.
+	st.funds = funds.NewService(repo, st.cash, st.lots, sh.prices, sh.cal, sh.conv, cfg.NAVFile)
+	if len(sh.funds) > 0 {
+		if err := st.funds.Ingest(context.Background(), sh.funds); err != nil {
+			fatal("funds store", err)
+		}
+	}
+	if err := st.funds.Load(context.Background()); err != nil {
+		fatal("load funds", err)
+	}
+	go st.funds.Run(context.Background())
+
+	st.portfolio = portfolio.NewService(repo, st.lots, st.cash, sh.cal, sh.conv)
+	go st.portfolio.Run(context.Background())
+	st.reports = reports.NewService(repo, st.lots, sh.taxRules, sh.conv)
+
+	st.baskets = baskets.NewService(repo, st.orders, st.lots, st.cash, st.charges, sh.prices, sh.cal, sh.conv)
+
+	st.accounts = accounts.NewService(repo, st.cash, sh.conv, mode, cfg.PaperStartingCash)
+	return st
+}
+
+// pair links the services that admin calls reach with the other mode's.
+// Admin calls carry no account, so they land in one stack; reference data
+// and the strategy kill switch must still apply to both.
+func (st *stack) pair(other *stack) {
+	st.actions.SetPeer(other.actions)
+	other.actions.SetPeer(st.actions)
+	st.shorts.SetPeer(other.shorts)
+	other.shorts.SetPeer(st.shorts)
+	st.funds.SetPeer(other.funds)
+	other.funds.SetPeer(st.funds)
+	st.strategies.SetPeer(other.strategies)
+	other.strategies.SetPeer(st.strategies)
+}
+
+// subscribe feeds the stack's engine and quote-driven services.
+func (st *stack) subscribe(feed marketdata.MarketDataFeed) {
+	feed.Subscribe(st.engine)
+	feed.Subscribe(st.algos)
+	feed.Subscribe(st.strategies)
+}
+
+func (st *stack) broker(sh *shared) *grpcService.BrokerService {
+	return grpcService.NewBrokerService(st.repo, sh.cfg, grpcService.Services{
+		Prices:     sh.prices,
+		Candles:    sh.bars,
+		Engine:     st.engine,
+		Orders:     st.orders,
+		Watchlists: sh.watch,
+		Alerts:     sh.alerts,
+		Calendar:   sh.cal,
+		Lots:       st.lots,
+
+		CorporateActions: st.actions,
+		Cash:             st.cash,
+		Settlement:       st.settlement,
+		Portfolio:        st.portfolio,
+		Reports:          st.reports,
+		Charges:          st.charges,
+		FX:               sh.conv,
+		House:            st.house,
+		Shorts:           st.shorts,
+		Baskets:          st.baskets,
+		Algos:            st.algos,
+		Derivatives:      st.derivatives,
+		Funds:            st.funds,
+		Strategies:       st.strategies,
+		Accounts:         st.accounts,
+	})
+}
+
+// router serves the HTTP API for the stack's mode. Both modes have the
+// same routes; middleware.AccountModes picks one per request.
+func (st *stack) router(sd *shared) *gin.Engine {
+	cfg := sd.cfg
+	r := gin.Default()
+	ah := handlers.NewAuthHandler(st.repo, cfg)
+	hh := handlers.NewHoldingsHandler(st.lots)
+	ob := handlers.NewOrderbookHandler(st.repo, st.lots, st.charges)
+	ph := handlers.NewPositionsHandler(st.lots, st.charges)
+	lh := handlers.NewLotsHandler(st.lots, st.charges)
+	chh := handlers.NewChargesHandler(st.charges)
+	cah := handlers.NewCorporateActionsHandler(st.actions)
+	cashH := handlers.NewCashHandler(st.cash, sd.conv)
+	sh := handlers.NewSettlementHandler(st.settlement)
+	hsh := handlers.NewHouseHandler(st.house)
+	shh := handlers.NewShortsHandler(st.shorts)
+	pfh := handlers.NewPortfolioHandler(st.portfolio)
+	rph := handlers.NewReportsHandler(st.reports)
+	ih := handlers.NewInstrumentsHandler(st.repo)
+	ch := handlers.NewCandlesHandler(st.repo)
+	oh := handlers.NewOrdersHandler(st.orders)
+	bh := handlers.NewBasketsHandler(st.baskets)
+	agh := handlers.NewAlgosHandler(st.algos)
+	dh := handlers.NewDepthHandler(st.engine, st.repo, cfg.MaxDepthLevels)
+	dvh := handlers.NewDerivativesHandler(st.derivatives)
+	fh := handlers.NewFundsHandler(st.funds)
+	sth := handlers.NewStrategiesHandler(st.strategies)
+	ach := handlers.NewAccountsHandler(st.accounts)
+	mh := handlers.NewMarketHandler(sd.cal)
+	wh := handlers.NewWatchlistsHandler(sd.watch)
+	alh := handlers.NewAlertsHandler(sd.alerts)
+
+	r.GET("/health", func(c *gin.Context) { c.Status(200) })
+	r.POST("/signup", ah.Signup)
+	r.POST("/login", ah.Login)
+	r.POST("/refresh", ah.Refresh)
+	r.GET("/instruments", ih.List)
+	r.GET("/instruments/search", ih.Search)
+	r.GET("/instruments/:symbol", ih.Get)
+	r.GET("/candles/:symbol", ch.Get)
+	r.GET("/depth/:symbol", dh.Get)
+	r.GET("/options/:underlying/chain", dvh.Chain)
+	r.GET("/funds", fh.List)
+	r.GET("/funds/:symbol", fh.Get)
+	r.GET("/market/status", mh.Status)
+	r.GET("/corporate-actions", cah.List)
+	r.GET("/fx/rates", cashH.Rates)
+
+	auth := r.Group("/", middleware.JWTAuth(cfg), middleware.Account(st.accounts))
+	{
+		auth.GET("/account", ach.Get)
+		auth.POST("/account/reset-balance", ach.ResetBalance)
+		auth.GET("/holdings", hh.Get)
+		auth.GET("/orderbook", ob.Get)
+		auth.GET("/positions", ph.Get)
+		auth.GET("/lots", lh.List)
+		auth.GET("/lots/closed", lh.Closed)
+		auth.GET("/adjustments", cah.Adjustments)
+		auth.GET("/locates", shh.Locates)
+		auth.GET("/shorts", shh.Positions)
+		auth.GET("/cash", cashH.Get)
+		auth.POST("/cash/deposits", cashH.Deposit)
+		auth.POST("/cash/withdrawals", cashH.Withdraw)
+		auth.POST("/fx/conversions", cashH.Convert)
+		auth.GET("/portfolio/history", pfh.History)
+		auth.GET("/portfolio/performance", pfh.Performance)
+		auth.GET("/reports/contract-notes/:date", rph.ContractNote)
+		auth.GET("/reports/statements/:month", rph.Statement)
+		auth.GET("/reports/tradebook", rph.Tradebook)
+		auth.GET("/reports/capital-gains", rph.CapitalGains)
+		auth.POST("/orders", oh.Place)
+		auth.POST("/charges/estimate", chh.Estimate)
+		auth.DELETE("/orders/:id", oh.Cancel)
+		auth.POST("/baskets", bh.Place)
+		auth.POST("/baskets/validate", bh.Validate)
+		auth.GET("/baskets", bh.List)
+		auth.GET("/baskets/:id", bh.Get)
+		auth.POST("/rebalance", bh.Rebalance)
+		auth.POST("/algos", agh.Create)
+		auth.GET("/algos/:id", agh.Get)
+		auth.POST("/algos/:id/pause", agh.Pause)
+		auth.POST("/algos/:id/resume", agh.Resume)
+		auth.DELETE("/algos/:id", agh.Cancel)
+		auth.POST("/fund-orders", fh.Place)
+		auth.GET("/fund-orders", fh.Orders)
+		auth.DELETE("/fund-orders/:id", fh.Cancel)
+		auth.POST("/sips", fh.CreateSIP)
+		auth.GET("/sips", fh.SIPs)
+		auth.POST("/sips/:id/pause", fh.PauseSIP)
+		auth.POST("/sips/:id/resume", fh.ResumeSIP)
+		auth.POST("/sips/:id/skip", fh.SkipSIP)
+		auth.DELETE("/sips/:id", fh.CancelSIP)
+		auth.GET("/strategy-types", sth.Types)
+		auth.POST("/strategies", sth.Start)
+		auth.GET("/strategies", sth.List)
+		auth.GET("/strategies/:id", sth.Get)
+		auth.POST("/strategies/:id/stop", sth.Stop)
+		auth.POST("/strategies/:id/kill", sth.Kill)
+
+		auth.GET("/watchlists", wh.List)
+		auth.POST("/watchlists", wh.Create)
+		auth.GET("/watchlists/:id", wh.Get)
+		auth.PATCH("/watchlists/:id", wh.Rename)
+		auth.DELETE("/watchlists/:id", wh.Delete)
+		auth.POST("/watchlists/:id/symbols", wh.AddSymbols)
+		auth.PUT("/watchlists/:id/symbols", wh.Reorder)
+		auth.DELETE("/watchlists/:id/symbols/:symbol", wh.RemoveSymbol)
+
+		auth.GET("/alerts", alh.List)
+		auth.POST("/alerts", alh.Create)
+		auth.GET("/alerts/history", alh.History)
+		auth.DELETE("/alerts/:id", alh.Delete)
+		auth.POST("/alerts/:id/rearm", alh.Rearm)
+		auth.GET("/notifications", alh.Inbox)
+		auth.POST("/notifications/read", alh.MarkRead)
+	}
+
+	admin := r.Group("/admin", middleware.AdminKey(cfg))
+	{
+		admin.POST("/corporate-actions", cah.Create)
+		admin.GET("/settlement-runs", sh.Runs)
+		admin.GET("/house", hsh.Get)
+		admin.PUT("/locates", shh.SetLocates)
+		admin.PUT("/users/:id/margin", shh.SetMargin)
+		admin.POST("/funds/navs", fh.PostNAVs)
+		admin.POST("/strategies/kill", sth.KillAll)
+	}
+
+	// POST API AS REQUESTED
+
+	r.POST("/push-data", func(c *gin.Context) {
+		ip, source, err := getPublicIP(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"message": fmt.Sprintf("welcome to the server from %s", ip),
+			"source":  source,
+		})
+	})
+	return r
+}
@@ -11,9 +11,56 @@ import (
 type Config struct {
 	MongoURI             string
 	DBName               string
+	PaperDBName          string // paper accounts' database
 	JWTSecret            string
 	RefreshSecret        string
 	AccessTokenExpireMin int
+	InstrumentsFile      string
+	CalendarFile         string
+	CorporateActionsFile string
+	ChargesFile          string
+	FXRatesFile          string
+	LocateFile           string // stock available to borrow for short sells
+	FundsFile            string // mutual fund catalogue
+	NAVFile              string // daily fund NAVs, reloaded when it changes
+	BaseCurrency         string // currency portfolios and PnL are reported in
+	AdminAPIKey          string // enables the /admin endpoints when set
+
+	MarketDataSource   string // "sim", "replay" or "off"
+	MarketDataFile     string
+	MarketDataSeed     int64
+	MarketDataInterval int // milliseconds between simulator steps
+
+	QuoteStreamInterval int // minimum milliseconds between streamed updates per symbol
+	MaxDepthLevels      int
+
+	HouseAccountID    string // user ID the house account trades fractional orders under
+	FractionalBatchMS int    // milliseconds between fractional order batches
+
+	AlgoSliceSeconds int // default slice length for TWAP/VWAP orders
+	AlgoProfileDays  int // days of bars VWAP volume profiles are built from
+
+	RiskFreeRate float64 // annual %, for option greeks and implied volatility
+
+	MaxStrategies      int // hosted strategies running at once per user
+	StrategyMemoryMB   int // resident memory one strategy's worker may use
+	StrategyCPUPercent int // share of a core one strategy's worker may use
+
+	PaperStartingCash float64 // virtual cash a paper account opens with, in the base currency
+
+	LotMethod    string // default lot selection for sells: fifo or lifo
+	LongTermDays int    // holding period beyond which lots are long term
+
+	FinancialYearStart  string // MM-DD, start of the tax year
+	LongTermDaysByClass string // per asset class overrides, e.g. "etf=730"
+
+	MaxWatchlists       int
+	MaxWatchlistSymbols int // per user, across all of their lists
+
+	SMTPAddr string // host:port; alert emails are only logged when empty
+	SMTPFrom string
+	SMTPUser string
+	SMTPPass string
 }
 
 func Load() *Config {
@@ -26,11 +73,89 @@ func Load() *Config {
 		exp = 10
 	}
 
+	mdSource := os.Getenv("MARKET_DATA_SOURCE")
+	if mdSource == "" {
+		mdSource = "sim"
+	}
+
+	paperDB := os.Getenv("PAPER_DB_NAME")
+	if paperDB == "" {
+		paperDB = os.Getenv("DB_NAME") + "_paper"
+	}
+
+	houseAccount := os.Getenv("HOUSE_ACCOUNT_ID")
+	if houseAccount == "" {
+		houseAccount = "house"
+	}
+
 	return &Config{
 		MongoURI:             os.Getenv("MONGO_URI"),
 		DBName:               os.Getenv("DB_NAME"),
+		PaperDBName:          paperDB,
 		JWTSecret:            os.Getenv("JWT_SECRET"),
 		RefreshSecret:        os.Getenv("REFRESH_SECRET"),
 		AccessTokenExpireMin: exp,
+		InstrumentsFile:      os.Getenv("INSTRUMENTS_FILE"),
+		CalendarFile:         os.Getenv("CALENDAR_FILE"),
+		CorporateActionsFile: os.Getenv("CORPORATE_ACTIONS_FILE"),
+		ChargesFile:          os.Getenv("CHARGES_FILE"),
+		FXRatesFile:          os.Getenv("FX_RATES_FILE"),
+		LocateFile:           os.Getenv("LOCATE_FILE"),
+		FundsFile:            os.Getenv("FUNDS_FILE"),
+		NAVFile:              os.Getenv("NAV_FILE"),
+		BaseCurrency:         os.Getenv("BASE_CURRENCY"),
+		AdminAPIKey:          os.Getenv("ADMIN_API_KEY"),
+
+		MarketDataSource:   mdSource,
+		MarketDataFile:     os.Getenv("MARKET_DATA_FILE"),
+		MarketDataSeed:     int64(envInt("MARKET_DATA_SEED", 1)),
+		MarketDataInterval: envInt("MARKET_DATA_INTERVAL_MS", 1000),
+
+		QuoteStreamInterval: envInt("QUOTE_STREAM_INTERVAL_MS", 250),
+		MaxDepthLevels:      envInt("MAX_DEPTH_LEVELS", 20),
+
+		HouseAccountID:    houseAccount,
+		FractionalBatchMS: envInt("FRACTIONAL_BATCH_MS", 1000),
+
+		AlgoSliceSeconds: envInt("ALGO_SLICE_SECONDS", 60),
+		AlgoProfileDays:  envInt("ALGO_PROFILE_DAYS", 5),
+
+		RiskFreeRate: envFloat("RISK_FREE_RATE", 5),
+
+		MaxStrategies:      envInt("MAX_STRATEGIES", 5),
+		StrategyMemoryMB:   envInt("STRATEGY_MEMORY_MB", 256),
+		StrategyCPUPercent: envInt("STRATEGY_CPU_PERCENT", 50),
+
+		PaperStartingCash: envFloat("PAPER_STARTING_CASH", 100000),
+
+		LotMethod:    os.Getenv("LOT_METHOD"),
+		LongTermDays: envInt("LONG_TERM_DAYS", 365),
+
+		FinancialYearStart:  os.Getenv("FINANCIAL_YEAR_START"),
+		LongTermDaysByClass: os.Getenv("LONG_TERM_DAYS_BY_CLASS"),
+
+		MaxWatchlists:       envInt("MAX_WATCHLISTS", 10),
+		MaxWatchlistSymbols: envInt("MAX_WATCHLIST_SYMBOLS", 50),
+
+		SMTPAddr: os.Getenv("SMTP_ADDR"),
+		SMTPFrom: os.Getenv("SMTP_FROM"),
+		SMTPUser: os.Getenv("SMTP_USER"),
+		SMTPPass: os.Getenv("SMTP_PASS"),
+	}
+}
+
+func envInt(key string, def int) int {
+	v, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+func envFloat(key string, def float64) float64 {
+	v, err := strconv.ParseFloat(os.Getenv(key), 64)
+	if err != nil {
+		return def
 	}
+	return v
 }
@@ -0,0 +1,146 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/cash"
+	"github.com/hahahamid/broker-backend/internal/fx"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+)
+
+var (
+	ErrInvalid = errors.New("invalid account request")
+	ErrState   = errors.New("account cannot do that")
+)
+
+// Service manages users' accounts in one mode. A paper account is opened
+// with startingCash of virtual money in the base currency the first time
+// it is used, and its balance can be reset; a live account's cash only
+// moves with deposits, withdrawals and trading.
+type Service struct {
+	repo     repository.AccountRepo
+	cash     *cash.Service
+	fx       *fx.Converter
+	mode     string
+	starting float64
+
+	mu     sync.Mutex
+	opened map[string]bool
+}
+
+func NewService(repo repository.AccountRepo, cashSvc *cash.Service, conv *fx.Converter, mode string, startingCash float64) *Service {
+	return &Service{repo: repo, cash: cashSvc, fx: conv, mode: mode, starting: startingCash, opened: map[string]bool{}}
+}
+
+// Mode is the account mode the service manages.
+func (s *Service) Mode() string {
+	return s.mode
+}
+
+// Open makes sure the user's paper account exists, crediting the starting
+// cash when it is first opened. Live accounts need no opening.
+func (s *Service) Open(ctx context.Context, userID string) error {
+	if s.mode != models.AccountPaper {
+		return nil
+	}
+	s.mu.Lock()
+	ok := s.opened[userID]
+	s.mu.Unlock()
+	if ok {
+		return nil
+	}
+	_, err := s.repo.GetAccount(ctx, userID)
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		now := time.Now()
+		if s.starting > 0 {
+			// The credit has a fixed ID, so requests racing to open the
+			// account credit it once.
+			if err := s.cash.Post(ctx, models.CashEntry{
+				ID:     "paper-open:" + userID,
+				UserID: userID,
+				Type:   models.CashPaperFunds,
+				Amount: s.starting,
+				Note:   "paper account opened",
+				Time:   now,
+			}); err != nil {
+				return err
+			}
+		}
+		if err := s.repo.SaveAccount(ctx, models.Account{
+			UserID:       userID,
+			Mode:         s.mode,
+			Currency:     s.fx.Base(),
+			StartingCash: s.starting,
+			OpenedAt:     now,
+		}); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	}
+	s.mu.Lock()
+	s.opened[userID] = true
+	s.mu.Unlock()
+	return nil
+}
+
+// Get returns the user's account in this mode with its cash in the base
+// currency.
+func (s *Service) Get(ctx context.Context, userID string) (models.Account, error) {
+	a := models.Account{UserID: userID, Mode: s.mode, Currency: s.fx.Base()}
+	if s.mode == models.AccountPaper {
+		if err := s.Open(ctx, userID); err != nil {
+			return a, err
+		}
+		stored, err := s.repo.GetAccount(ctx, userID)
+		if err != nil {
+			return a, err
+		}
+		a = *stored
+	}
+	var err error
+	a.Cash, a.Unconverted, err = s.cash.BaseBalance(ctx, userID)
+	return a, err
+}
+
+// ResetBalance sets a paper account's cash to amount in the base currency,
+// or to the starting cash when amount is zero, emptying its other currency
+// sub-ledgers. Holdings and working orders are left as they are.
+func (s *Service) ResetBalance(ctx context.Context, userID string, amount float64) (models.Account, error) {
+	if s.mode != models.AccountPaper {
+		return models.Account{}, fmt.Errorf("%w: only paper accounts can be reset", ErrState)
+	}
+	if amount < 0 {
+		return models.Account{}, fmt.Errorf("%w: amount cannot be negative", ErrInvalid)
+	}
+	if amount == 0 {
+		amount = s.starting
+	}
+	if err := s.Open(ctx, userID); err != nil {
+		return models.Account{}, err
+	}
+	a, err := s.repo.GetAccount(ctx, userID)
+	if err != nil {
+		return models.Account{}, err
+	}
+	// One transaction under the cash ledger's user lock, so a failure
+	// leaves every currency as it was.
+	if err := s.cash.SetBalances(ctx, userID, map[string]float64{s.fx.Base(): amount}, models.CashPaperFunds, "paper balance reset"); err != nil {
+		return *a, err
+	}
+	now := time.Now()
+	a.StartingCash = amount
+	a.Resets++
+	a.ResetAt = now
+	if err := s.repo.SaveAccount(ctx, *a); err != nil {
+		return *a, err
+	}
+	a.Cash, a.Unconverted, err = s.cash.BaseBalance(ctx, userID)
+	return *a, err
+}
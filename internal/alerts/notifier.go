@@ -0,0 +1,199 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"syscall"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+)
+
+// Recipient is where a notification is delivered.
+type Recipient struct {
+	UserID     string
+	Email      string
+	WebhookURL string
+}
+
+// Notifier delivers a triggered alert over one channel.
+type Notifier interface {
+	Notify(ctx context.Context, to Recipient, n models.Notification) error
+}
+
+// InboxNotifier stores the notification in the user's in-app inbox.
+type InboxNotifier struct {
+	repo repository.AlertRepo
+}
+
+func NewInboxNotifier(repo repository.AlertRepo) *InboxNotifier {
+	return &InboxNotifier{repo: repo}
+}
+
+func (n *InboxNotifier) Notify(ctx context.Context, to Recipient, msg models.Notification) error {
+	msg.UserID = to.UserID
+	return n.repo.SaveNotification(ctx, &msg)
+}
+
+// ErrBlockedAddress is returned for webhooks whose host resolves to an
+// address inside the platform's own network.
+var ErrBlockedAddress = errors.New("webhook address not allowed")
+
+// blockedNets are ranges not covered by the net.IP predicates used in
+// blocked: "this network", carrier-grade NAT (where some clouds serve
+// instance metadata) and the IPv6 EC2 metadata address.
+var blockedNets = []*net.IPNet{
+	mustCIDR("0.0.0.0/8"),
+	mustCIDR("100.64.0.0/10"),
+	mustCIDR("fd00:ec2::254/128"),
+}
+
+func mustCIDR(s string) *net.IPNet {
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// blocked reports whether ip is loopback, private, link-local (which
+// includes the 169.254.169.254 metadata endpoint), unspecified, multicast
+// or in blockedNets.
+func blocked(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return true
+	}
+	for _, n := range blockedNets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckWebhookURL rejects webhook URLs that are not https or whose host
+// resolves to a blocked address. Delivery checks every connection again,
+// since DNS can change after an alert is created.
+func CheckWebhookURL(ctx context.Context, raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return fmt.Errorf("%w: webhook_url must be an https URL", ErrBlockedAddress)
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+	if err != nil {
+		return fmt.Errorf("%w: resolve %s: %v", ErrBlockedAddress, u.Hostname(), err)
+	}
+	for _, a := range addrs {
+		if blocked(a.IP) {
+			return fmt.Errorf("%w: %s resolves to %s", ErrBlockedAddress, u.Hostname(), a.IP)
+		}
+	}
+	return nil
+}
+
+// WebhookNotifier POSTs the notification as JSON to the alert's URL. Only
+// https is used, redirects included, and the dialer refuses blocked
+// addresses after resolution, so a webhook cannot reach internal hosts or
+// cloud metadata endpoints. Proxies from the environment are ignored since
+// they would hide the destination from the dialer.
+type WebhookNotifier struct {
+	client *http.Client
+}
+
+func NewWebhookNotifier() *WebhookNotifier {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || blocked(ip) {
+				return fmt.Errorf("%w: %s", ErrBlockedAddress, host)
+			}
+			return nil
+		},
+	}
+	client := &http.Client{
+		Timeout: 5 * time.Second,
+		Transport: &http.Transport{
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 5 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if req.URL.Scheme != "https" {
+				return fmt.Errorf("%w: redirect to %s", ErrBlockedAddress, req.URL.Scheme)
+			}
+			if len(via) >= 5 {
+				return fmt.Errorf("webhook: too many redirects")
+			}
+			return nil
+		},
+	}
+	return &WebhookNotifier{client: client}
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, to Recipient, msg models.Notification) error {
+	if to.WebhookURL == "" {
+		return fmt.Errorf("webhook: no url")
+	}
+	if u, err := url.Parse(to.WebhookURL); err != nil || u.Scheme != "https" {
+		return fmt.Errorf("%w: webhook_url must be an https URL", ErrBlockedAddress)
+	}
+	body, err := json.Marshal(map[string]interface{}{"title": msg.Title, "body": msg.Body, "time": msg.CreatedAt})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, to.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook: %s", resp.Status)
+	}
+	return nil
+}
+
+// EmailNotifier sends plain-text mail through an SMTP relay. Without a
+// configured relay it only logs, which keeps local setups working.
+type EmailNotifier struct {
+	addr, from, user, pass string
+}
+
+func NewEmailNotifier(addr, from, user, pass string) *EmailNotifier {
+	return &EmailNotifier{addr: addr, from: from, user: user, pass: pass}
+}
+
+func (n *EmailNotifier) Notify(_ context.Context, to Recipient, msg models.Notification) error {
+	if to.Email == "" {
+		return fmt.Errorf("email: no address")
+	}
+	if n.addr == "" {
+		log.Printf("email (smtp not configured) to %s: %s", to.Email, msg.Title)
+		return nil
+	}
+	var auth smtp.Auth
+	if n.user != "" {
+		host, _, _ := net.SplitHostPort(n.addr)
+		auth = smtp.PlainAuth("", n.user, n.pass, host)
+	}
+	body := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s\r\n", n.from, to.Email, msg.Title, msg.Body)
+	return smtp.SendMail(n.addr, auth, n.from, []string{to.Email}, []byte(body))
+}
@@ -0,0 +1,343 @@
+package alerts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/calendar"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var ErrInvalid = errors.New("invalid alert")
+
+// Service stores alerts and evaluates the active ones against every trade
+// on the market data feed. Firing is handed to a worker so the feed is never
+// blocked on Mongo or on notification delivery. The previous close that
+// percent-change alerts measure against starts at the instrument master's
+// and rolls to the last traded price with each new trading day.
+type Service struct {
+	repo      repository.Repo
+	cal       *calendar.Calendar
+	notifiers map[string]Notifier
+
+	mu       sync.Mutex
+	armed    map[string]map[primitive.ObjectID]*armed // by symbol
+	ref      map[string]float64                       // previous close by symbol
+	last     map[string]float64                       // last trade by symbol
+	day      map[string]string                        // exchange date of the last trade
+	exchange map[string]string                        // by symbol
+	volumes  map[string]*volumeWindow
+
+	fired chan firing
+}
+
+type armed struct {
+	alert   models.Alert
+	latched bool // condition held at the last trade; wait for it to reset
+}
+
+type firing struct {
+	alert models.Alert
+	value float64
+	at    time.Time
+}
+
+func NewService(repo repository.Repo, cal *calendar.Calendar, notifiers map[string]Notifier) *Service {
+	return &Service{
+		repo:      repo,
+		cal:       cal,
+		notifiers: notifiers,
+		armed:     map[string]map[primitive.ObjectID]*armed{},
+		ref:       map[string]float64{},
+		last:      map[string]float64{},
+		day:       map[string]string{},
+		exchange:  map[string]string{},
+		volumes:   map[string]*volumeWindow{},
+		fired:     make(chan firing, 1024),
+	}
+}
+
+// Load arms the stored active alerts and reads reference prices.
+func (s *Service) Load(ctx context.Context) error {
+	insts, err := s.repo.ListInstruments(ctx, "", "")
+	if err != nil {
+		return err
+	}
+	list, err := s.repo.ListActiveAlerts(ctx)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, inst := range insts {
+		s.ref[inst.Symbol] = inst.PrevClose
+		s.exchange[inst.Symbol] = inst.Exchange
+	}
+	for _, a := range list {
+		s.arm(a)
+	}
+	return nil
+}
+
+func (s *Service) Create(ctx context.Context, userID string, a models.Alert) (*models.Alert, error) {
+	a.Symbol = strings.ToUpper(strings.TrimSpace(a.Symbol))
+	if err := s.validate(ctx, &a); err != nil {
+		return nil, err
+	}
+	a.ID = primitive.NilObjectID
+	a.UserID = userID
+	a.Status = models.AlertActive
+	a.LastTriggeredAt = time.Time{}
+	a.CreatedAt = time.Now()
+	if err := s.repo.CreateAlert(ctx, &a); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.arm(a)
+	s.mu.Unlock()
+	return &a, nil
+}
+
+func (s *Service) List(ctx context.Context, userID string) ([]models.Alert, error) {
+	return s.repo.ListAlerts(ctx, userID)
+}
+
+func (s *Service) Delete(ctx context.Context, userID, id string) error {
+	a, err := s.repo.GetAlert(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.DeleteAlert(ctx, userID, id); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	delete(s.armed[a.Symbol], a.ID)
+	s.mu.Unlock()
+	return nil
+}
+
+// Rearm puts a triggered alert back into evaluation.
+func (s *Service) Rearm(ctx context.Context, userID, id string) (*models.Alert, error) {
+	a, err := s.repo.GetAlert(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	a.Status = models.AlertActive
+	if err := s.repo.UpdateAlert(ctx, a); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.arm(*a)
+	s.mu.Unlock()
+	return a, nil
+}
+
+func (s *Service) History(ctx context.Context, userID string, limit int) ([]models.AlertEvent, error) {
+	return s.repo.ListAlertEvents(ctx, userID, limit)
+}
+
+func (s *Service) Inbox(ctx context.Context, userID string, unreadOnly bool) ([]models.Notification, error) {
+	return s.repo.ListNotifications(ctx, userID, unreadOnly)
+}
+
+func (s *Service) MarkRead(ctx context.Context, userID string, ids []string) error {
+	return s.repo.MarkNotificationsRead(ctx, userID, ids)
+}
+
+func (s *Service) validate(ctx context.Context, a *models.Alert) error {
+	switch a.Condition {
+	case models.AlertAbove, models.AlertBelow, models.AlertPercentChange:
+		if a.Threshold <= 0 {
+			return fmt.Errorf("%w: threshold must be positive", ErrInvalid)
+		}
+	case models.AlertVolumeSpike:
+		if a.Threshold <= 1 {
+			return fmt.Errorf("%w: volume spike threshold is a multiple of average volume and must exceed 1", ErrInvalid)
+		}
+	default:
+		return fmt.Errorf("%w: unknown condition %q", ErrInvalid, a.Condition)
+	}
+
+	switch a.Rearm {
+	case "":
+		a.Rearm = models.RearmNone
+	case models.RearmNone, models.RearmAuto:
+	default:
+		return fmt.Errorf("%w: rearm must be none or auto", ErrInvalid)
+	}
+	if a.CooldownSec < 0 {
+		return fmt.Errorf("%w: cooldown must not be negative", ErrInvalid)
+	}
+
+	if len(a.Channels) == 0 {
+		a.Channels = []string{models.ChannelInbox}
+	}
+	for _, ch := range a.Channels {
+		if _, ok := s.notifiers[ch]; !ok {
+			return fmt.Errorf("%w: unknown channel %q", ErrInvalid, ch)
+		}
+		if ch == models.ChannelWebhook {
+			if err := CheckWebhookURL(ctx, a.WebhookURL); err != nil {
+				return fmt.Errorf("%w: %v", ErrInvalid, err)
+			}
+		}
+	}
+
+	if _, err := s.repo.GetInstrument(ctx, a.Symbol); errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("%w: unknown symbol %s", ErrInvalid, a.Symbol)
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// arm adds an alert to evaluation; callers hold s.mu.
+func (s *Service) arm(a models.Alert) {
+	if s.armed[a.Symbol] == nil {
+		s.armed[a.Symbol] = map[primitive.ObjectID]*armed{}
+	}
+	s.armed[a.Symbol][a.ID] = &armed{alert: a}
+}
+
+func (s *Service) OnQuote(models.Quote) {}
+
+func (s *Service) OnTrade(t models.Trade) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w := s.volumes[t.Symbol]
+	if w == nil {
+		w = &volumeWindow{}
+		s.volumes[t.Symbol] = w
+	}
+	w.add(t.Time, t.Quantity)
+	s.roll(t)
+
+	for id, st := range s.armed[t.Symbol] {
+		a := &st.alert
+		value, hit := s.check(a, t)
+		if !hit {
+			st.latched = false
+			continue
+		}
+		if st.latched {
+			continue
+		}
+		cooldown := time.Duration(a.CooldownSec) * time.Second
+		if !a.LastTriggeredAt.IsZero() && t.Time.Sub(a.LastTriggeredAt) < cooldown {
+			continue
+		}
+
+		st.latched = true
+		a.LastTriggeredAt = t.Time
+		if a.Rearm == models.RearmNone {
+			a.Status = models.AlertTriggered
+			delete(s.armed[t.Symbol], id)
+		}
+		select {
+		case s.fired <- firing{alert: *a, value: value, at: t.Time}:
+		default:
+			log.Printf("alerts: queue full, dropping firing of %s", a.ID.Hex())
+		}
+	}
+}
+
+// roll makes the last price of the previous trading day the reference close
+// on the first trade of a new one; callers hold s.mu.
+func (s *Service) roll(t models.Trade) {
+	day := t.Time.In(s.cal.Location(s.exchange[t.Symbol])).Format(time.DateOnly)
+	if prev := s.day[t.Symbol]; prev != "" && prev != day {
+		s.ref[t.Symbol] = s.last[t.Symbol]
+	}
+	s.day[t.Symbol] = day
+	s.last[t.Symbol] = t.Price
+}
+
+// check evaluates a condition; callers hold s.mu.
+func (s *Service) check(a *models.Alert, t models.Trade) (float64, bool) {
+	switch a.Condition {
+	case models.AlertAbove:
+		return t.Price, t.Price >= a.Threshold
+	case models.AlertBelow:
+		return t.Price, t.Price <= a.Threshold
+	case models.AlertPercentChange:
+		ref := s.ref[t.Symbol]
+		if ref <= 0 {
+			return 0, false
+		}
+		pct := math.Abs(t.Price-ref) / ref * 100
+		return pct, pct >= a.Threshold
+	case models.AlertVolumeSpike:
+		ratio, ok := s.volumes[t.Symbol].ratio()
+		return ratio, ok && ratio >= a.Threshold
+	}
+	return 0, false
+}
+
+// Run records firings and delivers notifications until ctx is done.
+func (s *Service) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case f := <-s.fired:
+			s.deliver(ctx, f)
+		}
+	}
+}
+
+func (s *Service) deliver(ctx context.Context, f firing) {
+	a := f.alert
+	if err := s.repo.UpdateAlert(ctx, &a); err != nil && !errors.Is(err, repository.ErrNotFound) {
+		log.Printf("alerts: update %s: %v", a.ID.Hex(), err)
+	}
+
+	msg := describe(a, f.value)
+	ev := models.AlertEvent{
+		AlertID:   a.ID,
+		UserID:    a.UserID,
+		Symbol:    a.Symbol,
+		Condition: a.Condition,
+		Threshold: a.Threshold,
+		Value:     f.value,
+		Message:   msg,
+		Time:      f.at,
+	}
+	if err := s.repo.SaveAlertEvent(ctx, &ev); err != nil {
+		log.Printf("alerts: save event: %v", err)
+	}
+
+	to := Recipient{UserID: a.UserID, WebhookURL: a.WebhookURL}
+	for _, ch := range a.Channels {
+		if ch == models.ChannelEmail && to.Email == "" {
+			if u, err := s.repo.GetUserByID(ctx, a.UserID); err == nil {
+				to.Email = u.Email
+			}
+		}
+		n := models.Notification{Title: a.Symbol + " alert", Body: msg, CreatedAt: time.Now()}
+		if err := s.notifiers[ch].Notify(ctx, to, n); err != nil {
+			log.Printf("alerts: %s notify %s: %v", ch, a.ID.Hex(), err)
+		}
+	}
+}
+
+func describe(a models.Alert, value float64) string {
+	switch a.Condition {
+	case models.AlertAbove:
+		return fmt.Sprintf("%s traded at %.2f, at or above %.2f", a.Symbol, value, a.Threshold)
+	case models.AlertBelow:
+		return fmt.Sprintf("%s traded at %.2f, at or below %.2f", a.Symbol, value, a.Threshold)
+	case models.AlertPercentChange:
+		return fmt.Sprintf("%s moved %.2f%% from the previous close (alert at %.2f%%)", a.Symbol, value, a.Threshold)
+	default:
+		return fmt.Sprintf("%s volume is %.1fx its recent average (alert at %.1fx)", a.Symbol, value, a.Threshold)
+	}
+}
@@ -0,0 +1,54 @@
+package alerts
+
+import "time"
+
+const (
+	volumeLookback   = 20 // completed minutes averaged for the baseline
+	volumeMinHistory = 5  // minutes needed before spikes are reported
+)
+
+// volumeWindow tracks per-minute traded volume for one symbol.
+type volumeWindow struct {
+	minute  time.Time
+	current float64
+	history []float64 // completed minutes, oldest first
+}
+
+func (w *volumeWindow) add(t time.Time, qty float64) {
+	m := t.Truncate(time.Minute)
+	if !m.Equal(w.minute) {
+		if !w.minute.IsZero() {
+			// Minutes without trades count as zero volume.
+			gap := int(m.Sub(w.minute)/time.Minute) - 1
+			w.push(w.current)
+			for i := 0; i < gap && i < volumeLookback; i++ {
+				w.push(0)
+			}
+		}
+		w.minute, w.current = m, 0
+	}
+	w.current += qty
+}
+
+func (w *volumeWindow) push(v float64) {
+	w.history = append(w.history, v)
+	if len(w.history) > volumeLookback {
+		w.history = w.history[len(w.history)-volumeLookback:]
+	}
+}
+
+// ratio is the current minute's volume over the average of recent minutes.
+func (w *volumeWindow) ratio() (float64, bool) {
+	if w == nil || len(w.history) < volumeMinHistory {
+		return 0, false
+	}
+	var sum float64
+	for _, v := range w.history {
+		sum += v
+	}
+	avg := sum / float64(len(w.history))
+	if avg <= 0 {
+		return 0, false
+	}
+	return w.current / avg, true
+}
@@ -0,0 +1,626 @@
+package algos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/calendar"
+	"github.com/hahahamid/broker-backend/internal/instruments"
+	"github.com/hahahamid/broker-backend/internal/lots"
+	"github.com/hahahamid/broker-backend/internal/marketdata"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/orders"
+	"github.com/hahahamid/broker-backend/internal/repository"
+	"github.com/hahahamid/broker-backend/internal/utils"
+)
+
+var (
+	ErrInvalid  = errors.New("invalid algo order")
+	ErrNotFound = errors.New("algo order not found")
+	ErrState    = errors.New("algo order cannot do that now")
+)
+
+const (
+	eps = 1e-9
+
+	// MaxSlices caps how finely a window can be cut.
+	MaxSlices = 1000
+
+	// profileInterval is the bar size VWAP profiles are built from.
+	profileInterval = "15m"
+)
+
+type childFill struct {
+	qty, value float64
+}
+
+// Service works algo parent orders. Every second it checks each running
+// parent and, when a new slice is due, cancels what is left of the last
+// child and sends the next one for the quantity the schedule calls for by
+// the end of the slice, less what has filled. Slices are skipped while the
+// market is closed or the quote is through the parent's limit price, and
+// capped at the parent's share of the volume traded on the feed since its
+// previous slice. Whatever a skipped or capped slice leaves is picked up
+// by later ones; at the end of the window the parent expires with what has
+// filled.
+type Service struct {
+	repo        repository.Repo
+	orders      *orders.Service
+	lots        *lots.Service
+	prices      *marketdata.PriceCache
+	cal         *calendar.Calendar
+	slice       time.Duration
+	profileDays int
+	events      *utils.Queue[models.Order]
+
+	mu      sync.Mutex
+	parents map[string]*models.Order        // running and paused, by ID
+	fills   map[string]map[string]childFill // by parent, then child ID
+	traded  map[string]float64              // feed volume per symbol since startup
+	seen    map[string]float64              // traded in the parent's symbol at its last slice
+}
+
+// NewService slices windows every slice unless an order says otherwise,
+// and builds VWAP profiles from the last profileDays days of bars.
+func NewService(repo repository.Repo, orderSvc *orders.Service, lotSvc *lots.Service, prices *marketdata.PriceCache, cal *calendar.Calendar, slice time.Duration, profileDays int) *Service {
+	if slice <= 0 {
+		slice = time.Minute
+	}
+	if profileDays <= 0 {
+		profileDays = 5
+	}
+	return &Service{
+		repo:        repo,
+		orders:      orderSvc,
+		lots:        lotSvc,
+		prices:      prices,
+		cal:         cal,
+		slice:       slice,
+		profileDays: profileDays,
+		events:      utils.NewQueue[models.Order](time.Second),
+		parents:     map[string]*models.Order{},
+		fills:       map[string]map[string]childFill{},
+		traded:      map[string]float64{},
+		seen:        map[string]float64{},
+	}
+}
+
+// Restore reloads the parents still running or paused after a restart,
+// with what their children have filled.
+func (s *Service) Restore(ctx context.Context) error {
+	list, err := s.repo.ListOrdersByStatus(ctx, models.OrderOpen, models.OrderPartiallyFilled, models.OrderPaused)
+	if err != nil {
+		return err
+	}
+	for _, p := range list {
+		if p.Algo == nil {
+			continue
+		}
+		children, err := s.repo.ListChildOrders(ctx, p.ID)
+		if err != nil {
+			return err
+		}
+		s.mu.Lock()
+		p := p
+		s.parents[p.ID] = &p
+		s.fills[p.ID] = map[string]childFill{}
+		p.Algo.ChildID = ""
+		for _, c := range children {
+			s.record(&p, c)
+		}
+		s.mu.Unlock()
+	}
+	return nil
+}
+
+// Create validates a parent order and starts working it. The order takes
+// the fields of a single order, except notional; the limit price, if any,
+// applies to every child.
+func (s *Service) Create(ctx context.Context, userID string, o models.Order) (models.Order, error) {
+	if o.Algo == nil {
+		return o, fmt.Errorf("%w: missing algo parameters", ErrInvalid)
+	}
+	a := *o.Algo
+	a.Strategy = strings.ToLower(a.Strategy)
+	now := time.Now()
+	if a.StartAt.Before(now) {
+		a.StartAt = now
+	}
+	if a.SliceSeconds == 0 {
+		a.SliceSeconds = int(s.slice / time.Second)
+	}
+	switch {
+	case a.Strategy != models.AlgoTWAP && a.Strategy != models.AlgoVWAP:
+		return o, fmt.Errorf("%w: strategy must be twap or vwap", ErrInvalid)
+	case o.Notional != 0:
+		return o, fmt.Errorf("%w: algo orders take a quantity", ErrInvalid)
+	case o.DisplayQty != 0:
+		return o, fmt.Errorf("%w: algo orders cannot be icebergs", ErrInvalid)
+	case !a.EndAt.After(a.StartAt):
+		return o, fmt.Errorf("%w: end_at must be after the start", ErrInvalid)
+	case a.SliceSeconds < 1:
+		return o, fmt.Errorf("%w: slice_seconds must be positive", ErrInvalid)
+	case a.MaxParticipation < 0 || a.MaxParticipation > 1:
+		return o, fmt.Errorf("%w: max_participation must be between 0 and 1", ErrInvalid)
+	}
+	slice := time.Duration(a.SliceSeconds) * time.Second
+	n := int(math.Ceil(float64(a.EndAt.Sub(a.StartAt)) / float64(slice)))
+	if n > MaxSlices {
+		return o, fmt.Errorf("%w: %d slices, at most %d; lengthen slice_seconds", ErrInvalid, n, MaxSlices)
+	}
+
+	// Validate as a plain order. Children are only sent while the market
+	// is open, so a closed market is no reason to reject the parent.
+	o.Algo, o.AfterMarket, o.Validity = nil, true, ""
+	p, err := s.orders.Check(ctx, userID, o)
+	if err != nil {
+		return p, err
+	}
+	p.AfterMarket, p.Fractional, p.LotMethod, p.LotIDs = false, false, o.LotMethod, nil
+	if p.Side == "sell" && !p.Short {
+		settledOnly := s.cal.BlocksUnsettledSells(p.Exchange)
+		if avail := s.lots.Available(userID, p.Symbol, settledOnly); avail+eps < p.Quantity {
+			return p, fmt.Errorf("%w: %g %s available to sell", orders.ErrRejected, avail, p.Symbol)
+		}
+	}
+	inst, err := s.repo.GetInstrument(ctx, p.Symbol)
+	if err != nil {
+		return p, err
+	}
+	a.Step = instruments.Increment(inst)
+	a.Schedule = s.schedule(ctx, p.Symbol, a, n)
+	a.Slice, a.ChildID, a.Note = 0, "", ""
+	p.Algo = &a
+	p.Status = models.OrderOpen
+	p.CreatedAt, p.UpdatedAt = now, now
+	p.ExpiresAt = a.EndAt
+	if err := s.repo.SaveOrder(ctx, p); err != nil {
+		return p, err
+	}
+
+	s.mu.Lock()
+	s.parents[p.ID] = &p
+	s.fills[p.ID] = map[string]childFill{}
+	s.seen[p.ID] = s.traded[p.Symbol]
+	out := snapshot(&p)
+	s.mu.Unlock()
+	return out, nil
+}
+
+// schedule is the cumulative share of the parent due by the end of each of
+// n slices: in proportion to each slice's length for TWAP, and to the
+// symbol's average volume at that time of day for VWAP. A VWAP without
+// volume history to go on is scheduled like a TWAP.
+func (s *Service) schedule(ctx context.Context, symbol string, a models.Algo, n int) []float64 {
+	slice := time.Duration(a.SliceSeconds) * time.Second
+	var profile []float64
+	if a.Strategy == models.AlgoVWAP {
+		profile = s.profile(ctx, symbol, a.StartAt)
+	}
+	weights := make([]float64, n)
+	var total float64
+	for i := range weights {
+		from := a.StartAt.Add(time.Duration(i) * slice)
+		to := from.Add(slice)
+		if to.After(a.EndAt) {
+			to = a.EndAt
+		}
+		weights[i] = to.Sub(from).Seconds()
+		if profile != nil {
+			weights[i] *= profile[bucket(from.Add(to.Sub(from)/2))]
+		}
+		total += weights[i]
+	}
+	if total <= 0 {
+		return s.schedule(ctx, symbol, models.Algo{Strategy: models.AlgoTWAP, StartAt: a.StartAt, EndAt: a.EndAt, SliceSeconds: a.SliceSeconds}, n)
+	}
+	var cum float64
+	for i, w := range weights {
+		cum += w
+		weights[i] = cum / total
+	}
+	weights[n-1] = 1
+	return weights
+}
+
+// profile is the symbol's traded volume per time-of-day bucket over the
+// last profileDays days, or nil without any.
+func (s *Service) profile(ctx context.Context, symbol string, at time.Time) []float64 {
+	bars, err := s.repo.ListCandles(ctx, symbol, profileInterval, at.AddDate(0, 0, -s.profileDays), at, time.Time{}, 0)
+	if err != nil {
+		log.Printf("algos: volume profile for %s: %v", symbol, err)
+		return nil
+	}
+	out := make([]float64, 24*4)
+	var total float64
+	for _, b := range bars {
+		out[bucket(b.Start)] += b.Volume
+		total += b.Volume
+	}
+	if total <= 0 {
+		return nil
+	}
+	return out
+}
+
+// bucket is the 15-minute slot of the day t falls in, in local time like
+// the rest of the server's day boundaries.
+func bucket(t time.Time) int {
+	t = t.Local()
+	return (t.Hour()*60 + t.Minute()) / 15
+}
+
+// Pause stops a running parent sending children and cancels its working
+// child.
+func (s *Service) Pause(ctx context.Context, userID, id string) (models.Order, error) {
+	s.mu.Lock()
+	p, err := s.owned(userID, id)
+	if err == nil && p.Status == models.OrderPaused {
+		err = fmt.Errorf("%w: already paused", ErrState)
+	}
+	if err != nil {
+		s.mu.Unlock()
+		return models.Order{}, err
+	}
+	p.Status = models.OrderPaused
+	p.UpdatedAt = time.Now()
+	child := p.Algo.ChildID
+	s.mu.Unlock()
+
+	s.cancelChild(ctx, userID, child)
+	return s.publish(id), nil
+}
+
+// Resume restarts a paused parent. Slices missed while paused are caught
+// up from the next one, within any participation cap.
+func (s *Service) Resume(ctx context.Context, userID, id string) (models.Order, error) {
+	s.mu.Lock()
+	p, err := s.owned(userID, id)
+	if err == nil && p.Status != models.OrderPaused {
+		err = fmt.Errorf("%w: not paused", ErrState)
+	}
+	if err != nil {
+		s.mu.Unlock()
+		return models.Order{}, err
+	}
+	p.Status = models.OrderOpen
+	if p.FilledQty > eps {
+		p.Status = models.OrderPartiallyFilled
+	}
+	p.UpdatedAt = time.Now()
+	s.seen[id] = s.traded[p.Symbol]
+	s.mu.Unlock()
+	return s.publish(id), nil
+}
+
+// Cancel stops a parent for good and cancels its working child. It
+// reports false when id is not one of the user's running or paused
+// parents.
+func (s *Service) Cancel(ctx context.Context, userID, id string) (models.Order, bool, error) {
+	s.mu.Lock()
+	p, err := s.owned(userID, id)
+	if err != nil {
+		s.mu.Unlock()
+		return models.Order{}, false, nil
+	}
+	child := p.Algo.ChildID
+	s.mu.Unlock()
+
+	s.cancelChild(ctx, userID, child)
+	return s.finish(id, models.OrderCancelled, time.Now()), true, nil
+}
+
+// CancelSymbol cancels every parent in symbol.
+func (s *Service) CancelSymbol(ctx context.Context, symbol string) error {
+	s.mu.Lock()
+	var list []models.Order
+	for _, p := range s.parents {
+		if p.Symbol == symbol {
+			list = append(list, *p)
+		}
+	}
+	s.mu.Unlock()
+	for _, p := range list {
+		if _, _, err := s.Cancel(ctx, p.UserID, p.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get returns one of the user's parent orders with its children.
+func (s *Service) Get(ctx context.Context, userID, id string) (models.Order, []models.Order, error) {
+	s.mu.Lock()
+	p, err := s.owned(userID, id)
+	var out models.Order
+	if err == nil {
+		out = snapshot(p)
+	}
+	s.mu.Unlock()
+	if err != nil {
+		stored, err := s.repo.GetOrder(ctx, id)
+		if errors.Is(err, repository.ErrNotFound) || (err == nil && (stored.Algo == nil || stored.UserID != userID)) {
+			return models.Order{}, nil, ErrNotFound
+		}
+		if err != nil {
+			return models.Order{}, nil, err
+		}
+		out = *stored
+	}
+	children, err := s.repo.ListChildOrders(ctx, id)
+	if err != nil {
+		return out, nil, err
+	}
+	return out, children, nil
+}
+
+func (s *Service) owned(userID, id string) (*models.Order, error) {
+	p, ok := s.parents[id]
+	if !ok || p.UserID != userID {
+		return nil, ErrNotFound
+	}
+	return p, nil
+}
+
+func (s *Service) cancelChild(ctx context.Context, userID, childID string) {
+	if childID == "" {
+		return
+	}
+	// The child may have filled or been cancelled in the meantime.
+	_, _ = s.orders.Cancel(ctx, userID, childID)
+}
+
+// finish ends a parent: filled if its children filled it, otherwise with
+// the given status.
+func (s *Service) finish(id, status string, now time.Time) models.Order {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.parents[id]
+	if !ok {
+		return models.Order{}
+	}
+	p.Status = status
+	if p.FilledQty+eps >= p.Quantity {
+		p.Status = models.OrderFilled
+	}
+	p.Algo.ChildID = ""
+	p.UpdatedAt = now
+	delete(s.parents, id)
+	delete(s.fills, id)
+	delete(s.seen, id)
+	out := snapshot(p)
+	s.enqueue(out)
+	return out
+}
+
+// publish queues a parent's current state for persistence and returns it.
+func (s *Service) publish(id string) models.Order {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.parents[id]
+	if !ok {
+		return models.Order{}
+	}
+	out := snapshot(p)
+	s.enqueue(out)
+	return out
+}
+
+// snapshot copies a parent so it can leave the lock.
+func snapshot(p *models.Order) models.Order {
+	out := *p
+	a := *p.Algo
+	out.Algo = &a
+	return out
+}
+
+// tick sends the slices that are due and expires parents past their
+// window.
+func (s *Service) tick(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	var due []models.Order
+	for _, p := range s.parents {
+		a := p.Algo
+		if p.Status == models.OrderPaused || now.Before(a.StartAt) {
+			continue
+		}
+		if !now.Before(a.EndAt) || s.sliceAt(a, now) >= a.Slice {
+			due = append(due, snapshot(p))
+		}
+	}
+	s.mu.Unlock()
+	for _, p := range due {
+		s.send(ctx, p, now)
+	}
+}
+
+func (s *Service) sliceAt(a *models.Algo, now time.Time) int {
+	k := int(now.Sub(a.StartAt) / (time.Duration(a.SliceSeconds) * time.Second))
+	return min(k, len(a.Schedule)-1)
+}
+
+// send replaces a parent's working child with the next slice.
+func (s *Service) send(ctx context.Context, p models.Order, now time.Time) {
+	s.cancelChild(ctx, p.UserID, p.Algo.ChildID)
+	if !now.Before(p.Algo.EndAt) {
+		s.finish(p.ID, models.OrderExpired, now)
+		return
+	}
+
+	s.mu.Lock()
+	cur, ok := s.parents[p.ID]
+	if !ok || cur.Status == models.OrderPaused {
+		s.mu.Unlock()
+		return
+	}
+	a := cur.Algo
+	k := s.sliceAt(a, now)
+	a.Slice = k + 1
+	want := cur.Quantity*a.Schedule[k] - cur.FilledQty
+	if k == len(a.Schedule)-1 {
+		want = cur.Remaining()
+	}
+	want = floorStep(want, a.Step)
+	note := ""
+	volume := s.traded[cur.Symbol] - s.seen[cur.ID]
+	s.seen[cur.ID] = s.traded[cur.Symbol]
+	if a.MaxParticipation > 0 {
+		if c := floorStep(a.MaxParticipation*volume, a.Step); c < want {
+			want, note = c, fmt.Sprintf("capped at %g%% of %g traded", a.MaxParticipation*100, volume)
+		}
+	}
+	s.mu.Unlock()
+
+	switch {
+	case !s.cal.Status(p.Exchange, now).IsOpen:
+		want, note = 0, "market closed"
+	case p.Type == models.OrderTypeLimit && s.through(p):
+		want, note = 0, "quote through limit price"
+	case want < a.Step-eps && note == "":
+		note = "ahead of schedule"
+	}
+
+	var childID string
+	if want >= a.Step-eps {
+		child, err := s.orders.Place(ctx, p.UserID, models.Order{
+			Symbol:    p.Symbol,
+			Side:      p.Side,
+			Type:      p.Type,
+			Quantity:  want,
+			Price:     p.Price,
+			Validity:  models.ValidityDay,
+			Product:   p.Product,
+			LotMethod: p.LotMethod,
+			Short:     p.Short,
+			ParentID:  p.ID,
+		})
+		switch {
+		case err != nil:
+			note = err.Error()
+			log.Printf("algos: %s slice %d: %v", p.ID, k+1, err)
+		case child.Status == models.OrderOpen || child.Status == models.OrderPartiallyFilled || child.Status == models.OrderQueued:
+			childID = child.ID
+		}
+	}
+
+	s.mu.Lock()
+	if cur, ok := s.parents[p.ID]; ok {
+		cur.Algo.ChildID, cur.Algo.Note = childID, note
+		cur.UpdatedAt = now
+		s.enqueue(snapshot(cur))
+	}
+	s.mu.Unlock()
+}
+
+// through reports whether the quote a limit parent's child would take is
+// beyond its limit.
+func (s *Service) through(p models.Order) bool {
+	q, ok := s.prices.Quote(p.Symbol)
+	if !ok {
+		return false
+	}
+	if p.Side == "buy" {
+		return q.Ask > 0 && q.Ask > p.Price+eps
+	}
+	return q.Bid > 0 && q.Bid < p.Price-eps
+}
+
+func floorStep(q, step float64) float64 {
+	if q <= 0 {
+		return 0
+	}
+	n := math.Floor(q/step + 1e-6)
+	scale := math.Pow(10, math.Max(math.Ceil(-math.Log10(step)), 0))
+	return math.Round(n*step*scale) / scale
+}
+
+// OnOrder rolls a child's fills up into its parent.
+func (s *Service) OnOrder(o models.Order) {
+	if o.ParentID == "" {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.parents[o.ParentID]
+	if !ok {
+		return
+	}
+	s.record(p, o)
+	p.UpdatedAt = o.UpdatedAt
+	if p.FilledQty+eps >= p.Quantity {
+		p.Status = models.OrderFilled
+		p.Algo.ChildID = ""
+		delete(s.parents, p.ID)
+		delete(s.fills, p.ID)
+		delete(s.seen, p.ID)
+	}
+	s.enqueue(snapshot(p))
+}
+
+// record updates a parent from one child's state.
+func (s *Service) record(p *models.Order, child models.Order) {
+	fills := s.fills[p.ID]
+	fills[child.ID] = childFill{qty: child.FilledQty, value: child.FilledQty * child.AvgFillPrice}
+	var qty, value float64
+	for _, f := range fills {
+		qty += f.qty
+		value += f.value
+	}
+	p.FilledQty = qty
+	if qty > 0 {
+		p.AvgFillPrice = value / qty
+		if p.Status == models.OrderOpen {
+			p.Status = models.OrderPartiallyFilled
+		}
+	}
+	switch child.Status {
+	case models.OrderOpen, models.OrderPartiallyFilled, models.OrderQueued:
+		p.Algo.ChildID = child.ID
+	default:
+		if p.Algo.ChildID == child.ID {
+			p.Algo.ChildID = ""
+		}
+	}
+}
+
+func (s *Service) OnFill(models.Fill) {}
+
+// OnTrade counts market volume for participation caps.
+func (s *Service) OnTrade(t models.Trade) {
+	s.mu.Lock()
+	s.traded[t.Symbol] += t.Quantity
+	s.mu.Unlock()
+}
+
+func (s *Service) OnQuote(models.Quote) {}
+
+// enqueue queues a parent update for persistence. None is dropped: a
+// parent restored in a stale state could slice its children again.
+func (s *Service) enqueue(p models.Order) { s.events.Push(p) }
+
+// Run sends due slices every second and persists parent updates.
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.tick(ctx, now)
+		case <-s.events.Ready():
+			err := s.events.Flush(func(p models.Order) error {
+				return s.repo.SaveOrder(ctx, p)
+			})
+			if err != nil {
+				log.Printf("algos: persist parent: %v; retrying", err)
+			}
+		}
+	}
+}
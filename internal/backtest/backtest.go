@@ -0,0 +1,503 @@
+package backtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/calendar"
+	"github.com/hahahamid/broker-backend/internal/charges"
+	"github.com/hahahamid/broker-backend/internal/fx"
+	"github.com/hahahamid/broker-backend/internal/lots"
+	"github.com/hahahamid/broker-backend/internal/marketdata"
+	"github.com/hahahamid/broker-backend/internal/matching"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/orders"
+	"github.com/hahahamid/broker-backend/internal/portfolio"
+	"github.com/hahahamid/broker-backend/internal/strategy"
+)
+
+var ErrInvalid = errors.New("invalid backtest")
+
+// userID owns every order and lot in a backtest.
+const userID = "backtest"
+
+type Config struct {
+	Strategy    string // name reported with the result
+	TickFile    string // replayed when set; otherwise the seeded simulator runs
+	Instruments []models.Instrument
+	Calendar    *calendar.Calendar // nil treats every exchange as always open
+	Charges     *charges.Schedule  // nil charges nothing
+	Seed        int64
+	Cash        float64       // starting cash, 100000 when zero
+	Interval    time.Duration // between timer callbacks and equity points, a minute when zero
+	Steps       int           // simulator steps, one per Interval, 1000 when zero
+	Start       time.Time     // first simulator step; required without a tick file
+	LotMethod   string        // fifo or lifo
+}
+
+// Run replays the tick file, or the simulator, through a private matching
+// engine and lot ledger and trades strat against it. Orders pass the same
+// instrument, session and holdings checks as live orders and fills are
+// charged from the schedule. Everything runs on one goroutine against a
+// clock driven by the ticks, so a run is repeatable for a given seed.
+// Amounts are not converted, so instruments should share a currency.
+func Run(ctx context.Context, cfg Config, strat strategy.Strategy) (*models.BacktestResult, error) {
+	if cfg.Cash == 0 {
+		cfg.Cash = 100000
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = time.Minute
+	}
+	if cfg.Steps <= 0 {
+		cfg.Steps = 1000
+	}
+	if cfg.Cash < 0 {
+		return nil, fmt.Errorf("%w: cash must be positive", ErrInvalid)
+	}
+	if cfg.TickFile == "" && cfg.Start.IsZero() {
+		return nil, fmt.Errorf("%w: the simulator needs a start time", ErrInvalid)
+	}
+
+	var feed marketdata.MarketDataFeed
+	if cfg.TickFile != "" {
+		feed = marketdata.NewReplayer(cfg.TickFile, 0)
+	} else {
+		feed = marketdata.NewSimulator(marketdata.SimConfig{
+			Seed:     cfg.Seed,
+			Interval: cfg.Interval,
+			Steps:    cfg.Steps,
+			Start:    cfg.Start,
+		}, cfg.Instruments)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	r := newRunner(cfg, strat)
+	go r.lots.Run(ctx)
+	unsubscribe := feed.Subscribe(r)
+	defer unsubscribe()
+	if err := feed.Run(ctx); err != nil {
+		return nil, err
+	}
+	if r.start.IsZero() {
+		return nil, fmt.Errorf("%w: no ticks to replay", ErrInvalid)
+	}
+	return r.result(), nil
+}
+
+// runner is the strategy's broker and the only subscriber to the feed and
+// the engine.
+type runner struct {
+	cfg         Config
+	strat       strategy.Strategy
+	rng         *rand.Rand
+	engine      *matching.Engine
+	lots        *lots.Service
+	prices      *marketdata.PriceCache
+	instruments map[string]*models.Instrument
+	exchanges   []string
+
+	now, start, next time.Time
+	seq, fillSeq     int
+	cash, charges    float64
+	open             map[string]models.Order // resting orders by ID
+	filled           map[string]float64      // value filled so far by order ID, for brokerage caps
+	closed           int                     // closed lots already attributed to a fill
+	pending          []models.Fill           // fills not yet delivered to the strategy
+	fills            []models.BacktestFill
+	curve            []models.EquityPoint
+	stats            models.BacktestStats
+}
+
+func newRunner(cfg Config, strat strategy.Strategy) *runner {
+	r := &runner{
+		cfg:         cfg,
+		strat:       strat,
+		rng:         rand.New(rand.NewSource(cfg.Seed)),
+		engine:      matching.NewEngine(),
+		prices:      marketdata.NewPriceCache(),
+		instruments: map[string]*models.Instrument{},
+		cash:        cfg.Cash,
+		open:        map[string]models.Order{},
+		filled:      map[string]float64{},
+	}
+	r.lots = lots.NewService(discard{}, r.prices, cfg.Calendar, fx.NewConverter(nil, ""), cfg.LotMethod, 365*24*time.Hour)
+	seen := map[string]bool{}
+	for i := range cfg.Instruments {
+		inst := &cfg.Instruments[i]
+		r.instruments[inst.Symbol] = inst
+		if inst.Multiplier > 0 {
+			r.lots.SetMultiplier(inst.Symbol, inst.Multiplier)
+		}
+		if !seen[inst.Exchange] {
+			seen[inst.Exchange] = true
+			r.exchanges = append(r.exchanges, inst.Exchange)
+		}
+	}
+	sort.Strings(r.exchanges)
+
+	r.engine.SetClock(func() time.Time { return r.now })
+	r.engine.SetIDs(func() string {
+		r.fillSeq++
+		return fmt.Sprintf("fill-%08d", r.fillSeq)
+	})
+	r.engine.AddListener(r.lots)
+	r.engine.AddListener(r)
+	return r
+}
+
+func (r *runner) OnQuote(q models.Quote) {
+	r.advance(q.Time)
+	r.stats.Events++
+	r.engine.OnQuote(q)
+	r.prices.OnQuote(q)
+	r.drain()
+	r.strat.OnQuote(r, q)
+	r.drain()
+}
+
+func (r *runner) OnTrade(t models.Trade) {
+	r.advance(t.Time)
+	r.stats.Events++
+	r.prices.OnTrade(t)
+}
+
+// advance moves the clock to t, expiring DAY orders whose session closed
+// on the way and firing the timer once if an interval boundary was
+// crossed. Quiet stretches such as nights get a single timer call rather
+// than one per interval. Ticks out of order never move the clock back.
+func (r *runner) advance(t time.Time) {
+	if r.start.IsZero() {
+		r.start, r.now = t, t
+		r.next = t.Truncate(r.cfg.Interval).Add(r.cfg.Interval)
+		r.point(t)
+		return
+	}
+	if t.Before(r.now) {
+		return
+	}
+	if !t.Before(r.next) {
+		at := t.Truncate(r.cfg.Interval)
+		r.expire(at)
+		r.now = at
+		r.timer(at)
+		r.next = at.Add(r.cfg.Interval)
+	}
+	r.expire(t)
+	r.now = t
+}
+
+func (r *runner) expire(through time.Time) {
+	var due []models.Order
+	for _, o := range r.open {
+		if !o.ExpiresAt.IsZero() && !through.Before(o.ExpiresAt) {
+			due = append(due, o)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if !due[i].ExpiresAt.Equal(due[j].ExpiresAt) {
+			return due[i].ExpiresAt.Before(due[j].ExpiresAt)
+		}
+		return due[i].ID < due[j].ID
+	})
+	for _, o := range due {
+		r.now = o.ExpiresAt
+		r.engine.Expire(o.ID)
+	}
+}
+
+func (r *runner) timer(at time.Time) {
+	for _, ex := range r.exchanges {
+		r.lots.Settle(ex, at)
+	}
+	r.point(at)
+	r.strat.OnTimer(r, at)
+	r.drain()
+}
+
+// drain hands queued fills to the strategy. Fills are queued rather than
+// delivered from the engine listener because the strategy may place
+// orders, which the engine cannot take while it is still delivering.
+func (r *runner) drain() {
+	for len(r.pending) > 0 {
+		f := r.pending[0]
+		r.pending = r.pending[1:]
+		r.strat.OnFill(r, f)
+	}
+}
+
+func (r *runner) point(at time.Time) {
+	value := r.value()
+	r.curve = append(r.curve, models.EquityPoint{
+		Time:   at,
+		Cash:   r.cash,
+		Value:  value,
+		Equity: r.cash + value,
+		PNL:    r.cash + value - r.cfg.Cash,
+	})
+}
+
+// value marks the open lots to market, at cost when a symbol has no price.
+func (r *runner) value() float64 {
+	var v float64
+	for _, lot := range r.lots.Lots(userID, "") {
+		price, ok := r.prices.Mark(lot.Symbol)
+		if !ok {
+			price = lot.Price
+		}
+		v += lot.Quantity * price
+	}
+	return v
+}
+
+func (r *runner) OnOrder(o models.Order) {
+	switch o.Status {
+	case models.OrderOpen, models.OrderPartiallyFilled:
+		r.open[o.ID] = o
+	default:
+		delete(r.open, o.ID)
+		delete(r.filled, o.ID)
+	}
+}
+
+// OnFill settles a fill in cash and charges it the way the cash and
+// charges services would. The lots service has seen the fill already, so
+// the lots it closed are the newest.
+func (r *runner) OnFill(f models.Fill) {
+	value := f.Quantity * f.Price
+	prior := r.filled[f.OrderID]
+	r.filled[f.OrderID] = prior + value
+	segment := "default"
+	if inst, ok := r.instruments[f.Symbol]; ok {
+		segment = strings.ToLower(inst.AssetClass)
+	}
+	product := f.Product
+	if product == "" {
+		product = models.ProductDelivery
+	}
+	var cost float64
+	for _, c := range r.cfg.Charges.Calculate(segment, product, f.Side, value, prior) {
+		cost += c.Amount
+	}
+
+	if f.Side == "buy" {
+		r.cash -= value
+	} else {
+		r.cash += value
+	}
+	r.cash -= cost
+	r.charges += cost
+
+	closed := r.lots.Realized(userID)
+	var pnl float64
+	for _, c := range closed[:len(closed)-r.closed] {
+		pnl += c.RealizedPNL
+	}
+	r.closed = len(closed)
+
+	r.fills = append(r.fills, models.BacktestFill{Fill: f, Charges: cost, RealizedPNL: pnl, Cash: r.cash})
+	r.pending = append(r.pending, f)
+}
+
+func (r *runner) Now() time.Time {
+	return r.now
+}
+
+func (r *runner) Rand() *rand.Rand {
+	return r.rng
+}
+
+func (r *runner) Cash() float64 {
+	return r.cash
+}
+
+func (r *runner) Mark(symbol string) (float64, bool) {
+	return r.prices.Mark(symbol)
+}
+
+func (r *runner) Position(symbol string) float64 {
+	var qty float64
+	for _, lot := range r.lots.Lots(userID, symbol) {
+		qty += lot.Quantity
+	}
+	return qty
+}
+
+func (r *runner) OpenOrders() []models.Order {
+	out := make([]models.Order, 0, len(r.open))
+	for _, o := range r.open {
+		out = append(out, o)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+func (r *runner) Cancel(orderID string) error {
+	if _, ok := r.open[orderID]; !ok {
+		return matching.ErrOrderNotFound
+	}
+	_, err := r.engine.Cancel(orderID)
+	return err
+}
+
+func (r *runner) Place(o models.Order) (models.Order, error) {
+	r.stats.Orders++
+	o, err := r.place(o)
+	if err != nil {
+		r.stats.Rejected++
+	}
+	return o, err
+}
+
+// place checks an order like orders.Service does and submits it. Orders
+// that need the house account, borrow or an algo scheduler are out of
+// scope for a backtest.
+func (r *runner) place(o models.Order) (models.Order, error) {
+	if o.Notional != 0 || o.Short || o.AfterMarket || o.Algo != nil {
+		return o, fmt.Errorf("%w: backtests take quantity orders; notional, short, after-market and algo orders are not supported", orders.ErrRejected)
+	}
+	if err := orders.Normalize(&o); err != nil {
+		return o, err
+	}
+	inst := r.instruments[o.Symbol]
+	if err := orders.Validate(&o, inst, r.cfg.Calendar, r.now); err != nil {
+		return o, err
+	}
+	if o.Fractional {
+		return o, fmt.Errorf("%w: fractional orders are not supported in backtests", orders.ErrRejected)
+	}
+
+	r.seq++
+	o.ID = fmt.Sprintf("order-%08d", r.seq)
+	o.UserID = userID
+	o.Exchange = inst.Exchange
+	o.Currency = inst.Currency
+	o.FilledQty, o.AvgFillPrice = 0, 0
+	o.ExpiresAt = time.Time{}
+	o.HouseOrderID, o.BasketID, o.ParentID = "", "", ""
+	o.BuyIn, o.Settlement = false, false
+	o.CreatedAt = r.now
+
+	if st := r.cfg.Calendar.Status(o.Exchange, r.now); !st.IsOpen {
+		return o, fmt.Errorf("%w: %s is closed (%s)", orders.ErrRejected, o.Exchange, st.Session)
+	}
+	if err := r.lots.Reserve(&o); err != nil {
+		return o, fmt.Errorf("%w: %v", orders.ErrRejected, err)
+	}
+	if o.Validity == models.ValidityDay && o.Type == models.OrderTypeLimit {
+		o.ExpiresAt = r.cfg.Calendar.SessionClose(o.Exchange, r.now)
+	}
+	return r.engine.Submit(o), nil
+}
+
+func (r *runner) result() *models.BacktestResult {
+	// The last point is taken after the final ticks, replacing a timer
+	// point taken at the same time before them.
+	if last := r.curve[len(r.curve)-1]; last.Time.Equal(r.now) {
+		r.curve = r.curve[:len(r.curve)-1]
+	}
+	r.point(r.now)
+	res := &models.BacktestResult{
+		Strategy:  r.cfg.Strategy,
+		Seed:      r.cfg.Seed,
+		Start:     r.start,
+		End:       r.now,
+		Positions: []models.BacktestPosition{},
+		Fills:     r.fills,
+		Curve:     r.curve,
+	}
+	if res.Fills == nil {
+		res.Fills = []models.BacktestFill{}
+	}
+
+	st := &r.stats
+	st.StartCash = r.cfg.Cash
+	st.Fills = len(r.fills)
+	st.Charges = r.charges
+	for _, c := range r.lots.Realized(userID) {
+		st.RealizedPNL += c.RealizedPNL
+		st.Trades++
+		if c.RealizedPNL > 0 {
+			st.Wins++
+		}
+	}
+	if st.Trades > 0 {
+		st.WinRate = float64(st.Wins) / float64(st.Trades)
+	}
+
+	var pos *models.BacktestPosition
+	var cost float64
+	for _, lot := range r.lots.Lots(userID, "") {
+		if pos == nil || pos.Symbol != lot.Symbol {
+			res.Positions = append(res.Positions, models.BacktestPosition{Symbol: lot.Symbol})
+			pos, cost = &res.Positions[len(res.Positions)-1], 0
+		}
+		pos.Quantity += lot.Quantity
+		cost += lot.Quantity * lot.Price
+		pos.AvgPrice = cost / pos.Quantity
+	}
+	for i := range res.Positions {
+		p := &res.Positions[i]
+		p.LastPrice, _ = r.prices.Mark(p.Symbol)
+		p.UnrealizedPNL = r.prices.UnrealizedPNL(p.Symbol, p.Quantity, p.AvgPrice)
+		st.UnrealizedPNL += p.UnrealizedPNL
+	}
+
+	equity := make([]float64, len(r.curve))
+	for i, pt := range r.curve {
+		equity[i] = pt.Equity
+	}
+	st.FinalEquity = equity[len(equity)-1]
+	st.NetPNL = st.RealizedPNL + st.UnrealizedPNL - st.Charges
+	if st.StartCash > 0 {
+		st.Return = st.FinalEquity/st.StartCash - 1
+	}
+	st.MaxDrawdown, _, _ = portfolio.MaxDrawdown(equity)
+	st.Sharpe = sharpe(equity)
+	res.Stats = *st
+	return res
+}
+
+// sharpe is the mean return between equity points over its sample
+// standard deviation, zero when there is no variation to measure.
+func sharpe(equity []float64) float64 {
+	var rets []float64
+	for i := 1; i < len(equity); i++ {
+		if equity[i-1] > 0 {
+			rets = append(rets, equity[i]/equity[i-1]-1)
+		}
+	}
+	if len(rets) < 2 {
+		return 0
+	}
+	var mean float64
+	for _, x := range rets {
+		mean += x
+	}
+	mean /= float64(len(rets))
+	var ss float64
+	for _, x := range rets {
+		ss += (x - mean) * (x - mean)
+	}
+	sd := math.Sqrt(ss / float64(len(rets)-1))
+	if sd < 1e-12 {
+		return 0
+	}
+	return mean / sd
+}
+
+// discard stores nothing: a backtest keeps its lots in memory only.
+type discard struct{}
+
+func (discard) SaveLot(context.Context, models.Lot) error             { return nil }
+func (discard) ListOpenLots(context.Context) ([]models.Lot, error)    { return nil, nil }
+func (discard) SaveClosedLot(context.Context, models.ClosedLot) error { return nil }
+func (discard) ListClosedLots(context.Context, string) ([]models.ClosedLot, error) {
+	return nil, nil
+}
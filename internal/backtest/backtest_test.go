@@ -0,0 +1,53 @@
+package backtest
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/strategy"
+)
+
+func runRandom(t *testing.T, seed int64) *models.BacktestResult {
+	t.Helper()
+	strat, err := strategy.New("random", strategy.Params{"symbol": "ACME", "prob": "0.2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := Run(context.Background(), Config{
+		Strategy: "random",
+		Instruments: []models.Instrument{{
+			Symbol: "ACME", Exchange: "X", Currency: "USD", TickSize: 0.01, LotSize: 1,
+			PrevClose: 100, Status: models.InstrumentActive,
+		}},
+		Seed:  seed,
+		Steps: 500,
+		Start: time.Date(2024, 3, 4, 9, 30, 0, 0, time.UTC),
+	}, strat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func TestRunRepeatsForSeed(t *testing.T) {
+	a, b := runRandom(t, 7), runRandom(t, 7)
+	if len(a.Fills) == 0 {
+		t.Fatal("the run made no fills to compare")
+	}
+	if !reflect.DeepEqual(a.Fills, b.Fills) {
+		t.Errorf("fills differ between runs: %d vs %d", len(a.Fills), len(b.Fills))
+	}
+	if !reflect.DeepEqual(a.Curve, b.Curve) {
+		t.Errorf("equity curves differ between runs")
+	}
+	if a.Stats != b.Stats {
+		t.Errorf("stats differ: %+v vs %+v", a.Stats, b.Stats)
+	}
+
+	if c := runRandom(t, 8); reflect.DeepEqual(a.Fills, c.Fills) {
+		t.Error("another seed made the same fills")
+	}
+}
@@ -0,0 +1,378 @@
+package baskets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/calendar"
+	"github.com/hahahamid/broker-backend/internal/cash"
+	"github.com/hahahamid/broker-backend/internal/charges"
+	"github.com/hahahamid/broker-backend/internal/fx"
+	"github.com/hahahamid/broker-backend/internal/instruments"
+	"github.com/hahahamid/broker-backend/internal/lots"
+	"github.com/hahahamid/broker-backend/internal/marketdata"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/orders"
+	"github.com/hahahamid/broker-backend/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	ErrInvalid  = errors.New("invalid basket")
+	ErrRejected = errors.New("basket rejected")
+)
+
+// MaxLegs caps the number of orders in one basket.
+const MaxLegs = 100
+
+const eps = 1e-9
+
+// Service places baskets of orders all or nothing. Every leg is validated
+// first — the order itself, sells against holdings net of what working
+// sells have reserved, and the basket's buys less its sells against the
+// cash in each currency not committed to working buys — and only if all
+// pass are the legs placed. Should
+// a leg still be rejected when placed, the legs placed before it are
+// cancelled; market legs may already have filled by then.
+type Service struct {
+	repo    repository.Repo
+	orders  *orders.Service
+	lots    *lots.Service
+	cash    *cash.Service
+	charges *charges.Service
+	prices  *marketdata.PriceCache
+	cal     *calendar.Calendar
+	fx      *fx.Converter
+}
+
+func NewService(repo repository.Repo, orderSvc *orders.Service, lotSvc *lots.Service, cashSvc *cash.Service, chargeSvc *charges.Service, prices *marketdata.PriceCache, cal *calendar.Calendar, conv *fx.Converter) *Service {
+	return &Service{
+		repo:    repo,
+		orders:  orderSvc,
+		lots:    lotSvc,
+		cash:    cashSvc,
+		charges: chargeSvc,
+		prices:  prices,
+		cal:     cal,
+		fx:      conv,
+	}
+}
+
+// Validate checks every leg of a basket without placing anything. It
+// returns ErrRejected when any leg fails or the funds fall short; the
+// check says which.
+func (s *Service) Validate(ctx context.Context, userID string, legs []models.Order) (models.BasketCheck, error) {
+	check := models.BasketCheck{Legs: make([]models.BasketLeg, len(legs)), Funds: []models.BasketFunds{}}
+	switch {
+	case len(legs) == 0:
+		return check, fmt.Errorf("%w: a basket needs at least one leg", ErrInvalid)
+	case len(legs) > MaxLegs:
+		return check, fmt.Errorf("%w: at most %d legs", ErrInvalid, MaxLegs)
+	}
+
+	failed := false
+	fail := func(i int, err error) {
+		if check.Legs[i].Error == "" {
+			check.Legs[i].Error = err.Error()
+		}
+		failed = true
+	}
+	sells := map[string]float64{}
+	funds := map[string]*models.BasketFunds{}
+	for i, leg := range legs {
+		o, err := s.orders.Check(ctx, userID, leg)
+		o.ID = ""
+		check.Legs[i].Order = o
+		if err != nil {
+			fail(i, err)
+			continue
+		}
+		if o.Side == "sell" && !o.Short {
+			sells[o.Symbol] += o.Quantity
+		}
+		price, ok := s.price(o)
+		if !ok {
+			fail(i, fmt.Errorf("no price for %s to check funds against", o.Symbol))
+			continue
+		}
+		est, err := s.charges.Estimate(ctx, o.Symbol, o.Side, o.Product, o.Quantity, price)
+		if err != nil {
+			fail(i, err)
+			continue
+		}
+		ccy := s.fx.Currency(o.Currency)
+		f, ok := funds[ccy]
+		if !ok {
+			f = &models.BasketFunds{Currency: ccy}
+			funds[ccy] = f
+		}
+		if o.Side == "buy" {
+			f.Buys += est.NetAmount
+		} else {
+			f.Sells += est.NetAmount
+		}
+	}
+
+	// Sells are checked together, since legs in one symbol share holdings.
+	for i, leg := range check.Legs {
+		o := leg.Order
+		if leg.Error != "" || o.Side != "sell" || o.Short {
+			continue
+		}
+		settledOnly := s.cal.BlocksUnsettledSells(o.Exchange)
+		if avail := s.lots.Available(userID, o.Symbol, settledOnly); avail+eps < sells[o.Symbol] {
+			fail(i, fmt.Errorf("basket sells %g %s, %g available to sell", sells[o.Symbol], o.Symbol, avail))
+		}
+	}
+
+	if len(funds) > 0 {
+		bal, err := s.cash.Balances(ctx, userID)
+		if err != nil {
+			return check, err
+		}
+		held := s.cash.Held(userID)
+		for _, f := range funds {
+			f.Required = f.Buys - f.Sells
+			f.Committed = held[f.Currency]
+			f.Available = bal[f.Currency] - f.Committed
+			check.Funds = append(check.Funds, *f)
+			if f.Required > f.Available+eps {
+				failed = true
+			}
+		}
+		sort.Slice(check.Funds, func(i, j int) bool { return check.Funds[i].Currency < check.Funds[j].Currency })
+	}
+	if failed {
+		return check, fmt.Errorf("%w: %s", ErrRejected, summary(check))
+	}
+	return check, nil
+}
+
+// price is what a leg is expected to trade at: its limit, else the side of
+// the quote it would take, else the last price.
+func (s *Service) price(o models.Order) (float64, bool) {
+	if o.Type == models.OrderTypeLimit {
+		return o.Price, true
+	}
+	if q, ok := s.prices.Quote(o.Symbol); ok {
+		if o.Side == "buy" && q.Ask > 0 {
+			return q.Ask, true
+		}
+		if o.Side == "sell" && q.Bid > 0 {
+			return q.Bid, true
+		}
+	}
+	mark, ok := s.prices.Mark(o.Symbol)
+	return mark, ok && mark > 0
+}
+
+func summary(check models.BasketCheck) string {
+	var msgs []string
+	for i, leg := range check.Legs {
+		if leg.Error != "" {
+			msgs = append(msgs, fmt.Sprintf("leg %d (%s): %s", i+1, leg.Order.Symbol, leg.Error))
+		}
+	}
+	for _, f := range check.Funds {
+		if f.Required > f.Available+eps {
+			msgs = append(msgs, fmt.Sprintf("needs %.2f %s, %.2f available", f.Required, f.Currency, f.Available))
+		}
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Place validates a basket and, if every leg passes, places them all.
+func (s *Service) Place(ctx context.Context, userID, name string, legs []models.Order) (models.Basket, models.BasketCheck, error) {
+	check, err := s.Validate(ctx, userID, legs)
+	if err != nil {
+		return models.Basket{}, check, err
+	}
+	b := models.Basket{
+		ID:        primitive.NewObjectID().Hex(),
+		UserID:    userID,
+		Name:      strings.TrimSpace(name),
+		CreatedAt: time.Now(),
+	}
+	for i, leg := range legs {
+		leg.BasketID = b.ID
+		// The basket's funds were checked above, buys net of sells, so a
+		// buy funded by the basket's own sells is not refused on its own.
+		o, err := s.orders.PlaceFunded(ctx, userID, leg)
+		if err != nil {
+			check.Legs[i].Error = err.Error()
+			s.unwind(ctx, userID, b.Orders)
+			return models.Basket{}, check, fmt.Errorf("%w: leg %d (%s): %v; the %d legs placed before it were cancelled",
+				ErrRejected, i+1, leg.Symbol, err, i)
+		}
+		check.Legs[i].Order = o
+		b.OrderIDs = append(b.OrderIDs, o.ID)
+		b.Orders = append(b.Orders, o)
+	}
+	if err := s.repo.SaveBasket(ctx, b); err != nil {
+		// The orders stand and carry the basket ID, so the basket is only
+		// missing its name.
+		log.Printf("baskets: save %s: %v", b.ID, err)
+	}
+	return b, check, nil
+}
+
+// unwind cancels whatever of the placed legs is still working.
+func (s *Service) unwind(ctx context.Context, userID string, placed []models.Order) {
+	for _, o := range placed {
+		if _, err := s.orders.Cancel(ctx, userID, o.ID); err != nil {
+			log.Printf("baskets: cancel leg %s: %v", o.ID, err)
+		}
+	}
+}
+
+// Get returns one of the user's baskets with its orders as they now stand.
+func (s *Service) Get(ctx context.Context, userID, id string) (*models.Basket, error) {
+	b, err := s.repo.GetBasket(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if b.Orders, err = s.repo.ListBasketOrders(ctx, b.ID); err != nil {
+		return nil, err
+	}
+	s.lots.AttachPNL(userID, b.Orders)
+	return b, nil
+}
+
+// List returns the user's baskets, newest first, without their orders.
+func (s *Service) List(ctx context.Context, userID string) ([]models.Basket, error) {
+	return s.repo.ListBaskets(ctx, userID)
+}
+
+// Rebalance works out the market orders that move the user's holdings to
+// the target weights of holdings plus cash, at current prices. Held
+// symbols without a target are sold; whatever the weights leave over stays
+// in cash. Quantities are rounded down to the lot size, or the increment
+// for fractional instruments, so the result lands at or just under each
+// target. With execute the orders are placed as a basket, sells first.
+func (s *Service) Rebalance(ctx context.Context, userID string, targets []models.RebalanceTarget, execute bool) (models.RebalancePlan, error) {
+	plan := models.RebalancePlan{Currency: s.fx.Base(), Legs: []models.RebalanceLeg{}}
+	weights := map[string]float64{}
+	var total float64
+	for _, t := range targets {
+		sym := strings.ToUpper(strings.TrimSpace(t.Symbol))
+		switch {
+		case sym == "":
+			return plan, fmt.Errorf("%w: target without a symbol", ErrInvalid)
+		case t.Weight < 0 || t.Weight > 1:
+			return plan, fmt.Errorf("%w: %s weight must be between 0 and 1", ErrInvalid, sym)
+		}
+		if _, dup := weights[sym]; dup {
+			return plan, fmt.Errorf("%w: %s is targeted twice", ErrInvalid, sym)
+		}
+		weights[sym] = t.Weight
+		total += t.Weight
+	}
+	if total > 1+1e-6 {
+		return plan, fmt.Errorf("%w: weights add up to %g, more than 1", ErrInvalid, total)
+	}
+	plan.CashWeight = max(1-total, 0)
+
+	held := map[string]models.Holding{}
+	for _, h := range s.lots.Holdings(userID) {
+		held[h.Symbol] = h
+		plan.TotalValue += h.BaseValue
+		if _, ok := weights[h.Symbol]; !ok {
+			weights[h.Symbol] = 0
+		}
+	}
+	var err error
+	var unconverted []string
+	if plan.Cash, unconverted, err = s.cash.BaseBalance(ctx, userID); err != nil {
+		return plan, err
+	}
+	if len(unconverted) > 0 {
+		return plan, fmt.Errorf("%w: no fx rate to value %s cash", ErrInvalid, strings.Join(unconverted, ", "))
+	}
+	plan.TotalValue += plan.Cash
+	if plan.TotalValue <= 0 {
+		return plan, fmt.Errorf("%w: nothing to rebalance", ErrInvalid)
+	}
+
+	for sym, w := range weights {
+		inst, err := s.repo.GetInstrument(ctx, sym)
+		if errors.Is(err, repository.ErrNotFound) {
+			return plan, fmt.Errorf("%w: unknown instrument %s", ErrInvalid, sym)
+		}
+		if err != nil {
+			return plan, err
+		}
+		h := held[sym]
+		leg := models.RebalanceLeg{
+			Symbol:       sym,
+			Currency:     s.fx.Currency(inst.Currency),
+			CurrentQty:   h.Quantity,
+			CurrentValue: h.BaseValue,
+			TargetWeight: w,
+			TargetValue:  w * plan.TotalValue,
+		}
+		leg.CurrentWeight = leg.CurrentValue / plan.TotalValue
+		price, ok := s.prices.Mark(sym)
+		if !ok || price <= 0 {
+			return plan, fmt.Errorf("%w: no price for %s", ErrInvalid, sym)
+		}
+		rate, err := s.fx.Rate(leg.Currency, plan.Currency)
+		if err != nil {
+			return plan, fmt.Errorf("%w: %v", ErrInvalid, err)
+		}
+		leg.Price = price
+		step := instruments.Increment(inst)
+		leg.TargetQty = roundStep(math.Floor(leg.TargetValue/rate/price/step+eps)*step, step)
+		switch delta := roundStep(leg.TargetQty-leg.CurrentQty, step); {
+		case delta >= step-eps:
+			leg.Side, leg.Quantity = "buy", delta
+		case delta <= -step+eps:
+			leg.Side, leg.Quantity = "sell", -delta
+		}
+		plan.Legs = append(plan.Legs, leg)
+	}
+	sort.Slice(plan.Legs, func(i, j int) bool {
+		a, b := plan.Legs[i], plan.Legs[j]
+		if (a.Side == "sell") != (b.Side == "sell") {
+			return a.Side == "sell"
+		}
+		return a.Symbol < b.Symbol
+	})
+	if !execute {
+		return plan, nil
+	}
+
+	var legs []models.Order
+	for _, leg := range plan.Legs {
+		if leg.Side == "" {
+			continue
+		}
+		legs = append(legs, models.Order{
+			Symbol:   leg.Symbol,
+			Side:     leg.Side,
+			Type:     models.OrderTypeMarket,
+			Quantity: leg.Quantity,
+			Product:  models.ProductDelivery,
+		})
+	}
+	if len(legs) == 0 {
+		return plan, nil
+	}
+	b, _, err := s.Place(ctx, userID, "rebalance", legs)
+	if err != nil {
+		return plan, err
+	}
+	plan.Basket = &b
+	return plan, nil
+}
+
+// roundStep rounds q to the decimal places of step to drop float noise.
+func roundStep(q, step float64) float64 {
+	scale := math.Pow(10, math.Max(math.Ceil(-math.Log10(step)), 0))
+	return math.Round(q*scale) / scale
+}
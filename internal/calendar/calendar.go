@@ -0,0 +1,357 @@
+package calendar
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	_ "time/tzdata" // exchange time zones must resolve on hosts without zoneinfo
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+// file is the on-disk shape of the calendar config.
+type file map[string]struct {
+	Timezone     string            `json:"timezone"`
+	Sessions     map[string]string `json:"sessions"` // session -> "HH:MM-HH:MM"
+	HalfDayClose string            `json:"half_day_close"`
+	Holidays     []string          `json:"holidays"`  // YYYY-MM-DD
+	HalfDays     []string          `json:"half_days"` // YYYY-MM-DD
+	Weekend      []string          `json:"weekend"`   // defaults to Saturday and Sunday
+
+	SettlementDays      *int `json:"settlement_days"` // T+n, defaults to 1
+	BlockUnsettledSells bool `json:"block_unsettled_sells"`
+}
+
+// Calendar answers market-hours questions per exchange. A nil *Calendar, or
+// an exchange it does not know, is treated as always open.
+type Calendar struct {
+	exchanges map[string]*exchange
+}
+
+type exchange struct {
+	name     string
+	loc      *time.Location
+	sessions []session // in time order
+	halfDay  clock
+	holidays map[string]bool
+	halfDays map[string]bool
+	weekend  map[time.Weekday]bool
+
+	settlementDays      int
+	blockUnsettledSells bool
+}
+
+type session struct {
+	name       string
+	start, end clock
+}
+
+// clock is minutes after local midnight.
+type clock int
+
+var sessionOrder = []string{models.SessionPreOpen, models.SessionRegular, models.SessionPostClose}
+
+func Load(path string) (*Calendar, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f file
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("calendar: %w", err)
+	}
+
+	cal := &Calendar{exchanges: map[string]*exchange{}}
+	for name, cfg := range f {
+		loc, err := time.LoadLocation(cfg.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("calendar %s: %w", name, err)
+		}
+		ex := &exchange{
+			name:     name,
+			loc:      loc,
+			holidays: dateSet(cfg.Holidays),
+			halfDays: dateSet(cfg.HalfDays),
+			weekend:  map[time.Weekday]bool{},
+
+			settlementDays:      1,
+			blockUnsettledSells: cfg.BlockUnsettledSells,
+		}
+		if cfg.SettlementDays != nil {
+			if *cfg.SettlementDays < 0 {
+				return nil, fmt.Errorf("calendar %s: negative settlement_days", name)
+			}
+			ex.settlementDays = *cfg.SettlementDays
+		}
+		for _, sn := range sessionOrder {
+			spec, ok := cfg.Sessions[sn]
+			if !ok {
+				continue
+			}
+			start, end, err := parseRange(spec)
+			if err != nil {
+				return nil, fmt.Errorf("calendar %s %s: %w", name, sn, err)
+			}
+			ex.sessions = append(ex.sessions, session{name: sn, start: start, end: end})
+		}
+		if _, ok := cfg.Sessions[models.SessionRegular]; !ok {
+			return nil, fmt.Errorf("calendar %s: regular session is required", name)
+		}
+		if cfg.HalfDayClose != "" {
+			if ex.halfDay, err = parseClock(cfg.HalfDayClose); err != nil {
+				return nil, fmt.Errorf("calendar %s half_day_close: %w", name, err)
+			}
+		}
+		weekend := cfg.Weekend
+		if weekend == nil {
+			weekend = []string{"Saturday", "Sunday"}
+		}
+		for _, d := range weekend {
+			wd, ok := weekdays[strings.ToLower(d)]
+			if !ok {
+				return nil, fmt.Errorf("calendar %s: unknown weekday %q", name, d)
+			}
+			ex.weekend[wd] = true
+		}
+		cal.exchanges[name] = ex
+	}
+	return cal, nil
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "monday": time.Monday, "tuesday": time.Tuesday, "wednesday": time.Wednesday,
+	"thursday": time.Thursday, "friday": time.Friday, "saturday": time.Saturday,
+}
+
+func dateSet(days []string) map[string]bool {
+	m := map[string]bool{}
+	for _, d := range days {
+		m[d] = true
+	}
+	return m
+}
+
+func parseRange(s string) (clock, clock, error) {
+	parts := strings.Split(s, "-")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("want HH:MM-HH:MM, got %q", s)
+	}
+	start, err := parseClock(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := parseClock(parts[1])
+	if err != nil {
+		return 0, 0, err
+	}
+	if end <= start {
+		return 0, 0, fmt.Errorf("session %q ends before it starts", s)
+	}
+	return start, end, nil
+}
+
+func parseClock(s string) (clock, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, err
+	}
+	return clock(t.Hour()*60 + t.Minute()), nil
+}
+
+// Exchanges lists the configured exchange names.
+func (c *Calendar) Exchanges() []string {
+	if c == nil {
+		return nil
+	}
+	out := make([]string, 0, len(c.exchanges))
+	for name := range c.exchanges {
+		out = append(out, name)
+	}
+	return out
+}
+
+func (c *Calendar) lookup(name string) *exchange {
+	if c == nil {
+		return nil
+	}
+	return c.exchanges[name]
+}
+
+// Known reports whether the exchange has a calendar.
+func (c *Calendar) Known(name string) bool {
+	return c.lookup(name) != nil
+}
+
+// Location is the exchange's time zone, UTC for unknown exchanges.
+func (c *Calendar) Location(name string) *time.Location {
+	if ex := c.lookup(name); ex != nil {
+		return ex.loc
+	}
+	return time.UTC
+}
+
+// IsTradingDay reports whether the exchange trades on the date of t in the
+// exchange's time zone.
+func (c *Calendar) IsTradingDay(name string, t time.Time) bool {
+	ex := c.lookup(name)
+	if ex == nil {
+		return t.Weekday() != time.Saturday && t.Weekday() != time.Sunday
+	}
+	return ex.tradingDay(t.In(ex.loc))
+}
+
+// AddTradingDays returns the date n trading days after t's date (t itself
+// when n is 0 and t is a trading day), at local midnight.
+func (c *Calendar) AddTradingDays(name string, t time.Time, n int) time.Time {
+	loc := time.UTC
+	if ex := c.lookup(name); ex != nil {
+		loc = ex.loc
+	}
+	d := midnight(t.In(loc))
+	for !c.IsTradingDay(name, d) {
+		d = d.AddDate(0, 0, 1)
+	}
+	for i := 0; i < n; i++ {
+		d = d.AddDate(0, 0, 1)
+		for !c.IsTradingDay(name, d) {
+			d = d.AddDate(0, 0, 1)
+		}
+	}
+	return d
+}
+
+// SettlementDate is the trading day, at local midnight, on which a trade
+// made at t settles: T+1 unless the exchange configures otherwise.
+func (c *Calendar) SettlementDate(name string, t time.Time) time.Time {
+	n := 1
+	if ex := c.lookup(name); ex != nil {
+		n = ex.settlementDays
+	}
+	return c.AddTradingDays(name, t, n)
+}
+
+// BlocksUnsettledSells reports whether the exchange forbids selling shares
+// before their purchase has settled.
+func (c *Calendar) BlocksUnsettledSells(name string) bool {
+	ex := c.lookup(name)
+	return ex != nil && ex.blockUnsettledSells
+}
+
+// LastClosedDay is the most recent trading day, at local midnight, whose
+// regular session had ended by t.
+func (c *Calendar) LastClosedDay(name string, t time.Time) time.Time {
+	loc := time.UTC
+	if ex := c.lookup(name); ex != nil {
+		loc = ex.loc
+	}
+	d := midnight(t.In(loc))
+	for !c.IsTradingDay(name, d) || t.Before(c.SessionClose(name, d)) {
+		d = d.AddDate(0, 0, -1)
+	}
+	return d
+}
+
+// SessionClose is the end of the regular session on t's trading day, or on
+// the next trading day when t's date is not one. Unknown exchanges close at
+// the end of the UTC day.
+func (c *Calendar) SessionClose(name string, t time.Time) time.Time {
+	ex := c.lookup(name)
+	if ex == nil {
+		return midnight(t.UTC()).AddDate(0, 0, 1)
+	}
+	d := c.AddTradingDays(name, t, 0)
+	_, end := ex.regular(d)
+	return end
+}
+
+// Status describes the exchange's session at time t.
+func (c *Calendar) Status(name string, t time.Time) models.MarketStatus {
+	st := models.MarketStatus{Exchange: name, Session: models.SessionRegular, IsOpen: true, TradingDay: true}
+	ex := c.lookup(name)
+	if ex == nil {
+		return st
+	}
+	local := t.In(ex.loc)
+	day := midnight(local)
+	st.TradingDay = ex.tradingDay(local)
+	st.HalfDay = st.TradingDay && ex.halfDays[local.Format("2006-01-02")] && ex.halfDay > 0
+	st.Session, st.IsOpen = models.SessionClosed, false
+
+	if st.TradingDay {
+		for _, s := range ex.daySessions(day) {
+			if !local.Before(s.startAt) && local.Before(s.endAt) {
+				st.Session = s.name
+				st.IsOpen = s.name == models.SessionRegular
+				break
+			}
+		}
+	}
+
+	open, close := ex.regular(day)
+	switch {
+	case st.TradingDay && local.Before(open):
+		st.NextOpen, st.NextClose = open, close
+	case st.TradingDay && local.Before(close):
+		next := c.AddTradingDays(name, day, 1)
+		st.NextOpen, _ = ex.regular(next)
+		st.NextClose = close
+	default:
+		next := c.AddTradingDays(name, day.AddDate(0, 0, 1), 0)
+		st.NextOpen, st.NextClose = ex.regular(next)
+	}
+	return st
+}
+
+func (ex *exchange) tradingDay(local time.Time) bool {
+	if ex.weekend[local.Weekday()] {
+		return false
+	}
+	return !ex.holidays[local.Format("2006-01-02")]
+}
+
+type span struct {
+	name           string
+	startAt, endAt time.Time
+}
+
+// daySessions resolves the sessions of one trading day. On half days the
+// regular session closes early and the post-close session starts then.
+func (ex *exchange) daySessions(day time.Time) []span {
+	half := ex.halfDays[day.Format("2006-01-02")] && ex.halfDay > 0
+	at := func(c clock) time.Time {
+		return time.Date(day.Year(), day.Month(), day.Day(), int(c)/60, int(c)%60, 0, 0, ex.loc)
+	}
+	out := make([]span, 0, len(ex.sessions))
+	for _, s := range ex.sessions {
+		start, end := s.start, s.end
+		if half {
+			switch s.name {
+			case models.SessionRegular:
+				if ex.halfDay < end {
+					end = ex.halfDay
+				}
+			case models.SessionPostClose:
+				if ex.halfDay < start {
+					start = ex.halfDay
+				}
+			}
+		}
+		out = append(out, span{name: s.name, startAt: at(start), endAt: at(end)})
+	}
+	return out
+}
+
+func (ex *exchange) regular(day time.Time) (time.Time, time.Time) {
+	for _, s := range ex.daySessions(day) {
+		if s.name == models.SessionRegular {
+			return s.startAt, s.endAt
+		}
+	}
+	return time.Time{}, time.Time{}
+}
+
+func midnight(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
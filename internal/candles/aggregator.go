@@ -0,0 +1,174 @@
+package candles
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+)
+
+// Aggregator listens to the market data feed, records raw ticks and keeps an
+// in-progress bar per symbol and interval. Closed bars are written to the
+// store; live bars are pushed to watchers.
+type Aggregator struct {
+	repo repository.CandleRepo
+
+	mu       sync.Mutex
+	live     map[key]*models.Candle
+	watchers map[key]map[chan models.Candle]struct{}
+
+	writes chan write
+}
+
+type key struct{ symbol, interval string }
+
+type write struct {
+	tick   *models.Trade
+	candle *models.Candle
+}
+
+func NewAggregator(repo repository.CandleRepo) *Aggregator {
+	return &Aggregator{
+		repo:     repo,
+		live:     map[key]*models.Candle{},
+		watchers: map[key]map[chan models.Candle]struct{}{},
+		writes:   make(chan write, 4096),
+	}
+}
+
+func (a *Aggregator) OnQuote(models.Quote) {}
+
+func (a *Aggregator) OnTrade(t models.Trade) {
+	a.enqueue(write{tick: &t})
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, iv := range Intervals {
+		k := key{t.Symbol, iv}
+		start := bucket(t.Time, durations[iv])
+		c := a.live[k]
+		if c != nil && start.After(c.Start) {
+			closed := *c
+			a.enqueue(write{candle: &closed})
+			c = nil
+		}
+		if c == nil {
+			c = &models.Candle{Symbol: t.Symbol, Interval: iv, Start: start}
+			a.live[k] = c
+		}
+		add(c, t)
+		a.notify(k, *c)
+	}
+}
+
+// Live returns the in-progress bar, if any.
+func (a *Aggregator) Live(symbol, interval string) (models.Candle, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	c, ok := a.live[key{symbol, interval}]
+	if !ok {
+		return models.Candle{}, false
+	}
+	return *c, true
+}
+
+// Watch streams every update of the in-progress bar. Slow readers miss
+// intermediate updates rather than blocking the feed.
+func (a *Aggregator) Watch(symbol, interval string) (<-chan models.Candle, func()) {
+	ch := make(chan models.Candle, 16)
+	k := key{symbol, interval}
+
+	a.mu.Lock()
+	if a.watchers[k] == nil {
+		a.watchers[k] = map[chan models.Candle]struct{}{}
+	}
+	a.watchers[k][ch] = struct{}{}
+	a.mu.Unlock()
+
+	return ch, func() {
+		a.mu.Lock()
+		delete(a.watchers[k], ch)
+		a.mu.Unlock()
+	}
+}
+
+func (a *Aggregator) notify(k key, c models.Candle) {
+	for ch := range a.watchers[k] {
+		select {
+		case ch <- c:
+		default:
+		}
+	}
+}
+
+func (a *Aggregator) enqueue(w write) {
+	select {
+	case a.writes <- w:
+	default:
+		log.Println("candles: write queue full, dropping update")
+	}
+}
+
+// Run persists ticks and closed bars until ctx is done.
+func (a *Aggregator) Run(ctx context.Context) {
+	const batch = 256
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	var ticks []models.Trade
+	flush := func() {
+		if len(ticks) == 0 {
+			return
+		}
+		if err := a.repo.SaveTicks(ctx, ticks); err != nil {
+			log.Printf("candles: save ticks: %v", err)
+		}
+		ticks = ticks[:0]
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			flush()
+			return
+		case <-ticker.C:
+			flush()
+		case w := <-a.writes:
+			if w.tick != nil {
+				ticks = append(ticks, *w.tick)
+				if len(ticks) >= batch {
+					flush()
+				}
+			}
+			if w.candle != nil {
+				if err := a.repo.UpsertCandles(ctx, []models.Candle{*w.candle}); err != nil {
+					log.Printf("candles: save bar: %v", err)
+				}
+			}
+		}
+	}
+}
+
+// Rebuild recomputes stored bars for [from, to) from the raw ticks.
+func (a *Aggregator) Rebuild(ctx context.Context, symbol, interval string, from, to time.Time) (int, error) {
+	d, err := ParseInterval(interval)
+	if err != nil {
+		return 0, err
+	}
+	from, to = bucket(from, d), bucket(to.Add(d-1), d)
+	ticks, err := a.repo.ListTicks(ctx, symbol, from, to)
+	if err != nil {
+		return 0, err
+	}
+	bars, err := Build(symbol, interval, ticks)
+	if err != nil {
+		return 0, err
+	}
+	if err := a.repo.DeleteCandles(ctx, symbol, interval, from, to); err != nil {
+		return 0, err
+	}
+	return len(bars), a.repo.UpsertCandles(ctx, bars)
+}
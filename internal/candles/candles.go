@@ -0,0 +1,103 @@
+package candles
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+// Intervals lists the supported bar sizes, smallest first.
+var Intervals = []string{"1m", "5m", "15m", "1h", "1d"}
+
+var durations = map[string]time.Duration{
+	"1m":  time.Minute,
+	"5m":  5 * time.Minute,
+	"15m": 15 * time.Minute,
+	"1h":  time.Hour,
+	"1d":  24 * time.Hour,
+}
+
+func ParseInterval(s string) (time.Duration, error) {
+	d, ok := durations[s]
+	if !ok {
+		return 0, fmt.Errorf("unsupported interval %q", s)
+	}
+	return d, nil
+}
+
+// bucket returns the start of the bar containing t. Daily bars start at
+// midnight UTC.
+func bucket(t time.Time, d time.Duration) time.Time {
+	return t.UTC().Truncate(d)
+}
+
+// add folds a trade into c, opening the bar if it is empty.
+func add(c *models.Candle, t models.Trade) {
+	if c.Volume == 0 && c.Open == 0 {
+		c.Open, c.High, c.Low = t.Price, t.Price, t.Price
+	}
+	if t.Price > c.High {
+		c.High = t.Price
+	}
+	if t.Price < c.Low {
+		c.Low = t.Price
+	}
+	c.Close = t.Price
+	c.Volume += t.Quantity
+}
+
+// Build aggregates ticks of one symbol into bars of the given interval,
+// ordered by start time.
+func Build(symbol, interval string, ticks []models.Trade) ([]models.Candle, error) {
+	d, err := ParseInterval(interval)
+	if err != nil {
+		return nil, err
+	}
+	sorted := append([]models.Trade(nil), ticks...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Time.Before(sorted[j].Time) })
+
+	var out []models.Candle
+	for _, t := range sorted {
+		start := bucket(t.Time, d)
+		if len(out) == 0 || !out[len(out)-1].Start.Equal(start) {
+			out = append(out, models.Candle{Symbol: symbol, Interval: interval, Start: start})
+		}
+		add(&out[len(out)-1], t)
+	}
+	return out, nil
+}
+
+const (
+	DefaultPageSize = 500
+	MaxPageSize     = 5000
+)
+
+// PageToken encodes the start of the last bar on a page; the next page
+// resumes after it.
+func PageToken(last models.Candle) string {
+	return last.Start.UTC().Format(time.RFC3339Nano)
+}
+
+func ParsePageToken(tok string) (time.Time, error) {
+	if tok == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, tok)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid page token")
+	}
+	return t, nil
+}
+
+// PageSize clamps a requested page size to [1, MaxPageSize].
+func PageSize(n int) int {
+	if n <= 0 {
+		return DefaultPageSize
+	}
+	if n > MaxPageSize {
+		return MaxPageSize
+	}
+	return n
+}
@@ -0,0 +1,449 @@
+package cash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/fx"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+	"github.com/hahahamid/broker-backend/internal/utils"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	ErrInvalid           = errors.New("invalid cash request")
+	ErrInsufficientFunds = errors.New("insufficient funds")
+)
+
+const eps = 1e-9
+
+// Service is the per-user cash ledger, with a sub-ledger per currency. A
+// balance is the sum of entries; nothing is stored separately, so it cannot
+// drift from the history. As an engine listener it posts the consideration
+// of every fill in the instrument's currency. Buy orders hold their
+// expected cost while they work, and withdrawals, conversions and other
+// debits may not take what is held: their entries are written in one
+// transaction that also checks the balance, under a per-user lock.
+type Service struct {
+	repo   repository.CashRepo
+	fx     *fx.Converter
+	events *utils.Queue[models.CashEntry]
+
+	mu    sync.Mutex
+	users map[string]*sync.Mutex
+	held  map[string]*hold // by order ID
+}
+
+// hold is the cash a buy order has set aside: the expected cost of its
+// unfilled quantity, and of its fills until they are posted.
+type hold struct {
+	userID   string
+	currency string
+	unit     float64            // expected cost of one unit, charges included
+	qty      float64            // still to fill
+	unposted map[string]float64 // by fill ID
+}
+
+func (h *hold) amount() float64 {
+	v := h.qty * h.unit
+	for _, u := range h.unposted {
+		v += u
+	}
+	return v
+}
+
+func (h *hold) done() bool {
+	return h.qty <= eps && len(h.unposted) == 0
+}
+
+func NewService(repo repository.CashRepo, conv *fx.Converter) *Service {
+	return &Service{
+		repo:   repo,
+		fx:     conv,
+		events: utils.NewQueue[models.CashEntry](time.Second),
+		users:  map[string]*sync.Mutex{},
+		held:   map[string]*hold{},
+	}
+}
+
+// lock serializes balance-checked debits of one user and returns the
+// unlock.
+func (s *Service) lock(userID string) func() {
+	s.mu.Lock()
+	m, ok := s.users[userID]
+	if !ok {
+		m = &sync.Mutex{}
+		s.users[userID] = m
+	}
+	s.mu.Unlock()
+	m.Lock()
+	return m.Unlock
+}
+
+// Post records an entry. Callers that may replay a posting should set a
+// deterministic ID so the ledger stays idempotent.
+func (s *Service) Post(ctx context.Context, e models.CashEntry) error {
+	if e.ID == "" {
+		e.ID = primitive.NewObjectID().Hex()
+	}
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	e.Currency = s.fx.Currency(e.Currency)
+	return s.repo.SaveCashEntry(ctx, e)
+}
+
+// Debit posts an entry taking cash out of the user's account, refusing it
+// with ErrInsufficientFunds when its currency's balance, less what working
+// buy orders hold, would go below zero.
+func (s *Service) Debit(ctx context.Context, e models.CashEntry) error {
+	if e.Amount >= 0 {
+		return fmt.Errorf("%w: a debit must be negative", ErrInvalid)
+	}
+	if e.ID == "" {
+		e.ID = primitive.NewObjectID().Hex()
+	}
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	e.Currency = s.fx.Currency(e.Currency)
+	defer s.lock(e.UserID)()
+	return s.debit(ctx, e.UserID, e.Currency, e)
+}
+
+// SetBalances brings each of the user's currency sub-ledgers to its
+// amount in target, and those not in it to zero, with entries of type typ
+// written in one transaction under the user's lock.
+func (s *Service) SetBalances(ctx context.Context, userID string, target map[string]float64, typ, note string) error {
+	defer s.lock(userID)()
+	bal, err := s.Balances(ctx, userID)
+	if err != nil {
+		return err
+	}
+	want := map[string]float64{}
+	for ccy := range bal {
+		want[ccy] = 0
+	}
+	for ccy, v := range target {
+		want[s.fx.Currency(ccy)] = v
+	}
+	ref := primitive.NewObjectID().Hex()
+	now := time.Now()
+	var entries []models.CashEntry
+	for ccy, v := range want {
+		if diff := v - bal[ccy]; math.Abs(diff) > eps {
+			entries = append(entries, models.CashEntry{
+				ID:        ref + ":" + ccy,
+				UserID:    userID,
+				Type:      typ,
+				Currency:  ccy,
+				Amount:    diff,
+				Reference: ref,
+				Note:      note,
+				Time:      now,
+			})
+		}
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	return s.repo.SaveCashEntries(ctx, userID, entries, nil, 0)
+}
+
+// Reserve holds amount of the buy order's currency for it, refusing the
+// order with ErrInsufficientFunds when the balance less what the user's
+// other orders hold cannot cover it. The hold shrinks as the order fills
+// and its fills are posted, and is gone once the order is done.
+func (s *Service) Reserve(ctx context.Context, o models.Order, amount float64) error {
+	currency := s.fx.Currency(o.Currency)
+	defer s.lock(o.UserID)()
+	bal, err := s.Balance(ctx, o.UserID, currency)
+	if err != nil {
+		return err
+	}
+	if avail := bal - s.Held(o.UserID)[currency]; amount > avail+eps {
+		return fmt.Errorf("%w: needs %.2f %s, %.2f available", ErrInsufficientFunds, amount, currency, max(avail, 0))
+	}
+	s.Track(o, amount)
+	return nil
+}
+
+// Track holds amount for a restored buy order without checking the
+// balance.
+func (s *Service) Track(o models.Order, amount float64) {
+	qty := o.Remaining()
+	if qty <= eps {
+		return
+	}
+	s.mu.Lock()
+	s.held[o.ID] = &hold{
+		userID:   o.UserID,
+		currency: s.fx.Currency(o.Currency),
+		unit:     amount / qty,
+		qty:      qty,
+		unposted: map[string]float64{},
+	}
+	s.mu.Unlock()
+}
+
+// Release frees what an order holds for its unfilled quantity. Its fills
+// stay held until they are posted.
+func (s *Service) Release(orderID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if h, ok := s.held[orderID]; ok {
+		h.qty = 0
+		if h.done() {
+			delete(s.held, orderID)
+		}
+	}
+}
+
+// Held is what the user's working buy orders hold, by currency.
+func (s *Service) Held(userID string) map[string]float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := map[string]float64{}
+	for _, h := range s.held {
+		if h.userID == userID {
+			out[h.currency] += h.amount()
+		}
+	}
+	return out
+}
+
+// Deposit credits amount in currency, the base currency when empty.
+func (s *Service) Deposit(ctx context.Context, userID, currency string, amount float64, note string) (models.CashEntry, error) {
+	if amount <= 0 {
+		return models.CashEntry{}, fmt.Errorf("%w: amount must be positive", ErrInvalid)
+	}
+	e := models.CashEntry{UserID: userID, Type: models.CashDeposit, Currency: s.fx.Currency(currency), Amount: amount, Note: note}
+	return e, s.post(ctx, &e)
+}
+
+// Withdraw debits the user's cash in currency; that sub-ledger may not go
+// below zero.
+func (s *Service) Withdraw(ctx context.Context, userID, currency string, amount float64, note string) (models.CashEntry, error) {
+	if amount <= 0 {
+		return models.CashEntry{}, fmt.Errorf("%w: amount must be positive", ErrInvalid)
+	}
+	currency = s.fx.Currency(currency)
+	defer s.lock(userID)()
+	e := models.CashEntry{
+		ID:       primitive.NewObjectID().Hex(),
+		UserID:   userID,
+		Type:     models.CashWithdrawal,
+		Currency: currency,
+		Amount:   -amount,
+		Note:     note,
+		Time:     time.Now(),
+	}
+	return e, s.debit(ctx, userID, currency, e)
+}
+
+// Convert sells amount of from for to at the provider's rate, debiting one
+// sub-ledger and crediting the other in the same transaction.
+func (s *Service) Convert(ctx context.Context, userID, from, to string, amount float64) (models.FXConversion, error) {
+	c := models.FXConversion{From: s.fx.Currency(from), To: s.fx.Currency(to), Amount: amount}
+	if amount <= 0 {
+		return c, fmt.Errorf("%w: amount must be positive", ErrInvalid)
+	}
+	if c.From == c.To {
+		return c, fmt.Errorf("%w: from and to currencies must differ", ErrInvalid)
+	}
+	rate, err := s.fx.Rate(c.From, c.To)
+	if errors.Is(err, fx.ErrNoRate) {
+		return c, fmt.Errorf("%w: %v", ErrInvalid, err)
+	} else if err != nil {
+		return c, err
+	}
+	defer s.lock(userID)()
+	c.ID = primitive.NewObjectID().Hex()
+	c.Rate = rate
+	c.Converted = amount * rate
+	c.Time = time.Now()
+	note := fmt.Sprintf("%g %s -> %s @ %g", amount, c.From, c.To, rate)
+	return c, s.debit(ctx, userID, c.From,
+		models.CashEntry{ID: c.ID + ":out", UserID: userID, Type: models.CashFXOut, Currency: c.From, Amount: -amount, Reference: c.ID, Note: note, Time: c.Time},
+		models.CashEntry{ID: c.ID + ":in", UserID: userID, Type: models.CashFXIn, Currency: c.To, Amount: c.Converted, Reference: c.ID, Note: note, Time: c.Time},
+	)
+}
+
+// debit writes entries that take cash out of the user's currency
+// sub-ledger, all or none, refusing them if it would go below what working
+// buy orders hold. The caller holds the user's lock.
+func (s *Service) debit(ctx context.Context, userID, currency string, entries ...models.CashEntry) error {
+	check := []string{currency}
+	if currency == s.fx.Base() {
+		check = append(check, "") // legacy entries are in the base currency
+	}
+	held := s.Held(userID)[currency]
+	err := s.repo.SaveCashEntries(ctx, userID, entries, check, held)
+	if errors.Is(err, repository.ErrInsufficientCash) {
+		bal, berr := s.Balance(ctx, userID, currency)
+		if berr != nil {
+			return berr
+		}
+		if held > eps {
+			return fmt.Errorf("%w: %s balance is %.2f, of which working orders hold %.2f", ErrInsufficientFunds, currency, bal, held)
+		}
+		return fmt.Errorf("%w: %s balance is %.2f", ErrInsufficientFunds, currency, bal)
+	}
+	return err
+}
+
+func (s *Service) post(ctx context.Context, e *models.CashEntry) error {
+	e.ID = primitive.NewObjectID().Hex()
+	e.Time = time.Now()
+	return s.repo.SaveCashEntry(ctx, *e)
+}
+
+// Balances returns the balance of each currency sub-ledger.
+func (s *Service) Balances(ctx context.Context, userID string) (map[string]float64, error) {
+	sums, err := s.repo.CashBalances(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.normalize(sums), nil
+}
+
+// Balance is one currency's balance.
+func (s *Service) Balance(ctx context.Context, userID, currency string) (float64, error) {
+	bal, err := s.Balances(ctx, userID)
+	return bal[s.fx.Currency(currency)], err
+}
+
+// BaseBalance is all cash converted to the base currency. Currencies with
+// no rate are left out of the total and listed in unconverted.
+func (s *Service) BaseBalance(ctx context.Context, userID string) (total float64, unconverted []string, err error) {
+	bal, err := s.Balances(ctx, userID)
+	if err != nil {
+		return 0, nil, err
+	}
+	total, unconverted = s.fx.Total(bal)
+	return total, unconverted, nil
+}
+
+// normalize merges legacy entries without a currency into the base.
+func (s *Service) normalize(sums map[string]float64) map[string]float64 {
+	out := make(map[string]float64, len(sums))
+	for ccy, v := range sums {
+		out[s.fx.Currency(ccy)] += v
+	}
+	return out
+}
+
+// Entries returns the user's ledger, newest first.
+func (s *Service) Entries(ctx context.Context, userID string) ([]models.CashEntry, error) {
+	return s.repo.ListCashEntries(ctx, userID)
+}
+
+// NetFlows is deposits less withdrawals posted in (from, to], in the base
+// currency, with the currencies left out as for Sum.
+func (s *Service) NetFlows(ctx context.Context, userID string, from, to time.Time) (float64, []string, error) {
+	return s.Sum(ctx, userID, []string{models.CashDeposit, models.CashWithdrawal}, from, to)
+}
+
+// Sum totals the user's entries of the given types in (from, to], in the
+// base currency. Currencies with no rate are left out and listed in
+// unconverted.
+func (s *Service) Sum(ctx context.Context, userID string, types []string, from, to time.Time) (total float64, unconverted []string, err error) {
+	sums, err := s.repo.SumCashEntries(ctx, userID, types, from, to)
+	if err != nil {
+		return 0, nil, err
+	}
+	total, unconverted = s.fx.Total(s.normalize(sums))
+	return total, unconverted, nil
+}
+
+func (s *Service) OnOrder(o models.Order) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	h, ok := s.held[o.ID]
+	if !ok {
+		return
+	}
+	switch o.Status {
+	case models.OrderFilled, models.OrderCancelled, models.OrderRejected, models.OrderExpired:
+		h.qty = 0
+	default:
+		h.qty = o.Remaining()
+	}
+	if h.done() {
+		delete(s.held, o.ID)
+	}
+}
+
+func (s *Service) OnFill(f models.Fill) {
+	e := models.CashEntry{
+		ID:        "fill:" + f.ID,
+		UserID:    f.UserID,
+		Type:      models.CashBuy,
+		Currency:  s.fx.Currency(f.Currency),
+		Amount:    -f.Quantity * f.Price,
+		Symbol:    f.Symbol,
+		Reference: f.OrderID,
+		Note:      fmt.Sprintf("%s %g @ %g", f.Side, f.Quantity, f.Price),
+		Time:      f.Time,
+	}
+	if f.Side == "sell" {
+		e.Type, e.Amount = models.CashSell, -e.Amount
+	}
+	// The fill's cost stays held until its entry is posted, so the
+	// balance never shows it as free in between.
+	s.mu.Lock()
+	if h, ok := s.held[f.OrderID]; ok {
+		h.unposted[f.ID] = f.Quantity * h.unit
+		h.qty = max(h.qty-f.Quantity, 0)
+	}
+	s.mu.Unlock()
+	s.events.Push(e)
+}
+
+// posted frees what an order held for a fill once its entry is in.
+func (s *Service) posted(e models.CashEntry) {
+	fillID, ok := strings.CutPrefix(e.ID, "fill:")
+	if !ok {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if h, ok := s.held[e.Reference]; ok {
+		delete(h.unposted, fillID)
+		if h.done() {
+			delete(s.held, e.Reference)
+		}
+	}
+}
+
+// Run persists fill postings in the order they happened. A failed posting
+// is retried, which is safe as entry IDs derive from the fill.
+func (s *Service) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.events.Ready():
+			if err := s.flush(ctx); err != nil {
+				log.Printf("cash: persist fill: %v; retrying", err)
+			}
+		}
+	}
+}
+
+func (s *Service) flush(ctx context.Context) error {
+	return s.events.Flush(func(e models.CashEntry) error {
+		if err := s.repo.SaveCashEntry(ctx, e); err != nil {
+			return err
+		}
+		s.posted(e)
+		return nil
+	})
+}
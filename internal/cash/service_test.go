@@ -0,0 +1,143 @@
+package cash
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/hahahamid/broker-backend/internal/fx"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+)
+
+// memRepo keeps the ledger in memory.
+type memRepo struct {
+	repository.CashRepo
+	entries map[string]models.CashEntry
+	batches int // SaveCashEntries calls that wrote
+}
+
+func (r *memRepo) SaveCashEntry(_ context.Context, e models.CashEntry) error {
+	r.entries[e.ID] = e
+	return nil
+}
+
+func (r *memRepo) SaveCashEntries(ctx context.Context, userID string, entries []models.CashEntry, check []string, floor float64) error {
+	sums, _ := r.CashBalances(ctx, userID)
+	for _, e := range entries {
+		sums[e.Currency] += e.Amount
+	}
+	var bal float64
+	for _, ccy := range check {
+		bal += sums[ccy]
+	}
+	if len(check) > 0 && bal < floor-1e-9 {
+		return repository.ErrInsufficientCash
+	}
+	for _, e := range entries {
+		r.entries[e.ID] = e
+	}
+	r.batches++
+	return nil
+}
+
+func (r *memRepo) CashBalances(_ context.Context, userID string) (map[string]float64, error) {
+	out := map[string]float64{}
+	for _, e := range r.entries {
+		if e.UserID == userID {
+			out[e.Currency] += e.Amount
+		}
+	}
+	return out, nil
+}
+
+func newTestService(t *testing.T, deposits map[string]float64) (*Service, *memRepo) {
+	t.Helper()
+	repo := &memRepo{entries: map[string]models.CashEntry{}}
+	s := NewService(repo, fx.NewConverter(nil, "USD"))
+	for ccy, amount := range deposits {
+		if _, err := s.Deposit(context.Background(), "u", ccy, amount, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return s, repo
+}
+
+func TestBuyHolds(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService(t, map[string]float64{"USD": 1000})
+	buy := models.Order{ID: "a", UserID: "u", Symbol: "ACME", Side: "buy", Currency: "USD", Quantity: 5, Price: 100}
+
+	if err := s.Reserve(ctx, buy, 600); err != nil {
+		t.Fatal(err)
+	}
+	other := buy
+	other.ID = "b"
+	if err := s.Reserve(ctx, other, 500); !errors.Is(err, ErrInsufficientFunds) {
+		t.Errorf("second buy: got %v, want ErrInsufficientFunds", err)
+	}
+	if _, err := s.Withdraw(ctx, "u", "USD", 500, ""); !errors.Is(err, ErrInsufficientFunds) {
+		t.Errorf("withdrawing held cash: got %v, want ErrInsufficientFunds", err)
+	}
+	if _, err := s.Withdraw(ctx, "u", "USD", 400, ""); err != nil {
+		t.Fatalf("withdrawing free cash: %v", err)
+	}
+
+	// A fill stays held at the order's unit cost until it is posted.
+	s.OnFill(models.Fill{ID: "f1", OrderID: "a", UserID: "u", Symbol: "ACME", Side: "buy", Currency: "USD", Quantity: 2, Price: 100})
+	if got := s.Held("u")["USD"]; math.Abs(got-600) > eps {
+		t.Errorf("held %g after the fill, want 600", got)
+	}
+	if err := s.flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.Held("u")["USD"]; math.Abs(got-360) > eps {
+		t.Errorf("held %g after posting, want 360", got)
+	}
+	if bal, _ := s.Balance(ctx, "u", "USD"); math.Abs(bal-400) > eps {
+		t.Errorf("balance %g, want 400", bal)
+	}
+
+	buy.FilledQty, buy.Status = 2, models.OrderCancelled
+	s.OnOrder(buy)
+	if got := s.Held("u")["USD"]; got != 0 {
+		t.Errorf("held %g after the order ended, want 0", got)
+	}
+	if err := s.Reserve(ctx, other, 400); err != nil {
+		t.Errorf("cash freed by the order: %v", err)
+	}
+}
+
+func TestDebit(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService(t, map[string]float64{"USD": 100})
+	s.Track(models.Order{ID: "a", UserID: "u", Side: "buy", Currency: "USD", Quantity: 1}, 60)
+
+	e := models.CashEntry{ID: "fund:1", UserID: "u", Type: models.CashFundPurchase, Currency: "USD", Amount: -50}
+	if err := s.Debit(ctx, e); !errors.Is(err, ErrInsufficientFunds) {
+		t.Errorf("debit into held cash: got %v, want ErrInsufficientFunds", err)
+	}
+	e.Amount = -40
+	if err := s.Debit(ctx, e); err != nil {
+		t.Fatal(err)
+	}
+	if bal, _ := s.Balance(ctx, "u", "USD"); math.Abs(bal-60) > eps {
+		t.Errorf("balance %g, want 60", bal)
+	}
+}
+
+func TestSetBalances(t *testing.T) {
+	ctx := context.Background()
+	s, repo := newTestService(t, map[string]float64{"USD": 100, "EUR": 50})
+	if err := s.SetBalances(ctx, "u", map[string]float64{"USD": 1000}, models.CashPaperFunds, "reset"); err != nil {
+		t.Fatal(err)
+	}
+	bal, _ := s.Balances(ctx, "u")
+	if bal["USD"] != 1000 || bal["EUR"] != 0 {
+		t.Errorf("balances %v, want USD 1000 and EUR 0", bal)
+	}
+	if repo.batches != 1 {
+		t.Errorf("reset written in %d transactions, want 1", repo.batches)
+	}
+}
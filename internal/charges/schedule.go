@@ -0,0 +1,122 @@
+package charges
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+// Schedule holds the charge rates per segment (an instrument's asset class,
+// or "default") and product. Percentages are of trade value.
+type Schedule struct {
+	GSTPct   float64                     `json:"gst_pct"` // on brokerage and exchange fees
+	Segments map[string]map[string]Rates `json:"segments"`
+}
+
+type Rates struct {
+	Brokerage             Brokerage `json:"brokerage"`
+	ExchangeFeePct        float64   `json:"exchange_fee_pct"`
+	StampDutyPct          float64   `json:"stamp_duty_pct"` // buys only
+	TransactionTaxBuyPct  float64   `json:"transaction_tax_buy_pct"`
+	TransactionTaxSellPct float64   `json:"transaction_tax_sell_pct"`
+}
+
+// Brokerage is charged per order: Flat when Percent is zero, otherwise
+// Percent of the order's filled value capped at Max (no cap when zero).
+type Brokerage struct {
+	Flat    float64 `json:"flat"`
+	Percent float64 `json:"percent"`
+	Max     float64 `json:"max"`
+}
+
+// Load reads a JSON schedule.
+func Load(path string) (*Schedule, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var s Schedule
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, fmt.Errorf("charges: %w", err)
+	}
+	for seg, products := range s.Segments {
+		for p, r := range products {
+			if p != models.ProductDelivery && p != models.ProductIntraday {
+				return nil, fmt.Errorf("charges %s: unknown product %q", seg, p)
+			}
+			for _, v := range []float64{r.Brokerage.Flat, r.Brokerage.Percent, r.Brokerage.Max, r.ExchangeFeePct,
+				r.StampDutyPct, r.TransactionTaxBuyPct, r.TransactionTaxSellPct} {
+				if v < 0 {
+					return nil, fmt.Errorf("charges %s/%s: rates must not be negative", seg, p)
+				}
+			}
+		}
+	}
+	if s.GSTPct < 0 {
+		return nil, fmt.Errorf("charges: gst_pct must not be negative")
+	}
+	return &s, nil
+}
+
+// Rates returns the rates for a segment and product, falling back to the
+// "default" segment. A nil schedule charges nothing.
+func (s *Schedule) Rates(segment, product string) Rates {
+	if s == nil {
+		return Rates{}
+	}
+	if r, ok := s.Segments[segment][product]; ok {
+		return r
+	}
+	return s.Segments["default"][product]
+}
+
+// brokerage is the total brokerage on an order that has filled value v.
+func (b Brokerage) total(v float64) float64 {
+	if v <= 0 {
+		return 0
+	}
+	if b.Percent == 0 {
+		return b.Flat
+	}
+	amt := v * b.Percent / 100
+	if b.Max > 0 {
+		amt = math.Min(amt, b.Max)
+	}
+	return amt
+}
+
+// Calculate charges a fill of value on side, for an order that had already
+// filled prior before it. Brokerage is the increase in the order's total,
+// so a flat fee is taken once and a cap holds across partial fills.
+func (s *Schedule) Calculate(segment, product, side string, value, prior float64) []models.Charge {
+	r := s.Rates(segment, product)
+	brokerage := r.Brokerage.total(prior+value) - r.Brokerage.total(prior)
+	exchange := value * r.ExchangeFeePct / 100
+	var stamp, tax float64
+	if side == "buy" {
+		stamp = value * r.StampDutyPct / 100
+		tax = value * r.TransactionTaxBuyPct / 100
+	} else {
+		tax = value * r.TransactionTaxSellPct / 100
+	}
+	var gst float64
+	if s != nil {
+		gst = (brokerage + exchange) * s.GSTPct / 100
+	}
+	var out []models.Charge
+	for _, c := range []models.Charge{
+		{Name: models.ChargeBrokerage, Amount: brokerage},
+		{Name: models.ChargeExchangeFee, Amount: exchange},
+		{Name: models.ChargeStampDuty, Amount: stamp},
+		{Name: models.ChargeTransactionTax, Amount: tax},
+		{Name: models.ChargeGST, Amount: gst},
+	} {
+		if c.Amount > 0 {
+			out = append(out, c)
+		}
+	}
+	return out
+}
@@ -0,0 +1,316 @@
+package charges
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/cash"
+	"github.com/hahahamid/broker-backend/internal/fx"
+	"github.com/hahahamid/broker-backend/internal/marketdata"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+	"github.com/hahahamid/broker-backend/internal/utils"
+)
+
+var ErrInvalid = errors.New("invalid charges request")
+
+// Charged is told the total charged on each fill once it is posted.
+type Charged interface {
+	OnCharged(f models.Fill, total float64)
+}
+
+// Service applies the schedule to every fill and posts the charges to the
+// cash ledger, and costs buy orders for the cash they hold while they
+// work. As an engine listener it only queues fills; Run looks up
+// each instrument's segment and posts, so matching never waits on storage.
+type Service struct {
+	repo     repository.Repo
+	cash     *cash.Service
+	prices   *marketdata.PriceCache
+	schedule *Schedule
+	exempt   map[string]bool // accounts never charged; set before trading
+	charged  []Charged       // set before trading
+	events   *utils.Queue[interface{}]
+
+	mu       sync.Mutex
+	segments map[string]segment // by symbol
+
+	filled map[string]float64 // order ID -> value charged so far; Run only
+}
+
+// NewService charges per schedule; a nil schedule charges nothing.
+func NewService(repo repository.Repo, cashSvc *cash.Service, prices *marketdata.PriceCache, schedule *Schedule) *Service {
+	return &Service{
+		repo:     repo,
+		cash:     cashSvc,
+		prices:   prices,
+		schedule: schedule,
+		exempt:   map[string]bool{},
+		events:   utils.NewQueue[interface{}](time.Second),
+		segments: map[string]segment{},
+		filled:   map[string]float64{},
+	}
+}
+
+type segment struct {
+	name     string // the instrument's asset class, or "default" when unknown
+	currency string
+}
+
+func (s *Service) segment(ctx context.Context, symbol string) (segment, error) {
+	s.mu.Lock()
+	seg, ok := s.segments[symbol]
+	s.mu.Unlock()
+	if ok {
+		return seg, nil
+	}
+	inst, err := s.repo.GetInstrument(ctx, symbol)
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		seg = segment{name: "default"}
+	case err != nil:
+		return seg, err
+	default:
+		seg = segment{name: strings.ToLower(inst.AssetClass), currency: inst.Currency}
+	}
+	s.mu.Lock()
+	s.segments[symbol] = seg
+	s.mu.Unlock()
+	return seg, nil
+}
+
+// Estimate prices a prospective trade. Without a price it uses the current
+// mark.
+func (s *Service) Estimate(ctx context.Context, symbol, side, product string, qty, price float64) (models.ChargeEstimate, error) {
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	side = strings.ToLower(side)
+	e := models.ChargeEstimate{Symbol: symbol, Side: side, Product: product, Quantity: qty, Price: price, Charges: []models.Charge{}}
+	if side != "buy" && side != "sell" {
+		return e, fmt.Errorf("%w: side must be buy or sell", ErrInvalid)
+	}
+	if e.Product == "" {
+		e.Product = models.ProductDelivery
+	}
+	if e.Product != models.ProductDelivery && e.Product != models.ProductIntraday {
+		return e, fmt.Errorf("%w: product must be delivery or intraday", ErrInvalid)
+	}
+	if qty <= 0 {
+		return e, fmt.Errorf("%w: quantity must be positive", ErrInvalid)
+	}
+	if e.Price <= 0 {
+		mark, ok := s.prices.Mark(symbol)
+		if !ok {
+			return e, fmt.Errorf("%w: no price for %s, pass one", ErrInvalid, symbol)
+		}
+		e.Price = mark
+	}
+	seg, err := s.segment(ctx, symbol)
+	if err != nil {
+		return e, err
+	}
+	e.Segment, e.Currency = seg.name, seg.currency
+	e.Value = qty * e.Price
+	if list := s.schedule.Calculate(seg.name, e.Product, side, e.Value, 0); list != nil {
+		e.Charges = list
+	}
+	for _, c := range e.Charges {
+		e.Total += c.Amount
+	}
+	e.NetAmount = e.Value + e.Total
+	if side == "sell" {
+		e.NetAmount = e.Value - e.Total
+	}
+	return e, nil
+}
+
+// Reserve holds on the user's cash ledger what a buy order is expected to
+// cost, charges included, refusing it when the cash is not there.
+func (s *Service) Reserve(ctx context.Context, o models.Order) error {
+	cost, err := s.cost(ctx, o)
+	if err != nil {
+		return err
+	}
+	return s.cash.Reserve(ctx, o, cost)
+}
+
+// Track holds the expected cost of a restored buy order without checking
+// the balance. An order that cannot be costed is not held.
+func (s *Service) Track(ctx context.Context, o models.Order) {
+	cost, err := s.cost(ctx, o)
+	if err != nil {
+		log.Printf("charges: hold for order %s: %v", o.ID, err)
+		return
+	}
+	s.cash.Track(o, cost)
+}
+
+// Release frees what an order that never reached the engine holds.
+func (s *Service) Release(orderID string) {
+	s.cash.Release(orderID)
+}
+
+// cost is the expected cost of the rest of a buy order with its charges.
+// A limit order is costed at its limit, a market order at the ask, else the
+// last price, else the previous close.
+func (s *Service) cost(ctx context.Context, o models.Order) (float64, error) {
+	price := o.Price
+	if o.Type != models.OrderTypeLimit {
+		price = 0
+		if q, ok := s.prices.Quote(o.Symbol); ok && q.Ask > 0 {
+			price = q.Ask
+		} else if mark, ok := s.prices.Mark(o.Symbol); ok && mark > 0 {
+			price = mark
+		} else if inst, err := s.repo.GetInstrument(ctx, o.Symbol); err == nil {
+			price = inst.PrevClose
+		}
+	}
+	if price <= 0 {
+		return 0, fmt.Errorf("%w: no price for %s to cost the order at", ErrInvalid, o.Symbol)
+	}
+	est, err := s.Estimate(ctx, o.Symbol, o.Side, o.Product, o.Remaining(), price)
+	if err != nil {
+		return 0, err
+	}
+	return est.NetAmount, nil
+}
+
+// Total is everything the user has been charged so far, in the base
+// currency, leaving out and listing charges in currencies with no rate.
+func (s *Service) Total(ctx context.Context, userID string) (float64, []string, error) {
+	sum, unconverted, err := s.cash.Sum(ctx, userID, []string{models.CashCharge}, time.Time{}, time.Now())
+	return -sum, unconverted, err
+}
+
+// AttachCharges completes a PnL card with charges paid and net PnL.
+func (s *Service) AttachCharges(ctx context.Context, userID string, card *models.PNLCard) error {
+	total, unconverted, err := s.Total(ctx, userID)
+	if err != nil {
+		return err
+	}
+	card.Unconverted = fx.Union(card.Unconverted, unconverted)
+	card.Charges = total
+	card.NetPNL = card.RealizedPNL + card.UnrealizedPNL - total
+	return nil
+}
+
+func (s *Service) OnOrder(o models.Order) {
+	switch o.Status {
+	case models.OrderFilled, models.OrderCancelled, models.OrderExpired, models.OrderRejected:
+		s.enqueue(o)
+	}
+}
+
+// Exempt stops charging an account, such as the house account. Call it
+// before trading starts.
+func (s *Service) Exempt(userID string) {
+	s.exempt[userID] = true
+}
+
+// AddCharged reports every charged fill to c. Call it before trading
+// starts.
+func (s *Service) AddCharged(c Charged) {
+	s.charged = append(s.charged, c)
+}
+
+func (s *Service) OnFill(f models.Fill) {
+	if s.exempt[f.UserID] {
+		return
+	}
+	s.enqueue(f)
+}
+
+func (s *Service) enqueue(ev interface{}) { s.events.Push(ev) }
+
+// Run charges fills in the order they happened. A fill that fails is
+// retried before any later one, so no fill goes uncharged.
+func (s *Service) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.events.Ready():
+			err := s.events.Flush(func(ev interface{}) error {
+				switch ev := ev.(type) {
+				case models.Fill:
+					if err := s.charge(ctx, ev); err != nil {
+						return fmt.Errorf("fill %s: %w", ev.ID, err)
+					}
+				case models.Order:
+					delete(s.filled, ev.ID)
+				}
+				return nil
+			})
+			if err != nil {
+				log.Printf("charges: %v; retrying", err)
+			}
+		}
+	}
+}
+
+// prior is the value of the order's fills charged before f. An order first
+// seen since a restart reads it from the order's stored fills, since a fill
+// the schedule charged nothing leaves no charge entry. Fills stored after
+// f are not yet charged and are left out.
+func (s *Service) prior(ctx context.Context, f models.Fill) (float64, error) {
+	if v, ok := s.filled[f.OrderID]; ok {
+		return v, nil
+	}
+	list, err := s.repo.ListOrderFills(ctx, f.OrderID)
+	if err != nil {
+		return 0, err
+	}
+	var v float64
+	for _, fl := range list {
+		if fl.ID != f.ID && !fl.Time.After(f.Time) {
+			v += fl.Quantity * fl.Price
+		}
+	}
+	return v, nil
+}
+
+// charge posts one ledger entry per charge on the fill. Entry IDs derive
+// from the fill so a replay does not charge twice.
+func (s *Service) charge(ctx context.Context, f models.Fill) error {
+	seg, err := s.segment(ctx, f.Symbol)
+	if err != nil {
+		return err
+	}
+	prior, err := s.prior(ctx, f)
+	if err != nil {
+		return err
+	}
+	value := f.Quantity * f.Price
+
+	product := f.Product
+	if product == "" {
+		product = models.ProductDelivery
+	}
+	var total float64
+	for _, c := range s.schedule.Calculate(seg.name, product, f.Side, value, prior) {
+		total += c.Amount
+		err := s.cash.Post(ctx, models.CashEntry{
+			ID:        "charge:" + f.ID + ":" + c.Name,
+			UserID:    f.UserID,
+			Type:      models.CashCharge,
+			Currency:  f.Currency,
+			Amount:    -c.Amount,
+			Symbol:    f.Symbol,
+			Reference: f.OrderID,
+			Note:      c.Name,
+			Time:      f.Time,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	s.filled[f.OrderID] = prior + value
+	for _, c := range s.charged {
+		c.OnCharged(f, total)
+	}
+	return nil
+}
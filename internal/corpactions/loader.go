@@ -0,0 +1,161 @@
+package corpactions
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+// LoadFile reads corporate actions from a .csv or .json file. CSV columns
+// are symbol, type, ex_date, record_date, ratio_new, ratio_old, amount and
+// new_symbol, matched by header name. Plain CSV dates are midnight in the
+// zone loc gives for the symbol, its exchange's.
+func LoadFile(path string, loc func(symbol string) *time.Location) ([]models.CorporateAction, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var list []models.CorporateAction
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.NewDecoder(f).Decode(&list)
+	case ".csv":
+		list, err = readCSV(f, loc)
+	default:
+		return nil, fmt.Errorf("unsupported corporate actions file %q", path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range list {
+		if err := Normalize(&list[i]); err != nil {
+			return nil, fmt.Errorf("corporate action %d: %w", i+1, err)
+		}
+	}
+	return list, nil
+}
+
+func readCSV(r io.Reader, loc func(symbol string) *time.Location) ([]models.CorporateAction, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	col := map[string]int{}
+	for i, h := range rows[0] {
+		col[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	for _, name := range []string{"symbol", "type", "ex_date"} {
+		if _, ok := col[name]; !ok {
+			return nil, fmt.Errorf("corporate actions csv: missing %s column", name)
+		}
+	}
+
+	var list []models.CorporateAction
+	for n, row := range rows[1:] {
+		str := func(name string) string {
+			if i, ok := col[name]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		a := models.CorporateAction{
+			Symbol:    str("symbol"),
+			Type:      str("type"),
+			NewSymbol: str("new_symbol"),
+		}
+		for name, dst := range map[string]*time.Time{
+			"ex_date":     &a.ExDate,
+			"record_date": &a.RecordDate,
+		} {
+			if s := str(name); s != "" {
+				if *dst, err = ParseDate(s, loc(a.Symbol)); err != nil {
+					return nil, fmt.Errorf("corporate actions csv line %d: %s: %w", n+2, name, err)
+				}
+			}
+		}
+		for name, dst := range map[string]*float64{
+			"ratio_new": &a.RatioNew,
+			"ratio_old": &a.RatioOld,
+			"amount":    &a.Amount,
+		} {
+			if s := str(name); s != "" {
+				if *dst, err = strconv.ParseFloat(s, 64); err != nil {
+					return nil, fmt.Errorf("corporate actions csv line %d: %s: %w", n+2, name, err)
+				}
+			}
+		}
+		list = append(list, a)
+	}
+	return list, nil
+}
+
+// ParseDate accepts YYYY-MM-DD, taken as midnight in loc, or RFC 3339.
+func ParseDate(s string, loc *time.Location) (time.Time, error) {
+	if t, err := time.ParseInLocation(time.DateOnly, s, loc); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+// Normalize validates an action and fills in its ID and status. The ID is
+// derived from symbol, type and ex-date so the same action ingested twice,
+// from a file or the admin API, is stored once.
+func Normalize(a *models.CorporateAction) error {
+	a.Symbol = strings.ToUpper(strings.TrimSpace(a.Symbol))
+	a.NewSymbol = strings.ToUpper(strings.TrimSpace(a.NewSymbol))
+	a.Type = strings.ToLower(strings.TrimSpace(a.Type))
+	if a.Symbol == "" {
+		return fmt.Errorf("%w: symbol is required", ErrInvalid)
+	}
+	if a.ExDate.IsZero() {
+		return fmt.Errorf("%w: ex_date is required", ErrInvalid)
+	}
+	switch a.Type {
+	case models.ActionSplit:
+		if a.RatioNew <= a.RatioOld || a.RatioOld <= 0 {
+			return fmt.Errorf("%w: a split needs ratio_new > ratio_old > 0", ErrInvalid)
+		}
+	case models.ActionReverseSplit:
+		if a.RatioOld <= a.RatioNew || a.RatioNew <= 0 {
+			return fmt.Errorf("%w: a reverse split needs ratio_old > ratio_new > 0", ErrInvalid)
+		}
+	case models.ActionBonus:
+		if a.RatioNew <= 0 || a.RatioOld <= 0 {
+			return fmt.Errorf("%w: a bonus issue needs positive ratio_new and ratio_old", ErrInvalid)
+		}
+	case models.ActionDividend:
+		if a.Amount <= 0 {
+			return fmt.Errorf("%w: a dividend needs a positive amount", ErrInvalid)
+		}
+	case models.ActionSymbolChange:
+		if a.NewSymbol == "" || a.NewSymbol == a.Symbol {
+			return fmt.Errorf("%w: a symbol change needs a different new_symbol", ErrInvalid)
+		}
+	default:
+		return fmt.Errorf("%w: unknown action type %q", ErrInvalid, a.Type)
+	}
+	if a.RecordDate.IsZero() {
+		a.RecordDate = a.ExDate
+	}
+	a.ID = fmt.Sprintf("%s-%s-%s", a.Symbol, a.Type, a.ExDate.Format(time.DateOnly))
+	a.Status = models.ActionPending
+	a.AppliedAt = time.Time{}
+	if a.CreatedAt.IsZero() {
+		a.CreatedAt = time.Now()
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package corpactions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/calendar"
+	"github.com/hahahamid/broker-backend/internal/cash"
+	"github.com/hahahamid/broker-backend/internal/lots"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/orders"
+	"github.com/hahahamid/broker-backend/internal/repository"
+)
+
+var ErrInvalid = errors.New("invalid corporate action")
+
+// Service stores corporate actions and applies each one once its ex-date
+// arrives: working orders in the symbol are cancelled, lots acquired before
+// the ex-date are adjusted, dividends are credited to the cash ledger and
+// every affected user gets an adjustment record.
+type Service struct {
+	repo   repository.Repo
+	cal    *calendar.Calendar
+	lots   *lots.Service
+	orders *orders.Service
+	cash   *cash.Service
+	peer   *Service // the other account mode's, see SetPeer
+
+	// unrecorded holds, by action ID, adjustments made to lots whose cash
+	// or adjustment record failed to post. Lots are not adjusted twice, so
+	// these are the only way a retry can still post them.
+	unrecorded map[string][]models.Adjustment
+}
+
+func NewService(repo repository.Repo, cal *calendar.Calendar, lotSvc *lots.Service, orderSvc *orders.Service, cashSvc *cash.Service) *Service {
+	return &Service{repo: repo, cal: cal, lots: lotSvc, orders: orderSvc, cash: cashSvc, unrecorded: map[string][]models.Adjustment{}}
+}
+
+// SetPeer links the other account mode's service. Actions are reference
+// data, so ones ingested here are stored there too and adjust both modes'
+// lots.
+func (s *Service) SetPeer(p *Service) {
+	s.peer = p
+}
+
+// Location is the time zone of symbol's exchange, in which plain ex and
+// record dates are read.
+func (s *Service) Location(ctx context.Context, symbol string) *time.Location {
+	return Locator(ctx, s.repo, s.cal)(symbol)
+}
+
+// Locator returns a lookup of each symbol's exchange time zone, UTC when
+// the symbol or its exchange is unknown.
+func Locator(ctx context.Context, repo repository.InstrumentRepo, cal *calendar.Calendar) func(symbol string) *time.Location {
+	return func(symbol string) *time.Location {
+		inst, err := repo.GetInstrument(ctx, strings.ToUpper(strings.TrimSpace(symbol)))
+		if err != nil {
+			return time.UTC
+		}
+		return cal.Location(inst.Exchange)
+	}
+}
+
+// Ingest validates and stores actions; ones already stored are kept as is.
+func (s *Service) Ingest(ctx context.Context, list []models.CorporateAction) ([]models.CorporateAction, error) {
+	for i := range list {
+		if err := Normalize(&list[i]); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.repo.InsertCorporateActions(ctx, list); err != nil {
+		return nil, err
+	}
+	if s.peer != nil {
+		if err := s.peer.repo.InsertCorporateActions(ctx, list); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+func (s *Service) List(ctx context.Context, symbol string) ([]models.CorporateAction, error) {
+	return s.repo.ListCorporateActions(ctx, symbol, "")
+}
+
+// Adjustments is the user's adjustment history, newest first.
+func (s *Service) Adjustments(ctx context.Context, userID string) ([]models.Adjustment, error) {
+	return s.repo.ListAdjustments(ctx, userID)
+}
+
+// Run applies due actions at startup and then once a minute.
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		if err := s.ApplyDue(ctx, time.Now()); err != nil {
+			log.Printf("corporate actions: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ApplyDue applies pending actions whose ex-date has been reached, in
+// ex-date order. It is called from Run only and is not safe for
+// concurrent use.
+func (s *Service) ApplyDue(ctx context.Context, now time.Time) error {
+	pending, err := s.repo.ListCorporateActions(ctx, "", models.ActionPending)
+	if err != nil {
+		return err
+	}
+	for _, a := range pending {
+		if now.Before(a.ExDate) {
+			continue
+		}
+		if err := s.apply(ctx, a, now); err != nil {
+			return fmt.Errorf("apply %s: %w", a.ID, err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) apply(ctx context.Context, a models.CorporateAction, now time.Time) error {
+	if a.Type != models.ActionDividend {
+		if err := s.orders.CancelSymbol(ctx, a.Symbol); err != nil {
+			return err
+		}
+	}
+	// Instruments that cannot be held in fractions pay cash in lieu.
+	inst, err := s.repo.GetInstrument(ctx, a.Symbol)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return err
+	}
+	wholeOnly := inst == nil || !inst.Fractional
+	// Lots remember the actions applied to them, so a rerun adjusts
+	// nothing twice. Adjustments that fail to post are kept and the action
+	// stays pending, so the next run posts them again; entry and record IDs
+	// are derived from the adjustment, so posting twice changes nothing.
+	adjs := append(s.unrecorded[a.ID], s.lots.ApplyAction(a, now, wholeOnly)...)
+	var failed []models.Adjustment
+	var errs []error
+	for _, adj := range adjs {
+		if err := s.record(ctx, a, adj); err != nil {
+			failed = append(failed, adj)
+			errs = append(errs, fmt.Errorf("user %s: %w", adj.UserID, err))
+		}
+	}
+	if len(failed) > 0 {
+		s.unrecorded[a.ID] = failed
+		return errors.Join(errs...)
+	}
+	delete(s.unrecorded, a.ID)
+	a.Status = models.ActionApplied
+	a.AppliedAt = now
+	log.Printf("corporate action %s applied", a.ID)
+	return s.repo.UpdateCorporateAction(ctx, a)
+}
+
+func (s *Service) record(ctx context.Context, a models.CorporateAction, adj models.Adjustment) error {
+	// A short position owes the dividend to the lender, so its cash is
+	// negative.
+	if adj.Cash != 0 {
+		err := s.cash.Post(ctx, models.CashEntry{
+			ID:        adj.ID,
+			UserID:    adj.UserID,
+			Type:      models.CashDividend,
+			Currency:  adj.Currency,
+			Amount:    adj.Cash,
+			Symbol:    a.Symbol,
+			Reference: a.ID,
+			Note:      fmt.Sprintf("dividend %g x %g", a.Amount, adj.OldQty),
+			Time:      adj.Time,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if adj.CashInLieu > 0 {
+		err := s.cash.Post(ctx, models.CashEntry{
+			ID:        adj.ID + ":cash_in_lieu",
+			UserID:    adj.UserID,
+			Type:      models.CashInLieu,
+			Currency:  adj.Currency,
+			Amount:    adj.CashInLieu,
+			Symbol:    a.Symbol,
+			Reference: a.ID,
+			Note:      fmt.Sprintf("%g fractional share", adj.FractionQty),
+			Time:      adj.Time,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return s.repo.SaveAdjustment(ctx, adj)
+}
@@ -0,0 +1,99 @@
+package derivatives
+
+import (
+	"errors"
+	"math"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+// ErrNoVolatility is returned when no volatility reproduces a price, such
+// as a premium below the option's discounted intrinsic value.
+var ErrNoVolatility = errors.New("no implied volatility for price")
+
+const (
+	minVol = 1e-4
+	maxVol = 5.0 // 500% a year
+)
+
+func normCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+func normPDF(x float64) float64 {
+	return math.Exp(-x*x/2) / math.Sqrt(2*math.Pi)
+}
+
+func d1d2(s, k, t, r, vol float64) (float64, float64) {
+	d1 := (math.Log(s/k) + (r+vol*vol/2)*t) / (vol * math.Sqrt(t))
+	return d1, d1 - vol*math.Sqrt(t)
+}
+
+// Price is the Black-Scholes value of a European call or put on spot s
+// with strike k, t years to expiry, continuously compounded rate r and
+// annual volatility vol. At or past expiry it is the intrinsic value.
+func Price(call bool, s, k, t, r, vol float64) float64 {
+	if t <= 0 || vol <= 0 {
+		return Intrinsic(call, s, k)
+	}
+	d1, d2 := d1d2(s, k, t, r, vol)
+	df := math.Exp(-r * t)
+	if call {
+		return s*normCDF(d1) - k*df*normCDF(d2)
+	}
+	return k*df*normCDF(-d2) - s*normCDF(-d1)
+}
+
+// Intrinsic is what an option is worth exercised at spot s.
+func Intrinsic(call bool, s, k float64) float64 {
+	if call {
+		return math.Max(s-k, 0)
+	}
+	return math.Max(k-s, 0)
+}
+
+// Greeks are the Black-Scholes sensitivities per unit of the underlying:
+// theta per calendar day, vega and rho per percentage point.
+func Greeks(call bool, s, k, t, r, vol float64) models.Greeks {
+	if t <= 0 || vol <= 0 {
+		return models.Greeks{}
+	}
+	d1, d2 := d1d2(s, k, t, r, vol)
+	df := math.Exp(-r * t)
+	g := models.Greeks{
+		Gamma: normPDF(d1) / (s * vol * math.Sqrt(t)),
+		Vega:  s * normPDF(d1) * math.Sqrt(t) / 100,
+	}
+	decay := -s * normPDF(d1) * vol / (2 * math.Sqrt(t))
+	if call {
+		g.Delta = normCDF(d1)
+		g.Theta = (decay - r*k*df*normCDF(d2)) / 365
+		g.Rho = k * t * df * normCDF(d2) / 100
+	} else {
+		g.Delta = normCDF(d1) - 1
+		g.Theta = (decay + r*k*df*normCDF(-d2)) / 365
+		g.Rho = -k * t * df * normCDF(-d2) / 100
+	}
+	return g
+}
+
+// ImpliedVol finds the volatility at which Price matches price, by
+// bisection since the price rises monotonically with volatility.
+func ImpliedVol(call bool, price, s, k, t, r float64) (float64, error) {
+	if t <= 0 || price <= 0 || s <= 0 || k <= 0 {
+		return 0, ErrNoVolatility
+	}
+	lo, hi := minVol, maxVol
+	if price < Price(call, s, k, t, r, lo) || price > Price(call, s, k, t, r, hi) {
+		return 0, ErrNoVolatility
+	}
+	for i := 0; i < 100 && hi-lo > 1e-6; i++ {
+		mid := (lo + hi) / 2
+		if Price(call, s, k, t, r, mid) < price {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2, nil
+}
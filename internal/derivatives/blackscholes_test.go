@@ -0,0 +1,108 @@
+package derivatives
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func near(a, b, tol float64) bool {
+	return math.Abs(a-b) <= tol
+}
+
+func TestPrice(t *testing.T) {
+	tests := []struct {
+		name              string
+		s, k, t, r, vol   float64
+		wantCall, wantPut float64
+	}{
+		// Hull, Options, Futures and Other Derivatives, example 15.6.
+		{"hull", 42, 40, 0.5, 0.1, 0.2, 4.7594, 0.8086},
+		{"at the money", 100, 100, 1, 0.05, 0.2, 10.4506, 5.5735},
+		{"expired in the money", 110, 100, 0, 0.05, 0.2, 10, 0},
+		{"expired out of the money", 90, 100, 0, 0.05, 0.2, 0, 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Price(true, tt.s, tt.k, tt.t, tt.r, tt.vol); !near(got, tt.wantCall, 1e-4) {
+				t.Errorf("call %.4f, want %.4f", got, tt.wantCall)
+			}
+			if got := Price(false, tt.s, tt.k, tt.t, tt.r, tt.vol); !near(got, tt.wantPut, 1e-4) {
+				t.Errorf("put %.4f, want %.4f", got, tt.wantPut)
+			}
+		})
+	}
+}
+
+func TestPutCallParity(t *testing.T) {
+	for _, k := range []float64{60, 100, 140} {
+		call := Price(true, 100, k, 0.75, 0.03, 0.35)
+		put := Price(false, 100, k, 0.75, 0.03, 0.35)
+		if want := 100 - k*math.Exp(-0.03*0.75); !near(call-put, want, 1e-9) {
+			t.Errorf("k=%g: call-put %g, want %g", k, call-put, want)
+		}
+	}
+}
+
+func TestGreeks(t *testing.T) {
+	call := Greeks(true, 100, 100, 1, 0.05, 0.2)
+	put := Greeks(false, 100, 100, 1, 0.05, 0.2)
+	tests := []struct {
+		name      string
+		got, want float64
+	}{
+		{"call delta", call.Delta, 0.63683},
+		{"put delta", put.Delta, -0.36317},
+		{"gamma", call.Gamma, 0.018762},
+		{"vega", call.Vega, 0.37524},
+		{"call theta", call.Theta, -6.41403 / 365},
+		{"put theta", put.Theta, -1.65788 / 365},
+		{"call rho", call.Rho, 0.53232},
+		{"put rho", put.Rho, -0.41890},
+	}
+	for _, tt := range tests {
+		if !near(tt.got, tt.want, 1e-4) {
+			t.Errorf("%s %.5f, want %.5f", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestImpliedVolRoundTrip(t *testing.T) {
+	for _, call := range []bool{true, false} {
+		for _, k := range []float64{80, 100, 125} {
+			for _, vol := range []float64{0.05, 0.2, 0.8, 2} {
+				price := Price(call, 100, k, 0.5, 0.04, vol)
+				got, err := ImpliedVol(call, price, 100, k, 0.5, 0.04)
+				if err != nil {
+					t.Errorf("call=%v k=%g vol=%g: %v", call, k, vol, err)
+					continue
+				}
+				// Deep in or out of the money the price barely moves with
+				// volatility, so compare prices rather than volatilities.
+				if back := Price(call, 100, k, 0.5, 0.04, got); !near(back, price, 1e-4) {
+					t.Errorf("call=%v k=%g vol=%g: iv %g prices at %g, want %g", call, k, vol, got, back, price)
+				}
+				if k == 100 && !near(got, vol, 1e-4) {
+					t.Errorf("at the money vol=%g: iv %g", vol, got)
+				}
+			}
+		}
+	}
+}
+
+func TestImpliedVolRejects(t *testing.T) {
+	tests := []struct {
+		name  string
+		price float64
+		t     float64
+	}{
+		{"below intrinsic", 19, 0.5},
+		{"zero", 0, 0.5},
+		{"expired", 25, 0},
+	}
+	for _, tt := range tests {
+		if _, err := ImpliedVol(true, tt.price, 120, 100, tt.t, 0.04); !errors.Is(err, ErrNoVolatility) {
+			t.Errorf("%s: got %v, want ErrNoVolatility", tt.name, err)
+		}
+	}
+}
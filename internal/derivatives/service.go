@@ -0,0 +1,289 @@
+package derivatives
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/calendar"
+	"github.com/hahahamid/broker-backend/internal/lots"
+	"github.com/hahahamid/broker-backend/internal/marketdata"
+	"github.com/hahahamid/broker-backend/internal/matching"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/orders"
+	"github.com/hahahamid/broker-backend/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	ErrInvalid  = errors.New("invalid option chain request")
+	ErrNotFound = errors.New("no options listed")
+)
+
+const eps = 1e-9
+
+// Service prices option chains with Black-Scholes from each option's mark
+// and settles futures and options once their expiry session has closed.
+// Settlement is in cash: every open position is closed away from the book
+// at the underlying's price for a future, or at its intrinsic value for
+// an option, so in-the-money options are exercised and the rest expire
+// worthless. Futures are not margined; their trades post full notional cash
+// as shares do.
+type Service struct {
+	repo   repository.Repo
+	engine *matching.Engine
+	orders *orders.Service
+	lots   *lots.Service
+	prices *marketdata.PriceCache
+	cal    *calendar.Calendar
+	rate   float64 // continuously compounded risk-free rate
+}
+
+// NewService prices options at rate, an annual percentage.
+func NewService(repo repository.Repo, engine *matching.Engine, orderSvc *orders.Service, lotSvc *lots.Service, prices *marketdata.PriceCache, cal *calendar.Calendar, rate float64) *Service {
+	return &Service{
+		repo:   repo,
+		engine: engine,
+		orders: orderSvc,
+		lots:   lotSvc,
+		prices: prices,
+		cal:    cal,
+		rate:   rate / 100,
+	}
+}
+
+// Load registers every listed contract's multiplier with the lots service.
+func (s *Service) Load(ctx context.Context) error {
+	list, err := s.contracts(ctx)
+	if err != nil {
+		return err
+	}
+	for _, inst := range list {
+		s.lots.SetMultiplier(inst.Symbol, inst.Multiplier)
+	}
+	return nil
+}
+
+func (s *Service) contracts(ctx context.Context) ([]models.Instrument, error) {
+	futures, err := s.repo.ListInstruments(ctx, "", models.AssetClassFuture)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := s.repo.ListInstruments(ctx, "", models.AssetClassOption)
+	if err != nil {
+		return nil, err
+	}
+	return append(futures, opts...), nil
+}
+
+// Chain lists the options on underlying expiring on expiry (YYYY-MM-DD),
+// or on the nearest expiry when it is empty. Options without a mark, or
+// whose mark no volatility reproduces, come without IV and greeks.
+func (s *Service) Chain(ctx context.Context, underlying, expiry string) (models.OptionChain, error) {
+	underlying = strings.ToUpper(strings.TrimSpace(underlying))
+	chain := models.OptionChain{Underlying: underlying, Rate: s.rate, Expiries: []time.Time{}, Strikes: []models.OptionStrike{}}
+	if underlying == "" {
+		return chain, fmt.Errorf("%w: underlying is required", ErrInvalid)
+	}
+	if expiry != "" {
+		if _, err := time.Parse(time.DateOnly, expiry); err != nil {
+			return chain, fmt.Errorf("%w: expiry must be YYYY-MM-DD", ErrInvalid)
+		}
+	}
+	list, err := s.contracts(ctx)
+	if err != nil {
+		return chain, err
+	}
+
+	seen := map[string]bool{} // expiry dates
+	var opts []models.Instrument
+	for _, inst := range list {
+		if inst.Underlying != underlying || inst.Status == models.InstrumentExpired {
+			continue
+		}
+		if inst.AssetClass == models.AssetClassOption {
+			if date := inst.Expiry.UTC().Format(time.DateOnly); !seen[date] {
+				seen[date] = true
+				chain.Expiries = append(chain.Expiries, inst.Expiry)
+			}
+		}
+		opts = append(opts, inst)
+	}
+	if len(chain.Expiries) == 0 {
+		return chain, fmt.Errorf("%w: %s has no options", ErrNotFound, underlying)
+	}
+	sort.Slice(chain.Expiries, func(i, j int) bool { return chain.Expiries[i].Before(chain.Expiries[j]) })
+	chain.Expiry = chain.Expiries[0]
+	if expiry != "" {
+		if !seen[expiry] {
+			return chain, fmt.Errorf("%w: no %s options expire on %s", ErrNotFound, underlying, expiry)
+		}
+		chain.Expiry, _ = time.Parse(time.DateOnly, expiry)
+	}
+
+	chain.Spot, _ = s.prices.Mark(underlying)
+	strikes := map[float64]*models.OptionStrike{}
+	for _, inst := range opts {
+		if !inst.Expiry.Equal(chain.Expiry) {
+			continue
+		}
+		if inst.AssetClass == models.AssetClassFuture {
+			chain.Future = inst.Symbol
+			chain.FuturePrice, _ = s.prices.Mark(inst.Symbol)
+			continue
+		}
+		if chain.YearsLeft == 0 {
+			left := s.cal.SessionClose(inst.Exchange, inst.ExpiryDay()).Sub(time.Now())
+			chain.YearsLeft = math.Max(left.Hours()/24/365, 0)
+		}
+		row, ok := strikes[inst.Strike]
+		if !ok {
+			row = &models.OptionStrike{Strike: inst.Strike}
+			strikes[inst.Strike] = row
+		}
+		q := s.quote(inst, chain.Spot, chain.YearsLeft)
+		if inst.OptionType == models.OptionCall {
+			row.Call = &q
+		} else {
+			row.Put = &q
+		}
+	}
+	for _, row := range strikes {
+		chain.Strikes = append(chain.Strikes, *row)
+	}
+	sort.Slice(chain.Strikes, func(i, j int) bool { return chain.Strikes[i].Strike < chain.Strikes[j].Strike })
+	return chain, nil
+}
+
+func (s *Service) quote(inst models.Instrument, spot, years float64) models.OptionQuote {
+	call := inst.OptionType == models.OptionCall
+	q := models.OptionQuote{Symbol: inst.Symbol, Multiplier: inst.Multiplier}
+	if bq, ok := s.prices.Quote(inst.Symbol); ok {
+		q.Bid, q.Ask = bq.Bid, bq.Ask
+	}
+	q.Mark, _ = s.prices.Mark(inst.Symbol)
+	if spot <= 0 {
+		return q
+	}
+	q.Intrinsic = Intrinsic(call, spot, inst.Strike)
+	if iv, err := ImpliedVol(call, q.Mark, spot, inst.Strike, years, s.rate); err == nil {
+		g := Greeks(call, spot, inst.Strike, years, s.rate, iv)
+		q.IV, q.Greeks = iv, &g
+	}
+	return q
+}
+
+// Run settles due contracts at startup and then once a minute.
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		if err := s.SettleDue(ctx, time.Now()); err != nil {
+			log.Printf("derivatives: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SettleDue settles every contract whose expiry session has closed by now
+// and that this account mode has not settled yet. A contract that cannot
+// be priced yet is left for the next run. The shared instrument's expired
+// status is not used to skip it, since the other mode may have set it
+// before settling its own positions.
+func (s *Service) SettleDue(ctx context.Context, now time.Time) error {
+	list, err := s.contracts(ctx)
+	if err != nil {
+		return err
+	}
+	settled, err := s.repo.SettledContracts(ctx)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, inst := range list {
+		if settled[inst.Symbol] || now.Before(s.cal.SessionClose(inst.Exchange, inst.ExpiryDay())) {
+			continue
+		}
+		if err := s.settle(ctx, inst, now); err != nil {
+			errs = append(errs, fmt.Errorf("settle %s: %w", inst.Symbol, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Service) settle(ctx context.Context, inst models.Instrument, now time.Time) error {
+	price, err := s.settlementPrice(ctx, inst)
+	if err != nil {
+		return err
+	}
+	if err := s.orders.CancelSymbol(ctx, inst.Symbol); err != nil {
+		return err
+	}
+	var n int
+	for _, userID := range s.lots.Users() {
+		var qty float64
+		for _, lot := range s.lots.Lots(userID, inst.Symbol) {
+			qty += lot.Quantity
+		}
+		if math.Abs(qty) <= eps {
+			continue
+		}
+		o := models.Order{
+			ID:         primitive.NewObjectID().Hex(),
+			UserID:     userID,
+			Symbol:     inst.Symbol,
+			Exchange:   inst.Exchange,
+			Currency:   inst.Currency,
+			Side:       "sell",
+			Type:       models.OrderTypeMarket,
+			Quantity:   math.Abs(qty),
+			Product:    models.ProductDelivery,
+			Validity:   models.ValidityDay,
+			Settlement: true,
+			CreatedAt:  now,
+		}
+		if qty < 0 {
+			o.Side = "buy"
+		}
+		s.engine.Allocate(o, price, o.Quantity)
+		n++
+	}
+	if err := s.repo.SaveSettledContract(ctx, inst.Symbol, now); err != nil {
+		return err
+	}
+	inst.Status = models.InstrumentExpired
+	if err := s.repo.UpsertInstruments(ctx, []models.Instrument{inst}); err != nil {
+		return err
+	}
+	log.Printf("derivatives: %s expired, %d positions settled at %g", inst.Symbol, n, price)
+	return nil
+}
+
+// settlementPrice is the underlying's mark, or its previous close without
+// one, for a future and the intrinsic value at that price for an option.
+func (s *Service) settlementPrice(ctx context.Context, inst models.Instrument) (float64, error) {
+	spot, ok := s.prices.Mark(inst.Underlying)
+	if !ok {
+		u, err := s.repo.GetInstrument(ctx, inst.Underlying)
+		if err != nil && !errors.Is(err, repository.ErrNotFound) {
+			return 0, err
+		}
+		if u == nil || u.PrevClose <= 0 {
+			return 0, fmt.Errorf("no price for %s", inst.Underlying)
+		}
+		spot = u.PrevClose
+	}
+	if inst.AssetClass == models.AssetClassFuture {
+		return spot, nil
+	}
+	return Intrinsic(inst.OptionType == models.OptionCall, spot, inst.Strike), nil
+}
@@ -0,0 +1,174 @@
+package derivatives
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/fractional"
+	"github.com/hahahamid/broker-backend/internal/fx"
+	"github.com/hahahamid/broker-backend/internal/lots"
+	"github.com/hahahamid/broker-backend/internal/marketdata"
+	"github.com/hahahamid/broker-backend/internal/matching"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/orders"
+	"github.com/hahahamid/broker-backend/internal/repository"
+)
+
+// memRepo keeps instruments and settled contracts in memory; settlement
+// needs nothing else.
+type memRepo struct {
+	repository.Repo
+	instruments map[string]models.Instrument
+	settled     map[string]bool
+}
+
+func (r *memRepo) SaveSettledContract(_ context.Context, symbol string, _ time.Time) error {
+	r.settled[symbol] = true
+	return nil
+}
+
+func (r *memRepo) SettledContracts(context.Context) (map[string]bool, error) {
+	out := make(map[string]bool, len(r.settled))
+	for sym := range r.settled {
+		out[sym] = true
+	}
+	return out, nil
+}
+
+func (r *memRepo) UpsertInstruments(_ context.Context, list []models.Instrument) error {
+	for _, inst := range list {
+		r.instruments[inst.Symbol] = inst
+	}
+	return nil
+}
+
+func (r *memRepo) GetInstrument(_ context.Context, symbol string) (*models.Instrument, error) {
+	inst, ok := r.instruments[symbol]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &inst, nil
+}
+
+func (r *memRepo) ListInstruments(_ context.Context, _, class string) ([]models.Instrument, error) {
+	var out []models.Instrument
+	for _, inst := range r.instruments {
+		if class == "" || inst.AssetClass == class {
+			out = append(out, inst)
+		}
+	}
+	return out, nil
+}
+
+// settlements records the fills of settlement orders by symbol and user.
+type settlements map[string]models.Fill
+
+func (s settlements) OnOrder(models.Order) {}
+func (s settlements) OnFill(f models.Fill) { s[f.Symbol+"/"+f.UserID] = f }
+
+func TestSettleDue(t *testing.T) {
+	now := time.Now()
+	expiry := now.AddDate(0, 0, -3).UTC().Truncate(24 * time.Hour)
+	repo := &memRepo{instruments: map[string]models.Instrument{}, settled: map[string]bool{}}
+	contracts := []models.Instrument{
+		{Symbol: "ACME", PrevClose: 104},
+		{Symbol: "ACMEFUT", AssetClass: models.AssetClassFuture, Underlying: "ACME", Expiry: expiry, Multiplier: 10},
+		{Symbol: "ACME95CE", AssetClass: models.AssetClassOption, Underlying: "ACME", Expiry: expiry, Strike: 95, OptionType: models.OptionCall, Multiplier: 10},
+		{Symbol: "ACME95PE", AssetClass: models.AssetClassOption, Underlying: "ACME", Expiry: expiry, Strike: 95, OptionType: models.OptionPut, Multiplier: 10},
+		{Symbol: "ACMELATER", AssetClass: models.AssetClassFuture, Underlying: "ACME", Expiry: now.AddDate(0, 1, 0), Multiplier: 10},
+	}
+	_ = repo.UpsertInstruments(context.Background(), contracts)
+
+	engine := matching.NewEngine()
+	prices := marketdata.NewPriceCache()
+	prices.OnTrade(models.Trade{Symbol: "ACME", Price: 100})
+	lotSvc := lots.NewService(nil, prices, nil, fx.NewConverter(nil, "USD"), models.LotFIFO, 365*24*time.Hour)
+	house := fractional.NewService(engine, lotSvc, prices, nil, "house", time.Second)
+	orderSvc := orders.NewService(repo, engine, nil, lotSvc, house, nil)
+	got := settlements{}
+	engine.AddListener(lotSvc)
+	engine.AddListener(got)
+
+	bought := expiry.AddDate(0, 0, -5)
+	for _, sym := range []string{"ACMEFUT", "ACME95CE", "ACME95PE", "ACMELATER"} {
+		lotSvc.OnFill(models.Fill{ID: "long-" + sym, UserID: "long", Symbol: sym, Side: "buy", Price: 3, Quantity: 20, Time: bought})
+	}
+	lotSvc.Track(models.Order{ID: "short", UserID: "short", Symbol: "ACMEFUT", Side: "sell", Quantity: 10, Short: true})
+	lotSvc.OnFill(models.Fill{ID: "short-fut", OrderID: "short", UserID: "short", Symbol: "ACMEFUT", Side: "sell", Price: 98, Quantity: 10, Time: bought})
+
+	svc := NewService(repo, engine, orderSvc, lotSvc, prices, nil, 5)
+	if err := svc.SettleDue(context.Background(), now); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		key   string
+		side  string
+		price float64
+		qty   float64
+	}{
+		{"ACMEFUT/long", "sell", 100, 20}, // futures settle at the underlying's mark
+		{"ACMEFUT/short", "buy", 100, 10}, // shorts are bought back
+		{"ACME95CE/long", "sell", 5, 20},  // in the money: exercised for cash
+		{"ACME95PE/long", "sell", 0, 20},  // out of the money: expires worthless
+	}
+	for _, w := range want {
+		f, ok := got[w.key]
+		if !ok {
+			t.Errorf("%s: not settled", w.key)
+			continue
+		}
+		if f.Side != w.side || f.Price != w.price || f.Quantity != w.qty || !f.Allocation {
+			t.Errorf("%s: %s %g @ %g (allocation %v), want %s %g @ %g", w.key, f.Side, f.Quantity, f.Price, f.Allocation, w.side, w.qty, w.price)
+		}
+	}
+	if _, ok := got["ACMELATER/long"]; ok {
+		t.Error("settled a contract before its expiry")
+	}
+	for _, user := range []string{"long", "short"} {
+		for _, lot := range lotSvc.Lots(user, "") {
+			if lot.Symbol != "ACMELATER" && math.Abs(lot.Quantity) > eps {
+				t.Errorf("%s still holds %g %s", user, lot.Quantity, lot.Symbol)
+			}
+		}
+	}
+	if st := repo.instruments["ACMEFUT"].Status; st != models.InstrumentExpired {
+		t.Errorf("future status %q, want expired", st)
+	}
+	if st := repo.instruments["ACMELATER"].Status; st == models.InstrumentExpired {
+		t.Error("unexpired future marked expired")
+	}
+
+	// A second run finds nothing left to settle.
+	got = settlements{}
+	engine.AddListener(got)
+	if err := svc.SettleDue(context.Background(), now); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("second run settled %d positions", len(got))
+	}
+
+	// The other account mode shares the instruments, now marked expired,
+	// but still settles its own positions.
+	other := &memRepo{instruments: repo.instruments, settled: map[string]bool{}}
+	otherEngine := matching.NewEngine()
+	otherLots := lots.NewService(nil, prices, nil, fx.NewConverter(nil, "USD"), models.LotFIFO, 365*24*time.Hour)
+	otherOrders := orders.NewService(other, otherEngine, nil, otherLots, fractional.NewService(otherEngine, otherLots, prices, nil, "house", time.Second), nil)
+	got = settlements{}
+	otherEngine.AddListener(otherLots)
+	otherEngine.AddListener(got)
+	otherLots.OnFill(models.Fill{ID: "paper-fut", UserID: "paper", Symbol: "ACMEFUT", Side: "buy", Price: 3, Quantity: 10, Time: bought})
+	otherSvc := NewService(other, otherEngine, otherOrders, otherLots, prices, nil, 5)
+	if err := otherSvc.SettleDue(context.Background(), now); err != nil {
+		t.Fatal(err)
+	}
+	if f, ok := got["ACMEFUT/paper"]; !ok || f.Quantity != 10 || f.Price != 100 {
+		t.Errorf("other mode's future not settled: %+v", f)
+	}
+	if !other.settled["ACMEFUT"] || len(repo.settled) != 3 {
+		t.Errorf("settled sets: this mode %v, other %v", repo.settled, other.settled)
+	}
+}
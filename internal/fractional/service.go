@@ -0,0 +1,286 @@
+package fractional
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/calendar"
+	"github.com/hahahamid/broker-backend/internal/instruments"
+	"github.com/hahahamid/broker-backend/internal/lots"
+	"github.com/hahahamid/broker-backend/internal/marketdata"
+	"github.com/hahahamid/broker-backend/internal/matching"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrNoPrice is returned when a notional order cannot be sized because the
+// symbol has no quote yet.
+var ErrNoPrice = errors.New("no price to size the order")
+
+const eps = 1e-9
+
+// Service works fractional orders through a house account. Orders are
+// collected per symbol and every batch interval the batch is netted
+// against the house inventory: whatever the inventory cannot cover is
+// bought on the book in whole shares, and whole shares the house no longer
+// needs are sold. Every order in the batch is then filled out of, or into,
+// the house account at one price: the street order's average price, or
+// the last price when the batch nets out within the inventory.
+type Service struct {
+	engine   *matching.Engine
+	lots     *lots.Service
+	prices   *marketdata.PriceCache
+	cal      *calendar.Calendar
+	account  string
+	interval time.Duration
+
+	mu      sync.Mutex
+	pending map[string][]models.Order // by symbol, in arrival order
+}
+
+// NewService trades for the house on account, batching every interval.
+func NewService(engine *matching.Engine, lotSvc *lots.Service, prices *marketdata.PriceCache, cal *calendar.Calendar, account string, interval time.Duration) *Service {
+	if interval <= 0 {
+		interval = time.Second
+	}
+	return &Service{
+		engine:   engine,
+		lots:     lotSvc,
+		prices:   prices,
+		cal:      cal,
+		account:  account,
+		interval: interval,
+		pending:  map[string][]models.Order{},
+	}
+}
+
+// Account is the house account's user ID.
+func (s *Service) Account() string {
+	return s.account
+}
+
+// Size works out a notional order's quantity from the current ask for
+// buys or bid for sells, rounded down to the instrument's increment, so
+// the amount actually traded can differ slightly from the notional.
+func (s *Service) Size(o *models.Order, inst *models.Instrument) error {
+	price := 0.0
+	if q, ok := s.prices.Quote(o.Symbol); ok {
+		if o.Side == "buy" {
+			price = q.Ask
+		} else {
+			price = q.Bid
+		}
+	}
+	if price <= 0 {
+		var ok bool
+		if price, ok = s.prices.Mark(o.Symbol); !ok || price <= 0 {
+			return ErrNoPrice
+		}
+	}
+	step := instruments.Increment(inst)
+	// Round to the step's decimal places to drop the float noise of n*step.
+	n := math.Floor(o.Notional/price/step + eps)
+	scale := math.Pow(10, math.Max(math.Ceil(-math.Log10(step)), 0))
+	o.Quantity = math.Round(n*step*scale) / scale
+	if o.Quantity <= 0 {
+		return fmt.Errorf("%g buys less than %g %s at %g", o.Notional, step, o.Symbol, price)
+	}
+	return nil
+}
+
+// Add queues an accepted fractional order for the next batch.
+func (s *Service) Add(o models.Order, now time.Time) models.Order {
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = now
+	}
+	o.UpdatedAt = now
+	o.Status = models.OrderOpen
+	s.mu.Lock()
+	s.pending[o.Symbol] = append(s.pending[o.Symbol], o)
+	s.mu.Unlock()
+	return o
+}
+
+// Cancel takes a user's order out of the queue. It reports false once the
+// order is no longer waiting.
+func (s *Service) Cancel(userID, orderID string) (models.Order, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sym, list := range s.pending {
+		for i, o := range list {
+			if o.ID == orderID && o.UserID == userID {
+				s.pending[sym] = append(list[:i:i], list[i+1:]...)
+				return o, true
+			}
+		}
+	}
+	return models.Order{}, false
+}
+
+// CancelSymbol takes every order in symbol out of the queue and returns
+// them.
+func (s *Service) CancelSymbol(symbol string) []models.Order {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := s.pending[symbol]
+	delete(s.pending, symbol)
+	return list
+}
+
+// Pending lists the queued orders, oldest first.
+func (s *Service) Pending() []models.Order {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []models.Order
+	for _, list := range s.pending {
+		out = append(out, list...)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	return out
+}
+
+// Holdings is the house inventory.
+func (s *Service) Holdings() []models.Holding {
+	return s.lots.Holdings(s.account)
+}
+
+// Run works the batches of every symbol whose exchange is open.
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.tick(now)
+		}
+	}
+}
+
+func (s *Service) tick(now time.Time) {
+	s.mu.Lock()
+	batches := map[string][]models.Order{}
+	for sym, list := range s.pending {
+		if len(list) > 0 && s.cal.Status(list[0].Exchange, now).IsOpen {
+			batches[sym] = list
+			delete(s.pending, sym)
+		}
+	}
+	s.mu.Unlock()
+	for sym, list := range batches {
+		s.batch(sym, list)
+	}
+}
+
+// batch nets one symbol's orders against the house inventory, trades the
+// whole-share difference and allocates. Buys are served in arrival order;
+// any the house cannot cover because the street order only partly filled
+// are cancelled.
+func (s *Service) batch(symbol string, list []models.Order) {
+	var buys, sells float64
+	for _, o := range list {
+		if o.Side == "buy" {
+			buys += o.Remaining()
+		} else {
+			sells += o.Remaining()
+		}
+	}
+	inventory := s.lots.Available(s.account, symbol, false)
+
+	var price float64
+	if need := buys - sells - inventory; need > eps {
+		street := s.street(list[0], "buy", math.Ceil(need-eps))
+		street = s.engine.Submit(street)
+		if street.FilledQty > eps {
+			price = street.AvgFillPrice
+		}
+		inventory += street.FilledQty
+	}
+	if price <= 0 {
+		mark, ok := s.prices.Mark(symbol)
+		if !ok || mark <= 0 {
+			log.Printf("fractional: no price for %s, cancelling %d orders", symbol, len(list))
+			for _, o := range list {
+				s.engine.Allocate(o, 0, 0)
+			}
+			return
+		}
+		price = mark
+	}
+
+	// Sells land in the house first so their shares can cover buys.
+	available := inventory + sells
+	alloc := make(map[string]float64, len(list))
+	var bought float64
+	for _, o := range list {
+		if o.Side == "buy" && o.Remaining() <= available+eps {
+			alloc[o.ID] = o.Remaining()
+			available -= o.Remaining()
+			bought += o.Remaining()
+		} else if o.Side == "sell" {
+			alloc[o.ID] = o.Remaining()
+		}
+	}
+
+	// The house takes the other side of every allocation, netted per side.
+	var intoHouse, outOfHouse models.Order
+	if sells > eps {
+		intoHouse = s.engine.Allocate(s.street(list[0], "buy", sells), price, sells)
+	}
+	for _, o := range list {
+		if o.Side == "buy" {
+			continue
+		}
+		o.HouseOrderID = intoHouse.ID
+		s.engine.Allocate(o, price, alloc[o.ID])
+	}
+	if bought > eps {
+		outOfHouse = s.street(list[0], "sell", bought)
+	}
+	for _, o := range list {
+		if o.Side == "sell" {
+			continue
+		}
+		if alloc[o.ID] > 0 {
+			o.HouseOrderID = outOfHouse.ID
+		}
+		s.engine.Allocate(o, price, alloc[o.ID])
+	}
+	if bought > eps {
+		s.engine.Allocate(outOfHouse, price, bought)
+	}
+
+	// Sell down whole shares the house no longer needs.
+	settledOnly := s.cal.BlocksUnsettledSells(list[0].Exchange)
+	if excess := math.Floor(s.lots.Available(s.account, symbol, settledOnly) + eps); excess >= 1 {
+		street := s.street(list[0], "sell", excess)
+		if err := s.lots.Reserve(&street); err != nil {
+			log.Printf("fractional: house sell of %g %s: %v", excess, symbol, err)
+			return
+		}
+		s.engine.Submit(street)
+	}
+}
+
+// street is a house market order in like's symbol.
+func (s *Service) street(like models.Order, side string, qty float64) models.Order {
+	return models.Order{
+		ID:       primitive.NewObjectID().Hex(),
+		UserID:   s.account,
+		Symbol:   like.Symbol,
+		Exchange: like.Exchange,
+		Currency: like.Currency,
+		Side:     side,
+		Type:     models.OrderTypeMarket,
+		Quantity: qty,
+		Validity: models.ValidityDay,
+		Product:  models.ProductDelivery,
+	}
+}
@@ -0,0 +1,105 @@
+package fractional
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/fx"
+	"github.com/hahahamid/broker-backend/internal/lots"
+	"github.com/hahahamid/broker-backend/internal/marketdata"
+	"github.com/hahahamid/broker-backend/internal/matching"
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+// recorder keeps the latest state of every order the engine reports and
+// the fills made on the book rather than allocated.
+type recorder struct {
+	orders map[string]models.Order
+	street []models.Fill
+}
+
+func (r *recorder) OnOrder(o models.Order) { r.orders[o.ID] = o }
+
+func (r *recorder) OnFill(f models.Fill) {
+	if !f.Allocation {
+		r.street = append(r.street, f)
+	}
+}
+
+func newTestService(t *testing.T, q models.Quote) (*Service, *recorder) {
+	t.Helper()
+	engine := matching.NewEngine()
+	prices := marketdata.NewPriceCache()
+	lotSvc := lots.NewService(nil, prices, nil, fx.NewConverter(nil, "USD"), models.LotFIFO, 365*24*time.Hour)
+	rec := &recorder{orders: map[string]models.Order{}}
+	engine.AddListener(lotSvc)
+	engine.AddListener(rec)
+	prices.OnQuote(q)
+	engine.OnQuote(q)
+	return NewService(engine, lotSvc, prices, nil, "house", time.Second), rec
+}
+
+func buy(id, user string, qty float64) models.Order {
+	return models.Order{ID: id, UserID: user, Symbol: "ACME", Exchange: "X", Side: "buy", Type: models.OrderTypeMarket, Quantity: qty}
+}
+
+func TestBatchPartialStreetFill(t *testing.T) {
+	// The street buy of 2 shares only gets 1, so the second order in
+	// arrival order no longer fits and is cancelled while the third does.
+	s, rec := newTestService(t, models.Quote{Symbol: "ACME", Bid: 9, Ask: 10, BidSize: 100, AskSize: 1})
+	s.batch("ACME", []models.Order{buy("a", "u1", 0.5), buy("b", "u2", 0.7), buy("c", "u3", 0.4)})
+
+	want := map[string]struct {
+		status string
+		filled float64
+	}{
+		"a": {models.OrderFilled, 0.5},
+		"b": {models.OrderCancelled, 0},
+		"c": {models.OrderFilled, 0.4},
+	}
+	for id, w := range want {
+		o := rec.orders[id]
+		if o.Status != w.status || math.Abs(o.FilledQty-w.filled) > eps {
+			t.Errorf("order %s: got %s %g, want %s %g", id, o.Status, o.FilledQty, w.status, w.filled)
+		}
+		if w.filled > 0 && o.AvgFillPrice != 10 {
+			t.Errorf("order %s: price %g, want the street price 10", id, o.AvgFillPrice)
+		}
+	}
+	if got := s.lots.Available("house", "ACME", false); math.Abs(got-0.1) > 1e-6 {
+		t.Errorf("house keeps %g, want 0.1", got)
+	}
+}
+
+func TestBatchRemainderRounding(t *testing.T) {
+	// 0.1 + 0.2 sums to a hair over 0.3; the house inventory of 0.3 must
+	// still cover both without a street order or a cancelled remainder.
+	s, rec := newTestService(t, models.Quote{Symbol: "ACME", Bid: 9, Ask: 10, BidSize: 100, AskSize: 100})
+	s.lots.OnFill(models.Fill{ID: "inv", UserID: "house", Symbol: "ACME", Exchange: "X", Side: "buy", Price: 8, Quantity: 0.3, Time: time.Now()})
+	s.batch("ACME", []models.Order{buy("a", "u1", 0.1), buy("b", "u2", 0.2)})
+
+	for _, id := range []string{"a", "b"} {
+		if o := rec.orders[id]; o.Status != models.OrderFilled {
+			t.Errorf("order %s: %s with %g filled, want filled", id, o.Status, o.FilledQty)
+		}
+	}
+	if len(rec.street) > 0 {
+		t.Errorf("traded %g on the book, want nothing", rec.street[0].Quantity)
+	}
+	if got := s.lots.Available("house", "ACME", false); got > 1e-6 {
+		t.Errorf("house keeps %g, want nothing", got)
+	}
+}
+
+func TestSizeRoundsDownToIncrement(t *testing.T) {
+	s, _ := newTestService(t, models.Quote{Symbol: "ACME", Bid: 29, Ask: 30, BidSize: 1, AskSize: 1})
+	inst := &models.Instrument{Symbol: "ACME", Fractional: true, MinIncrement: 0.001, LotSize: 1}
+	o := models.Order{Symbol: "ACME", Side: "buy", Notional: 100}
+	if err := s.Size(&o, inst); err != nil {
+		t.Fatal(err)
+	}
+	if o.Quantity != 3.333 {
+		t.Errorf("quantity %v, want 3.333", o.Quantity)
+	}
+}
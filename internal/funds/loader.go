@@ -0,0 +1,180 @@
+package funds
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+// LoadFile reads the fund catalogue from a .csv or .json file. CSV columns
+// are symbol, name, amc, category, isin, exchange, currency, cutoff,
+// min_purchase and min_sip, matched by header name.
+func LoadFile(path string) ([]models.Fund, error) {
+	var list []models.Fund
+	err := load(path, "fund", &list, func(str func(string) string, num func(string) (float64, error)) error {
+		f := models.Fund{
+			Symbol:   str("symbol"),
+			Name:     str("name"),
+			AMC:      str("amc"),
+			Category: str("category"),
+			ISIN:     str("isin"),
+			Exchange: str("exchange"),
+			Currency: str("currency"),
+			Cutoff:   str("cutoff"),
+		}
+		var err error
+		if f.MinPurchase, err = num("min_purchase"); err != nil {
+			return err
+		}
+		if f.MinSIP, err = num("min_sip"); err != nil {
+			return err
+		}
+		list = append(list, f)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := Normalize(list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// LoadNAVFile reads daily NAVs from a .csv or .json file with symbol, date
+// (YYYY-MM-DD) and nav columns.
+func LoadNAVFile(path string) ([]models.NAV, error) {
+	var list []models.NAV
+	err := load(path, "nav", &list, func(str func(string) string, num func(string) (float64, error)) error {
+		n := models.NAV{Symbol: str("symbol"), Date: str("date")}
+		var err error
+		if n.NAV, err = num("nav"); err != nil {
+			return err
+		}
+		list = append(list, n)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := NormalizeNAVs(list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// load decodes a JSON file into out, or hands each CSV row to row.
+func load(path, kind string, out interface{}, row func(str func(string) string, num func(string) (float64, error)) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return json.NewDecoder(f).Decode(out)
+	case ".csv":
+		return readCSV(f, kind, row)
+	default:
+		return fmt.Errorf("unsupported %s file %q", kind, path)
+	}
+}
+
+func readCSV(r io.Reader, kind string, fn func(str func(string) string, num func(string) (float64, error)) error) error {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	col := map[string]int{}
+	for i, h := range rows[0] {
+		col[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	if _, ok := col["symbol"]; !ok {
+		return fmt.Errorf("%s csv: missing symbol column", kind)
+	}
+
+	for n, row := range rows[1:] {
+		str := func(name string) string {
+			if i, ok := col[name]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		num := func(name string) (float64, error) {
+			s := str(name)
+			if s == "" {
+				return 0, nil
+			}
+			v, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return 0, fmt.Errorf("%s csv line %d: %s: %w", kind, n+2, name, err)
+			}
+			return v, nil
+		}
+		if err := fn(str, num); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Normalize upper-cases symbols and validates a fund catalogue. NAVs are
+// not part of the catalogue and are cleared.
+func Normalize(list []models.Fund) error {
+	seen := map[string]bool{}
+	for i := range list {
+		f := &list[i]
+		f.Symbol = strings.ToUpper(strings.TrimSpace(f.Symbol))
+		f.Exchange = strings.ToUpper(strings.TrimSpace(f.Exchange))
+		f.Currency = strings.ToUpper(strings.TrimSpace(f.Currency))
+		f.NAV, f.NAVDate = 0, ""
+		switch {
+		case f.Symbol == "":
+			return fmt.Errorf("%w: fund %d has no symbol", ErrInvalid, i+1)
+		case seen[f.Symbol]:
+			return fmt.Errorf("%w: %s is listed twice", ErrInvalid, f.Symbol)
+		case f.Name == "":
+			return fmt.Errorf("%w: %s has no name", ErrInvalid, f.Symbol)
+		case f.MinPurchase < 0 || f.MinSIP < 0:
+			return fmt.Errorf("%w: %s minimum amounts must not be negative", ErrInvalid, f.Symbol)
+		}
+		if f.Cutoff != "" {
+			if _, err := time.Parse("15:04", f.Cutoff); err != nil {
+				return fmt.Errorf("%w: %s cutoff must be HH:MM", ErrInvalid, f.Symbol)
+			}
+		}
+		seen[f.Symbol] = true
+	}
+	return nil
+}
+
+// NormalizeNAVs upper-cases symbols and validates dates and values.
+func NormalizeNAVs(list []models.NAV) error {
+	for i := range list {
+		n := &list[i]
+		n.Symbol = strings.ToUpper(strings.TrimSpace(n.Symbol))
+		if n.Symbol == "" {
+			return fmt.Errorf("%w: nav %d has no symbol", ErrInvalid, i+1)
+		}
+		if _, err := time.Parse(time.DateOnly, n.Date); err != nil {
+			return fmt.Errorf("%w: %s nav date must be YYYY-MM-DD", ErrInvalid, n.Symbol)
+		}
+		if n.NAV <= 0 {
+			return fmt.Errorf("%w: %s nav on %s must be positive", ErrInvalid, n.Symbol, n.Date)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,787 @@
+package funds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/calendar"
+	"github.com/hahahamid/broker-backend/internal/cash"
+	"github.com/hahahamid/broker-backend/internal/fx"
+	"github.com/hahahamid/broker-backend/internal/lots"
+	"github.com/hahahamid/broker-backend/internal/marketdata"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	ErrInvalid  = errors.New("invalid fund request")
+	ErrNotFound = errors.New("not found")
+	ErrState    = errors.New("cannot do that now")
+)
+
+const (
+	eps = 1e-9
+
+	// unitStep is the precision units are allotted and redeemed in.
+	unitStep = 0.001
+
+	// navHistory is how many NAVs a fund lookup returns.
+	navHistory = 30
+)
+
+// Service runs mutual fund orders and SIPs. Orders are not matched on the
+// book: a purchase debits its amount when placed and, once the NAV for its
+// trade date is published, is allotted the whole thousandths of a unit that
+// amount buys, with the remainder refunded. A redemption reserves its units
+// and pays out units times NAV. Allotted units are booked as fills into the
+// lot ledger, so they show in holdings and positions next to shares. SIPs
+// place a purchase on their day of every month.
+type Service struct {
+	repo    repository.Repo
+	cash    *cash.Service
+	lots    *lots.Service
+	prices  *marketdata.PriceCache
+	cal     *calendar.Calendar
+	fx      *fx.Converter
+	navFile string
+	peer    *Service // the other account mode's, see SetPeer
+
+	mu     sync.Mutex // serialises order and SIP state changes
+	navMod time.Time  // of navFile when last read
+}
+
+// NewService reloads NAVs from navFile, when set, whenever it changes.
+func NewService(repo repository.Repo, cashSvc *cash.Service, lotSvc *lots.Service, prices *marketdata.PriceCache, cal *calendar.Calendar, conv *fx.Converter, navFile string) *Service {
+	return &Service{
+		repo:    repo,
+		cash:    cashSvc,
+		lots:    lotSvc,
+		prices:  prices,
+		cal:     cal,
+		fx:      conv,
+		navFile: navFile,
+	}
+}
+
+// Ingest stores a fund catalogue, keeping the latest NAV of funds already
+// known, and lists each fund as an instrument so it can be looked up,
+// searched and watched like one.
+func (s *Service) Ingest(ctx context.Context, list []models.Fund) error {
+	if err := Normalize(list); err != nil {
+		return err
+	}
+	for i := range list {
+		old, err := s.repo.GetFund(ctx, list[i].Symbol)
+		if err != nil && !errors.Is(err, repository.ErrNotFound) {
+			return err
+		}
+		if old != nil {
+			list[i].NAV, list[i].NAVDate = old.NAV, old.NAVDate
+		}
+	}
+	if err := s.repo.UpsertFunds(ctx, list); err != nil {
+		return err
+	}
+	return s.list(ctx, list)
+}
+
+func (s *Service) list(ctx context.Context, list []models.Fund) error {
+	insts := make([]models.Instrument, 0, len(list))
+	for _, f := range list {
+		insts = append(insts, models.Instrument{
+			Symbol:       f.Symbol,
+			Name:         f.Name,
+			Exchange:     f.Exchange,
+			ISIN:         f.ISIN,
+			AssetClass:   models.AssetClassFund,
+			Currency:     s.fx.Currency(f.Currency),
+			TickSize:     0.0001,
+			LotSize:      1,
+			Fractional:   true,
+			MinIncrement: unitStep,
+			PrevClose:    f.NAV,
+			Status:       models.InstrumentActive,
+		})
+	}
+	return s.repo.UpsertInstruments(ctx, insts)
+}
+
+// Load registers every fund with the lots service and the price cache at
+// its latest NAV, reserves the units of pending redemptions and reads the
+// NAV file.
+func (s *Service) Load(ctx context.Context) error {
+	list, err := s.repo.ListFunds(ctx)
+	if err != nil {
+		return err
+	}
+	exchanges := make(map[string]string, len(list))
+	for _, f := range list {
+		s.register(f)
+		exchanges[f.Symbol] = f.Exchange
+	}
+	pending, err := s.repo.ListPendingFundOrders(ctx)
+	if err != nil {
+		return err
+	}
+	for _, o := range pending {
+		if o.Side == models.FundRedeem {
+			s.lots.Track(lotOrder(o, exchanges[o.Symbol]))
+		}
+	}
+	return s.reloadNAVs(ctx)
+}
+
+func (s *Service) register(f models.Fund) {
+	s.lots.SetFund(f.Symbol)
+	if f.NAV > 0 {
+		s.prices.OnTrade(models.Trade{Symbol: f.Symbol, Price: f.NAV, Time: time.Now()})
+	}
+}
+
+// lotOrder is the sell order a redemption reserves its units under.
+func lotOrder(o models.FundOrder, exchange string) models.Order {
+	return models.Order{
+		ID:        o.ID,
+		UserID:    o.UserID,
+		Symbol:    o.Symbol,
+		Exchange:  exchange,
+		Currency:  o.Currency,
+		Side:      "sell",
+		Type:      models.OrderTypeMarket,
+		Quantity:  o.Units,
+		Product:   models.ProductDelivery,
+		LotMethod: o.LotMethod,
+	}
+}
+
+func (s *Service) reloadNAVs(ctx context.Context) error {
+	if s.navFile == "" {
+		return nil
+	}
+	st, err := os.Stat(s.navFile)
+	if err != nil {
+		return err
+	}
+	if st.ModTime().Equal(s.navMod) {
+		return nil
+	}
+	list, err := LoadNAVFile(s.navFile)
+	if err != nil {
+		return err
+	}
+	if _, err := s.postNAVs(ctx, list); err != nil {
+		return err
+	}
+	s.navMod = st.ModTime()
+	log.Printf("funds: loaded %d NAVs from %s", len(list), s.navFile)
+	return nil
+}
+
+// SetPeer links the other account mode's service, so NAVs posted here
+// price that mode's orders too. The NAV file is read by each mode itself.
+func (s *Service) SetPeer(p *Service) {
+	s.peer = p
+}
+
+// PostNAVs stores NAVs in both account modes, moves each fund's latest NAV
+// forward and allots the pending orders they price. It returns how many
+// NAVs were stored.
+func (s *Service) PostNAVs(ctx context.Context, list []models.NAV) (int, error) {
+	n, err := s.postNAVs(ctx, list)
+	if err != nil || s.peer == nil {
+		return n, err
+	}
+	return s.peer.postNAVs(ctx, list)
+}
+
+func (s *Service) postNAVs(ctx context.Context, list []models.NAV) (int, error) {
+	if len(list) == 0 {
+		return 0, fmt.Errorf("%w: no NAVs given", ErrInvalid)
+	}
+	if err := NormalizeNAVs(list); err != nil {
+		return 0, err
+	}
+	latest := map[string]models.NAV{}
+	for _, n := range list {
+		if cur, ok := latest[n.Symbol]; !ok || n.Date >= cur.Date {
+			latest[n.Symbol] = n
+		}
+	}
+	var changed []models.Fund
+	for sym, n := range latest {
+		f, err := s.fund(ctx, sym)
+		if err != nil {
+			return 0, err
+		}
+		if n.Date >= f.NAVDate {
+			f.NAV, f.NAVDate = n.NAV, n.Date
+			changed = append(changed, f)
+		}
+	}
+	if err := s.repo.SaveNAVs(ctx, list); err != nil {
+		return 0, err
+	}
+	if err := s.repo.UpsertFunds(ctx, changed); err != nil {
+		return 0, err
+	}
+	if err := s.list(ctx, changed); err != nil {
+		return 0, err
+	}
+	for _, f := range changed {
+		s.register(f)
+	}
+	if err := s.Process(ctx, time.Now()); err != nil {
+		log.Printf("funds: %v", err)
+	}
+	return len(list), nil
+}
+
+func (s *Service) fund(ctx context.Context, symbol string) (models.Fund, error) {
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	if symbol == "" {
+		return models.Fund{}, fmt.Errorf("%w: symbol is required", ErrInvalid)
+	}
+	f, err := s.repo.GetFund(ctx, symbol)
+	if errors.Is(err, repository.ErrNotFound) {
+		return models.Fund{}, fmt.Errorf("%w: no fund %s", ErrNotFound, symbol)
+	}
+	if err != nil {
+		return models.Fund{}, err
+	}
+	return *f, nil
+}
+
+// Funds lists the catalogue with each fund's latest NAV.
+func (s *Service) Funds(ctx context.Context) ([]models.Fund, error) {
+	return s.repo.ListFunds(ctx)
+}
+
+// Fund returns a fund with its recent NAVs, newest first.
+func (s *Service) Fund(ctx context.Context, symbol string) (models.Fund, []models.NAV, error) {
+	f, err := s.fund(ctx, symbol)
+	if err != nil {
+		return f, nil, err
+	}
+	navs, err := s.repo.ListNAVs(ctx, f.Symbol, navHistory)
+	return f, navs, err
+}
+
+// cutoff is when orders for a trade date stop being taken: the fund's
+// cutoff time on that date, or the session close when that is earlier, as
+// on a half day, or when the fund has no cutoff.
+func (s *Service) cutoff(f models.Fund, day time.Time) time.Time {
+	end := s.cal.SessionClose(f.Exchange, day)
+	if c, err := time.Parse("15:04", f.Cutoff); err == nil {
+		loc := s.cal.Location(f.Exchange)
+		y, m, d := day.In(loc).Date()
+		if cut := time.Date(y, m, d, c.Hour(), c.Minute(), 0, 0, loc); cut.Before(end) {
+			return cut
+		}
+	}
+	return end
+}
+
+// tradeDate is the NAV date an order placed at t is processed at: today
+// before the cutoff on a trading day, else the next trading day.
+func (s *Service) tradeDate(f models.Fund, t time.Time) string {
+	day := s.cal.AddTradingDays(f.Exchange, t, 0)
+	if !t.Before(s.cutoff(f, day)) {
+		day = s.cal.AddTradingDays(f.Exchange, day, 1)
+	}
+	return day.Format(time.DateOnly)
+}
+
+// day is a YYYY-MM-DD date at midday UTC, which falls on that date in every
+// exchange's time zone, for use with the calendar.
+func day(date string) time.Time {
+	d, _ := time.Parse(time.DateOnly, date)
+	return d.Add(12 * time.Hour)
+}
+
+// Place takes a purchase of Amount or a redemption of Units.
+func (s *Service) Place(ctx context.Context, userID string, o models.FundOrder) (models.FundOrder, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o.SIPID = ""
+	return s.place(ctx, userID, o)
+}
+
+func (s *Service) place(ctx context.Context, userID string, o models.FundOrder) (models.FundOrder, error) {
+	f, err := s.fund(ctx, o.Symbol)
+	if err != nil {
+		return o, err
+	}
+	now := time.Now()
+	o.ID = primitive.NewObjectID().Hex()
+	o.UserID = userID
+	o.Symbol = f.Symbol
+	o.Side = strings.ToLower(strings.TrimSpace(o.Side))
+	o.Currency = s.fx.Currency(f.Currency)
+	o.TradeDate = s.tradeDate(f, now)
+	o.Status = models.FundOrderPending
+	o.NAV, o.Note = 0, ""
+	o.CreatedAt, o.ProcessedAt = now, time.Time{}
+
+	switch o.Side {
+	case models.FundPurchase:
+		return o, s.purchase(ctx, f, &o)
+	case models.FundRedeem:
+		return o, s.redeem(ctx, f, &o)
+	default:
+		return o, fmt.Errorf("%w: side must be purchase or redeem", ErrInvalid)
+	}
+}
+
+func (s *Service) purchase(ctx context.Context, f models.Fund, o *models.FundOrder) error {
+	least := f.MinPurchase
+	if o.SIPID != "" {
+		least = f.MinSIP
+	}
+	switch {
+	case o.Amount <= 0:
+		return fmt.Errorf("%w: amount must be positive", ErrInvalid)
+	case o.Amount+eps < least:
+		return fmt.Errorf("%w: %s takes at least %g", ErrInvalid, f.Symbol, least)
+	}
+	o.Amount = math.Round(o.Amount*100) / 100
+	o.Units, o.LotMethod = 0, ""
+	if err := s.repo.SaveFundOrder(ctx, *o); err != nil {
+		return err
+	}
+	// The debit is checked against the balance, less what working buys
+	// hold, in the same transaction that writes it.
+	err := s.cash.Debit(ctx, models.CashEntry{
+		ID:        "fund:" + o.ID,
+		UserID:    o.UserID,
+		Type:      models.CashFundPurchase,
+		Currency:  o.Currency,
+		Amount:    -o.Amount,
+		Symbol:    o.Symbol,
+		Reference: o.ID,
+		Note:      "purchase of " + f.Name,
+		Time:      o.CreatedAt,
+	})
+	if err != nil {
+		o.Status, o.Note = models.FundOrderRejected, "payment failed"
+		if errors.Is(err, cash.ErrInsufficientFunds) {
+			o.Note = "insufficient funds"
+		}
+		if serr := s.repo.SaveFundOrder(ctx, *o); serr != nil {
+			log.Printf("funds: reject %s: %v", o.ID, serr)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Service) redeem(ctx context.Context, f models.Fund, o *models.FundOrder) error {
+	if o.Units <= 0 {
+		return fmt.Errorf("%w: units must be positive", ErrInvalid)
+	}
+	if n := o.Units / unitStep; math.Abs(n-math.Round(n)) > 1e-6 {
+		return fmt.Errorf("%w: units must be a multiple of %g", ErrInvalid, unitStep)
+	}
+	o.Amount = 0
+	ord := lotOrder(*o, f.Exchange)
+	if err := s.lots.Reserve(&ord); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	o.LotMethod = ord.LotMethod
+	if err := s.repo.SaveFundOrder(ctx, *o); err != nil {
+		s.lots.Release(o.ID)
+		return err
+	}
+	return nil
+}
+
+// Cancel withdraws a pending order before its trade date's cutoff,
+// refunding a purchase and releasing a redemption's units.
+func (s *Service) Cancel(ctx context.Context, userID, id string) (models.FundOrder, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o, err := s.repo.GetFundOrder(ctx, userID, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return models.FundOrder{}, fmt.Errorf("%w: no fund order %s", ErrNotFound, id)
+	}
+	if err != nil {
+		return models.FundOrder{}, err
+	}
+	if o.Status != models.FundOrderPending {
+		return *o, fmt.Errorf("%w: order is %s", ErrState, o.Status)
+	}
+	f, err := s.fund(ctx, o.Symbol)
+	if err != nil {
+		return *o, err
+	}
+	if cut := s.cutoff(f, s.cal.AddTradingDays(f.Exchange, day(o.TradeDate), 0)); !time.Now().Before(cut) {
+		return *o, fmt.Errorf("%w: the %s cutoff has passed", ErrState, o.TradeDate)
+	}
+	return *o, s.close(ctx, o, models.FundOrderCancelled, "cancelled by user")
+}
+
+// close ends a pending order without allotting it.
+func (s *Service) close(ctx context.Context, o *models.FundOrder, status, note string) error {
+	o.Status, o.Note, o.ProcessedAt = status, note, time.Now()
+	if err := s.repo.SaveFundOrder(ctx, *o); err != nil {
+		return err
+	}
+	if o.Side == models.FundRedeem {
+		s.lots.Release(o.ID)
+		return nil
+	}
+	return s.refund(ctx, o, o.Amount)
+}
+
+func (s *Service) refund(ctx context.Context, o *models.FundOrder, amount float64) error {
+	return s.cash.Post(ctx, models.CashEntry{
+		ID:        "fund:" + o.ID + ":refund",
+		UserID:    o.UserID,
+		Type:      models.CashFundPurchase,
+		Currency:  o.Currency,
+		Amount:    amount,
+		Symbol:    o.Symbol,
+		Reference: o.ID,
+		Note:      "refund: " + o.Note,
+		Time:      o.ProcessedAt,
+	})
+}
+
+// Orders lists the user's fund orders, newest first.
+func (s *Service) Orders(ctx context.Context, userID string) ([]models.FundOrder, error) {
+	return s.repo.ListFundOrders(ctx, userID)
+}
+
+// Process allots every pending order whose trade date's NAV is published
+// and whose cutoff has passed by now. Orders without a NAV wait.
+func (s *Service) Process(ctx context.Context, now time.Time) error {
+	pending, err := s.repo.ListPendingFundOrders(ctx)
+	if err != nil {
+		return err
+	}
+	funds := map[string]models.Fund{}
+	var errs []error
+	for _, p := range pending {
+		f, ok := funds[p.Symbol]
+		if !ok {
+			if f, err = s.fund(ctx, p.Symbol); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			funds[p.Symbol] = f
+		}
+		if now.Before(s.cutoff(f, s.cal.AddTradingDays(f.Exchange, day(p.TradeDate), 0))) {
+			continue
+		}
+		nav, err := s.repo.GetNAV(ctx, p.Symbol, p.TradeDate)
+		if errors.Is(err, repository.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := s.allot(ctx, f, p.UserID, p.ID, nav.NAV, now); err != nil {
+			errs = append(errs, fmt.Errorf("allot %s: %w", p.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Service) allot(ctx context.Context, f models.Fund, userID, id string, nav float64, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o, err := s.repo.GetFundOrder(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	switch o.Status {
+	case models.FundOrderPending:
+		if err := s.price(ctx, o, nav, now); err != nil || o.Status != models.FundOrderAllotting {
+			return err
+		}
+	case models.FundOrderAllotting:
+		// Interrupted after pricing; book what is missing.
+	default:
+		return nil // cancelled meanwhile
+	}
+
+	side := "sell"
+	if o.Side == models.FundPurchase {
+		side = "buy"
+	}
+	fill := models.Fill{
+		ID:       o.ID,
+		OrderID:  o.ID,
+		UserID:   o.UserID,
+		Symbol:   o.Symbol,
+		Exchange: f.Exchange,
+		Currency: o.Currency,
+		Side:     side,
+		Product:  models.ProductDelivery,
+		Price:    o.NAV,
+		Quantity: o.Units,
+		Time:     o.ProcessedAt,
+	}
+	if err := s.repo.SaveFill(ctx, fill); err != nil {
+		return err
+	}
+	if !s.lots.Applied(o.UserID, fill.ID) {
+		s.lots.OnFill(fill)
+	}
+
+	// Cash entry IDs derive from the order, so reposting them is safe.
+	if side == "buy" {
+		value := math.Round(o.Units*o.NAV*100) / 100
+		if refund := math.Round((o.Amount-value)*100) / 100; refund > 0 {
+			if err := s.refund(ctx, o, refund); err != nil {
+				return err
+			}
+		}
+	} else {
+		s.lots.Release(o.ID)
+		err := s.cash.Post(ctx, models.CashEntry{
+			ID:        "fund:" + o.ID,
+			UserID:    o.UserID,
+			Type:      models.CashFundRedemption,
+			Currency:  o.Currency,
+			Amount:    o.Amount,
+			Symbol:    o.Symbol,
+			Reference: o.ID,
+			Note:      fmt.Sprintf("redeemed %g units of %s @ %g", o.Units, f.Name, o.NAV),
+			Time:      o.ProcessedAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	o.Status = models.FundOrderAllotted
+	return s.repo.SaveFundOrder(ctx, *o)
+}
+
+// price fixes a pending order's units and amount at nav and saves it as
+// allotting, so an allotment cut short resumes at the same figures. A
+// purchase that buys no units is rejected instead.
+func (s *Service) price(ctx context.Context, o *models.FundOrder, nav float64, now time.Time) error {
+	if o.Side == models.FundPurchase {
+		o.Units = math.Floor(o.Amount/nav/unitStep+eps) * unitStep
+		o.Units = math.Round(o.Units/unitStep) * unitStep
+		if o.Units <= 0 {
+			return s.close(ctx, o, models.FundOrderRejected, fmt.Sprintf("amount buys no units at NAV %g", nav))
+		}
+		if value := math.Round(o.Units*nav*100) / 100; math.Round((o.Amount-value)*100) > 0 {
+			o.Note = "uninvested balance"
+		}
+	} else {
+		o.Amount = math.Round(o.Units*nav*100) / 100
+	}
+	o.NAV, o.Status, o.ProcessedAt = nav, models.FundOrderAllotting, now
+	return s.repo.SaveFundOrder(ctx, *o)
+}
+
+// today is t's date in the fund's time zone.
+func (s *Service) today(f models.Fund, t time.Time) string {
+	return t.In(s.cal.Location(f.Exchange)).Format(time.DateOnly)
+}
+
+// nextDate is the first date on or after from that falls on day of the
+// month.
+func nextDate(dom int, from string) string {
+	d, _ := time.Parse(time.DateOnly, from)
+	next := time.Date(d.Year(), d.Month(), dom, 0, 0, 0, 0, time.UTC)
+	if next.Before(d) {
+		next = next.AddDate(0, 1, 0)
+	}
+	return next.Format(time.DateOnly)
+}
+
+// dayAfter is the date after date.
+func dayAfter(date string) string {
+	d, _ := time.Parse(time.DateOnly, date)
+	return d.AddDate(0, 0, 1).Format(time.DateOnly)
+}
+
+// CreateSIP starts a monthly purchase of Amount on Day, 1 to 28. The first
+// installment is on the next such day, today included.
+func (s *Service) CreateSIP(ctx context.Context, userID string, sip models.SIP) (models.SIP, error) {
+	f, err := s.fund(ctx, sip.Symbol)
+	if err != nil {
+		return sip, err
+	}
+	switch {
+	case sip.Amount <= 0:
+		return sip, fmt.Errorf("%w: amount must be positive", ErrInvalid)
+	case sip.Amount+eps < f.MinSIP:
+		return sip, fmt.Errorf("%w: %s SIPs take at least %g", ErrInvalid, f.Symbol, f.MinSIP)
+	case sip.Day < 1 || sip.Day > 28:
+		return sip, fmt.Errorf("%w: day must be between 1 and 28", ErrInvalid)
+	}
+	now := time.Now()
+	sip = models.SIP{
+		ID:        primitive.NewObjectID().Hex(),
+		UserID:    userID,
+		Symbol:    f.Symbol,
+		Amount:    math.Round(sip.Amount*100) / 100,
+		Day:       sip.Day,
+		Status:    models.SIPActive,
+		NextDate:  nextDate(sip.Day, s.today(f, now)),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	return sip, s.repo.SaveSIP(ctx, sip)
+}
+
+// SIPs lists the user's SIPs, oldest first.
+func (s *Service) SIPs(ctx context.Context, userID string) ([]models.SIP, error) {
+	return s.repo.ListSIPs(ctx, userID)
+}
+
+// PauseSIP stops installments until the SIP is resumed.
+func (s *Service) PauseSIP(ctx context.Context, userID, id string) (models.SIP, error) {
+	return s.updateSIP(ctx, userID, id, func(sip *models.SIP, f models.Fund) error {
+		if sip.Status != models.SIPActive {
+			return fmt.Errorf("%w: SIP is %s", ErrState, sip.Status)
+		}
+		sip.Status, sip.NextDate = models.SIPPaused, ""
+		return nil
+	})
+}
+
+// ResumeSIP restarts a paused SIP from its next day of the month.
+func (s *Service) ResumeSIP(ctx context.Context, userID, id string) (models.SIP, error) {
+	return s.updateSIP(ctx, userID, id, func(sip *models.SIP, f models.Fund) error {
+		if sip.Status != models.SIPPaused {
+			return fmt.Errorf("%w: SIP is %s", ErrState, sip.Status)
+		}
+		sip.Status, sip.NextDate = models.SIPActive, nextDate(sip.Day, s.today(f, time.Now()))
+		return nil
+	})
+}
+
+// SkipSIP moves an active SIP's next installment on by a month.
+func (s *Service) SkipSIP(ctx context.Context, userID, id string) (models.SIP, error) {
+	return s.updateSIP(ctx, userID, id, func(sip *models.SIP, f models.Fund) error {
+		if sip.Status != models.SIPActive {
+			return fmt.Errorf("%w: SIP is %s", ErrState, sip.Status)
+		}
+		sip.NextDate = nextDate(sip.Day, dayAfter(sip.NextDate))
+		return nil
+	})
+}
+
+// CancelSIP ends a SIP for good. Purchases it already placed stand.
+func (s *Service) CancelSIP(ctx context.Context, userID, id string) (models.SIP, error) {
+	return s.updateSIP(ctx, userID, id, func(sip *models.SIP, f models.Fund) error {
+		if sip.Status == models.SIPCancelled {
+			return fmt.Errorf("%w: SIP is already cancelled", ErrState)
+		}
+		sip.Status, sip.NextDate = models.SIPCancelled, ""
+		return nil
+	})
+}
+
+func (s *Service) updateSIP(ctx context.Context, userID, id string, fn func(*models.SIP, models.Fund) error) (models.SIP, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sip, err := s.repo.GetSIP(ctx, userID, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return models.SIP{}, fmt.Errorf("%w: no SIP %s", ErrNotFound, id)
+	}
+	if err != nil {
+		return models.SIP{}, err
+	}
+	f, err := s.fund(ctx, sip.Symbol)
+	if err != nil {
+		return *sip, err
+	}
+	if err := fn(sip, f); err != nil {
+		return *sip, err
+	}
+	sip.UpdatedAt = time.Now()
+	return *sip, s.repo.SaveSIP(ctx, *sip)
+}
+
+// RunSIPs places the installment of every active SIP due by now. One that
+// cannot be placed, such as for want of cash, is counted as missed; either
+// way the SIP moves on to its next month.
+func (s *Service) RunSIPs(ctx context.Context, now time.Time) error {
+	list, err := s.repo.ListActiveSIPs(ctx)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, sip := range list {
+		f, err := s.fund(ctx, sip.Symbol)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		today := s.today(f, now)
+		if sip.NextDate > today {
+			continue
+		}
+		if err := s.installment(ctx, sip.UserID, sip.ID, today, now); err != nil {
+			errs = append(errs, fmt.Errorf("sip %s: %w", sip.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Service) installment(ctx context.Context, userID, id, today string, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sip, err := s.repo.GetSIP(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if sip.Status != models.SIPActive || sip.NextDate > today {
+		return nil // paused, skipped or cancelled meanwhile
+	}
+	o, err := s.place(ctx, userID, models.FundOrder{
+		Symbol: sip.Symbol,
+		Side:   models.FundPurchase,
+		Amount: sip.Amount,
+		SIPID:  sip.ID,
+	})
+	if err != nil {
+		sip.Missed++
+		sip.LastError = err.Error()
+	} else {
+		sip.Installments++
+		sip.LastOrderID, sip.LastError = o.ID, ""
+	}
+	sip.NextDate = nextDate(sip.Day, dayAfter(today))
+	sip.UpdatedAt = now
+	return s.repo.SaveSIP(ctx, *sip)
+}
+
+// Run reloads the NAV file when it changes, places due SIP installments
+// and allots priced orders, at startup and then once a minute.
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		now := time.Now()
+		if err := s.reloadNAVs(ctx); err != nil {
+			log.Printf("funds: nav file: %v", err)
+		}
+		if err := s.RunSIPs(ctx, now); err != nil {
+			log.Printf("funds: %v", err)
+		}
+		if err := s.Process(ctx, now); err != nil {
+			log.Printf("funds: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
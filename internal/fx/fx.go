@@ -0,0 +1,178 @@
+package fx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrNoRate = errors.New("no fx rate")
+
+// Provider quotes how many units of to one unit of from buys.
+type Provider interface {
+	Rate(from, to string) (float64, error)
+}
+
+// Static serves rates from a file of the form
+//
+//	{"pivot": "USD", "as_of": "2025-06-30", "rates": {"INR": 83.2, "EUR": 0.92}}
+//
+// where each rate is units of that currency per pivot unit. Cross rates go
+// through the pivot.
+type Static struct {
+	Pivot string             `json:"pivot"`
+	AsOf  string             `json:"as_of"`
+	Rates map[string]float64 `json:"rates"`
+}
+
+// LoadStatic reads a rates file.
+func LoadStatic(path string) (*Static, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var s Static
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, fmt.Errorf("fx: %w", err)
+	}
+	s.Pivot = strings.ToUpper(s.Pivot)
+	if s.Pivot == "" {
+		return nil, fmt.Errorf("fx: missing pivot currency")
+	}
+	rates := map[string]float64{s.Pivot: 1}
+	for c, r := range s.Rates {
+		if r <= 0 {
+			return nil, fmt.Errorf("fx: rate for %s must be positive", c)
+		}
+		rates[strings.ToUpper(c)] = r
+	}
+	s.Rates = rates
+	return &s, nil
+}
+
+func (s *Static) Rate(from, to string) (float64, error) {
+	f, ok1 := s.Rates[from]
+	t, ok2 := s.Rates[to]
+	if !ok1 || !ok2 {
+		return 0, fmt.Errorf("%w: %s/%s", ErrNoRate, from, to)
+	}
+	return t / f, nil
+}
+
+// Converter converts between currencies and the platform's base currency.
+// Amounts with no currency (recorded before currencies were tracked) are in
+// the base currency.
+type Converter struct {
+	provider Provider
+	base     string
+
+	mu     sync.Mutex
+	warned map[string]time.Time
+}
+
+// NewConverter uses provider for rates; with a nil provider only
+// same-currency conversions succeed.
+func NewConverter(provider Provider, base string) *Converter {
+	return &Converter{provider: provider, base: Normalize(base, "USD"), warned: map[string]time.Time{}}
+}
+
+// Normalize upper-cases a currency code, defaulting empty codes to def.
+func Normalize(ccy, def string) string {
+	ccy = strings.ToUpper(strings.TrimSpace(ccy))
+	if ccy == "" {
+		return def
+	}
+	return ccy
+}
+
+func (c *Converter) Base() string { return c.base }
+
+// Currency normalizes ccy, treating an empty code as the base currency.
+func (c *Converter) Currency(ccy string) string { return Normalize(ccy, c.base) }
+
+// Rate is units of to per unit of from.
+func (c *Converter) Rate(from, to string) (float64, error) {
+	from, to = c.Currency(from), c.Currency(to)
+	if from == to {
+		return 1, nil
+	}
+	if c.provider == nil {
+		return 0, fmt.Errorf("%w: %s/%s", ErrNoRate, from, to)
+	}
+	return c.provider.Rate(from, to)
+}
+
+// ToBase converts amount in ccy to the base currency. When no rate is known
+// it logs (at most once an hour per currency) and reports false.
+func (c *Converter) ToBase(amount float64, ccy string) (float64, bool) {
+	r, err := c.Rate(ccy, c.base)
+	if err != nil {
+		c.mu.Lock()
+		if time.Since(c.warned[ccy]) > time.Hour {
+			c.warned[ccy] = time.Now()
+			log.Printf("fx: %v; %s amounts are left out of base totals", err, ccy)
+		}
+		c.mu.Unlock()
+		return 0, false
+	}
+	return amount * r, true
+}
+
+// Total converts per-currency amounts to the base currency and sums them.
+// Currencies with a non-zero amount but no rate are left out and returned,
+// sorted, so callers can flag the total as partial.
+func (c *Converter) Total(amounts map[string]float64) (float64, []string) {
+	var total float64
+	var missing []string
+	for ccy, v := range amounts {
+		b, ok := c.ToBase(v, ccy)
+		if !ok {
+			if v != 0 {
+				missing = append(missing, c.Currency(ccy))
+			}
+			continue
+		}
+		total += b
+	}
+	sort.Strings(missing)
+	return total, missing
+}
+
+// Union merges lists of currency codes, such as those Total leaves out,
+// sorted and without duplicates.
+func Union(lists ...[]string) []string {
+	var out []string
+	for _, l := range lists {
+		out = append(out, l...)
+	}
+	sort.Strings(out)
+	return slices.Compact(out)
+}
+
+// Rates lists, for every known currency, the base units one unit buys.
+func (c *Converter) Rates() map[string]float64 {
+	out := map[string]float64{c.base: 1}
+	if s, ok := c.provider.(*Static); ok {
+		for ccy := range s.Rates {
+			if r, err := c.Rate(ccy, c.base); err == nil {
+				out[ccy] = r
+			}
+		}
+	}
+	return out
+}
+
+// AsOf is the date of the static rates, if any.
+func (c *Converter) AsOf() string {
+	if s, ok := c.provider.(*Static); ok {
+		return s.AsOf
+	}
+	return ""
+}
@@ -0,0 +1,144 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/hahahamid/broker-backend/config"
+	"github.com/hahahamid/broker-backend/internal/accounts"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/utils"
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *BrokerService) GetAccount(ctx context.Context, _ *pb.Empty) (*pb.Account, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.svc.Accounts.Get(ctx, uid)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return toPBAccount(a), nil
+}
+
+func (s *BrokerService) ResetBalance(ctx context.Context, req *pb.ResetBalanceRequest) (*pb.Account, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.svc.Accounts.ResetBalance(ctx, uid, req.Amount)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return toPBAccount(a), nil
+}
+
+func toPBAccount(a models.Account) *pb.Account {
+	out := &pb.Account{
+		Mode:         a.Mode,
+		Currency:     a.Currency,
+		StartingCash: a.StartingCash,
+		Resets:       int32(a.Resets),
+		Cash:         a.Cash,
+	}
+	if !a.OpenedAt.IsZero() {
+		out.OpenedAt = timestamppb.New(a.OpenedAt)
+	}
+	if !a.ResetAt.IsZero() {
+		out.ResetAt = timestamppb.New(a.ResetAt)
+	}
+	return out
+}
+
+func accountError(err error) error {
+	switch {
+	case errors.Is(err, accounts.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, accounts.ErrState):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// Modes serves each call from the BrokerService of the account mode it
+// selects with the "x-account-mode" metadata or its token's mode claim,
+// live by default. The server is registered with the live service and
+// installs Unary and Stream as interceptors.
+type Modes struct {
+	cfg     *config.Config
+	paper   *BrokerService
+	methods map[string]grpc.MethodDesc
+	streams map[string]grpc.StreamDesc
+}
+
+func NewModes(cfg *config.Config, paper *BrokerService) *Modes {
+	m := &Modes{cfg: cfg, paper: paper, methods: map[string]grpc.MethodDesc{}, streams: map[string]grpc.StreamDesc{}}
+	for _, md := range pb.Broker_ServiceDesc.Methods {
+		m.methods[md.MethodName] = md
+	}
+	for _, sd := range pb.Broker_ServiceDesc.Streams {
+		m.streams[sd.StreamName] = sd
+	}
+	return m
+}
+
+func (m *Modes) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	mode, err := m.mode(ctx)
+	if err != nil {
+		return nil, err
+	}
+	md, ok := m.methods[path.Base(info.FullMethod)]
+	if mode != models.AccountPaper || !ok {
+		return handler(ctx, req)
+	}
+	// The request is already decoded, so the paper handler gets a copy.
+	return md.Handler(m.paper, ctx, func(v interface{}) error {
+		proto.Merge(v.(proto.Message), req.(proto.Message))
+		return nil
+	}, nil)
+}
+
+func (m *Modes) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	mode, err := m.mode(ss.Context())
+	if err != nil {
+		return err
+	}
+	sd, ok := m.streams[path.Base(info.FullMethod)]
+	if mode != models.AccountPaper || !ok {
+		return handler(srv, ss)
+	}
+	return sd.Handler(m.paper, ss)
+}
+
+func (m *Modes) mode(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var requested, claimed string
+	if vals := md.Get("x-account-mode"); len(vals) > 0 {
+		requested = vals[0]
+	}
+	if vals := md.Get("authorization"); len(vals) > 0 && strings.HasPrefix(vals[0], "Bearer ") {
+		if token, err := utils.ValidateToken(strings.TrimPrefix(vals[0], "Bearer "), m.cfg.JWTSecret); err == nil && token.Valid {
+			claimed, _ = token.Claims.(jwt.MapClaims)["mode"].(string)
+		}
+	}
+	mode, err := utils.AccountMode(requested, claimed)
+	switch {
+	case errors.Is(err, utils.ErrModeClaim):
+		return "", status.Error(codes.PermissionDenied, err.Error())
+	case err != nil:
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	return mode, nil
+}
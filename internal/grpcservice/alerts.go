@@ -0,0 +1,161 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hahahamid/broker-backend/internal/alerts"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *BrokerService) CreateAlert(ctx context.Context, req *pb.CreateAlertRequest) (*pb.Alert, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.svc.Alerts.Create(ctx, uid, models.Alert{
+		Symbol:      req.Symbol,
+		Condition:   req.Condition,
+		Threshold:   req.Threshold,
+		Channels:    req.Channels,
+		WebhookURL:  req.WebhookUrl,
+		Rearm:       req.Rearm,
+		CooldownSec: int(req.CooldownSec),
+	})
+	if err != nil {
+		return nil, alertError(err)
+	}
+	return toPBAlert(a), nil
+}
+
+func (s *BrokerService) ListAlerts(ctx context.Context, _ *pb.Empty) (*pb.AlertsResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.svc.Alerts.List(ctx, uid)
+	if err != nil {
+		return nil, alertError(err)
+	}
+	resp := &pb.AlertsResponse{}
+	for i := range list {
+		resp.Alerts = append(resp.Alerts, toPBAlert(&list[i]))
+	}
+	return resp, nil
+}
+
+func (s *BrokerService) DeleteAlert(ctx context.Context, req *pb.AlertRequest) (*pb.Empty, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.svc.Alerts.Delete(ctx, uid, req.Id); err != nil {
+		return nil, alertError(err)
+	}
+	return &pb.Empty{}, nil
+}
+
+func (s *BrokerService) RearmAlert(ctx context.Context, req *pb.AlertRequest) (*pb.Alert, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.svc.Alerts.Rearm(ctx, uid, req.Id)
+	if err != nil {
+		return nil, alertError(err)
+	}
+	return toPBAlert(a), nil
+}
+
+func (s *BrokerService) GetAlertHistory(ctx context.Context, req *pb.AlertHistoryRequest) (*pb.AlertHistoryResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.svc.Alerts.History(ctx, uid, int(req.Limit))
+	if err != nil {
+		return nil, alertError(err)
+	}
+	resp := &pb.AlertHistoryResponse{}
+	for _, ev := range list {
+		resp.Events = append(resp.Events, &pb.AlertEvent{
+			Id:        ev.ID.Hex(),
+			AlertId:   ev.AlertID.Hex(),
+			Symbol:    ev.Symbol,
+			Condition: ev.Condition,
+			Threshold: ev.Threshold,
+			Value:     ev.Value,
+			Message:   ev.Message,
+			Time:      timestamppb.New(ev.Time),
+		})
+	}
+	return resp, nil
+}
+
+func (s *BrokerService) ListNotifications(ctx context.Context, req *pb.NotificationsRequest) (*pb.NotificationsResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.svc.Alerts.Inbox(ctx, uid, req.UnreadOnly)
+	if err != nil {
+		return nil, alertError(err)
+	}
+	resp := &pb.NotificationsResponse{}
+	for _, n := range list {
+		resp.Notifications = append(resp.Notifications, &pb.Notification{
+			Id:        n.ID.Hex(),
+			Title:     n.Title,
+			Body:      n.Body,
+			Read:      n.Read,
+			CreatedAt: timestamppb.New(n.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+func (s *BrokerService) MarkNotificationsRead(ctx context.Context, req *pb.MarkNotificationsReadRequest) (*pb.Empty, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.svc.Alerts.MarkRead(ctx, uid, req.Ids); err != nil {
+		return nil, alertError(err)
+	}
+	return &pb.Empty{}, nil
+}
+
+func alertError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return status.Error(codes.NotFound, "alert not found")
+	case errors.Is(err, alerts.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toPBAlert(a *models.Alert) *pb.Alert {
+	out := &pb.Alert{
+		Id:          a.ID.Hex(),
+		Symbol:      a.Symbol,
+		Condition:   a.Condition,
+		Threshold:   a.Threshold,
+		Channels:    a.Channels,
+		WebhookUrl:  a.WebhookURL,
+		Rearm:       a.Rearm,
+		CooldownSec: int32(a.CooldownSec),
+		Status:      a.Status,
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+	}
+	if !a.LastTriggeredAt.IsZero() {
+		out.LastTriggeredAt = timestamppb.New(a.LastTriggeredAt)
+	}
+	return out
+}
@@ -0,0 +1,108 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hahahamid/broker-backend/internal/algos"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/orders"
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *BrokerService) CreateAlgoOrder(ctx context.Context, req *pb.AlgoOrderRequest) (*pb.Order, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.svc.Algos.Create(ctx, uid, models.Order{
+		Symbol:    req.Symbol,
+		Side:      req.Side,
+		Quantity:  req.Quantity,
+		Price:     req.Price,
+		Product:   req.Product,
+		LotMethod: req.LotMethod,
+		Short:     req.Short,
+		Algo: &models.Algo{
+			Strategy:         req.Strategy,
+			StartAt:          tsTime(req.StartAt),
+			EndAt:            tsTime(req.EndAt),
+			SliceSeconds:     int(req.SliceSeconds),
+			MaxParticipation: req.MaxParticipation,
+		},
+	})
+	if err != nil {
+		return nil, algoError(err)
+	}
+	return toPBOrder(p), nil
+}
+
+func (s *BrokerService) GetAlgoOrder(ctx context.Context, req *pb.AlgoOrderID) (*pb.AlgoOrderResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, children, err := s.svc.Algos.Get(ctx, uid, req.Id)
+	if err != nil {
+		return nil, algoError(err)
+	}
+	resp := &pb.AlgoOrderResponse{Parent: toPBOrder(p)}
+	for _, c := range children {
+		resp.Children = append(resp.Children, toPBOrder(c))
+	}
+	return resp, nil
+}
+
+func (s *BrokerService) PauseAlgoOrder(ctx context.Context, req *pb.AlgoOrderID) (*pb.Order, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.svc.Algos.Pause(ctx, uid, req.Id)
+	if err != nil {
+		return nil, algoError(err)
+	}
+	return toPBOrder(p), nil
+}
+
+func (s *BrokerService) ResumeAlgoOrder(ctx context.Context, req *pb.AlgoOrderID) (*pb.Order, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.svc.Algos.Resume(ctx, uid, req.Id)
+	if err != nil {
+		return nil, algoError(err)
+	}
+	return toPBOrder(p), nil
+}
+
+func (s *BrokerService) CancelAlgoOrder(ctx context.Context, req *pb.AlgoOrderID) (*pb.Order, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, ok, err := s.svc.Algos.Cancel(ctx, uid, req.Id)
+	if !ok && err == nil {
+		err = algos.ErrNotFound
+	}
+	if err != nil {
+		return nil, algoError(err)
+	}
+	return toPBOrder(p), nil
+}
+
+func algoError(err error) error {
+	switch {
+	case errors.Is(err, algos.ErrInvalid), errors.Is(err, orders.ErrRejected):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, algos.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, algos.ErrState):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,47 @@
+package grpcservice
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/hahahamid/broker-backend/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// userID authenticates the caller from the "authorization: Bearer <token>"
+// metadata, which grpc-gateway forwards from the HTTP header, and opens
+// their account in the service's mode if it is new.
+func (s *BrokerService) userID(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	vals := md.Get("authorization")
+	if len(vals) == 0 || !strings.HasPrefix(vals[0], "Bearer ") {
+		return "", status.Error(codes.Unauthenticated, "missing or invalid auth header")
+	}
+	token, err := utils.ValidateToken(strings.TrimPrefix(vals[0], "Bearer "), s.cfg.JWTSecret)
+	if err != nil || !token.Valid {
+		return "", status.Error(codes.Unauthenticated, "invalid token")
+	}
+	sub, _ := token.Claims.(jwt.MapClaims)["sub"].(string)
+	if sub == "" {
+		return "", status.Error(codes.Unauthenticated, "invalid token")
+	}
+	if err := s.svc.Accounts.Open(ctx, sub); err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+	return sub, nil
+}
+
+// admin checks the "x-admin-key" metadata against ADMIN_API_KEY.
+func (s *BrokerService) admin(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	vals := md.Get("x-admin-key")
+	if s.cfg.AdminAPIKey == "" || len(vals) == 0 ||
+		subtle.ConstantTimeCompare([]byte(vals[0]), []byte(s.cfg.AdminAPIKey)) != 1 {
+		return status.Error(codes.PermissionDenied, "admin key required")
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hahahamid/broker-backend/internal/baskets"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *BrokerService) PlaceBasket(ctx context.Context, req *pb.BasketRequest) (*pb.BasketResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	b, check, err := s.svc.Baskets.Place(ctx, uid, req.Name, fromPBLegs(req.Legs))
+	if err != nil {
+		return nil, basketError(err)
+	}
+	resp := toPBCheck(check)
+	resp.Basket = toPBBasket(b)
+	return resp, nil
+}
+
+// ValidateBasket reports a rejected basket in the legs' errors and the
+// funds rather than as an RPC error.
+func (s *BrokerService) ValidateBasket(ctx context.Context, req *pb.BasketRequest) (*pb.BasketResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	check, err := s.svc.Baskets.Validate(ctx, uid, fromPBLegs(req.Legs))
+	if err != nil && !errors.Is(err, baskets.ErrRejected) {
+		return nil, basketError(err)
+	}
+	return toPBCheck(check), nil
+}
+
+func (s *BrokerService) ListBaskets(ctx context.Context, _ *pb.Empty) (*pb.BasketsResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.svc.Baskets.List(ctx, uid)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &pb.BasketsResponse{}
+	for _, b := range list {
+		resp.Baskets = append(resp.Baskets, toPBBasket(b))
+	}
+	return resp, nil
+}
+
+func (s *BrokerService) GetBasket(ctx context.Context, req *pb.GetBasketRequest) (*pb.Basket, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	b, err := s.svc.Baskets.Get(ctx, uid, req.Id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "basket not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return toPBBasket(*b), nil
+}
+
+func (s *BrokerService) Rebalance(ctx context.Context, req *pb.RebalanceRequest) (*pb.RebalancePlan, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]models.RebalanceTarget, 0, len(req.Targets))
+	for _, t := range req.Targets {
+		targets = append(targets, models.RebalanceTarget{Symbol: t.Symbol, Weight: t.Weight})
+	}
+	plan, err := s.svc.Baskets.Rebalance(ctx, uid, targets, req.Execute)
+	if err != nil {
+		return nil, basketError(err)
+	}
+	resp := &pb.RebalancePlan{
+		Currency:   plan.Currency,
+		TotalValue: plan.TotalValue,
+		Cash:       plan.Cash,
+		CashWeight: plan.CashWeight,
+	}
+	for _, l := range plan.Legs {
+		resp.Legs = append(resp.Legs, &pb.RebalanceLeg{
+			Symbol:        l.Symbol,
+			Currency:      l.Currency,
+			Price:         l.Price,
+			CurrentQty:    l.CurrentQty,
+			CurrentValue:  l.CurrentValue,
+			CurrentWeight: l.CurrentWeight,
+			TargetWeight:  l.TargetWeight,
+			TargetValue:   l.TargetValue,
+			TargetQty:     l.TargetQty,
+			Side:          l.Side,
+			Quantity:      l.Quantity,
+		})
+	}
+	if plan.Basket != nil {
+		resp.Basket = toPBBasket(*plan.Basket)
+	}
+	return resp, nil
+}
+
+func basketError(err error) error {
+	switch {
+	case errors.Is(err, baskets.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, baskets.ErrRejected):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func fromPBLegs(legs []*pb.PlaceOrderRequest) []models.Order {
+	out := make([]models.Order, 0, len(legs))
+	for _, l := range legs {
+		out = append(out, fromPBOrderRequest(l))
+	}
+	return out
+}
+
+func toPBCheck(check models.BasketCheck) *pb.BasketResponse {
+	resp := &pb.BasketResponse{}
+	for _, l := range check.Legs {
+		resp.Legs = append(resp.Legs, &pb.BasketLeg{Order: toPBOrder(l.Order), Error: l.Error})
+	}
+	for _, f := range check.Funds {
+		resp.Funds = append(resp.Funds, &pb.BasketFunds{
+			Currency:  f.Currency,
+			Buys:      f.Buys,
+			Sells:     f.Sells,
+			Required:  f.Required,
+			Available: f.Available,
+		})
+	}
+	return resp
+}
+
+func toPBBasket(b models.Basket) *pb.Basket {
+	out := &pb.Basket{
+		Id:        b.ID,
+		Name:      b.Name,
+		OrderIds:  b.OrderIDs,
+		CreatedAt: timestamppb.New(b.CreatedAt),
+	}
+	for _, o := range b.Orders {
+		out.Orders = append(out.Orders, toPBOrder(o))
+	}
+	return out
+}
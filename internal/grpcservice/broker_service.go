@@ -5,22 +5,74 @@ import (
 
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/hahahamid/broker-backend/config"
+	"github.com/hahahamid/broker-backend/internal/accounts"
+	"github.com/hahahamid/broker-backend/internal/alerts"
+	"github.com/hahahamid/broker-backend/internal/algos"
+	"github.com/hahahamid/broker-backend/internal/baskets"
+	"github.com/hahahamid/broker-backend/internal/calendar"
+	"github.com/hahahamid/broker-backend/internal/candles"
+	"github.com/hahahamid/broker-backend/internal/cash"
+	"github.com/hahahamid/broker-backend/internal/charges"
+	"github.com/hahahamid/broker-backend/internal/corpactions"
+	"github.com/hahahamid/broker-backend/internal/derivatives"
+	"github.com/hahahamid/broker-backend/internal/fractional"
+	"github.com/hahahamid/broker-backend/internal/funds"
+	"github.com/hahahamid/broker-backend/internal/fx"
+	"github.com/hahahamid/broker-backend/internal/lots"
+	"github.com/hahahamid/broker-backend/internal/marketdata"
+	"github.com/hahahamid/broker-backend/internal/matching"
+	"github.com/hahahamid/broker-backend/internal/orders"
+	"github.com/hahahamid/broker-backend/internal/portfolio"
+	"github.com/hahahamid/broker-backend/internal/reports"
 	"github.com/hahahamid/broker-backend/internal/repository"
+	"github.com/hahahamid/broker-backend/internal/settlement"
+	"github.com/hahahamid/broker-backend/internal/shorts"
+	"github.com/hahahamid/broker-backend/internal/strategy"
 	"github.com/hahahamid/broker-backend/internal/utils"
+	"github.com/hahahamid/broker-backend/internal/watchlists"
 	pb "github.com/hahahamid/broker-backend/proto"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// Services bundles the in-process components the RPCs delegate to.
+type Services struct {
+	Prices     *marketdata.PriceCache
+	Candles    *candles.Aggregator
+	Engine     *matching.Engine
+	Orders     *orders.Service
+	Watchlists *watchlists.Service
+	Alerts     *alerts.Service
+	Calendar   *calendar.Calendar
+	Lots       *lots.Service
+
+	CorporateActions *corpactions.Service
+	Cash             *cash.Service
+	Settlement       *settlement.Service
+	Portfolio        *portfolio.Service
+	Reports          *reports.Service
+	Charges          *charges.Service
+	FX               *fx.Converter
+	House            *fractional.Service
+	Shorts           *shorts.Service
+	Baskets          *baskets.Service
+	Algos            *algos.Service
+	Derivatives      *derivatives.Service
+	Funds            *funds.Service
+	Strategies       *strategy.Service
+	Accounts         *accounts.Service // of the mode the service serves
+}
+
 type BrokerService struct {
 	pb.UnimplementedBrokerServer
-	repo repository.UserRepo
+	repo repository.Repo
 	cfg  *config.Config
+	svc  Services
 }
 
-func NewBrokerService(repo repository.UserRepo, cfg *config.Config) *BrokerService {
-	return &BrokerService{repo: repo, cfg: cfg}
+func NewBrokerService(repo repository.Repo, cfg *config.Config, svc Services) *BrokerService {
+	return &BrokerService{repo: repo, cfg: cfg, svc: svc}
 }
 
 func (s *BrokerService) Signup(ctx context.Context, req *pb.SignupRequest) (*pb.Empty, error) {
@@ -38,8 +90,14 @@ func (s *BrokerService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Au
 	if bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)) != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "invalid credentials")
 	}
+	mode := req.Mode
+	if mode != "" {
+		if mode, err = utils.AccountMode(mode, ""); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
 
-	at, rt, err := utils.GenerateTokens(user.ID.Hex(), s.cfg.JWTSecret, s.cfg.RefreshSecret, s.cfg.AccessTokenExpireMin)
+	at, rt, err := utils.GenerateTokens(user.ID.Hex(), mode, s.cfg.JWTSecret, s.cfg.RefreshSecret, s.cfg.AccessTokenExpireMin)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "token generation failed")
 	}
@@ -54,38 +112,12 @@ func (s *BrokerService) Refresh(ctx context.Context, req *pb.RefreshRequest) (*p
 	}
 	claims := token.Claims.(jwt.MapClaims)
 	userID := claims["sub"].(string)
+	mode, _ := claims["mode"].(string)
 
-	at, rt, err := utils.GenerateTokens(userID, s.cfg.JWTSecret, s.cfg.RefreshSecret, s.cfg.AccessTokenExpireMin)
+	at, rt, err := utils.GenerateTokens(userID, mode, s.cfg.JWTSecret, s.cfg.RefreshSecret, s.cfg.AccessTokenExpireMin)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "token generation failed")
 	}
 	_ = s.repo.SaveRefreshToken(ctx, userID, rt)
 	return &pb.AuthResponse{AccessToken: at, RefreshToken: rt}, nil
 }
-
-func (s *BrokerService) GetHoldings(ctx context.Context, _ *pb.Empty) (*pb.HoldingsResponse, error) {
-	return &pb.HoldingsResponse{
-		Holdings: []*pb.Holding{
-			{Symbol: "AAPL", Quantity: 10, AvgPrice: 150},
-			{Symbol: "GOOGL", Quantity: 5, AvgPrice: 2500},
-		},
-	}, nil
-}
-
-func (s *BrokerService) GetOrderbook(ctx context.Context, _ *pb.Empty) (*pb.OrderbookResponse, error) {
-	return &pb.OrderbookResponse{
-		Orders: []*pb.Order{
-			{Id: "1", Symbol: "AAPL", Side: "buy", Quantity: 10, Price: 150, RealizedPnl: 0, UnrealizedPnl: 20},
-			{Id: "2", Symbol: "TSLA", Side: "sell", Quantity: 2, Price: 700, RealizedPnl: 50, UnrealizedPnl: 0},
-		},
-	}, nil
-}
-
-func (s *BrokerService) GetPositions(ctx context.Context, _ *pb.Empty) (*pb.PositionsResponse, error) {
-	return &pb.PositionsResponse{
-		Positions: []*pb.Position{
-			{Symbol: "AAPL", Quantity: 10, AvgPrice: 150, Pnl: 20},
-			{Symbol: "TSLA", Quantity: 2, AvgPrice: 700, Pnl: 50},
-		},
-	}, nil
-}
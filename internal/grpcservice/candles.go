@@ -0,0 +1,103 @@
+package grpcservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/candles"
+	"github.com/hahahamid/broker-backend/internal/models"
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *BrokerService) GetCandles(ctx context.Context, req *pb.GetCandlesRequest) (*pb.CandlesResponse, error) {
+	if _, err := candles.ParseInterval(req.Interval); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	after, err := candles.ParsePageToken(req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	from, to := timeRange(req.From, req.To)
+	size := candles.PageSize(int(req.PageSize))
+
+	list, err := s.repo.ListCandles(ctx, req.Symbol, req.Interval, from, to, after, size)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &pb.CandlesResponse{}
+	for _, c := range list {
+		resp.Candles = append(resp.Candles, toPBCandle(c))
+	}
+	if len(list) == size {
+		resp.NextPageToken = candles.PageToken(list[len(list)-1])
+	}
+	return resp, nil
+}
+
+func (s *BrokerService) StreamCandles(req *pb.StreamCandlesRequest, stream grpc.ServerStreamingServer[pb.Candle]) error {
+	if _, err := candles.ParseInterval(req.Interval); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	updates, stop := s.svc.Candles.Watch(req.Symbol, req.Interval)
+	defer stop()
+
+	if c, ok := s.svc.Candles.Live(req.Symbol, req.Interval); ok {
+		if err := stream.Send(toPBCandle(c)); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case c := <-updates:
+			if err := stream.Send(toPBCandle(c)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *BrokerService) RebuildCandles(ctx context.Context, req *pb.RebuildCandlesRequest) (*pb.RebuildCandlesResponse, error) {
+	if err := s.admin(ctx); err != nil {
+		return nil, err
+	}
+	if req.From == nil {
+		return nil, status.Error(codes.InvalidArgument, "from is required")
+	}
+	from, to := timeRange(req.From, req.To)
+	n, err := s.svc.Candles.Rebuild(ctx, req.Symbol, req.Interval, from, to)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &pb.RebuildCandlesResponse{Bars: int32(n)}, nil
+}
+
+// timeRange defaults an open-ended range to [epoch, now].
+func timeRange(from, to *timestamppb.Timestamp) (time.Time, time.Time) {
+	f, t := time.Unix(0, 0).UTC(), time.Now().UTC()
+	if from != nil {
+		f = from.AsTime()
+	}
+	if to != nil {
+		t = to.AsTime()
+	}
+	return f, t
+}
+
+func toPBCandle(c models.Candle) *pb.Candle {
+	return &pb.Candle{
+		Symbol:   c.Symbol,
+		Interval: c.Interval,
+		Start:    timestamppb.New(c.Start),
+		Open:     c.Open,
+		High:     c.High,
+		Low:      c.Low,
+		Close:    c.Close,
+		Volume:   c.Volume,
+	}
+}
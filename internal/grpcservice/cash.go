@@ -0,0 +1,114 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hahahamid/broker-backend/internal/cash"
+	"github.com/hahahamid/broker-backend/internal/models"
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *BrokerService) GetCashLedger(ctx context.Context, _ *pb.Empty) (*pb.CashLedgerResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bal, err := s.svc.Cash.Balances(ctx, uid)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// Balances lists every currency, including any left out of the total.
+	total, _, err := s.svc.Cash.BaseBalance(ctx, uid)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	list, err := s.svc.Cash.Entries(ctx, uid)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &pb.CashLedgerResponse{Balance: total, Balances: bal, BaseCurrency: s.svc.FX.Base()}
+	for _, e := range list {
+		resp.Entries = append(resp.Entries, toPBCashEntry(e))
+	}
+	return resp, nil
+}
+
+func (s *BrokerService) Deposit(ctx context.Context, req *pb.CashRequest) (*pb.CashEntry, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	e, err := s.svc.Cash.Deposit(ctx, uid, req.Currency, req.Amount, req.Note)
+	if err != nil {
+		return nil, cashError(err)
+	}
+	return toPBCashEntry(e), nil
+}
+
+func (s *BrokerService) Withdraw(ctx context.Context, req *pb.CashRequest) (*pb.CashEntry, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	e, err := s.svc.Cash.Withdraw(ctx, uid, req.Currency, req.Amount, req.Note)
+	if err != nil {
+		return nil, cashError(err)
+	}
+	return toPBCashEntry(e), nil
+}
+
+func (s *BrokerService) GetFXRates(ctx context.Context, _ *pb.Empty) (*pb.FXRatesResponse, error) {
+	return &pb.FXRatesResponse{
+		BaseCurrency: s.svc.FX.Base(),
+		AsOf:         s.svc.FX.AsOf(),
+		Rates:        s.svc.FX.Rates(),
+	}, nil
+}
+
+func (s *BrokerService) ConvertCurrency(ctx context.Context, req *pb.ConvertCurrencyRequest) (*pb.FXConversion, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.svc.Cash.Convert(ctx, uid, req.From, req.To, req.Amount)
+	if err != nil {
+		return nil, cashError(err)
+	}
+	return &pb.FXConversion{
+		Id:        c.ID,
+		From:      c.From,
+		To:        c.To,
+		Amount:    c.Amount,
+		Rate:      c.Rate,
+		Converted: c.Converted,
+		Time:      timestamppb.New(c.Time),
+	}, nil
+}
+
+func cashError(err error) error {
+	switch {
+	case errors.Is(err, cash.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, cash.ErrInsufficientFunds):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toPBCashEntry(e models.CashEntry) *pb.CashEntry {
+	return &pb.CashEntry{
+		Id:        e.ID,
+		Type:      e.Type,
+		Currency:  e.Currency,
+		Amount:    e.Amount,
+		Symbol:    e.Symbol,
+		Reference: e.Reference,
+		Note:      e.Note,
+		Time:      timestamppb.New(e.Time),
+	}
+}
@@ -0,0 +1,40 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hahahamid/broker-backend/internal/charges"
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *BrokerService) CalculateCharges(ctx context.Context, req *pb.CalculateChargesRequest) (*pb.ChargeEstimate, error) {
+	if _, err := s.userID(ctx); err != nil {
+		return nil, err
+	}
+	e, err := s.svc.Charges.Estimate(ctx, req.Symbol, req.Side, req.Product, req.Quantity, req.Price)
+	if errors.Is(err, charges.ErrInvalid) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &pb.ChargeEstimate{
+		Symbol:    e.Symbol,
+		Currency:  e.Currency,
+		Segment:   e.Segment,
+		Product:   e.Product,
+		Side:      e.Side,
+		Quantity:  e.Quantity,
+		Price:     e.Price,
+		Value:     e.Value,
+		Total:     e.Total,
+		NetAmount: e.NetAmount,
+	}
+	for _, c := range e.Charges {
+		resp.Charges = append(resp.Charges, &pb.Charge{Name: c.Name, Amount: c.Amount})
+	}
+	return resp, nil
+}
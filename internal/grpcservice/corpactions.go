@@ -0,0 +1,111 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/corpactions"
+	"github.com/hahahamid/broker-backend/internal/models"
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *BrokerService) ListCorporateActions(ctx context.Context, req *pb.ListCorporateActionsRequest) (*pb.CorporateActionsResponse, error) {
+	list, err := s.svc.CorporateActions.List(ctx, strings.ToUpper(req.Symbol))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return toPBActions(list), nil
+}
+
+func (s *BrokerService) CreateCorporateActions(ctx context.Context, req *pb.CreateCorporateActionsRequest) (*pb.CorporateActionsResponse, error) {
+	if err := s.admin(ctx); err != nil {
+		return nil, err
+	}
+	list := make([]models.CorporateAction, 0, len(req.Actions))
+	for _, a := range req.Actions {
+		list = append(list, models.CorporateAction{
+			Symbol:     a.Symbol,
+			Type:       a.Type,
+			ExDate:     tsTime(a.ExDate),
+			RecordDate: tsTime(a.RecordDate),
+			RatioNew:   a.RatioNew,
+			RatioOld:   a.RatioOld,
+			Amount:     a.Amount,
+			NewSymbol:  a.NewSymbol,
+		})
+	}
+	list, err := s.svc.CorporateActions.Ingest(ctx, list)
+	if errors.Is(err, corpactions.ErrInvalid) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return toPBActions(list), nil
+}
+
+func (s *BrokerService) GetAdjustments(ctx context.Context, _ *pb.Empty) (*pb.AdjustmentsResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.svc.CorporateActions.Adjustments(ctx, uid)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &pb.AdjustmentsResponse{}
+	for _, a := range list {
+		resp.Adjustments = append(resp.Adjustments, &pb.Adjustment{
+			Id:          a.ID,
+			ActionId:    a.ActionID,
+			Type:        a.Type,
+			Symbol:      a.Symbol,
+			NewSymbol:   a.NewSymbol,
+			Currency:    a.Currency,
+			OldQty:      a.OldQty,
+			NewQty:      a.NewQty,
+			OldAvgPrice: a.OldAvgPrice,
+			NewAvgPrice: a.NewAvgPrice,
+			Cash:        a.Cash,
+			FractionQty: a.FractionQty,
+			CashInLieu:  a.CashInLieu,
+			Time:        timestamppb.New(a.Time),
+		})
+	}
+	return resp, nil
+}
+
+func toPBActions(list []models.CorporateAction) *pb.CorporateActionsResponse {
+	resp := &pb.CorporateActionsResponse{}
+	for _, a := range list {
+		out := &pb.CorporateAction{
+			Id:         a.ID,
+			Symbol:     a.Symbol,
+			Type:       a.Type,
+			ExDate:     timestamppb.New(a.ExDate),
+			RecordDate: timestamppb.New(a.RecordDate),
+			RatioNew:   a.RatioNew,
+			RatioOld:   a.RatioOld,
+			Amount:     a.Amount,
+			NewSymbol:  a.NewSymbol,
+			Status:     a.Status,
+		}
+		if !a.AppliedAt.IsZero() {
+			out.AppliedAt = timestamppb.New(a.AppliedAt)
+		}
+		resp.Actions = append(resp.Actions, out)
+	}
+	return resp
+}
+
+func tsTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
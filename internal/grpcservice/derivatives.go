@@ -0,0 +1,64 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hahahamid/broker-backend/internal/derivatives"
+	"github.com/hahahamid/broker-backend/internal/models"
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *BrokerService) GetOptionChain(ctx context.Context, req *pb.GetOptionChainRequest) (*pb.OptionChain, error) {
+	chain, err := s.svc.Derivatives.Chain(ctx, req.Underlying, req.Expiry)
+	switch {
+	case errors.Is(err, derivatives.ErrInvalid):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, derivatives.ErrNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &pb.OptionChain{
+		Underlying:  chain.Underlying,
+		Expiry:      timestamppb.New(chain.Expiry),
+		Spot:        chain.Spot,
+		Future:      chain.Future,
+		FuturePrice: chain.FuturePrice,
+		YearsLeft:   chain.YearsLeft,
+		Rate:        chain.Rate,
+	}
+	for _, e := range chain.Expiries {
+		resp.Expiries = append(resp.Expiries, timestamppb.New(e))
+	}
+	for _, row := range chain.Strikes {
+		resp.Strikes = append(resp.Strikes, &pb.OptionStrike{
+			Strike: row.Strike,
+			Call:   toPBOptionQuote(row.Call),
+			Put:    toPBOptionQuote(row.Put),
+		})
+	}
+	return resp, nil
+}
+
+func toPBOptionQuote(q *models.OptionQuote) *pb.OptionQuote {
+	if q == nil {
+		return nil
+	}
+	out := &pb.OptionQuote{
+		Symbol:     q.Symbol,
+		Bid:        q.Bid,
+		Ask:        q.Ask,
+		Mark:       q.Mark,
+		Intrinsic:  q.Intrinsic,
+		Iv:         q.IV,
+		Multiplier: q.Multiplier,
+	}
+	if g := q.Greeks; g != nil {
+		out.Greeks = &pb.Greeks{Delta: g.Delta, Gamma: g.Gamma, Theta: g.Theta, Vega: g.Vega, Rho: g.Rho}
+	}
+	return out
+}
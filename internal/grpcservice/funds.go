@@ -0,0 +1,221 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hahahamid/broker-backend/internal/cash"
+	"github.com/hahahamid/broker-backend/internal/funds"
+	"github.com/hahahamid/broker-backend/internal/models"
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *BrokerService) ListFunds(ctx context.Context, _ *pb.Empty) (*pb.FundsResponse, error) {
+	list, err := s.svc.Funds.Funds(ctx)
+	if err != nil {
+		return nil, fundError(err)
+	}
+	resp := &pb.FundsResponse{}
+	for _, f := range list {
+		resp.Funds = append(resp.Funds, toPBFund(f))
+	}
+	return resp, nil
+}
+
+func (s *BrokerService) GetFund(ctx context.Context, req *pb.GetFundRequest) (*pb.FundResponse, error) {
+	f, navs, err := s.svc.Funds.Fund(ctx, req.Symbol)
+	if err != nil {
+		return nil, fundError(err)
+	}
+	resp := &pb.FundResponse{Fund: toPBFund(f)}
+	for _, n := range navs {
+		resp.Navs = append(resp.Navs, &pb.Nav{Symbol: n.Symbol, Date: n.Date, Nav: n.NAV})
+	}
+	return resp, nil
+}
+
+func (s *BrokerService) PostNavs(ctx context.Context, req *pb.PostNavsRequest) (*pb.PostNavsResponse, error) {
+	if err := s.admin(ctx); err != nil {
+		return nil, err
+	}
+	list := make([]models.NAV, 0, len(req.Navs))
+	for _, n := range req.Navs {
+		list = append(list, models.NAV{Symbol: n.Symbol, Date: n.Date, NAV: n.Nav})
+	}
+	stored, err := s.svc.Funds.PostNAVs(ctx, list)
+	if err != nil {
+		return nil, fundError(err)
+	}
+	return &pb.PostNavsResponse{Stored: int32(stored)}, nil
+}
+
+func (s *BrokerService) PlaceFundOrder(ctx context.Context, req *pb.FundOrderRequest) (*pb.FundOrder, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	o, err := s.svc.Funds.Place(ctx, uid, models.FundOrder{
+		Symbol: req.Symbol,
+		Side:   req.Side,
+		Amount: req.Amount,
+		Units:  req.Units,
+	})
+	if err != nil {
+		return nil, fundError(err)
+	}
+	return toPBFundOrder(o), nil
+}
+
+func (s *BrokerService) ListFundOrders(ctx context.Context, _ *pb.Empty) (*pb.FundOrdersResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.svc.Funds.Orders(ctx, uid)
+	if err != nil {
+		return nil, fundError(err)
+	}
+	resp := &pb.FundOrdersResponse{}
+	for _, o := range list {
+		resp.Orders = append(resp.Orders, toPBFundOrder(o))
+	}
+	return resp, nil
+}
+
+func (s *BrokerService) CancelFundOrder(ctx context.Context, req *pb.FundOrderID) (*pb.FundOrder, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	o, err := s.svc.Funds.Cancel(ctx, uid, req.Id)
+	if err != nil {
+		return nil, fundError(err)
+	}
+	return toPBFundOrder(o), nil
+}
+
+func (s *BrokerService) CreateSip(ctx context.Context, req *pb.SipRequest) (*pb.Sip, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sip, err := s.svc.Funds.CreateSIP(ctx, uid, models.SIP{Symbol: req.Symbol, Amount: req.Amount, Day: int(req.Day)})
+	if err != nil {
+		return nil, fundError(err)
+	}
+	return toPBSip(sip), nil
+}
+
+func (s *BrokerService) ListSips(ctx context.Context, _ *pb.Empty) (*pb.SipsResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.svc.Funds.SIPs(ctx, uid)
+	if err != nil {
+		return nil, fundError(err)
+	}
+	resp := &pb.SipsResponse{}
+	for _, sip := range list {
+		resp.Sips = append(resp.Sips, toPBSip(sip))
+	}
+	return resp, nil
+}
+
+func (s *BrokerService) PauseSip(ctx context.Context, req *pb.SipID) (*pb.Sip, error) {
+	return s.updateSip(ctx, req.Id, s.svc.Funds.PauseSIP)
+}
+
+func (s *BrokerService) ResumeSip(ctx context.Context, req *pb.SipID) (*pb.Sip, error) {
+	return s.updateSip(ctx, req.Id, s.svc.Funds.ResumeSIP)
+}
+
+func (s *BrokerService) SkipSip(ctx context.Context, req *pb.SipID) (*pb.Sip, error) {
+	return s.updateSip(ctx, req.Id, s.svc.Funds.SkipSIP)
+}
+
+func (s *BrokerService) CancelSip(ctx context.Context, req *pb.SipID) (*pb.Sip, error) {
+	return s.updateSip(ctx, req.Id, s.svc.Funds.CancelSIP)
+}
+
+func (s *BrokerService) updateSip(ctx context.Context, id string, fn func(ctx context.Context, userID, id string) (models.SIP, error)) (*pb.Sip, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sip, err := fn(ctx, uid, id)
+	if err != nil {
+		return nil, fundError(err)
+	}
+	return toPBSip(sip), nil
+}
+
+func toPBFund(f models.Fund) *pb.Fund {
+	return &pb.Fund{
+		Symbol:      f.Symbol,
+		Name:        f.Name,
+		Amc:         f.AMC,
+		Category:    f.Category,
+		Isin:        f.ISIN,
+		Exchange:    f.Exchange,
+		Currency:    f.Currency,
+		Cutoff:      f.Cutoff,
+		MinPurchase: f.MinPurchase,
+		MinSip:      f.MinSIP,
+		Nav:         f.NAV,
+		NavDate:     f.NAVDate,
+	}
+}
+
+func toPBFundOrder(o models.FundOrder) *pb.FundOrder {
+	out := &pb.FundOrder{
+		Id:        o.ID,
+		Symbol:    o.Symbol,
+		Side:      o.Side,
+		Amount:    o.Amount,
+		Units:     o.Units,
+		Nav:       o.NAV,
+		Currency:  o.Currency,
+		TradeDate: o.TradeDate,
+		Status:    o.Status,
+		SipId:     o.SIPID,
+		Note:      o.Note,
+		CreatedAt: timestamppb.New(o.CreatedAt),
+	}
+	if !o.ProcessedAt.IsZero() {
+		out.ProcessedAt = timestamppb.New(o.ProcessedAt)
+	}
+	return out
+}
+
+func toPBSip(sip models.SIP) *pb.Sip {
+	return &pb.Sip{
+		Id:           sip.ID,
+		Symbol:       sip.Symbol,
+		Amount:       sip.Amount,
+		Day:          int32(sip.Day),
+		Status:       sip.Status,
+		NextDate:     sip.NextDate,
+		Installments: int32(sip.Installments),
+		Missed:       int32(sip.Missed),
+		LastOrderId:  sip.LastOrderID,
+		LastError:    sip.LastError,
+		CreatedAt:    timestamppb.New(sip.CreatedAt),
+	}
+}
+
+func fundError(err error) error {
+	switch {
+	case errors.Is(err, funds.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, funds.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, funds.ErrState), errors.Is(err, cash.ErrInsufficientFunds):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,21 @@
+package grpcservice
+
+import (
+	"context"
+
+	pb "github.com/hahahamid/broker-backend/proto"
+)
+
+func (s *BrokerService) GetHouseAccount(ctx context.Context, _ *pb.Empty) (*pb.HouseAccountResponse, error) {
+	if err := s.admin(ctx); err != nil {
+		return nil, err
+	}
+	resp := &pb.HouseAccountResponse{AccountId: s.svc.House.Account()}
+	for _, h := range s.svc.House.Holdings() {
+		resp.Holdings = append(resp.Holdings, toPBHolding(h))
+	}
+	for _, o := range s.svc.House.Pending() {
+		resp.Pending = append(resp.Pending, toPBOrder(o))
+	}
+	return resp, nil
+}
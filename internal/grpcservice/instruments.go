@@ -0,0 +1,80 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *BrokerService) ListInstruments(ctx context.Context, req *pb.ListInstrumentsRequest) (*pb.InstrumentsResponse, error) {
+	list, err := s.repo.ListInstruments(ctx, req.Exchange, req.AssetClass)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return instrumentsResponse(list), nil
+}
+
+func (s *BrokerService) GetInstrument(ctx context.Context, req *pb.GetInstrumentRequest) (*pb.Instrument, error) {
+	inst, err := s.repo.GetInstrument(ctx, req.Symbol)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "instrument %s not found", req.Symbol)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return toPBInstrument(inst), nil
+}
+
+func (s *BrokerService) SearchInstruments(ctx context.Context, req *pb.SearchInstrumentsRequest) (*pb.InstrumentsResponse, error) {
+	if req.Query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+	list, err := s.repo.SearchInstruments(ctx, req.Query, int(req.Limit))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return instrumentsResponse(list), nil
+}
+
+func instrumentsResponse(list []models.Instrument) *pb.InstrumentsResponse {
+	resp := &pb.InstrumentsResponse{}
+	for i := range list {
+		resp.Instruments = append(resp.Instruments, toPBInstrument(&list[i]))
+	}
+	return resp
+}
+
+func toPBInstrument(inst *models.Instrument) *pb.Instrument {
+	out := &pb.Instrument{
+		Symbol:     inst.Symbol,
+		Name:       inst.Name,
+		Exchange:   inst.Exchange,
+		Isin:       inst.ISIN,
+		AssetClass: inst.AssetClass,
+		Currency:   inst.Currency,
+		TickSize:   inst.TickSize,
+		LotSize:    inst.LotSize,
+		Fractional: inst.Fractional,
+
+		MinIncrement: inst.MinIncrement,
+		PrevClose:    inst.PrevClose,
+		LowerBand:    inst.LowerBand,
+		UpperBand:    inst.UpperBand,
+		Status:       inst.Status,
+
+		Underlying: inst.Underlying,
+		Strike:     inst.Strike,
+		OptionType: inst.OptionType,
+		Multiplier: inst.Multiplier,
+	}
+	if !inst.Expiry.IsZero() {
+		out.Expiry = timestamppb.New(inst.Expiry)
+	}
+	return out
+}
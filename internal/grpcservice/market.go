@@ -0,0 +1,42 @@
+package grpcservice
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *BrokerService) GetMarketStatus(ctx context.Context, req *pb.GetMarketStatusRequest) (*pb.MarketStatusResponse, error) {
+	names := []string{req.Exchange}
+	if req.Exchange == "" {
+		names = s.svc.Calendar.Exchanges()
+		sort.Strings(names)
+	}
+	now := time.Now()
+	resp := &pb.MarketStatusResponse{}
+	for _, name := range names {
+		resp.Statuses = append(resp.Statuses, toPBMarketStatus(s.svc.Calendar.Status(name, now)))
+	}
+	return resp, nil
+}
+
+func toPBMarketStatus(st models.MarketStatus) *pb.MarketStatus {
+	out := &pb.MarketStatus{
+		Exchange:   st.Exchange,
+		Session:    st.Session,
+		IsOpen:     st.IsOpen,
+		TradingDay: st.TradingDay,
+		HalfDay:    st.HalfDay,
+	}
+	if !st.NextOpen.IsZero() {
+		out.NextOpen = timestamppb.New(st.NextOpen)
+	}
+	if !st.NextClose.IsZero() {
+		out.NextClose = timestamppb.New(st.NextClose)
+	}
+	return out
+}
@@ -0,0 +1,120 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hahahamid/broker-backend/internal/matching"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/orders"
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *BrokerService) PlaceOrder(ctx context.Context, req *pb.PlaceOrderRequest) (*pb.Order, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	o, err := s.svc.Orders.Place(ctx, uid, fromPBOrderRequest(req))
+	if err != nil {
+		return nil, orderError(err)
+	}
+	return toPBOrder(o), nil
+}
+
+func (s *BrokerService) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest) (*pb.Order, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	o, err := s.svc.Orders.Cancel(ctx, uid, req.Id)
+	if err != nil {
+		return nil, orderError(err)
+	}
+	return toPBOrder(o), nil
+}
+
+func fromPBOrderRequest(req *pb.PlaceOrderRequest) models.Order {
+	return models.Order{
+		Symbol:      req.Symbol,
+		Side:        req.Side,
+		Type:        req.Type,
+		Quantity:    req.Quantity,
+		Notional:    req.Notional,
+		Price:       req.Price,
+		DisplayQty:  req.DisplayQty,
+		Validity:    req.Validity,
+		Product:     req.Product,
+		AfterMarket: req.AfterMarket,
+		LotMethod:   req.LotMethod,
+		LotIDs:      req.LotIds,
+		Short:       req.Short,
+	}
+}
+
+func orderError(err error) error {
+	switch {
+	case errors.Is(err, orders.ErrRejected):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, matching.ErrOrderNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toPBOrder(o models.Order) *pb.Order {
+	out := &pb.Order{
+		Id:            o.ID,
+		Symbol:        o.Symbol,
+		Side:          o.Side,
+		Quantity:      o.Quantity,
+		Price:         o.Price,
+		RealizedPnl:   o.RealizedPNL,
+		UnrealizedPnl: o.UnrealizedPNL,
+		Type:          o.Type,
+		Status:        o.Status,
+		FilledQty:     o.FilledQty,
+		AvgFillPrice:  o.AvgFillPrice,
+		Validity:      o.Validity,
+		Product:       o.Product,
+		Currency:      o.Currency,
+		AfterMarket:   o.AfterMarket,
+		LotMethod:     o.LotMethod,
+		LotIds:        o.LotIDs,
+		Notional:      o.Notional,
+		Fractional:    o.Fractional,
+		HouseOrderId:  o.HouseOrderID,
+		Short:         o.Short,
+		BuyIn:         o.BuyIn,
+		Settlement:    o.Settlement,
+		StrategyId:    o.StrategyID,
+		BasketId:      o.BasketID,
+		ParentId:      o.ParentID,
+		DisplayQty:    o.DisplayQty,
+	}
+	if !o.CreatedAt.IsZero() {
+		out.CreatedAt = timestamppb.New(o.CreatedAt)
+	}
+	if !o.ExpiresAt.IsZero() {
+		out.ExpiresAt = timestamppb.New(o.ExpiresAt)
+	}
+	if a := o.Algo; a != nil {
+		out.Algo = &pb.Algo{
+			Strategy:         a.Strategy,
+			StartAt:          timestamppb.New(a.StartAt),
+			EndAt:            timestamppb.New(a.EndAt),
+			SliceSeconds:     int32(a.SliceSeconds),
+			MaxParticipation: a.MaxParticipation,
+			Step:             a.Step,
+			Schedule:         a.Schedule,
+			Slice:            int32(a.Slice),
+			ChildId:          a.ChildID,
+			Note:             a.Note,
+		}
+	}
+	return out
+}
@@ -0,0 +1,265 @@
+package grpcservice
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *BrokerService) GetHoldings(ctx context.Context, _ *pb.Empty) (*pb.HoldingsResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.HoldingsResponse{}
+	for _, h := range s.svc.Lots.Holdings(uid) {
+		resp.Holdings = append(resp.Holdings, toPBHolding(h))
+	}
+	return resp, nil
+}
+
+func toPBHolding(h models.Holding) *pb.Holding {
+	return &pb.Holding{
+		Symbol:        h.Symbol,
+		Quantity:      h.Quantity,
+		AvgPrice:      h.AvgPrice,
+		LastPrice:     h.LastPrice,
+		UnrealizedPnl: h.UnrealizedPNL,
+		ShortTermQty:  h.ShortTermQty,
+		LongTermQty:   h.LongTermQty,
+		SettledQty:    h.SettledQty,
+		UnsettledQty:  h.UnsettledQty,
+
+		Currency:          h.Currency,
+		Value:             h.Value,
+		FxRate:            h.FXRate,
+		BaseValue:         h.BaseValue,
+		BaseUnrealizedPnl: h.BaseUnrealizedPNL,
+		Multiplier:        h.Multiplier,
+		Contracts:         h.Contracts,
+		Fund:              h.Fund,
+	}
+}
+
+func (s *BrokerService) GetOrderbook(ctx context.Context, _ *pb.Empty) (*pb.OrderbookResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.repo.ListOrders(ctx, uid)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.svc.Lots.AttachPNL(uid, list)
+	card, err := s.card(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.OrderbookResponse{Card: card}
+	for _, o := range list {
+		resp.Orders = append(resp.Orders, toPBOrder(o))
+	}
+	return resp, nil
+}
+
+func (s *BrokerService) GetPositions(ctx context.Context, _ *pb.Empty) (*pb.PositionsResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	card, err := s.card(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.PositionsResponse{Card: card}
+	for _, p := range s.svc.Lots.Positions(uid) {
+		resp.Positions = append(resp.Positions, &pb.Position{
+			Symbol:        p.Symbol,
+			Currency:      p.Currency,
+			Quantity:      p.Quantity,
+			AvgPrice:      p.AvgPrice,
+			Pnl:           p.PNL,
+			BuyQty:        p.BuyQty,
+			SellQty:       p.SellQty,
+			RealizedPnl:   p.RealizedPNL,
+			UnrealizedPnl: p.UnrealizedPNL,
+			CarriedQty:    p.CarriedQty,
+			Multiplier:    p.Multiplier,
+			Contracts:     p.Contracts,
+			Fund:          p.Fund,
+		})
+	}
+	return resp, nil
+}
+
+func (s *BrokerService) GetLots(ctx context.Context, req *pb.GetLotsRequest) (*pb.LotsResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.LotsResponse{}
+	for _, l := range s.svc.Lots.Lots(uid, strings.ToUpper(req.Symbol)) {
+		resp.Lots = append(resp.Lots, &pb.Lot{
+			Id:         l.ID,
+			Symbol:     l.Symbol,
+			Currency:   l.Currency,
+			OrderId:    l.OrderID,
+			Quantity:   l.Quantity,
+			OrigQty:    l.OrigQty,
+			Price:      l.Price,
+			AcquiredAt: timestamppb.New(l.AcquiredAt),
+			Term:       l.Term,
+			Exchange:   l.Exchange,
+			SettleDate: timestamppb.New(l.SettleDate),
+			Settled:    l.Settled,
+			Short:      l.Short,
+		})
+	}
+	return resp, nil
+}
+
+func (s *BrokerService) GetClosedLots(ctx context.Context, _ *pb.Empty) (*pb.ClosedLotsResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	card, err := s.card(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ClosedLotsResponse{Card: card}
+	for _, c := range s.svc.Lots.Realized(uid) {
+		resp.ClosedLots = append(resp.ClosedLots, &pb.ClosedLot{
+			Id:          c.ID,
+			Symbol:      c.Symbol,
+			Currency:    c.Currency,
+			LotId:       c.LotID,
+			OrderId:     c.OrderID,
+			Quantity:    c.Quantity,
+			CostPrice:   c.CostPrice,
+			SalePrice:   c.SalePrice,
+			RealizedPnl: c.RealizedPNL,
+			AcquiredAt:  timestamppb.New(c.AcquiredAt),
+			ClosedAt:    timestamppb.New(c.ClosedAt),
+			Term:        c.Term,
+			Short:       c.Short,
+		})
+	}
+	return resp, nil
+}
+
+// card is the user's PnL card net of charges.
+func (s *BrokerService) card(ctx context.Context, uid string) (*pb.PnlCard, error) {
+	c := s.svc.Lots.Card(uid)
+	if err := s.svc.Charges.AttachCharges(ctx, uid, &c); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return toPBCard(c), nil
+}
+
+func toPBCard(c models.PNLCard) *pb.PnlCard {
+	return &pb.PnlCard{
+		Currency:      c.Currency,
+		RealizedPnl:   c.RealizedPNL,
+		UnrealizedPnl: c.UnrealizedPNL,
+		ShortTermPnl:  c.ShortTermPNL,
+		LongTermPnl:   c.LongTermPNL,
+		Charges:       c.Charges,
+		NetPnl:        c.NetPNL,
+	}
+}
+
+func (s *BrokerService) GetPortfolioHistory(ctx context.Context, req *pb.PortfolioRangeRequest) (*pb.PortfolioHistoryResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := dateRange(req); err != nil {
+		return nil, err
+	}
+	list, err := s.svc.Portfolio.History(ctx, uid, req.From, req.To)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &pb.PortfolioHistoryResponse{}
+	for _, snap := range list {
+		out := &pb.PortfolioSnapshot{
+			Date:          snap.Date,
+			Currency:      snap.Currency,
+			Time:          timestamppb.New(snap.Time),
+			HoldingsValue: snap.HoldingsValue,
+			Cash:          snap.Cash,
+			TotalValue:    snap.TotalValue,
+			NetFlow:       snap.NetFlow,
+			DayChange:     snap.DayChange,
+		}
+		for _, h := range snap.Holdings {
+			out.Holdings = append(out.Holdings, &pb.SnapshotHolding{
+				Symbol:    h.Symbol,
+				Currency:  h.Currency,
+				Quantity:  h.Quantity,
+				AvgPrice:  h.AvgPrice,
+				Price:     h.Price,
+				Value:     h.Value,
+				BaseValue: h.BaseValue,
+			})
+		}
+		resp.Snapshots = append(resp.Snapshots, out)
+	}
+	return resp, nil
+}
+
+func (s *BrokerService) GetPerformance(ctx context.Context, req *pb.PortfolioRangeRequest) (*pb.Performance, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := dateRange(req); err != nil {
+		return nil, err
+	}
+	p, err := s.svc.Portfolio.Performance(ctx, uid, req.From, req.To)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	out := &pb.Performance{
+		StartValue:    p.StartValue,
+		EndValue:      p.EndValue,
+		NetFlows:      p.NetFlows,
+		Twr:           p.TWR,
+		Xirr:          p.XIRR,
+		MaxDrawdown:   p.MaxDrawdown,
+		DayChange:     p.DayChange,
+		DayChangePct:  p.DayChangePct,
+		CurrentValue:  p.CurrentValue,
+		SnapshotCount: int32(p.SnapshotCount),
+	}
+	for dst, t := range map[**timestamppb.Timestamp]time.Time{
+		&out.From:         p.From,
+		&out.To:           p.To,
+		&out.DrawdownPeak: p.DrawdownPeak,
+		&out.DrawdownLow:  p.DrawdownLow,
+	} {
+		if !t.IsZero() {
+			*dst = timestamppb.New(t)
+		}
+	}
+	return out, nil
+}
+
+func dateRange(req *pb.PortfolioRangeRequest) error {
+	for _, d := range []string{req.From, req.To} {
+		if d == "" {
+			continue
+		}
+		if _, err := time.Parse(time.DateOnly, d); err != nil {
+			return status.Errorf(codes.InvalidArgument, "dates must be YYYY-MM-DD: %v", err)
+		}
+	}
+	return nil
+}
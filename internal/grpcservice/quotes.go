@@ -0,0 +1,119 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *BrokerService) GetMarketDepth(ctx context.Context, req *pb.GetMarketDepthRequest) (*pb.MarketDepth, error) {
+	if req.Symbol == "" {
+		return nil, status.Error(codes.InvalidArgument, "symbol is required")
+	}
+	symbol := strings.ToUpper(req.Symbol)
+	_, err := s.repo.GetInstrument(ctx, symbol)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "instrument %s not found", symbol)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	d := s.svc.Engine.Depth(symbol, s.depthLevels(req.Levels))
+	return &pb.MarketDepth{Symbol: d.Symbol, Bids: toPBLevels(d.Bids), Asks: toPBLevels(d.Asks)}, nil
+}
+
+// SubscribeQuotes streams top of book and depth for the requested symbols.
+// Book changes are coalesced: each symbol is sent at most once per interval,
+// with its latest state, so slow clients see fewer updates rather than a
+// growing backlog.
+func (s *BrokerService) SubscribeQuotes(req *pb.SubscribeQuotesRequest, stream grpc.ServerStreamingServer[pb.QuoteUpdate]) error {
+	if len(req.Symbols) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one symbol is required")
+	}
+	interval := time.Duration(s.cfg.QuoteStreamInterval) * time.Millisecond
+	if d := time.Duration(req.IntervalMs) * time.Millisecond; d > interval {
+		interval = d
+	}
+	levels := int(req.Levels)
+	if levels > 0 {
+		levels = s.depthLevels(req.Levels)
+	}
+
+	wanted := map[string]bool{}
+	dirty := map[string]bool{}
+	for _, sym := range req.Symbols {
+		sym = strings.ToUpper(sym)
+		wanted[sym] = true
+		dirty[sym] = true
+	}
+
+	changes, stop := s.svc.Engine.Watch()
+	defer stop()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case sym := <-changes:
+			if wanted[sym] {
+				dirty[sym] = true
+			}
+		case <-ticker.C:
+			for sym := range dirty {
+				if err := stream.Send(s.quoteUpdate(sym, levels)); err != nil {
+					return err
+				}
+				delete(dirty, sym)
+			}
+		}
+	}
+}
+
+func (s *BrokerService) quoteUpdate(symbol string, levels int) *pb.QuoteUpdate {
+	n := levels
+	if n == 0 {
+		n = 1
+	}
+	d := s.svc.Engine.Depth(symbol, n)
+	u := &pb.QuoteUpdate{Symbol: symbol, Time: timestamppb.Now()}
+	if len(d.Bids) > 0 {
+		u.Bid, u.BidSize = d.Bids[0].Price, d.Bids[0].Quantity
+	}
+	if len(d.Asks) > 0 {
+		u.Ask, u.AskSize = d.Asks[0].Price, d.Asks[0].Quantity
+	}
+	if last, ok := s.svc.Prices.Mark(symbol); ok {
+		u.Last = last
+	}
+	if levels > 0 {
+		u.Bids, u.Asks = toPBLevels(d.Bids), toPBLevels(d.Asks)
+	}
+	return u
+}
+
+// depthLevels clamps a requested level count to the configured maximum.
+func (s *BrokerService) depthLevels(n int32) int {
+	if n <= 0 || int(n) > s.cfg.MaxDepthLevels {
+		return s.cfg.MaxDepthLevels
+	}
+	return int(n)
+}
+
+func toPBLevels(levels []models.PriceLevel) []*pb.PriceLevel {
+	out := make([]*pb.PriceLevel, 0, len(levels))
+	for _, l := range levels {
+		out = append(out, &pb.PriceLevel{Price: l.Price, Quantity: l.Quantity, Orders: int32(l.Orders)})
+	}
+	return out
+}
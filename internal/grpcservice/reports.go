@@ -0,0 +1,86 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hahahamid/broker-backend/internal/reports"
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *BrokerService) GetReport(ctx context.Context, req *pb.ReportRequest) (*pb.ReportFile, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	f, err := s.svc.Reports.Generate(ctx, uid, reports.Request{
+		Kind:   req.Kind,
+		Date:   req.Date,
+		Month:  req.Month,
+		From:   req.From,
+		To:     req.To,
+		Year:   int(req.FinancialYear),
+		Format: req.Format,
+	})
+	if errors.Is(err, reports.ErrInvalid) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.ReportFile{Filename: f.Name, ContentType: f.ContentType, Data: f.Data}, nil
+}
+
+func (s *BrokerService) GetCapitalGains(ctx context.Context, req *pb.CapitalGainsRequest) (*pb.CapitalGainsReport, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	g, err := s.svc.Reports.CapitalGains(ctx, uid, int(req.FinancialYear))
+	if errors.Is(err, reports.ErrInvalid) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &pb.CapitalGainsReport{
+		FinancialYear: g.FinancialYear,
+		From:          g.From,
+		To:            g.To,
+		ShortTermGain: g.ShortTermGain,
+		LongTermGain:  g.LongTermGain,
+		TotalGain:     g.TotalGain,
+	}
+	for _, i := range g.Instruments {
+		resp.Instruments = append(resp.Instruments, &pb.InstrumentGains{
+			Symbol:        i.Symbol,
+			Quantity:      i.Quantity,
+			Cost:          i.Cost,
+			Proceeds:      i.Proceeds,
+			ShortTermGain: i.ShortTermGain,
+			LongTermGain:  i.LongTermGain,
+			TotalGain:     i.TotalGain,
+		})
+	}
+	for _, e := range g.Entries {
+		resp.Entries = append(resp.Entries, &pb.GainEntry{
+			Symbol:      e.Symbol,
+			LotId:       e.LotID,
+			OrderId:     e.OrderID,
+			Quantity:    e.Quantity,
+			AcquiredAt:  timestamppb.New(e.AcquiredAt),
+			SoldAt:      timestamppb.New(e.SoldAt),
+			HoldingDays: int32(e.HoldingDays),
+			CostPrice:   e.CostPrice,
+			SalePrice:   e.SalePrice,
+			Cost:        e.Cost,
+			Proceeds:    e.Proceeds,
+			Gain:        e.Gain,
+			Term:        e.Term,
+		})
+	}
+	return resp, nil
+}
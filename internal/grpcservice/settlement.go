@@ -0,0 +1,43 @@
+package grpcservice
+
+import (
+	"context"
+
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *BrokerService) ListSettlementRuns(ctx context.Context, req *pb.ListSettlementRunsRequest) (*pb.SettlementRunsResponse, error) {
+	if err := s.admin(ctx); err != nil {
+		return nil, err
+	}
+	limit := int(req.Limit)
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	runs, err := s.svc.Settlement.Runs(ctx, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &pb.SettlementRunsResponse{}
+	for _, run := range runs {
+		out := &pb.SettlementRun{
+			Id:          run.ID,
+			Exchange:    run.Exchange,
+			TradingDate: run.TradingDate,
+			RanAt:       timestamppb.New(run.RanAt),
+		}
+		for _, r := range run.Results {
+			out.Results = append(out.Results, &pb.SettlementResult{
+				UserId:   r.UserID,
+				Symbol:   r.Symbol,
+				Quantity: r.Quantity,
+				Value:    r.Value,
+			})
+		}
+		resp.Runs = append(resp.Runs, out)
+	}
+	return resp, nil
+}
@@ -0,0 +1,91 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+	"github.com/hahahamid/broker-backend/internal/shorts"
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *BrokerService) ListLocates(ctx context.Context, _ *pb.Empty) (*pb.LocatesResponse, error) {
+	if _, err := s.userID(ctx); err != nil {
+		return nil, err
+	}
+	return toPBLocates(s.svc.Shorts.Locates()), nil
+}
+
+func (s *BrokerService) GetShortPositions(ctx context.Context, _ *pb.Empty) (*pb.ShortPositionsResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.svc.Shorts.Positions(ctx, uid)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &pb.ShortPositionsResponse{}
+	for _, p := range list {
+		resp.Shorts = append(resp.Shorts, &pb.ShortPosition{
+			Symbol:        p.Symbol,
+			Currency:      p.Currency,
+			Quantity:      p.Quantity,
+			AvgPrice:      p.AvgPrice,
+			LastPrice:     p.LastPrice,
+			UnrealizedPnl: p.UnrealizedPNL,
+			FeeRate:       p.FeeRate,
+			AccruedFees:   p.AccruedFees,
+		})
+	}
+	return resp, nil
+}
+
+func (s *BrokerService) SetLocates(ctx context.Context, req *pb.SetLocatesRequest) (*pb.LocatesResponse, error) {
+	if err := s.admin(ctx); err != nil {
+		return nil, err
+	}
+	list := make([]models.Locate, 0, len(req.Locates))
+	for _, l := range req.Locates {
+		list = append(list, models.Locate{Symbol: l.Symbol, Quantity: l.Quantity, FeeRate: l.FeeRate})
+	}
+	err := s.svc.Shorts.SetLocates(ctx, list)
+	if errors.Is(err, shorts.ErrInvalid) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return toPBLocates(s.svc.Shorts.Locates()), nil
+}
+
+func (s *BrokerService) SetMargin(ctx context.Context, req *pb.SetMarginRequest) (*pb.SetMarginResponse, error) {
+	if err := s.admin(ctx); err != nil {
+		return nil, err
+	}
+	err := s.svc.Shorts.SetMargin(ctx, req.UserId, req.Enabled)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.SetMarginResponse{UserId: req.UserId, Margin: req.Enabled}, nil
+}
+
+func toPBLocates(list []models.Locate) *pb.LocatesResponse {
+	resp := &pb.LocatesResponse{}
+	for _, l := range list {
+		resp.Locates = append(resp.Locates, &pb.Locate{
+			Symbol:    l.Symbol,
+			Quantity:  l.Quantity,
+			FeeRate:   l.FeeRate,
+			Borrowed:  l.Borrowed,
+			Available: l.Available,
+		})
+	}
+	return resp
+}
@@ -0,0 +1,152 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/strategy"
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *BrokerService) ListStrategyTypes(ctx context.Context, _ *pb.Empty) (*pb.StrategyTypesResponse, error) {
+	if _, err := s.userID(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.StrategyTypesResponse{Strategies: strategy.Names()}, nil
+}
+
+func (s *BrokerService) StartStrategy(ctx context.Context, req *pb.StartStrategyRequest) (*pb.Strategy, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	st := models.Strategy{
+		Name:         req.Name,
+		Params:       req.Params,
+		Symbols:      req.Symbols,
+		TimerSeconds: int(req.TimerSeconds),
+		Seed:         req.Seed,
+	}
+	if l := req.Limits; l != nil {
+		st.Limits = models.StrategyLimits{
+			MaxOrdersPerMinute: int(l.MaxOrdersPerMinute),
+			MaxOpenOrders:      int(l.MaxOpenOrders),
+			MaxOrderValue:      l.MaxOrderValue,
+			MaxPosition:        l.MaxPosition,
+			MaxLoss:            l.MaxLoss,
+			CallbackMS:         int(l.CallbackMs),
+		}
+	}
+	st, err = s.svc.Strategies.Start(ctx, uid, st)
+	if err != nil {
+		return nil, strategyError(err)
+	}
+	return toPBStrategy(st), nil
+}
+
+func (s *BrokerService) ListStrategies(ctx context.Context, _ *pb.Empty) (*pb.StrategiesResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.svc.Strategies.List(ctx, uid)
+	if err != nil {
+		return nil, strategyError(err)
+	}
+	resp := &pb.StrategiesResponse{}
+	for _, st := range list {
+		resp.Strategies = append(resp.Strategies, toPBStrategy(st))
+	}
+	return resp, nil
+}
+
+func (s *BrokerService) GetStrategy(ctx context.Context, req *pb.StrategyID) (*pb.Strategy, error) {
+	return s.strategy(ctx, req.Id, s.svc.Strategies.Get)
+}
+
+func (s *BrokerService) StopStrategy(ctx context.Context, req *pb.StrategyID) (*pb.Strategy, error) {
+	return s.strategy(ctx, req.Id, s.svc.Strategies.Stop)
+}
+
+func (s *BrokerService) KillStrategy(ctx context.Context, req *pb.StrategyID) (*pb.Strategy, error) {
+	return s.strategy(ctx, req.Id, s.svc.Strategies.Kill)
+}
+
+func (s *BrokerService) strategy(ctx context.Context, id string, fn func(ctx context.Context, userID, id string) (models.Strategy, error)) (*pb.Strategy, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	st, err := fn(ctx, uid, id)
+	if err != nil {
+		return nil, strategyError(err)
+	}
+	return toPBStrategy(st), nil
+}
+
+func (s *BrokerService) KillAllStrategies(ctx context.Context, _ *pb.Empty) (*pb.KillAllStrategiesResponse, error) {
+	if err := s.admin(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.KillAllStrategiesResponse{Killed: int32(s.svc.Strategies.KillAll(ctx))}, nil
+}
+
+func toPBStrategy(st models.Strategy) *pb.Strategy {
+	out := &pb.Strategy{
+		Id:           st.ID,
+		Name:         st.Name,
+		Params:       st.Params,
+		Symbols:      st.Symbols,
+		TimerSeconds: int32(st.TimerSeconds),
+		Seed:         st.Seed,
+		Status:       st.Status,
+		Reason:       st.Reason,
+		Orders:       int32(st.Orders),
+		Rejected:     int32(st.Rejected),
+		Fills:        int32(st.Fills),
+		Volume:       st.Volume,
+		Limits: &pb.StrategyLimits{
+			MaxOrdersPerMinute: int32(st.Limits.MaxOrdersPerMinute),
+			MaxOpenOrders:      int32(st.Limits.MaxOpenOrders),
+			MaxOrderValue:      st.Limits.MaxOrderValue,
+			MaxPosition:        st.Limits.MaxPosition,
+			MaxLoss:            st.Limits.MaxLoss,
+			CallbackMs:         int32(st.Limits.CallbackMS),
+		},
+		RealizedPnl:   st.RealizedPNL,
+		UnrealizedPnl: st.UnrealizedPNL,
+		Pnl:           st.PNL,
+		StartedAt:     timestamppb.New(st.StartedAt),
+	}
+	for _, p := range st.Positions {
+		out.Positions = append(out.Positions, &pb.StrategyPosition{
+			Symbol:        p.Symbol,
+			Quantity:      p.Quantity,
+			AvgPrice:      p.AvgPrice,
+			LastPrice:     p.LastPrice,
+			RealizedPnl:   p.RealizedPNL,
+			UnrealizedPnl: p.UnrealizedPNL,
+		})
+	}
+	if !st.StoppedAt.IsZero() {
+		out.StoppedAt = timestamppb.New(st.StoppedAt)
+	}
+	return out
+}
+
+func strategyError(err error) error {
+	switch {
+	case errors.Is(err, strategy.ErrInvalid), errors.Is(err, strategy.ErrUnknown):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, strategy.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, strategy.ErrState):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,122 @@
+package grpcservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+	"github.com/hahahamid/broker-backend/internal/watchlists"
+	pb "github.com/hahahamid/broker-backend/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *BrokerService) ListWatchlists(ctx context.Context, _ *pb.Empty) (*pb.WatchlistsResponse, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.svc.Watchlists.List(ctx, uid)
+	if err != nil {
+		return nil, watchlistError(err)
+	}
+	resp := &pb.WatchlistsResponse{}
+	for i := range list {
+		resp.Watchlists = append(resp.Watchlists, s.toPBWatchlist(&list[i]))
+	}
+	return resp, nil
+}
+
+func (s *BrokerService) GetWatchlist(ctx context.Context, req *pb.WatchlistRequest) (*pb.Watchlist, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.watchlistResult(s.svc.Watchlists.Get(ctx, uid, req.Id))
+}
+
+func (s *BrokerService) CreateWatchlist(ctx context.Context, req *pb.CreateWatchlistRequest) (*pb.Watchlist, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.watchlistResult(s.svc.Watchlists.Create(ctx, uid, req.Name, req.Symbols))
+}
+
+func (s *BrokerService) RenameWatchlist(ctx context.Context, req *pb.RenameWatchlistRequest) (*pb.Watchlist, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.watchlistResult(s.svc.Watchlists.Rename(ctx, uid, req.Id, req.Name))
+}
+
+func (s *BrokerService) AddWatchlistSymbols(ctx context.Context, req *pb.WatchlistSymbolsRequest) (*pb.Watchlist, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.watchlistResult(s.svc.Watchlists.Add(ctx, uid, req.Id, req.Symbols))
+}
+
+func (s *BrokerService) ReorderWatchlist(ctx context.Context, req *pb.WatchlistSymbolsRequest) (*pb.Watchlist, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.watchlistResult(s.svc.Watchlists.Reorder(ctx, uid, req.Id, req.Symbols))
+}
+
+func (s *BrokerService) RemoveWatchlistSymbol(ctx context.Context, req *pb.RemoveWatchlistSymbolRequest) (*pb.Watchlist, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.watchlistResult(s.svc.Watchlists.Remove(ctx, uid, req.Id, req.Symbol))
+}
+
+func (s *BrokerService) DeleteWatchlist(ctx context.Context, req *pb.WatchlistRequest) (*pb.Empty, error) {
+	uid, err := s.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.svc.Watchlists.Delete(ctx, uid, req.Id); err != nil {
+		return nil, watchlistError(err)
+	}
+	return &pb.Empty{}, nil
+}
+
+func (s *BrokerService) watchlistResult(w *models.Watchlist, err error) (*pb.Watchlist, error) {
+	if err != nil {
+		return nil, watchlistError(err)
+	}
+	return s.toPBWatchlist(w), nil
+}
+
+func watchlistError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return status.Error(codes.NotFound, "watchlist or symbol not found")
+	case errors.Is(err, watchlists.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, watchlists.ErrLimit):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (s *BrokerService) toPBWatchlist(w *models.Watchlist) *pb.Watchlist {
+	out := &pb.Watchlist{
+		Id:        w.ID.Hex(),
+		Name:      w.Name,
+		CreatedAt: timestamppb.New(w.CreatedAt),
+		UpdatedAt: timestamppb.New(w.UpdatedAt),
+	}
+	for _, it := range s.svc.Watchlists.Items(w) {
+		out.Items = append(out.Items, &pb.WatchlistItem{Symbol: it.Symbol, Last: it.Last, Bid: it.Bid, Ask: it.Ask})
+	}
+	return out
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/accounts"
+)
+
+type AccountsHandler struct {
+	svc *accounts.Service
+}
+
+func NewAccountsHandler(s *accounts.Service) *AccountsHandler {
+	return &AccountsHandler{svc: s}
+}
+
+// Get returns the account the request selected, with its cash.
+func (h *AccountsHandler) Get(c *gin.Context) {
+	a, err := h.svc.Get(c.Request.Context(), c.GetString("userID"))
+	if accountError(c, err) {
+		return
+	}
+	c.JSON(http.StatusOK, a)
+}
+
+// ResetBalance sets a paper account's cash to `amount`, or its starting
+// cash when omitted.
+func (h *AccountsHandler) ResetBalance(c *gin.Context) {
+	var req struct {
+		Amount float64 `json:"amount"`
+	}
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	a, err := h.svc.ResetBalance(c.Request.Context(), c.GetString("userID"), req.Amount)
+	if accountError(c, err) {
+		return
+	}
+	c.JSON(http.StatusOK, a)
+}
+
+// accountError writes the response for a failed account request and
+// reports whether there was one.
+func accountError(c *gin.Context, err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, accounts.ErrInvalid):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, accounts.ErrState):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+	return true
+}
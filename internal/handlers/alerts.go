@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/alerts"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+)
+
+type AlertsHandler struct {
+	svc *alerts.Service
+}
+
+func NewAlertsHandler(s *alerts.Service) *AlertsHandler {
+	return &AlertsHandler{svc: s}
+}
+
+func (h *AlertsHandler) Create(c *gin.Context) {
+	var req models.Alert
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	a, err := h.svc.Create(c.Request.Context(), c.GetString("userID"), req)
+	if err != nil {
+		alertError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, a)
+}
+
+func (h *AlertsHandler) List(c *gin.Context) {
+	list, err := h.svc.List(c.Request.Context(), c.GetString("userID"))
+	if err != nil {
+		alertError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"alerts": list})
+}
+
+func (h *AlertsHandler) Delete(c *gin.Context) {
+	if err := h.svc.Delete(c.Request.Context(), c.GetString("userID"), c.Param("id")); err != nil {
+		alertError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *AlertsHandler) Rearm(c *gin.Context) {
+	a, err := h.svc.Rearm(c.Request.Context(), c.GetString("userID"), c.Param("id"))
+	if err != nil {
+		alertError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, a)
+}
+
+func (h *AlertsHandler) History(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "100"))
+	list, err := h.svc.History(c.Request.Context(), c.GetString("userID"), limit)
+	if err != nil {
+		alertError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"events": list})
+}
+
+func (h *AlertsHandler) Inbox(c *gin.Context) {
+	list, err := h.svc.Inbox(c.Request.Context(), c.GetString("userID"), c.Query("unread_only") == "true")
+	if err != nil {
+		alertError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"notifications": list})
+}
+
+func (h *AlertsHandler) MarkRead(c *gin.Context) {
+	var req struct {
+		IDs []string `json:"ids"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := h.svc.MarkRead(c.Request.Context(), c.GetString("userID"), req.IDs); err != nil {
+		alertError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func alertError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "alert not found"})
+	case errors.Is(err, alerts.ErrInvalid):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
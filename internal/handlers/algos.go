@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/algos"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/orders"
+)
+
+type AlgosHandler struct {
+	svc *algos.Service
+}
+
+func NewAlgosHandler(s *algos.Service) *AlgosHandler {
+	return &AlgosHandler{svc: s}
+}
+
+// Create starts a TWAP or VWAP parent order. Times are RFC 3339; start_at
+// defaults to now.
+func (h *AlgosHandler) Create(c *gin.Context) {
+	var req struct {
+		Symbol           string    `json:"symbol" binding:"required"`
+		Side             string    `json:"side" binding:"required"`
+		Quantity         float64   `json:"quantity" binding:"required"`
+		Price            float64   `json:"price"` // limit for every child
+		Product          string    `json:"product"`
+		LotMethod        string    `json:"lot_method"`
+		Short            bool      `json:"short"`
+		Strategy         string    `json:"strategy" binding:"required"`
+		StartAt          time.Time `json:"start_at"`
+		EndAt            time.Time `json:"end_at" binding:"required"`
+		SliceSeconds     int       `json:"slice_seconds"`
+		MaxParticipation float64   `json:"max_participation"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	p, err := h.svc.Create(c.Request.Context(), c.GetString("userID"), models.Order{
+		Symbol:    req.Symbol,
+		Side:      req.Side,
+		Quantity:  req.Quantity,
+		Price:     req.Price,
+		Product:   req.Product,
+		LotMethod: req.LotMethod,
+		Short:     req.Short,
+		Algo: &models.Algo{
+			Strategy:         req.Strategy,
+			StartAt:          req.StartAt,
+			EndAt:            req.EndAt,
+			SliceSeconds:     req.SliceSeconds,
+			MaxParticipation: req.MaxParticipation,
+		},
+	})
+	if algoError(c, err) {
+		return
+	}
+	c.JSON(http.StatusCreated, p)
+}
+
+// Get returns a parent order with its child orders.
+func (h *AlgosHandler) Get(c *gin.Context) {
+	p, children, err := h.svc.Get(c.Request.Context(), c.GetString("userID"), c.Param("id"))
+	if algoError(c, err) {
+		return
+	}
+	if children == nil {
+		children = []models.Order{}
+	}
+	c.JSON(http.StatusOK, gin.H{"parent": p, "children": children})
+}
+
+func (h *AlgosHandler) Pause(c *gin.Context) {
+	p, err := h.svc.Pause(c.Request.Context(), c.GetString("userID"), c.Param("id"))
+	if algoError(c, err) {
+		return
+	}
+	c.JSON(http.StatusOK, p)
+}
+
+func (h *AlgosHandler) Resume(c *gin.Context) {
+	p, err := h.svc.Resume(c.Request.Context(), c.GetString("userID"), c.Param("id"))
+	if algoError(c, err) {
+		return
+	}
+	c.JSON(http.StatusOK, p)
+}
+
+func (h *AlgosHandler) Cancel(c *gin.Context) {
+	p, ok, err := h.svc.Cancel(c.Request.Context(), c.GetString("userID"), c.Param("id"))
+	if !ok && err == nil {
+		err = algos.ErrNotFound
+	}
+	if algoError(c, err) {
+		return
+	}
+	c.JSON(http.StatusOK, p)
+}
+
+// algoError writes the response for a failed algo request and reports
+// whether there was one.
+func algoError(c *gin.Context, err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, algos.ErrInvalid), errors.Is(err, orders.ErrRejected):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, algos.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, algos.ErrState):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+	return true
+}
@@ -41,11 +41,20 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	var req struct {
 		Email    string `json:"email" binding:"required,email"`
 		Password string `json:"password" binding:"required"`
+		Mode     string `json:"mode"` // limits the tokens to one account mode
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	if req.Mode != "" {
+		mode, err := utils.AccountMode(req.Mode, "")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		req.Mode = mode
+	}
 	user, err := h.repo.GetUserByEmail(context.Background(), req.Email)
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid credentials"})
@@ -55,7 +64,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid credentials"})
 		return
 	}
-	at, rt, err := utils.GenerateTokens(user.ID.Hex(), h.cfg.JWTSecret, h.cfg.RefreshSecret, h.cfg.AccessTokenExpireMin)
+	at, rt, err := utils.GenerateTokens(user.ID.Hex(), req.Mode, h.cfg.JWTSecret, h.cfg.RefreshSecret, h.cfg.AccessTokenExpireMin)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not generate tokens"})
 		return
@@ -79,8 +88,9 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 	}
 	claims := token.Claims.(jwt.MapClaims)
 	userID := claims["sub"].(string)
+	mode, _ := claims["mode"].(string)
 
-	newAt, newRt, err := utils.GenerateTokens(userID, h.cfg.JWTSecret, h.cfg.RefreshSecret, h.cfg.AccessTokenExpireMin)
+	newAt, newRt, err := utils.GenerateTokens(userID, mode, h.cfg.JWTSecret, h.cfg.RefreshSecret, h.cfg.AccessTokenExpireMin)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not generate tokens"})
 		return
@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/baskets"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+)
+
+type BasketsHandler struct {
+	svc *baskets.Service
+}
+
+func NewBasketsHandler(s *baskets.Service) *BasketsHandler {
+	return &BasketsHandler{svc: s}
+}
+
+// basketRequest is a basket of legs, each taking the fields of POST /orders.
+type basketRequest struct {
+	Name string `json:"name"`
+	Legs []struct {
+		Symbol      string   `json:"symbol" binding:"required"`
+		Side        string   `json:"side" binding:"required"`
+		Type        string   `json:"type"`
+		Quantity    float64  `json:"quantity"`
+		Notional    float64  `json:"notional"`
+		Price       float64  `json:"price"`
+		DisplayQty  float64  `json:"display_qty"`
+		Validity    string   `json:"validity"`
+		Product     string   `json:"product"`
+		AfterMarket bool     `json:"after_market"`
+		LotMethod   string   `json:"lot_method"`
+		LotIDs      []string `json:"lot_ids"`
+		Short       bool     `json:"short"`
+	} `json:"legs" binding:"required,dive"`
+}
+
+func (r basketRequest) orders() []models.Order {
+	out := make([]models.Order, 0, len(r.Legs))
+	for _, l := range r.Legs {
+		out = append(out, models.Order{
+			Symbol:      l.Symbol,
+			Side:        l.Side,
+			Type:        l.Type,
+			Quantity:    l.Quantity,
+			Notional:    l.Notional,
+			Price:       l.Price,
+			DisplayQty:  l.DisplayQty,
+			Validity:    l.Validity,
+			Product:     l.Product,
+			AfterMarket: l.AfterMarket,
+			LotMethod:   l.LotMethod,
+			LotIDs:      l.LotIDs,
+			Short:       l.Short,
+		})
+	}
+	return out
+}
+
+// Place validates every leg and places them all, or none. A rejected
+// basket comes back with each leg's error and the funds it needs.
+func (h *BasketsHandler) Place(c *gin.Context) {
+	var req basketRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	b, check, err := h.svc.Place(c.Request.Context(), c.GetString("userID"), req.Name, req.orders())
+	if basketError(c, err, check) {
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"basket": b, "legs": check.Legs, "funds": check.Funds})
+}
+
+// Validate runs the basket checks without placing anything.
+func (h *BasketsHandler) Validate(c *gin.Context) {
+	var req basketRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	check, err := h.svc.Validate(c.Request.Context(), c.GetString("userID"), req.orders())
+	if basketError(c, err, check) {
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"valid": true, "legs": check.Legs, "funds": check.Funds})
+}
+
+// basketError writes the response for a failed basket and reports whether
+// there was one.
+func basketError(c *gin.Context, err error, check models.BasketCheck) bool {
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, baskets.ErrInvalid):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, baskets.ErrRejected):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error(), "legs": check.Legs, "funds": check.Funds})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+	return true
+}
+
+func (h *BasketsHandler) List(c *gin.Context) {
+	list, err := h.svc.List(c.Request.Context(), c.GetString("userID"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if list == nil {
+		list = []models.Basket{}
+	}
+	c.JSON(http.StatusOK, gin.H{"baskets": list})
+}
+
+func (h *BasketsHandler) Get(c *gin.Context) {
+	b, err := h.svc.Get(c.Request.Context(), c.GetString("userID"), c.Param("id"))
+	if errors.Is(err, repository.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "basket not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, b)
+}
+
+// Rebalance takes {"targets": [{"symbol", "weight"}], "execute": bool} and
+// returns the trades that reach the targets, placing them as a basket when
+// execute is set.
+func (h *BasketsHandler) Rebalance(c *gin.Context) {
+	var req struct {
+		Targets []models.RebalanceTarget `json:"targets" binding:"required"`
+		Execute bool                     `json:"execute"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	plan, err := h.svc.Rebalance(c.Request.Context(), c.GetString("userID"), req.Targets, req.Execute)
+	switch {
+	case errors.Is(err, baskets.ErrInvalid):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, baskets.ErrRejected):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error(), "plan": plan})
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	case req.Execute && plan.Basket != nil:
+		c.JSON(http.StatusCreated, plan)
+	default:
+		c.JSON(http.StatusOK, plan)
+	}
+}
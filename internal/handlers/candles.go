@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/candles"
+	"github.com/hahahamid/broker-backend/internal/repository"
+)
+
+type CandlesHandler struct {
+	repo repository.CandleRepo
+}
+
+func NewCandlesHandler(r repository.CandleRepo) *CandlesHandler {
+	return &CandlesHandler{repo: r}
+}
+
+// Get serves GET /candles/:symbol?interval=1m&from=...&to=...&page_size=&page_token=
+// with from/to in RFC 3339.
+func (h *CandlesHandler) Get(c *gin.Context) {
+	interval := c.DefaultQuery("interval", "1m")
+	if _, err := candles.ParseInterval(interval); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	from, to := time.Unix(0, 0).UTC(), time.Now().UTC()
+	for name, dst := range map[string]*time.Time{"from": &from, "to": &to} {
+		if v := c.Query(name); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid " + name})
+				return
+			}
+			*dst = t
+		}
+	}
+	after, err := candles.ParsePageToken(c.Query("page_token"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	n, _ := strconv.Atoi(c.Query("page_size"))
+	size := candles.PageSize(n)
+
+	list, err := h.repo.ListCandles(c.Request.Context(), c.Param("symbol"), interval, from, to, after, size)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	next := ""
+	if len(list) == size {
+		next = candles.PageToken(list[len(list)-1])
+	}
+	c.JSON(http.StatusOK, gin.H{"candles": list, "next_page_token": next})
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/cash"
+	"github.com/hahahamid/broker-backend/internal/fx"
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+type CashHandler struct {
+	svc *cash.Service
+	fx  *fx.Converter
+}
+
+func NewCashHandler(s *cash.Service, conv *fx.Converter) *CashHandler {
+	return &CashHandler{svc: s, fx: conv}
+}
+
+func (h *CashHandler) Get(c *gin.Context) {
+	uid := c.GetString("userID")
+	bal, err := h.svc.Balances(c.Request.Context(), uid)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	total, unconverted, err := h.svc.BaseBalance(c.Request.Context(), uid)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	list, err := h.svc.Entries(c.Request.Context(), uid)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if list == nil {
+		list = []models.CashEntry{}
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"balance":       total,
+		"balances":      bal,
+		"base_currency": h.fx.Base(),
+		"unconverted":   unconverted,
+		"entries":       list,
+	})
+}
+
+func (h *CashHandler) Deposit(c *gin.Context) {
+	h.move(c, h.svc.Deposit)
+}
+
+func (h *CashHandler) Withdraw(c *gin.Context) {
+	h.move(c, h.svc.Withdraw)
+}
+
+func (h *CashHandler) move(c *gin.Context, fn func(context.Context, string, string, float64, string) (models.CashEntry, error)) {
+	var req struct {
+		Amount   float64 `json:"amount" binding:"required"`
+		Currency string  `json:"currency"`
+		Note     string  `json:"note"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	e, err := fn(c.Request.Context(), c.GetString("userID"), req.Currency, req.Amount, req.Note)
+	switch {
+	case errors.Is(err, cash.ErrInvalid), errors.Is(err, cash.ErrInsufficientFunds):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusCreated, e)
+	}
+}
+
+// Rates lists the FX rates against the base currency.
+func (h *CashHandler) Rates(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"base_currency": h.fx.Base(),
+		"as_of":         h.fx.AsOf(),
+		"rates":         h.fx.Rates(),
+	})
+}
+
+// Convert moves cash between currency sub-ledgers.
+func (h *CashHandler) Convert(c *gin.Context) {
+	var req struct {
+		From   string  `json:"from" binding:"required"`
+		To     string  `json:"to" binding:"required"`
+		Amount float64 `json:"amount" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	conv, err := h.svc.Convert(c.Request.Context(), c.GetString("userID"), req.From, req.To, req.Amount)
+	switch {
+	case errors.Is(err, cash.ErrInvalid), errors.Is(err, cash.ErrInsufficientFunds):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusCreated, conv)
+	}
+}
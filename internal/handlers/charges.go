@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/charges"
+	"github.com/hahahamid/broker-backend/internal/lots"
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+type ChargesHandler struct {
+	svc *charges.Service
+}
+
+func NewChargesHandler(s *charges.Service) *ChargesHandler {
+	return &ChargesHandler{svc: s}
+}
+
+// Estimate prices a prospective order before it is placed.
+func (h *ChargesHandler) Estimate(c *gin.Context) {
+	var req struct {
+		Symbol   string  `json:"symbol" binding:"required"`
+		Side     string  `json:"side" binding:"required"`
+		Quantity float64 `json:"quantity" binding:"required"`
+		Price    float64 `json:"price"`
+		Product  string  `json:"product"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	e, err := h.svc.Estimate(c.Request.Context(), req.Symbol, req.Side, req.Product, req.Quantity, req.Price)
+	if errors.Is(err, charges.ErrInvalid) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, e)
+}
+
+// netCard is the user's PnL card with charges deducted. It writes the
+// error response itself and reports whether the caller may continue.
+func netCard(c *gin.Context, l *lots.Service, ch *charges.Service, uid string) (models.PNLCard, bool) {
+	card := l.Card(uid)
+	if err := ch.AttachCharges(c.Request.Context(), uid, &card); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return card, false
+	}
+	return card, true
+}
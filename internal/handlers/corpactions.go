@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/corpactions"
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+type CorporateActionsHandler struct {
+	svc *corpactions.Service
+}
+
+func NewCorporateActionsHandler(s *corpactions.Service) *CorporateActionsHandler {
+	return &CorporateActionsHandler{svc: s}
+}
+
+func (h *CorporateActionsHandler) List(c *gin.Context) {
+	list, err := h.svc.List(c.Request.Context(), strings.ToUpper(c.Query("symbol")))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"actions": list})
+}
+
+// Create ingests {"actions": [...]}; dates are YYYY-MM-DD, midnight at
+// the symbol's exchange, or RFC 3339.
+func (h *CorporateActionsHandler) Create(c *gin.Context) {
+	var req struct {
+		Actions []struct {
+			Symbol     string  `json:"symbol"`
+			Type       string  `json:"type"`
+			ExDate     string  `json:"ex_date"`
+			RecordDate string  `json:"record_date"`
+			RatioNew   float64 `json:"ratio_new"`
+			RatioOld   float64 `json:"ratio_old"`
+			Amount     float64 `json:"amount"`
+			NewSymbol  string  `json:"new_symbol"`
+		} `json:"actions" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	list := make([]models.CorporateAction, 0, len(req.Actions))
+	for i, a := range req.Actions {
+		action := models.CorporateAction{
+			Symbol:    a.Symbol,
+			Type:      a.Type,
+			RatioNew:  a.RatioNew,
+			RatioOld:  a.RatioOld,
+			Amount:    a.Amount,
+			NewSymbol: a.NewSymbol,
+		}
+		var err error
+		if a.ExDate != "" {
+			if action.ExDate, err = corpactions.ParseDate(a.ExDate, h.svc.Location(c.Request.Context(), a.Symbol)); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("actions[%d].ex_date: %v", i, err)})
+				return
+			}
+		}
+		if a.RecordDate != "" {
+			if action.RecordDate, err = corpactions.ParseDate(a.RecordDate, h.svc.Location(c.Request.Context(), a.Symbol)); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("actions[%d].record_date: %v", i, err)})
+				return
+			}
+		}
+		list = append(list, action)
+	}
+	list, err := h.svc.Ingest(c.Request.Context(), list)
+	if errors.Is(err, corpactions.ErrInvalid) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"actions": list})
+}
+
+func (h *CorporateActionsHandler) Adjustments(c *gin.Context) {
+	list, err := h.svc.Adjustments(c.Request.Context(), c.GetString("userID"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if list == nil {
+		list = []models.Adjustment{}
+	}
+	c.JSON(http.StatusOK, gin.H{"adjustments": list})
+}
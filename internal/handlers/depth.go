@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/matching"
+	"github.com/hahahamid/broker-backend/internal/repository"
+)
+
+type DepthHandler struct {
+	engine    *matching.Engine
+	repo      repository.InstrumentRepo
+	maxLevels int
+}
+
+func NewDepthHandler(e *matching.Engine, r repository.InstrumentRepo, maxLevels int) *DepthHandler {
+	return &DepthHandler{engine: e, repo: r, maxLevels: maxLevels}
+}
+
+func (h *DepthHandler) Get(c *gin.Context) {
+	n, _ := strconv.Atoi(c.Query("levels"))
+	if n <= 0 || n > h.maxLevels {
+		n = h.maxLevels
+	}
+	symbol := strings.ToUpper(c.Param("symbol"))
+	_, err := h.repo.GetInstrument(c.Request.Context(), symbol)
+	if errors.Is(err, repository.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "instrument not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, h.engine.Depth(symbol, n))
+}
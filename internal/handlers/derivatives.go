@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/derivatives"
+)
+
+type DerivativesHandler struct {
+	svc *derivatives.Service
+}
+
+func NewDerivativesHandler(s *derivatives.Service) *DerivativesHandler {
+	return &DerivativesHandler{svc: s}
+}
+
+// Chain lists an underlying's options for ?expiry=YYYY-MM-DD, by default
+// the nearest, with implied volatility and greeks.
+func (h *DerivativesHandler) Chain(c *gin.Context) {
+	chain, err := h.svc.Chain(c.Request.Context(), c.Param("underlying"), c.Query("expiry"))
+	switch {
+	case errors.Is(err, derivatives.ErrInvalid):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, derivatives.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusOK, chain)
+	}
+}
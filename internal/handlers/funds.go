@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/cash"
+	"github.com/hahahamid/broker-backend/internal/funds"
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+type FundsHandler struct {
+	svc *funds.Service
+}
+
+func NewFundsHandler(s *funds.Service) *FundsHandler {
+	return &FundsHandler{svc: s}
+}
+
+// List returns the fund catalogue with each fund's latest NAV.
+func (h *FundsHandler) List(c *gin.Context) {
+	list, err := h.svc.Funds(c.Request.Context())
+	if fundError(c, err) {
+		return
+	}
+	if list == nil {
+		list = []models.Fund{}
+	}
+	c.JSON(http.StatusOK, gin.H{"funds": list})
+}
+
+// Get returns a fund with its recent NAVs, newest first.
+func (h *FundsHandler) Get(c *gin.Context) {
+	f, navs, err := h.svc.Fund(c.Request.Context(), c.Param("symbol"))
+	if fundError(c, err) {
+		return
+	}
+	if navs == nil {
+		navs = []models.NAV{}
+	}
+	c.JSON(http.StatusOK, gin.H{"fund": f, "navs": navs})
+}
+
+// Place takes a purchase of amount or a redemption of units, processed at
+// the NAV of the returned trade date.
+func (h *FundsHandler) Place(c *gin.Context) {
+	var req struct {
+		Symbol string  `json:"symbol" binding:"required"`
+		Side   string  `json:"side" binding:"required"` // purchase or redeem
+		Amount float64 `json:"amount"`
+		Units  float64 `json:"units"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	o, err := h.svc.Place(c.Request.Context(), c.GetString("userID"), models.FundOrder{
+		Symbol: req.Symbol,
+		Side:   req.Side,
+		Amount: req.Amount,
+		Units:  req.Units,
+	})
+	if fundError(c, err) {
+		return
+	}
+	c.JSON(http.StatusCreated, o)
+}
+
+func (h *FundsHandler) Orders(c *gin.Context) {
+	list, err := h.svc.Orders(c.Request.Context(), c.GetString("userID"))
+	if fundError(c, err) {
+		return
+	}
+	if list == nil {
+		list = []models.FundOrder{}
+	}
+	c.JSON(http.StatusOK, gin.H{"orders": list})
+}
+
+func (h *FundsHandler) Cancel(c *gin.Context) {
+	o, err := h.svc.Cancel(c.Request.Context(), c.GetString("userID"), c.Param("id"))
+	if fundError(c, err) {
+		return
+	}
+	c.JSON(http.StatusOK, o)
+}
+
+// CreateSIP starts a monthly purchase of amount on day, 1 to 28.
+func (h *FundsHandler) CreateSIP(c *gin.Context) {
+	var req struct {
+		Symbol string  `json:"symbol" binding:"required"`
+		Amount float64 `json:"amount" binding:"required"`
+		Day    int     `json:"day" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	sip, err := h.svc.CreateSIP(c.Request.Context(), c.GetString("userID"), models.SIP{
+		Symbol: req.Symbol,
+		Amount: req.Amount,
+		Day:    req.Day,
+	})
+	if fundError(c, err) {
+		return
+	}
+	c.JSON(http.StatusCreated, sip)
+}
+
+func (h *FundsHandler) SIPs(c *gin.Context) {
+	list, err := h.svc.SIPs(c.Request.Context(), c.GetString("userID"))
+	if fundError(c, err) {
+		return
+	}
+	if list == nil {
+		list = []models.SIP{}
+	}
+	c.JSON(http.StatusOK, gin.H{"sips": list})
+}
+
+func (h *FundsHandler) PauseSIP(c *gin.Context) {
+	h.updateSIP(c, h.svc.PauseSIP)
+}
+
+func (h *FundsHandler) ResumeSIP(c *gin.Context) {
+	h.updateSIP(c, h.svc.ResumeSIP)
+}
+
+// SkipSIP moves the next installment on by a month.
+func (h *FundsHandler) SkipSIP(c *gin.Context) {
+	h.updateSIP(c, h.svc.SkipSIP)
+}
+
+func (h *FundsHandler) CancelSIP(c *gin.Context) {
+	h.updateSIP(c, h.svc.CancelSIP)
+}
+
+func (h *FundsHandler) updateSIP(c *gin.Context, fn func(ctx context.Context, userID, id string) (models.SIP, error)) {
+	sip, err := fn(c.Request.Context(), c.GetString("userID"), c.Param("id"))
+	if fundError(c, err) {
+		return
+	}
+	c.JSON(http.StatusOK, sip)
+}
+
+// PostNAVs stores {"navs": [{"symbol", "date", "nav"}]} and allots the
+// pending orders they price.
+func (h *FundsHandler) PostNAVs(c *gin.Context) {
+	var req struct {
+		NAVs []models.NAV `json:"navs" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	n, err := h.svc.PostNAVs(c.Request.Context(), req.NAVs)
+	if fundError(c, err) {
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"stored": n})
+}
+
+// fundError writes the response for a failed fund request and reports
+// whether there was one.
+func fundError(c *gin.Context, err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, funds.ErrInvalid), errors.Is(err, cash.ErrInsufficientFunds):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, funds.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, funds.ErrState):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+	return true
+}
@@ -4,19 +4,22 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/lots"
 	"github.com/hahahamid/broker-backend/internal/models"
 )
 
-type HoldingsHandler struct{}
+type HoldingsHandler struct {
+	lots *lots.Service
+}
 
-func NewHoldingsHandler() *HoldingsHandler {
-	return &HoldingsHandler{}
+func NewHoldingsHandler(l *lots.Service) *HoldingsHandler {
+	return &HoldingsHandler{lots: l}
 }
 
 func (h *HoldingsHandler) Get(c *gin.Context) {
-	data := []models.Holding{
-		{Symbol: "AAPL", Quantity: 10, AvgPrice: 150},
-		{Symbol: "GOOGL", Quantity: 5, AvgPrice: 2500},
+	data := h.lots.Holdings(c.GetString("userID"))
+	if data == nil {
+		data = []models.Holding{}
 	}
 	c.JSON(http.StatusOK, data)
 }
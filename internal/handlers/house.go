@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/fractional"
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+type HouseHandler struct {
+	svc *fractional.Service
+}
+
+func NewHouseHandler(s *fractional.Service) *HouseHandler {
+	return &HouseHandler{svc: s}
+}
+
+// Get shows the house account's inventory and the fractional orders
+// waiting for the next batch.
+func (h *HouseHandler) Get(c *gin.Context) {
+	holdings := h.svc.Holdings()
+	if holdings == nil {
+		holdings = []models.Holding{}
+	}
+	pending := h.svc.Pending()
+	if pending == nil {
+		pending = []models.Order{}
+	}
+	c.JSON(http.StatusOK, gin.H{"account_id": h.svc.Account(), "holdings": holdings, "pending": pending})
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/repository"
+)
+
+type InstrumentsHandler struct {
+	repo repository.InstrumentRepo
+}
+
+func NewInstrumentsHandler(r repository.InstrumentRepo) *InstrumentsHandler {
+	return &InstrumentsHandler{repo: r}
+}
+
+func (h *InstrumentsHandler) List(c *gin.Context) {
+	list, err := h.repo.ListInstruments(c.Request.Context(), c.Query("exchange"), c.Query("asset_class"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"instruments": list})
+}
+
+func (h *InstrumentsHandler) Get(c *gin.Context) {
+	inst, err := h.repo.GetInstrument(c.Request.Context(), c.Param("symbol"))
+	if errors.Is(err, repository.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "instrument not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, inst)
+}
+
+func (h *InstrumentsHandler) Search(c *gin.Context) {
+	q := c.Query("query")
+	if q == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "query is required"})
+		return
+	}
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	list, err := h.repo.SearchInstruments(c.Request.Context(), q, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"instruments": list})
+}
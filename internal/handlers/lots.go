@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/charges"
+	"github.com/hahahamid/broker-backend/internal/lots"
+)
+
+type LotsHandler struct {
+	lots    *lots.Service
+	charges *charges.Service
+}
+
+func NewLotsHandler(l *lots.Service, ch *charges.Service) *LotsHandler {
+	return &LotsHandler{lots: l, charges: ch}
+}
+
+// List returns the caller's open lots, optionally for one ?symbol=. Their
+// IDs are what a specific-lot sell names in lot_ids.
+func (h *LotsHandler) List(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"lots": h.lots.Lots(c.GetString("userID"), strings.ToUpper(c.Query("symbol")))})
+}
+
+func (h *LotsHandler) Closed(c *gin.Context) {
+	uid := c.GetString("userID")
+	card, ok := netCard(c, h.lots, h.charges, uid)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"closed_lots": h.lots.Realized(uid),
+		"card":        card,
+	})
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/calendar"
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+type MarketHandler struct {
+	cal *calendar.Calendar
+}
+
+func NewMarketHandler(cal *calendar.Calendar) *MarketHandler {
+	return &MarketHandler{cal: cal}
+}
+
+// Status reports the current session for ?exchange=, or every configured
+// exchange when it is omitted.
+func (h *MarketHandler) Status(c *gin.Context) {
+	names := []string{strings.ToUpper(c.Query("exchange"))}
+	if names[0] == "" {
+		names = h.cal.Exchanges()
+		sort.Strings(names)
+	}
+	now := time.Now()
+	out := make([]models.MarketStatus, 0, len(names))
+	for _, name := range names {
+		out = append(out, h.cal.Status(name, now))
+	}
+	c.JSON(http.StatusOK, out)
+}
@@ -4,22 +4,35 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/charges"
+	"github.com/hahahamid/broker-backend/internal/lots"
+	"github.com/hahahamid/broker-backend/internal/repository"
 )
 
-type OrderbookHandler struct{}
+type OrderbookHandler struct {
+	repo    repository.OrderRepo
+	lots    *lots.Service
+	charges *charges.Service
+}
 
-func NewOrderbookHandler() *OrderbookHandler {
-	return &OrderbookHandler{}
+func NewOrderbookHandler(repo repository.OrderRepo, l *lots.Service, ch *charges.Service) *OrderbookHandler {
+	return &OrderbookHandler{repo: repo, lots: l, charges: ch}
 }
 
 func (h *OrderbookHandler) Get(c *gin.Context) {
-	data := []models.Order{
-		{ID: "1", Symbol: "AAPL", Side: "buy", Quantity: 10, Price: 150, RealizedPNL: 0, UnrealizedPNL: 20},
-		{ID: "2", Symbol: "TSLA", Side: "sell", Quantity: 2, Price: 700, RealizedPNL: 50, UnrealizedPNL: 0},
+	uid := c.GetString("userID")
+	data, err := h.repo.ListOrders(c.Request.Context(), uid)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	h.lots.AttachPNL(uid, data)
+	card, ok := netCard(c, h.lots, h.charges, uid)
+	if !ok {
+		return
 	}
 	c.JSON(http.StatusOK, gin.H{
 		"orders": data,
-		"card":   gin.H{"realized_pnl": 50, "unrealized_pnl": 20},
+		"card":   card,
 	})
 }
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/matching"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/orders"
+)
+
+type OrdersHandler struct {
+	orders *orders.Service
+}
+
+func NewOrdersHandler(o *orders.Service) *OrdersHandler {
+	return &OrdersHandler{orders: o}
+}
+
+func (h *OrdersHandler) Place(c *gin.Context) {
+	var req struct {
+		Symbol      string   `json:"symbol" binding:"required"`
+		Side        string   `json:"side" binding:"required"`
+		Type        string   `json:"type"`
+		Quantity    float64  `json:"quantity"`
+		Notional    float64  `json:"notional"`
+		Price       float64  `json:"price"`
+		DisplayQty  float64  `json:"display_qty"`
+		Validity    string   `json:"validity"`
+		Product     string   `json:"product"`
+		AfterMarket bool     `json:"after_market"`
+		LotMethod   string   `json:"lot_method"`
+		LotIDs      []string `json:"lot_ids"`
+		Short       bool     `json:"short"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	o, err := h.orders.Place(c.Request.Context(), c.GetString("userID"), models.Order{
+		Symbol:      req.Symbol,
+		Side:        req.Side,
+		Type:        req.Type,
+		Quantity:    req.Quantity,
+		Notional:    req.Notional,
+		Price:       req.Price,
+		DisplayQty:  req.DisplayQty,
+		Validity:    req.Validity,
+		Product:     req.Product,
+		AfterMarket: req.AfterMarket,
+		LotMethod:   req.LotMethod,
+		LotIDs:      req.LotIDs,
+		Short:       req.Short,
+	})
+	if errors.Is(err, orders.ErrRejected) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, o)
+}
+
+func (h *OrdersHandler) Cancel(c *gin.Context) {
+	o, err := h.orders.Cancel(c.Request.Context(), c.GetString("userID"), c.Param("id"))
+	if errors.Is(err, matching.ErrOrderNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "order not found or no longer open"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, o)
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/portfolio"
+)
+
+type PortfolioHandler struct {
+	svc *portfolio.Service
+}
+
+func NewPortfolioHandler(s *portfolio.Service) *PortfolioHandler {
+	return &PortfolioHandler{svc: s}
+}
+
+// History returns end-of-day snapshots within ?from= and ?to= (YYYY-MM-DD).
+func (h *PortfolioHandler) History(c *gin.Context) {
+	from, to, ok := dateRange(c)
+	if !ok {
+		return
+	}
+	list, err := h.svc.History(c.Request.Context(), c.GetString("userID"), from, to)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if list == nil {
+		list = []models.PortfolioSnapshot{}
+	}
+	c.JSON(http.StatusOK, gin.H{"snapshots": list})
+}
+
+func (h *PortfolioHandler) Performance(c *gin.Context) {
+	from, to, ok := dateRange(c)
+	if !ok {
+		return
+	}
+	p, err := h.svc.Performance(c.Request.Context(), c.GetString("userID"), from, to)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, p)
+}
+
+func dateRange(c *gin.Context) (string, string, bool) {
+	from, to := c.Query("from"), c.Query("to")
+	for _, d := range []string{from, to} {
+		if d == "" {
+			continue
+		}
+		if _, err := time.Parse(time.DateOnly, d); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "dates must be YYYY-MM-DD"})
+			return "", "", false
+		}
+	}
+	return from, to, true
+}
@@ -4,19 +4,27 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/charges"
+	"github.com/hahahamid/broker-backend/internal/lots"
 )
 
-type PositionsHandler struct{}
+type PositionsHandler struct {
+	lots    *lots.Service
+	charges *charges.Service
+}
 
-func NewPositionsHandler() *PositionsHandler {
-	return &PositionsHandler{}
+func NewPositionsHandler(l *lots.Service, ch *charges.Service) *PositionsHandler {
+	return &PositionsHandler{lots: l, charges: ch}
 }
 
 func (h *PositionsHandler) Get(c *gin.Context) {
-	data := []models.Position{
-		{Symbol: "AAPL", Quantity: 10, AvgPrice: 150, PNL: 20},
-		{Symbol: "TSLA", Quantity: 2, AvgPrice: 700, PNL: 50},
+	uid := c.GetString("userID")
+	card, ok := netCard(c, h.lots, h.charges, uid)
+	if !ok {
+		return
 	}
-	c.JSON(http.StatusOK, gin.H{"positions": data})
+	c.JSON(http.StatusOK, gin.H{
+		"positions": h.lots.Positions(uid),
+		"card":      card,
+	})
 }
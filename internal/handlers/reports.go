@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/reports"
+)
+
+type ReportsHandler struct {
+	svc *reports.Service
+}
+
+func NewReportsHandler(s *reports.Service) *ReportsHandler {
+	return &ReportsHandler{svc: s}
+}
+
+// ContractNote serves GET /reports/contract-notes/:date.
+func (h *ReportsHandler) ContractNote(c *gin.Context) {
+	h.download(c, reports.Request{Kind: reports.KindContractNote, Date: c.Param("date")})
+}
+
+// Statement serves GET /reports/statements/:month.
+func (h *ReportsHandler) Statement(c *gin.Context) {
+	h.download(c, reports.Request{Kind: reports.KindStatement, Month: c.Param("month")})
+}
+
+// Tradebook serves GET /reports/tradebook?from=&to=.
+func (h *ReportsHandler) Tradebook(c *gin.Context) {
+	h.download(c, reports.Request{Kind: reports.KindTradebook, From: c.Query("from"), To: c.Query("to")})
+}
+
+// CapitalGains serves GET /reports/capital-gains?fy=&format=, as JSON by
+// default or as a csv/pdf download.
+func (h *ReportsHandler) CapitalGains(c *gin.Context) {
+	var year int
+	if fy := c.Query("fy"); fy != "" {
+		n, err := strconv.Atoi(fy)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "fy must be the year the financial year starts in"})
+			return
+		}
+		year = n
+	}
+	if format := c.DefaultQuery("format", "json"); format != "json" {
+		h.download(c, reports.Request{Kind: reports.KindCapitalGains, Year: year})
+		return
+	}
+	g, err := h.svc.CapitalGains(c.Request.Context(), c.GetString("userID"), year)
+	if errors.Is(err, reports.ErrInvalid) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, g)
+}
+
+func (h *ReportsHandler) download(c *gin.Context, req reports.Request) {
+	req.Format = c.DefaultQuery("format", reports.FormatCSV)
+	f, err := h.svc.Generate(c.Request.Context(), c.GetString("userID"), req)
+	if errors.Is(err, reports.ErrInvalid) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.Header("Content-Disposition", `attachment; filename="`+f.Name+`"`)
+	c.Data(http.StatusOK, f.ContentType, f.Data)
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/settlement"
+)
+
+type SettlementHandler struct {
+	svc *settlement.Service
+}
+
+func NewSettlementHandler(s *settlement.Service) *SettlementHandler {
+	return &SettlementHandler{svc: s}
+}
+
+func (h *SettlementHandler) Runs(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	runs, err := h.svc.Runs(c.Request.Context(), limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if runs == nil {
+		runs = []models.SettlementRun{}
+	}
+	c.JSON(http.StatusOK, gin.H{"runs": runs})
+}
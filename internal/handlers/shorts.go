@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+	"github.com/hahahamid/broker-backend/internal/shorts"
+)
+
+type ShortsHandler struct {
+	svc *shorts.Service
+}
+
+func NewShortsHandler(s *shorts.Service) *ShortsHandler {
+	return &ShortsHandler{svc: s}
+}
+
+// Locates lists what can be borrowed per symbol.
+func (h *ShortsHandler) Locates(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"locates": h.svc.Locates()})
+}
+
+// Positions lists the user's open shorts and the borrow fees on them.
+func (h *ShortsHandler) Positions(c *gin.Context) {
+	list, err := h.svc.Positions(c.Request.Context(), c.GetString("userID"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if list == nil {
+		list = []models.ShortPosition{}
+	}
+	c.JSON(http.StatusOK, gin.H{"shorts": list})
+}
+
+// SetLocates replaces the locate list with {"locates": [...]}. Shorts the
+// new list no longer covers are bought in.
+func (h *ShortsHandler) SetLocates(c *gin.Context) {
+	var req struct {
+		Locates []models.Locate `json:"locates" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	err := h.svc.SetLocates(c.Request.Context(), req.Locates)
+	if errors.Is(err, shorts.ErrInvalid) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"locates": h.svc.Locates()})
+}
+
+// SetMargin turns margin on or off for a user with {"enabled": bool}.
+// Turning it off buys in the user's shorts.
+func (h *ShortsHandler) SetMargin(c *gin.Context) {
+	var req struct {
+		Enabled *bool `json:"enabled" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	err := h.svc.SetMargin(c.Request.Context(), c.Param("id"), *req.Enabled)
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusOK, gin.H{"user_id": c.Param("id"), "margin": *req.Enabled})
+	}
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/strategy"
+)
+
+type StrategiesHandler struct {
+	svc *strategy.Service
+}
+
+func NewStrategiesHandler(s *strategy.Service) *StrategiesHandler {
+	return &StrategiesHandler{svc: s}
+}
+
+// Types lists the strategies that can be started.
+func (h *StrategiesHandler) Types(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"strategies": strategy.Names()})
+}
+
+// Start runs a registered strategy on the caller's account.
+func (h *StrategiesHandler) Start(c *gin.Context) {
+	var req struct {
+		Name         string                `json:"name" binding:"required"`
+		Params       map[string]string     `json:"params"`
+		Symbols      []string              `json:"symbols" binding:"required"`
+		Limits       models.StrategyLimits `json:"limits"`
+		TimerSeconds int                   `json:"timer_seconds"`
+		Seed         int64                 `json:"seed"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	st, err := h.svc.Start(c.Request.Context(), c.GetString("userID"), models.Strategy{
+		Name:         req.Name,
+		Params:       req.Params,
+		Symbols:      req.Symbols,
+		Limits:       req.Limits,
+		TimerSeconds: req.TimerSeconds,
+		Seed:         req.Seed,
+	})
+	if strategyError(c, err) {
+		return
+	}
+	c.JSON(http.StatusCreated, st)
+}
+
+func (h *StrategiesHandler) List(c *gin.Context) {
+	list, err := h.svc.List(c.Request.Context(), c.GetString("userID"))
+	if strategyError(c, err) {
+		return
+	}
+	if list == nil {
+		list = []models.Strategy{}
+	}
+	c.JSON(http.StatusOK, gin.H{"strategies": list})
+}
+
+// Get returns a strategy's status and attributed PnL.
+func (h *StrategiesHandler) Get(c *gin.Context) {
+	h.respond(c, h.svc.Get)
+}
+
+// Stop stops a strategy and cancels its working orders.
+func (h *StrategiesHandler) Stop(c *gin.Context) {
+	h.respond(c, h.svc.Stop)
+}
+
+// Kill is the kill switch for one strategy.
+func (h *StrategiesHandler) Kill(c *gin.Context) {
+	h.respond(c, h.svc.Kill)
+}
+
+func (h *StrategiesHandler) respond(c *gin.Context, fn func(ctx context.Context, userID, id string) (models.Strategy, error)) {
+	st, err := fn(c.Request.Context(), c.GetString("userID"), c.Param("id"))
+	if strategyError(c, err) {
+		return
+	}
+	c.JSON(http.StatusOK, st)
+}
+
+// KillAll is the kill switch for every user's strategies.
+func (h *StrategiesHandler) KillAll(c *gin.Context) {
+	n := h.svc.KillAll(c.Request.Context())
+	c.JSON(http.StatusOK, gin.H{"killed": n})
+}
+
+// strategyError writes the response for a failed strategy request and
+// reports whether there was one.
+func strategyError(c *gin.Context, err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, strategy.ErrInvalid), errors.Is(err, strategy.ErrUnknown):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, strategy.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, strategy.ErrState):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+	return true
+}
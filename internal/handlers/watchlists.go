@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+	"github.com/hahahamid/broker-backend/internal/watchlists"
+)
+
+type WatchlistsHandler struct {
+	svc *watchlists.Service
+}
+
+func NewWatchlistsHandler(s *watchlists.Service) *WatchlistsHandler {
+	return &WatchlistsHandler{svc: s}
+}
+
+func (h *WatchlistsHandler) List(c *gin.Context) {
+	list, err := h.svc.List(c.Request.Context(), c.GetString("userID"))
+	if err != nil {
+		watchlistError(c, err)
+		return
+	}
+	out := make([]gin.H, 0, len(list))
+	for i := range list {
+		out = append(out, h.view(&list[i]))
+	}
+	c.JSON(http.StatusOK, gin.H{"watchlists": out})
+}
+
+func (h *WatchlistsHandler) Get(c *gin.Context) {
+	h.respond(c, http.StatusOK)(h.svc.Get(c.Request.Context(), c.GetString("userID"), c.Param("id")))
+}
+
+func (h *WatchlistsHandler) Create(c *gin.Context) {
+	var req struct {
+		Name    string   `json:"name" binding:"required"`
+		Symbols []string `json:"symbols"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	h.respond(c, http.StatusCreated)(h.svc.Create(c.Request.Context(), c.GetString("userID"), req.Name, req.Symbols))
+}
+
+func (h *WatchlistsHandler) Rename(c *gin.Context) {
+	var req struct {
+		Name string `json:"name" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	h.respond(c, http.StatusOK)(h.svc.Rename(c.Request.Context(), c.GetString("userID"), c.Param("id"), req.Name))
+}
+
+func (h *WatchlistsHandler) AddSymbols(c *gin.Context) {
+	var req struct {
+		Symbols []string `json:"symbols" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	h.respond(c, http.StatusOK)(h.svc.Add(c.Request.Context(), c.GetString("userID"), c.Param("id"), req.Symbols))
+}
+
+func (h *WatchlistsHandler) Reorder(c *gin.Context) {
+	var req struct {
+		Symbols []string `json:"symbols" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	h.respond(c, http.StatusOK)(h.svc.Reorder(c.Request.Context(), c.GetString("userID"), c.Param("id"), req.Symbols))
+}
+
+func (h *WatchlistsHandler) RemoveSymbol(c *gin.Context) {
+	h.respond(c, http.StatusOK)(h.svc.Remove(c.Request.Context(), c.GetString("userID"), c.Param("id"), c.Param("symbol")))
+}
+
+func (h *WatchlistsHandler) Delete(c *gin.Context) {
+	if err := h.svc.Delete(c.Request.Context(), c.GetString("userID"), c.Param("id")); err != nil {
+		watchlistError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *WatchlistsHandler) respond(c *gin.Context, code int) func(*models.Watchlist, error) {
+	return func(w *models.Watchlist, err error) {
+		if err != nil {
+			watchlistError(c, err)
+			return
+		}
+		c.JSON(code, h.view(w))
+	}
+}
+
+func (h *WatchlistsHandler) view(w *models.Watchlist) gin.H {
+	return gin.H{
+		"id":         w.ID.Hex(),
+		"name":       w.Name,
+		"items":      h.svc.Items(w),
+		"created_at": w.CreatedAt,
+		"updated_at": w.UpdatedAt,
+	}
+}
+
+func watchlistError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "watchlist or symbol not found"})
+	case errors.Is(err, watchlists.ErrInvalid):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, watchlists.ErrLimit):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
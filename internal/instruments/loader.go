@@ -0,0 +1,217 @@
+package instruments
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+// LoadFile reads the instrument master from a .csv or .json file. CSV files
+// need a header row; columns are matched by name so their order is free.
+func LoadFile(path string) ([]models.Instrument, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		var list []models.Instrument
+		if err := json.NewDecoder(f).Decode(&list); err != nil {
+			return nil, err
+		}
+		return normalize(list)
+	case ".csv":
+		list, err := readCSV(f)
+		if err != nil {
+			return nil, err
+		}
+		return normalize(list)
+	default:
+		return nil, fmt.Errorf("unsupported instrument file %q", path)
+	}
+}
+
+func readCSV(r io.Reader) ([]models.Instrument, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	col := map[string]int{}
+	for i, h := range rows[0] {
+		col[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	if _, ok := col["symbol"]; !ok {
+		return nil, fmt.Errorf("instrument csv: missing symbol column")
+	}
+
+	var list []models.Instrument
+	for n, row := range rows[1:] {
+		str := func(name string) string {
+			if i, ok := col[name]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		num := func(name string) (float64, error) {
+			s := str(name)
+			if s == "" {
+				return 0, nil
+			}
+			v, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return 0, fmt.Errorf("instrument csv line %d: %s: %w", n+2, name, err)
+			}
+			return v, nil
+		}
+
+		inst := models.Instrument{
+			Symbol:     str("symbol"),
+			Name:       str("name"),
+			Exchange:   str("exchange"),
+			ISIN:       str("isin"),
+			AssetClass: str("asset_class"),
+			Currency:   strings.ToUpper(str("currency")),
+			Status:     str("status"),
+			Underlying: str("underlying"),
+			OptionType: str("option_type"),
+		}
+		if s := str("expiry"); s != "" {
+			if inst.Expiry, err = time.Parse(time.DateOnly, s); err != nil {
+				return nil, fmt.Errorf("instrument csv line %d: expiry: %w", n+2, err)
+			}
+		}
+		if s := str("fractional"); s != "" {
+			if inst.Fractional, err = strconv.ParseBool(s); err != nil {
+				return nil, fmt.Errorf("instrument csv line %d: fractional: %w", n+2, err)
+			}
+		}
+		for name, dst := range map[string]*float64{
+			"tick_size":  &inst.TickSize,
+			"lot_size":   &inst.LotSize,
+			"prev_close": &inst.PrevClose,
+			"lower_band": &inst.LowerBand,
+			"upper_band": &inst.UpperBand,
+
+			"min_increment": &inst.MinIncrement,
+			"strike":        &inst.Strike,
+			"multiplier":    &inst.Multiplier,
+		} {
+			if *dst, err = num(name); err != nil {
+				return nil, err
+			}
+		}
+		list = append(list, inst)
+	}
+	return list, nil
+}
+
+// normalize upper-cases symbols and fills in defaults so that a sparse file
+// still yields tradable instruments.
+func normalize(list []models.Instrument) ([]models.Instrument, error) {
+	seen := map[string]bool{}
+	for i := range list {
+		inst := &list[i]
+		inst.Symbol = strings.ToUpper(strings.TrimSpace(inst.Symbol))
+		if inst.Symbol == "" {
+			return nil, fmt.Errorf("instrument %d: empty symbol", i+1)
+		}
+		if seen[inst.Symbol] {
+			return nil, fmt.Errorf("instrument %s: duplicate symbol", inst.Symbol)
+		}
+		seen[inst.Symbol] = true
+
+		if inst.TickSize <= 0 {
+			inst.TickSize = 0.01
+		}
+		if inst.LotSize <= 0 {
+			inst.LotSize = 1
+		}
+		if inst.Fractional {
+			// The house account trades whole shares, so fractions are of one.
+			if inst.LotSize != 1 {
+				return nil, fmt.Errorf("instrument %s: fractional instruments need a lot size of 1", inst.Symbol)
+			}
+			if inst.MinIncrement <= 0 {
+				inst.MinIncrement = 0.0001
+			}
+			if inst.MinIncrement >= 1 {
+				return nil, fmt.Errorf("instrument %s: min increment must be below 1", inst.Symbol)
+			}
+		} else {
+			inst.MinIncrement = 0
+		}
+		if inst.AssetClass == "" {
+			inst.AssetClass = "equity"
+		}
+		if err := normalizeContract(inst); err != nil {
+			return nil, err
+		}
+		inst.Currency = strings.ToUpper(strings.TrimSpace(inst.Currency))
+		if inst.Currency == "" {
+			inst.Currency = "USD"
+		}
+		if inst.Status == "" {
+			inst.Status = models.InstrumentActive
+		}
+		if inst.UpperBand > 0 && inst.LowerBand > inst.UpperBand {
+			return nil, fmt.Errorf("instrument %s: lower band above upper band", inst.Symbol)
+		}
+	}
+	return list, nil
+}
+
+// normalizeContract checks a future's or option's contract terms and makes
+// its multiplier the lot size, so orders come in whole contracts. Other
+// instruments must not carry contract terms.
+func normalizeContract(inst *models.Instrument) error {
+	inst.AssetClass = strings.ToLower(inst.AssetClass)
+	inst.Underlying = strings.ToUpper(strings.TrimSpace(inst.Underlying))
+	inst.OptionType = strings.ToUpper(strings.TrimSpace(inst.OptionType))
+	if !inst.IsDerivative() {
+		if inst.Underlying != "" || !inst.Expiry.IsZero() || inst.Strike != 0 || inst.OptionType != "" || inst.Multiplier != 0 {
+			return fmt.Errorf("instrument %s: contract terms on a %s", inst.Symbol, inst.AssetClass)
+		}
+		return nil
+	}
+	switch {
+	case inst.Underlying == "":
+		return fmt.Errorf("instrument %s: underlying is required", inst.Symbol)
+	case inst.Expiry.IsZero():
+		return fmt.Errorf("instrument %s: expiry is required", inst.Symbol)
+	case inst.Fractional:
+		return fmt.Errorf("instrument %s: contracts cannot be fractional", inst.Symbol)
+	case inst.Multiplier < 0:
+		return fmt.Errorf("instrument %s: multiplier must be positive", inst.Symbol)
+	}
+	if inst.AssetClass == models.AssetClassOption {
+		if inst.Strike <= 0 {
+			return fmt.Errorf("instrument %s: strike must be positive", inst.Symbol)
+		}
+		if inst.OptionType != models.OptionCall && inst.OptionType != models.OptionPut {
+			return fmt.Errorf("instrument %s: option_type must be CE or PE", inst.Symbol)
+		}
+	} else if inst.Strike != 0 || inst.OptionType != "" {
+		return fmt.Errorf("instrument %s: futures have no strike or option type", inst.Symbol)
+	}
+	y, m, d := inst.Expiry.UTC().Date()
+	inst.Expiry = time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	if inst.Multiplier == 0 {
+		inst.Multiplier = inst.LotSize
+	}
+	inst.LotSize = inst.Multiplier
+	return nil
+}
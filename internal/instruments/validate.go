@@ -0,0 +1,70 @@
+package instruments
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+var ErrUnknownSymbol = errors.New("unknown symbol")
+
+// ValidateOrder checks an order's quantity and price against the instrument's
+// reference data. A zero price means a market order and skips the price checks.
+func ValidateOrder(inst *models.Instrument, quantity, price float64) error {
+	if inst == nil {
+		return ErrUnknownSymbol
+	}
+	if inst.Status != models.InstrumentActive {
+		return fmt.Errorf("%s is %s", inst.Symbol, inst.Status)
+	}
+	if quantity <= 0 {
+		return errors.New("quantity must be positive")
+	}
+	if !isMultiple(quantity, inst.LotSize) {
+		if !inst.Fractional {
+			return fmt.Errorf("quantity must be a multiple of lot size %g", inst.LotSize)
+		}
+		if !isMultiple(quantity, inst.MinIncrement) {
+			return fmt.Errorf("fractional quantity must be a multiple of %g", inst.MinIncrement)
+		}
+	}
+	if price == 0 {
+		return nil
+	}
+	if price < 0 {
+		return errors.New("price must not be negative")
+	}
+	if !isMultiple(price, inst.TickSize) {
+		return fmt.Errorf("price must be a multiple of tick size %g", inst.TickSize)
+	}
+	if inst.LowerBand > 0 && price < inst.LowerBand {
+		return fmt.Errorf("price below lower band %g", inst.LowerBand)
+	}
+	if inst.UpperBand > 0 && price > inst.UpperBand {
+		return fmt.Errorf("price above upper band %g", inst.UpperBand)
+	}
+	return nil
+}
+
+// IsFractional reports whether quantity is not a whole number of lots.
+func IsFractional(inst *models.Instrument, quantity float64) bool {
+	return !isMultiple(quantity, inst.LotSize)
+}
+
+// Increment is the smallest quantity step an order in inst may use.
+func Increment(inst *models.Instrument) float64 {
+	if inst.Fractional && inst.MinIncrement > 0 {
+		return inst.MinIncrement
+	}
+	return inst.LotSize
+}
+
+func isMultiple(v, step float64) bool {
+	if step <= 0 {
+		return true
+	}
+	n := v / step
+	return math.Abs(n-math.Round(n)) < 1e-6
+}
@@ -0,0 +1,287 @@
+package lots
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// eps absorbs float noise when comparing quantities.
+const eps = 1e-9
+
+// roundQty drops the float noise that fractional quantities pick up from
+// sums and corporate action ratios.
+func roundQty(q float64) float64 {
+	return math.Round(q*1e9) / 1e9
+}
+
+// Ledger holds one user's open lots per symbol, oldest first. A symbol's
+// lots are either all long or all short: buys cover shorts before opening
+// a long lot, and shorts are only opened with no long lots left.
+type Ledger struct {
+	longTermAfter time.Duration
+	open          map[string][]*models.Lot
+}
+
+func NewLedger(longTermAfter time.Duration) *Ledger {
+	return &Ledger{longTermAfter: longTermAfter, open: map[string][]*models.Lot{}}
+}
+
+// Term tags a holding period as short or long term.
+func (l *Ledger) Term(acquired, at time.Time) string {
+	if at.Sub(acquired) > l.longTermAfter {
+		return models.TermLong
+	}
+	return models.TermShort
+}
+
+// Add opens a lot, keeping the symbol's lots in acquisition order.
+func (l *Ledger) Add(lot models.Lot) {
+	list := l.open[lot.Symbol]
+	i := sort.Search(len(list), func(i int) bool { return list[i].AcquiredAt.After(lot.AcquiredAt) })
+	list = append(list, nil)
+	copy(list[i+1:], list[i:])
+	list[i] = &lot
+	l.open[lot.Symbol] = list
+}
+
+// Quantity is the open quantity of symbol, only counting settled lots when
+// settledOnly is set.
+func (l *Ledger) Quantity(symbol string, settledOnly bool) float64 {
+	var q float64
+	for _, lot := range l.open[symbol] {
+		if lot.Settled || !settledOnly {
+			q += lot.Quantity
+		}
+	}
+	return q
+}
+
+// Settle marks the exchange's lots due by through as settled and returns
+// them.
+func (l *Ledger) Settle(exchange string, through time.Time) []models.Lot {
+	return l.due(exchange, through, true)
+}
+
+// Due returns the exchange's unsettled lots due by through, as Settle
+// would, without settling them.
+func (l *Ledger) Due(exchange string, through time.Time) []models.Lot {
+	return l.due(exchange, through, false)
+}
+
+func (l *Ledger) due(exchange string, through time.Time, settle bool) []models.Lot {
+	var out []models.Lot
+	for _, sym := range l.Symbols() {
+		for _, lot := range l.open[sym] {
+			if !lot.Settled && lot.Exchange == exchange && !lot.SettleDate.After(through) {
+				lot.Settled = settle
+				out = append(out, *lot)
+			}
+		}
+	}
+	return out
+}
+
+// Lots returns copies of the open lots of symbol, or of every symbol when
+// symbol is empty, tagged with their current holding period.
+func (l *Ledger) Lots(symbol string, now time.Time) []models.Lot {
+	var out []models.Lot
+	for _, sym := range l.Symbols() {
+		if symbol != "" && sym != symbol {
+			continue
+		}
+		for _, lot := range l.open[sym] {
+			c := *lot
+			c.Term = l.Term(c.AcquiredAt, now)
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// Symbols lists the symbols with open lots, sorted.
+func (l *Ledger) Symbols() []string {
+	out := make([]string, 0, len(l.open))
+	for sym := range l.open {
+		out = append(out, sym)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Close matches a sell fill against open lots using method. For
+// LotSpecific the named lots are consumed in the given order and any
+// remainder falls back to FIFO. With settledOnly, unsettled lots are never
+// closed. It returns the closing records, the lots whose open quantity
+// changed and the quantity no lot could cover.
+func (l *Ledger) Close(f models.Fill, method string, lotIDs []string, settledOnly bool) ([]models.ClosedLot, []models.Lot, float64) {
+	list := l.open[f.Symbol]
+	var order []*models.Lot
+	switch method {
+	case models.LotLIFO:
+		for i := len(list) - 1; i >= 0; i-- {
+			order = append(order, list[i])
+		}
+	case models.LotSpecific:
+		byID := make(map[string]*models.Lot, len(list))
+		for _, lot := range list {
+			byID[lot.ID] = lot
+		}
+		seen := map[string]bool{}
+		for _, id := range lotIDs {
+			if lot, ok := byID[id]; ok && !seen[id] {
+				order = append(order, lot)
+				seen[id] = true
+			}
+		}
+		for _, lot := range list {
+			if !seen[lot.ID] {
+				order = append(order, lot)
+			}
+		}
+	default:
+		order = list
+	}
+
+	var closed []models.ClosedLot
+	var touched []models.Lot
+	remaining := f.Quantity
+	for _, lot := range order {
+		if remaining <= eps {
+			break
+		}
+		if lot.Quantity <= eps || (settledOnly && !lot.Settled) {
+			continue
+		}
+		qty := min(lot.Quantity, remaining)
+		lot.Quantity = roundQty(lot.Quantity - qty)
+		remaining -= qty
+		closed = append(closed, models.ClosedLot{
+			ID:          primitive.NewObjectID().Hex(),
+			UserID:      f.UserID,
+			Symbol:      f.Symbol,
+			Currency:    lot.Currency,
+			LotID:       lot.ID,
+			OrderID:     f.OrderID,
+			FillID:      f.ID,
+			Quantity:    qty,
+			CostPrice:   lot.Price,
+			SalePrice:   f.Price,
+			RealizedPNL: (f.Price - lot.Price) * qty,
+			AcquiredAt:  lot.AcquiredAt,
+			ClosedAt:    f.Time,
+			Term:        l.Term(lot.AcquiredAt, f.Time),
+		})
+		touched = append(touched, *lot)
+	}
+
+	l.prune(f.Symbol)
+	if remaining < eps {
+		remaining = 0
+	}
+	return closed, touched, remaining
+}
+
+// Cover closes short lots of a buy fill's symbol, oldest first. It returns
+// the closing records, the lots whose open quantity changed and the
+// quantity left over to open a long lot with.
+func (l *Ledger) Cover(f models.Fill) ([]models.ClosedLot, []models.Lot, float64) {
+	var closed []models.ClosedLot
+	var touched []models.Lot
+	remaining := f.Quantity
+	for _, lot := range l.open[f.Symbol] {
+		if remaining <= eps {
+			break
+		}
+		if lot.Quantity >= -eps {
+			continue
+		}
+		qty := min(-lot.Quantity, remaining)
+		lot.Quantity = roundQty(lot.Quantity + qty)
+		remaining -= qty
+		closed = append(closed, models.ClosedLot{
+			ID:          primitive.NewObjectID().Hex(),
+			UserID:      f.UserID,
+			Symbol:      f.Symbol,
+			Currency:    lot.Currency,
+			LotID:       lot.ID,
+			OrderID:     f.OrderID,
+			FillID:      f.ID,
+			Quantity:    qty,
+			CostPrice:   f.Price,
+			SalePrice:   lot.Price,
+			RealizedPNL: (lot.Price - f.Price) * qty,
+			AcquiredAt:  lot.AcquiredAt,
+			ClosedAt:    f.Time,
+			Term:        models.TermShort,
+			Short:       true,
+		})
+		touched = append(touched, *lot)
+	}
+	l.prune(f.Symbol)
+	if remaining < eps {
+		remaining = 0
+	}
+	return closed, touched, remaining
+}
+
+// prune drops the symbol's lots with nothing left open.
+func (l *Ledger) prune(symbol string) {
+	list := l.open[symbol]
+	kept := list[:0]
+	for _, lot := range list {
+		if math.Abs(lot.Quantity) > eps {
+			kept = append(kept, lot)
+		}
+	}
+	if len(kept) == 0 {
+		delete(l.open, symbol)
+	} else {
+		l.open[symbol] = kept
+	}
+}
+
+// Adjust applies a corporate action to the lots of a.Symbol acquired before
+// its ex-date, or to all of them for a symbol change, and returns those lots
+// as they were and as they are now. Dividends leave the lots unchanged.
+// Splits and bonuses are recorded on each lot, so lots already adjusted for
+// a are skipped if it is applied again.
+func (l *Ledger) Adjust(a models.CorporateAction) (before, after []models.Lot) {
+	list := l.open[a.Symbol]
+	var keep, moved []*models.Lot
+	for _, lot := range list {
+		if a.Type != models.ActionSymbolChange && (!lot.AcquiredAt.Before(a.ExDate) || slices.Contains(lot.Actions, a.ID)) {
+			keep = append(keep, lot)
+			continue
+		}
+		before = append(before, *lot)
+		switch a.Type {
+		case models.ActionSplit, models.ActionReverseSplit, models.ActionBonus:
+			lot.Actions = append(slices.Clip(lot.Actions), a.ID)
+			f := a.Factor()
+			lot.Quantity = roundQty(lot.Quantity * f)
+			lot.OrigQty = roundQty(lot.OrigQty * f)
+			lot.Price /= f
+		case models.ActionSymbolChange:
+			lot.Symbol = a.NewSymbol
+			moved = append(moved, lot)
+		}
+		after = append(after, *lot)
+		if a.Type != models.ActionSymbolChange {
+			keep = append(keep, lot)
+		}
+	}
+	if len(keep) == 0 {
+		delete(l.open, a.Symbol)
+	} else {
+		l.open[a.Symbol] = keep
+	}
+	for _, lot := range moved {
+		l.Add(*lot)
+	}
+	return before, after
+}
@@ -0,0 +1,814 @@
+package lots
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/calendar"
+	"github.com/hahahamid/broker-backend/internal/fx"
+	"github.com/hahahamid/broker-backend/internal/marketdata"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+	"github.com/hahahamid/broker-backend/internal/utils"
+)
+
+var (
+	// ErrInsufficient is returned when a sell exceeds the unreserved open
+	// quantity.
+	ErrInsufficient = errors.New("insufficient holdings")
+	ErrInvalid      = errors.New("invalid lot selection")
+)
+
+// reservation is the part of an open sell order not yet filled.
+type reservation struct {
+	userID string
+	symbol string
+	method string
+	lotIDs []string
+	qty    float64
+	short  bool // opens short lots rather than closing long ones
+}
+
+// Service keeps every user's lot ledger current from engine fills, reserves
+// holdings for working sell orders and derives holdings, positions and PnL
+// from the lots. Fills are applied in memory as they happen and persisted
+// by Run. Bought lots settle on the calendar's settlement date; exchanges
+// that require it only let settled lots be sold.
+type Service struct {
+	repo          repository.LotRepo
+	prices        *marketdata.PriceCache
+	cal           *calendar.Calendar
+	fx            *fx.Converter
+	method        string
+	longTermAfter time.Duration
+	events        *utils.Queue[interface{}]
+
+	mu       sync.Mutex
+	ledgers  map[string]*Ledger
+	closed   map[string][]models.ClosedLot // by user, oldest first
+	reserved map[string]*reservation       // by order ID
+
+	multipliers map[string]float64 // contract size of futures and options by symbol
+	funds       map[string]bool    // mutual fund symbols
+}
+
+// NewService uses method for sells that do not choose one and tags lots held
+// longer than longTermAfter as long term. Holdings and PnL cards are also
+// converted to conv's base currency.
+func NewService(repo repository.LotRepo, prices *marketdata.PriceCache, cal *calendar.Calendar, conv *fx.Converter, method string, longTermAfter time.Duration) *Service {
+	if method != models.LotLIFO {
+		method = models.LotFIFO
+	}
+	return &Service{
+		repo:          repo,
+		prices:        prices,
+		cal:           cal,
+		fx:            conv,
+		method:        method,
+		longTermAfter: longTermAfter,
+		events:        utils.NewQueue[interface{}](time.Second),
+		ledgers:       map[string]*Ledger{},
+		closed:        map[string][]models.ClosedLot{},
+		reserved:      map[string]*reservation{},
+		multipliers:   map[string]float64{},
+		funds:         map[string]bool{},
+	}
+}
+
+// SetMultiplier registers symbol as a contract of m units, so holdings and
+// positions in it also show whole contracts.
+func (s *Service) SetMultiplier(symbol string, m float64) {
+	s.mu.Lock()
+	s.multipliers[symbol] = m
+	s.mu.Unlock()
+}
+
+// SetFund registers symbol as a mutual fund, so holdings and positions in
+// it are flagged as fund units.
+func (s *Service) SetFund(symbol string) {
+	s.mu.Lock()
+	s.funds[symbol] = true
+	s.mu.Unlock()
+}
+
+func (s *Service) isFund(symbol string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.funds[symbol]
+}
+
+// contracts is qty of symbol in contracts, with the multiplier, or zeros
+// when symbol is not a contract.
+func (s *Service) contracts(symbol string, qty float64) (multiplier, n float64) {
+	s.mu.Lock()
+	m := s.multipliers[symbol]
+	s.mu.Unlock()
+	if m <= 0 {
+		return 0, 0
+	}
+	return m, roundQty(qty / m)
+}
+
+// Load rebuilds the ledgers from the stored open and closed lots.
+func (s *Service) Load(ctx context.Context) error {
+	open, err := s.repo.ListOpenLots(ctx)
+	if err != nil {
+		return err
+	}
+	closed, err := s.repo.ListClosedLots(ctx, "")
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, lot := range open {
+		if lot.SettleDate.IsZero() {
+			lot.Settled = true // stored before settlement was tracked
+		}
+		s.ledger(lot.UserID).Add(lot)
+	}
+	for _, c := range closed {
+		s.closed[c.UserID] = append(s.closed[c.UserID], c)
+	}
+	return nil
+}
+
+func (s *Service) ledger(userID string) *Ledger {
+	l, ok := s.ledgers[userID]
+	if !ok {
+		l = NewLedger(s.longTermAfter)
+		s.ledgers[userID] = l
+	}
+	return l
+}
+
+// Reserve sets aside holdings for a sell order, filling in its lot method.
+// Specific lots must be open lots of the symbol that cover the quantity.
+func (s *Service) Reserve(o *models.Order) error {
+	if o.Side != "sell" {
+		o.LotMethod, o.LotIDs = "", nil
+		return nil
+	}
+	if o.Short {
+		// Borrow availability is up to the caller.
+		o.LotMethod, o.LotIDs = "", nil
+		s.Track(*o)
+		return nil
+	}
+	switch o.LotMethod {
+	case "":
+		o.LotMethod = s.method
+	case models.LotFIFO, models.LotLIFO, models.LotSpecific:
+	default:
+		return fmt.Errorf("%w: lot method must be fifo, lifo or specific", ErrInvalid)
+	}
+	if o.LotMethod != models.LotSpecific {
+		o.LotIDs = nil
+	}
+
+	settledOnly := s.cal.BlocksUnsettledSells(o.Exchange)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l := s.ledger(o.UserID)
+	if o.LotMethod == models.LotSpecific {
+		if len(o.LotIDs) == 0 {
+			return fmt.Errorf("%w: specific lot selection needs lot_ids", ErrInvalid)
+		}
+		open := map[string]float64{}
+		for _, lot := range l.open[o.Symbol] {
+			if lot.Settled || !settledOnly {
+				open[lot.ID] = lot.Quantity
+			}
+		}
+		var covered float64
+		for _, id := range o.LotIDs {
+			q, ok := open[id]
+			if !ok {
+				return fmt.Errorf("%w: %s is not an open, sellable %s lot", ErrInvalid, id, o.Symbol)
+			}
+			covered += q
+			delete(open, id)
+		}
+		if covered+eps < o.Quantity {
+			return fmt.Errorf("%w: selected lots hold %g of %g", ErrInvalid, covered, o.Quantity)
+		}
+	}
+	if avail := l.Quantity(o.Symbol, settledOnly) - s.reservedQty(o.UserID, o.Symbol); avail+eps < o.Quantity {
+		if settledOnly {
+			return fmt.Errorf("%w: %g settled %s available to sell", ErrInsufficient, max(avail, 0), o.Symbol)
+		}
+		return fmt.Errorf("%w: %g %s available to sell", ErrInsufficient, max(avail, 0), o.Symbol)
+	}
+	s.track(*o)
+	return nil
+}
+
+// Track reserves the unfilled part of a restored sell order without
+// checking it against holdings.
+func (s *Service) Track(o models.Order) {
+	if o.Side != "sell" {
+		return
+	}
+	s.mu.Lock()
+	s.track(o)
+	s.mu.Unlock()
+}
+
+func (s *Service) track(o models.Order) {
+	s.reserved[o.ID] = &reservation{
+		userID: o.UserID,
+		symbol: o.Symbol,
+		method: o.LotMethod,
+		lotIDs: o.LotIDs,
+		qty:    o.Remaining(),
+		short:  o.Short,
+	}
+}
+
+// Release frees whatever an order still has reserved.
+func (s *Service) Release(orderID string) {
+	s.mu.Lock()
+	delete(s.reserved, orderID)
+	s.mu.Unlock()
+}
+
+// Available is the user's open quantity of symbol, only counting settled
+// lots when settledOnly is set, less what working sells have reserved.
+func (s *Service) Available(userID, symbol string, settledOnly bool) float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return max(s.ledger(userID).Quantity(symbol, settledOnly)-s.reservedQty(userID, symbol), 0)
+}
+
+func (s *Service) reservedQty(userID, symbol string) float64 {
+	var q float64
+	for _, r := range s.reserved {
+		if r.userID == userID && r.symbol == symbol && !r.short {
+			q += r.qty
+		}
+	}
+	return q
+}
+
+func (s *Service) OnOrder(o models.Order) {
+	switch o.Status {
+	case models.OrderFilled, models.OrderCancelled, models.OrderRejected, models.OrderExpired:
+		s.Release(o.ID)
+	}
+}
+
+func (s *Service) OnFill(f models.Fill) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l := s.ledger(f.UserID)
+	if f.Side == "buy" {
+		closed, touched, rest := l.Cover(f)
+		s.record(f.UserID, closed, touched)
+		if rest <= 0 {
+			return
+		}
+		lot := models.Lot{
+			ID:         f.ID,
+			UserID:     f.UserID,
+			Symbol:     f.Symbol,
+			Exchange:   f.Exchange,
+			Currency:   s.fx.Currency(f.Currency),
+			OrderID:    f.OrderID,
+			Quantity:   rest,
+			OrigQty:    rest,
+			Price:      f.Price,
+			AcquiredAt: f.Time,
+			SettleDate: s.cal.SettlementDate(f.Exchange, f.Time),
+		}
+		lot.Settled = !lot.SettleDate.After(f.Time)
+		l.Add(lot)
+		s.enqueue(lot)
+		return
+	}
+
+	method, ids := s.method, []string(nil)
+	settledOnly := s.cal.BlocksUnsettledSells(f.Exchange)
+	if r, ok := s.reserved[f.OrderID]; ok && r.short {
+		r.qty -= f.Quantity
+		lot := models.Lot{
+			ID:         f.ID,
+			UserID:     f.UserID,
+			Symbol:     f.Symbol,
+			Exchange:   f.Exchange,
+			Currency:   s.fx.Currency(f.Currency),
+			OrderID:    f.OrderID,
+			Quantity:   -f.Quantity,
+			OrigQty:    -f.Quantity,
+			Price:      f.Price,
+			AcquiredAt: f.Time,
+			Settled:    true, // nothing to deliver into holdings
+			Short:      true,
+		}
+		l.Add(lot)
+		s.enqueue(lot)
+		return
+	} else if ok {
+		method, ids = r.method, r.lotIDs
+		r.qty -= f.Quantity
+	} else if f.Allocation {
+		// Deliveries out of the house account are internal transfers and
+		// may hand on shares that have not settled yet.
+		settledOnly = false
+	}
+	closed, touched, uncovered := l.Close(f, method, ids, settledOnly)
+	if uncovered > 0 {
+		log.Printf("lots: sell fill %s left %g %s uncovered", f.ID, uncovered, f.Symbol)
+	}
+	s.record(f.UserID, closed, touched)
+}
+
+// Applied reports whether the user's ledger already holds the fill: a lot
+// it opened, or a lot it closed or covered.
+func (s *Service) Applied(userID, fillID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, lot := range s.ledger(userID).Lots("", time.Time{}) {
+		if lot.ID == fillID {
+			return true
+		}
+	}
+	for _, c := range s.closed[userID] {
+		if c.FillID == fillID || c.LotID == fillID {
+			return true
+		}
+	}
+	return false
+}
+
+// record keeps closing records and queues them and the lots they touched
+// for persistence.
+func (s *Service) record(userID string, closed []models.ClosedLot, touched []models.Lot) {
+	s.closed[userID] = append(s.closed[userID], closed...)
+	for _, lot := range touched {
+		s.enqueue(lot)
+	}
+	for _, c := range closed {
+		s.enqueue(c)
+	}
+}
+
+// ShortInterest is the quantity of symbol borrowed across all users:
+// open shorts plus the unfilled part of working short sells.
+func (s *Service) ShortInterest(symbol string) float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var q float64
+	for _, l := range s.ledgers {
+		for _, lot := range l.open[symbol] {
+			if lot.Quantity < 0 {
+				q -= lot.Quantity
+			}
+		}
+	}
+	for _, r := range s.reserved {
+		if r.short && r.symbol == symbol {
+			q += r.qty
+		}
+	}
+	return q
+}
+
+// Shorts returns every user's open short lots, oldest first.
+func (s *Service) Shorts() []models.Lot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []models.Lot
+	for _, l := range s.ledgers {
+		for _, list := range l.open {
+			for _, lot := range list {
+				if lot.Quantity < 0 {
+					out = append(out, *lot)
+				}
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].AcquiredAt.Before(out[j].AcquiredAt) })
+	return out
+}
+
+// enqueue queues a lot or closed lot for persistence. Nothing is dropped:
+// the stored lots are what holdings and realized PnL reload from.
+func (s *Service) enqueue(ev interface{}) { s.events.Push(ev) }
+
+// Run persists lot changes in the order they were applied, retrying a
+// failed write before any later one.
+func (s *Service) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.events.Ready():
+			err := s.events.Flush(func(ev interface{}) error {
+				switch ev := ev.(type) {
+				case models.Lot:
+					return s.repo.SaveLot(ctx, ev)
+				case models.ClosedLot:
+					return s.repo.SaveClosedLot(ctx, ev)
+				}
+				return nil
+			})
+			if err != nil {
+				log.Printf("lots: persist: %v; retrying", err)
+			}
+		}
+	}
+}
+
+// ApplyAction adjusts every user's lots for a corporate action and
+// returns one adjustment per affected user. Dividend adjustments carry the
+// cash due on the eligible quantity; crediting it is up to the caller.
+// With wholeOnly, a fractional share left by a split or bonus is sold off
+// from the newest adjusted lots and the adjustment carries the cash in
+// lieu.
+func (s *Service) ApplyAction(a models.CorporateAction, now time.Time, wholeOnly bool) []models.Adjustment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []models.Adjustment
+	for userID, l := range s.ledgers {
+		before, after := l.Adjust(a)
+		if len(before) == 0 {
+			continue
+		}
+		adj := models.Adjustment{
+			ID:        a.ID + ":" + userID,
+			UserID:    userID,
+			ActionID:  a.ID,
+			Type:      a.Type,
+			Symbol:    a.Symbol,
+			NewSymbol: a.NewSymbol,
+			Time:      now,
+		}
+		adj.Currency = s.fx.Currency(before[0].Currency)
+		adj.OldQty, adj.OldAvgPrice = totals(before)
+		adj.NewQty, adj.NewAvgPrice = totals(after)
+		switch a.Type {
+		case models.ActionDividend:
+			adj.Cash = adj.OldQty * a.Amount
+		case models.ActionSplit, models.ActionReverseSplit, models.ActionBonus:
+			if wholeOnly {
+				after = s.cashInLieu(l, a, &adj, after)
+			}
+			fallthrough
+		default:
+			for _, lot := range after {
+				s.enqueue(lot)
+			}
+		}
+		out = append(out, adj)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].UserID < out[j].UserID })
+	return out
+}
+
+// cashInLieu closes the fraction of a share left in the adjusted lots at
+// the last price scaled by the action's ratio, falling back to cost when
+// there is no price. It returns the lots as they now stand.
+func (s *Service) cashInLieu(l *Ledger, a models.CorporateAction, adj *models.Adjustment, after []models.Lot) []models.Lot {
+	frac := adj.NewQty - math.Floor(adj.NewQty+eps)
+	if frac <= eps || adj.NewQty < 0 {
+		return after
+	}
+	price := adj.NewAvgPrice
+	if mark, ok := s.prices.Mark(a.Symbol); ok {
+		price = mark / a.Factor()
+	}
+	ids := make([]string, 0, len(after))
+	for i := len(after) - 1; i >= 0; i-- {
+		ids = append(ids, after[i].ID)
+	}
+	closed, touched, _ := l.Close(models.Fill{
+		ID:       adj.ID,
+		OrderID:  a.ID,
+		UserID:   adj.UserID,
+		Symbol:   a.Symbol,
+		Exchange: after[0].Exchange,
+		Currency: adj.Currency,
+		Side:     "sell",
+		Price:    price,
+		Quantity: frac,
+		Time:     adj.Time,
+	}, models.LotSpecific, ids, false)
+	s.closed[adj.UserID] = append(s.closed[adj.UserID], closed...)
+	for _, c := range closed {
+		s.enqueue(c)
+	}
+	// touched holds the closed lots' remaining quantity; the rest of the
+	// adjusted lots are unchanged.
+	byID := map[string]models.Lot{}
+	for _, lot := range touched {
+		byID[lot.ID] = lot
+	}
+	for i := range after {
+		if lot, ok := byID[after[i].ID]; ok {
+			after[i] = lot
+		}
+	}
+	adj.FractionQty = frac
+	adj.CashInLieu = frac * price
+	adj.NewQty, adj.NewAvgPrice = totals(after)
+	return after
+}
+
+func totals(list []models.Lot) (qty, avg float64) {
+	var cost float64
+	for _, lot := range list {
+		qty += lot.Quantity
+		cost += lot.Quantity * lot.Price
+	}
+	if qty > 0 {
+		avg = cost / qty
+	}
+	return qty, avg
+}
+
+// Settle marks every user's lots on exchange due by through as settled and
+// returns them.
+func (s *Service) Settle(exchange string, through time.Time) []models.Lot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []models.Lot
+	for _, l := range s.ledgers {
+		for _, lot := range l.Settle(exchange, through) {
+			s.enqueue(lot)
+			out = append(out, lot)
+		}
+	}
+	return out
+}
+
+// Due returns every user's unsettled lots on exchange due by through,
+// without settling them.
+func (s *Service) Due(exchange string, through time.Time) []models.Lot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []models.Lot
+	for _, l := range s.ledgers {
+		out = append(out, l.Due(exchange, through)...)
+	}
+	return out
+}
+
+// Users lists the users with open lots.
+func (s *Service) Users() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []string
+	for id, l := range s.ledgers {
+		if len(l.open) > 0 {
+			out = append(out, id)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// UnsettledExchanges lists the exchanges with lots awaiting settlement.
+func (s *Service) UnsettledExchanges() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	seen := map[string]bool{}
+	for _, l := range s.ledgers {
+		for _, list := range l.open {
+			for _, lot := range list {
+				if !lot.Settled {
+					seen[lot.Exchange] = true
+				}
+			}
+		}
+	}
+	out := make([]string, 0, len(seen))
+	for ex := range seen {
+		out = append(out, ex)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Lots returns the user's open lots of symbol, or of every symbol.
+func (s *Service) Lots(userID, symbol string) []models.Lot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ledger(userID).Lots(symbol, time.Now())
+}
+
+// Realized returns the user's closed lots, newest first.
+func (s *Service) Realized(userID string) []models.ClosedLot {
+	s.mu.Lock()
+	list := s.closed[userID]
+	out := make([]models.ClosedLot, len(list))
+	for i, c := range list {
+		out[len(list)-1-i] = c
+	}
+	s.mu.Unlock()
+	return out
+}
+
+// Holdings aggregates the user's open lots per symbol, marked to market in
+// the instrument currency and converted to the base currency.
+func (s *Service) Holdings(userID string) []models.Holding {
+	var out []models.Holding
+	var cur *models.Holding
+	var cost float64
+	for _, lot := range s.Lots(userID, "") {
+		if cur == nil || cur.Symbol != lot.Symbol {
+			out = append(out, models.Holding{Symbol: lot.Symbol, Currency: s.fx.Currency(lot.Currency)})
+			cur, cost = &out[len(out)-1], 0
+		}
+		cur.Quantity += lot.Quantity
+		cost += lot.Quantity * lot.Price
+		cur.AvgPrice = cost / cur.Quantity
+		if lot.Settled {
+			cur.SettledQty += lot.Quantity
+		} else {
+			cur.UnsettledQty += lot.Quantity
+		}
+		if lot.Term == models.TermLong {
+			cur.LongTermQty += lot.Quantity
+		} else {
+			cur.ShortTermQty += lot.Quantity
+		}
+	}
+	for i := range out {
+		h := &out[i]
+		h.Quantity = roundQty(h.Quantity)
+		h.SettledQty, h.UnsettledQty = roundQty(h.SettledQty), roundQty(h.UnsettledQty)
+		h.ShortTermQty, h.LongTermQty = roundQty(h.ShortTermQty), roundQty(h.LongTermQty)
+		h.LastPrice, _ = s.prices.Mark(h.Symbol)
+		h.UnrealizedPNL = s.prices.UnrealizedPNL(h.Symbol, h.Quantity, h.AvgPrice)
+		price := h.LastPrice
+		if price <= 0 {
+			price = h.AvgPrice
+		}
+		h.Value = h.Quantity * price
+		h.Multiplier, h.Contracts = s.contracts(h.Symbol, h.Quantity)
+		h.Fund = s.isFund(h.Symbol)
+		if rate, err := s.fx.Rate(h.Currency, s.fx.Base()); err == nil {
+			h.FXRate = rate
+			h.BaseValue = h.Value * rate
+			h.BaseUnrealizedPNL = h.UnrealizedPNL * rate
+		}
+	}
+	return out
+}
+
+// Positions summarises today's buys and sells per symbol: lots acquired
+// and lots closed since local midnight. Shorts opened on earlier days are
+// carried in so that every open short shows.
+func (s *Service) Positions(userID string) []models.Position {
+	now := time.Now()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	bySymbol := map[string]*models.Position{}
+	get := func(sym string) *models.Position {
+		p, ok := bySymbol[sym]
+		if !ok {
+			p = &models.Position{Symbol: sym}
+			bySymbol[sym] = p
+		}
+		return p
+	}
+
+	s.mu.Lock()
+	l := s.ledger(userID)
+	var bought, carried []models.Lot
+	for _, sym := range l.Symbols() {
+		for _, lot := range l.open[sym] {
+			if !lot.AcquiredAt.Before(day) {
+				bought = append(bought, *lot)
+			} else if lot.Quantity < 0 {
+				carried = append(carried, *lot)
+			}
+		}
+	}
+	var sold []models.ClosedLot
+	for _, c := range s.closed[userID] {
+		if !c.ClosedAt.Before(day) {
+			sold = append(sold, c)
+		}
+	}
+	s.mu.Unlock()
+
+	// Lots bought and fully sold today only survive as closed lots, and
+	// shorts opened and covered today as closed short lots.
+	sellValue, shortQty := map[string]float64{}, map[string]float64{}
+	for _, c := range sold {
+		p := get(c.Symbol)
+		p.Currency = s.fx.Currency(c.Currency)
+		p.RealizedPNL += c.RealizedPNL
+		opened := !c.AcquiredAt.Before(day)
+		switch {
+		case c.Short:
+			p.BuyQty += c.Quantity
+			p.AvgPrice += c.Quantity * c.CostPrice
+			if opened {
+				p.SellQty += c.Quantity
+				sellValue[c.Symbol] += c.Quantity * c.SalePrice
+				shortQty[c.Symbol] += c.Quantity
+			} else {
+				p.CarriedQty -= c.Quantity
+			}
+		default:
+			p.SellQty += c.Quantity
+			if opened {
+				p.BuyQty += c.Quantity
+				p.AvgPrice += c.Quantity * c.CostPrice
+			}
+		}
+	}
+	for _, lot := range bought {
+		p := get(lot.Symbol)
+		p.Currency = s.fx.Currency(lot.Currency)
+		if lot.Quantity < 0 {
+			p.SellQty -= lot.Quantity
+			sellValue[lot.Symbol] -= lot.Quantity * lot.Price
+			shortQty[lot.Symbol] -= lot.Quantity
+		} else {
+			p.BuyQty += lot.Quantity
+			p.AvgPrice += lot.Quantity * lot.Price
+		}
+		p.UnrealizedPNL += s.prices.UnrealizedPNL(lot.Symbol, lot.Quantity, lot.Price)
+	}
+	for _, lot := range carried {
+		p := get(lot.Symbol)
+		p.Currency = s.fx.Currency(lot.Currency)
+		p.CarriedQty += lot.Quantity
+		sellValue[lot.Symbol] -= lot.Quantity * lot.Price
+		shortQty[lot.Symbol] -= lot.Quantity
+		p.UnrealizedPNL += s.prices.UnrealizedPNL(lot.Symbol, lot.Quantity, lot.Price)
+	}
+
+	out := make([]models.Position, 0, len(bySymbol))
+	for _, p := range bySymbol {
+		// A net short shows the average sale price of its shorts.
+		p.Quantity = p.BuyQty - p.SellQty + p.CarriedQty
+		if p.Quantity < -eps && shortQty[p.Symbol] > 0 {
+			p.AvgPrice = sellValue[p.Symbol] / shortQty[p.Symbol]
+		} else if p.BuyQty > 0 {
+			p.AvgPrice /= p.BuyQty
+		}
+		p.PNL = p.RealizedPNL + p.UnrealizedPNL
+		p.Multiplier, p.Contracts = s.contracts(p.Symbol, p.Quantity)
+		p.Fund = s.isFund(p.Symbol)
+		out = append(out, *p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Symbol < out[j].Symbol })
+	return out
+}
+
+// Card totals realized PnL from closed lots, split by holding period, and
+// unrealized PnL from open lots, in the base currency. PnL in a currency
+// without a rate is left out and the currency listed in Unconverted.
+func (s *Service) Card(userID string) models.PNLCard {
+	card := models.PNLCard{Currency: s.fx.Base()}
+	var missing []string
+	for _, c := range s.Realized(userID) {
+		pnl, ok := s.fx.ToBase(c.RealizedPNL, c.Currency)
+		if !ok {
+			missing = append(missing, s.fx.Currency(c.Currency))
+			continue
+		}
+		card.RealizedPNL += pnl
+		if c.Term == models.TermLong {
+			card.LongTermPNL += pnl
+		} else {
+			card.ShortTermPNL += pnl
+		}
+	}
+	for _, lot := range s.Lots(userID, "") {
+		pnl, ok := s.fx.ToBase(s.prices.UnrealizedPNL(lot.Symbol, lot.Quantity, lot.Price), lot.Currency)
+		if !ok {
+			missing = append(missing, s.fx.Currency(lot.Currency))
+		}
+		card.UnrealizedPNL += pnl
+	}
+	card.Unconverted = fx.Union(missing)
+	return card
+}
+
+// AttachPNL fills in each order's realized PnL from the lots its fills
+// closed and, for buys, the unrealized PnL of the lots it still has open.
+func (s *Service) AttachPNL(userID string, list []models.Order) {
+	realized := map[string]float64{}
+	for _, c := range s.Realized(userID) {
+		realized[c.OrderID] += c.RealizedPNL
+	}
+	unrealized := map[string]float64{}
+	for _, lot := range s.Lots(userID, "") {
+		unrealized[lot.OrderID] += s.prices.UnrealizedPNL(lot.Symbol, lot.Quantity, lot.Price)
+	}
+	for i := range list {
+		list[i].RealizedPNL = realized[list[i].ID]
+		list[i].UnrealizedPNL = unrealized[list[i].ID]
+	}
+}
@@ -0,0 +1,60 @@
+package lots
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/fx"
+	"github.com/hahahamid/broker-backend/internal/marketdata"
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+func TestCashInLieu(t *testing.T) {
+	exDate := time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)
+	split := models.CorporateAction{ID: "split", Type: models.ActionSplit, Symbol: "ACME", RatioNew: 3, RatioOld: 2, ExDate: exDate}
+
+	tests := []struct {
+		name      string
+		mark      float64 // pre-split price, 0 for none
+		wantPrice float64
+	}{
+		{"marked", 15, 10},
+		// Without a price the fraction goes at the adjusted average cost:
+		// 5 shares costing 54 become 7.5 shares at 7.2.
+		{"at cost", 0, 7.2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prices := marketdata.NewPriceCache()
+			if tt.mark > 0 {
+				prices.OnTrade(models.Trade{Symbol: "ACME", Price: tt.mark})
+			}
+			s := NewService(nil, prices, nil, fx.NewConverter(nil, "USD"), models.LotFIFO, 365*24*time.Hour)
+			s.OnFill(models.Fill{ID: "old", UserID: "u", Symbol: "ACME", Side: "buy", Price: 10, Quantity: 3, Time: exDate.AddDate(0, 0, -10)})
+			s.OnFill(models.Fill{ID: "new", UserID: "u", Symbol: "ACME", Side: "buy", Price: 12, Quantity: 2, Time: exDate.AddDate(0, 0, -5)})
+
+			adj := s.ApplyAction(split, exDate, true)
+			if len(adj) != 1 {
+				t.Fatalf("got %d adjustments, want 1", len(adj))
+			}
+			a := adj[0]
+			if a.FractionQty != 0.5 || a.NewQty != 7 {
+				t.Errorf("fraction %g, new qty %g; want 0.5 and 7", a.FractionQty, a.NewQty)
+			}
+			if math.Abs(a.CashInLieu-0.5*tt.wantPrice) > 1e-9 {
+				t.Errorf("cash in lieu %g, want %g", a.CashInLieu, 0.5*tt.wantPrice)
+			}
+			// The fraction comes off the newest lot.
+			for _, lot := range s.Lots("u", "ACME") {
+				want := map[string]float64{"old": 4.5, "new": 2.5}[lot.ID]
+				if lot.Quantity != want {
+					t.Errorf("lot %s holds %g, want %g", lot.ID, lot.Quantity, want)
+				}
+			}
+			if again := s.ApplyAction(split, exDate, true); len(again) != 0 {
+				t.Errorf("applying the split again adjusted %d holdings", len(again))
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package marketdata
+
+import (
+	"sync"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+// PriceCache keeps the latest quote and trade per symbol. It is the single
+// source of mark prices for PnL, risk checks and triggers.
+type PriceCache struct {
+	mu     sync.RWMutex
+	quotes map[string]models.Quote
+	trades map[string]models.Trade
+}
+
+func NewPriceCache() *PriceCache {
+	return &PriceCache{
+		quotes: map[string]models.Quote{},
+		trades: map[string]models.Trade{},
+	}
+}
+
+func (c *PriceCache) OnQuote(q models.Quote) {
+	c.mu.Lock()
+	c.quotes[q.Symbol] = q
+	c.mu.Unlock()
+}
+
+func (c *PriceCache) OnTrade(t models.Trade) {
+	c.mu.Lock()
+	c.trades[t.Symbol] = t
+	c.mu.Unlock()
+}
+
+func (c *PriceCache) Quote(symbol string) (models.Quote, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	q, ok := c.quotes[symbol]
+	return q, ok
+}
+
+func (c *PriceCache) LastTrade(symbol string) (models.Trade, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	t, ok := c.trades[symbol]
+	return t, ok
+}
+
+// Mark returns the last traded price, falling back to the quote mid.
+func (c *PriceCache) Mark(symbol string) (float64, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if t, ok := c.trades[symbol]; ok {
+		return t.Price, true
+	}
+	if q, ok := c.quotes[symbol]; ok && q.Bid > 0 && q.Ask > 0 {
+		return (q.Bid + q.Ask) / 2, true
+	}
+	return 0, false
+}
+
+// UnrealizedPNL marks qty units bought at avgPrice to the cached price.
+// It is zero when no price has been seen for the symbol yet.
+func (c *PriceCache) UnrealizedPNL(symbol string, qty, avgPrice float64) float64 {
+	mark, ok := c.Mark(symbol)
+	if !ok {
+		return 0
+	}
+	return (mark - avgPrice) * qty
+}
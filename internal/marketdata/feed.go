@@ -0,0 +1,84 @@
+package marketdata
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+// Listener receives market data events. Callbacks run on the feed's
+// goroutine, so implementations must return quickly.
+type Listener interface {
+	OnQuote(q models.Quote)
+	OnTrade(t models.Trade)
+}
+
+// MarketDataFeed publishes quotes and trades per symbol.
+type MarketDataFeed interface {
+	// Subscribe registers l for the given symbols, or for every symbol when
+	// none are given. The returned func removes the subscription.
+	Subscribe(l Listener, symbols ...string) (unsubscribe func())
+	// Run publishes events until ctx is done or the source is exhausted.
+	Run(ctx context.Context) error
+}
+
+// Hub fans events out to subscribers. Feed implementations embed it and call
+// PublishQuote/PublishTrade.
+type Hub struct {
+	mu   sync.RWMutex
+	next int
+	subs map[int]subscription
+}
+
+type subscription struct {
+	l       Listener
+	symbols map[string]bool
+}
+
+func (h *Hub) Subscribe(l Listener, symbols ...string) func() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subs == nil {
+		h.subs = map[int]subscription{}
+	}
+	sub := subscription{l: l}
+	if len(symbols) > 0 {
+		sub.symbols = map[string]bool{}
+		for _, s := range symbols {
+			sub.symbols[s] = true
+		}
+	}
+	id := h.next
+	h.next++
+	h.subs[id] = sub
+	return func() {
+		h.mu.Lock()
+		delete(h.subs, id)
+		h.mu.Unlock()
+	}
+}
+
+func (h *Hub) PublishQuote(q models.Quote) {
+	for _, l := range h.listeners(q.Symbol) {
+		l.OnQuote(q)
+	}
+}
+
+func (h *Hub) PublishTrade(t models.Trade) {
+	for _, l := range h.listeners(t.Symbol) {
+		l.OnTrade(t)
+	}
+}
+
+func (h *Hub) listeners(symbol string) []Listener {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	out := make([]Listener, 0, len(h.subs))
+	for _, s := range h.subs {
+		if s.symbols == nil || s.symbols[symbol] {
+			out = append(out, s.l)
+		}
+	}
+	return out
+}
@@ -0,0 +1,121 @@
+package marketdata
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+// Replayer publishes ticks from a CSV file with the header
+// time,symbol,price,quantity[,bid,ask,bid_size,ask_size]. Times are RFC 3339.
+// Rows carrying a bid/ask also publish a quote.
+type Replayer struct {
+	Hub
+	path  string
+	speed float64 // 0 replays as fast as possible, 1 in real time
+}
+
+func NewReplayer(path string, speed float64) *Replayer {
+	return &Replayer{path: path, speed: speed}
+}
+
+func (r *Replayer) Run(ctx context.Context) error {
+	f, err := os.Open(r.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	cr := csv.NewReader(f)
+	header, err := cr.Read()
+	if err != nil {
+		return fmt.Errorf("tick file: %w", err)
+	}
+	col := map[string]int{}
+	for i, h := range header {
+		col[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	for _, name := range []string{"time", "symbol", "price", "quantity"} {
+		if _, ok := col[name]; !ok {
+			return fmt.Errorf("tick file: missing %s column", name)
+		}
+	}
+
+	var prev time.Time
+	for line := 2; ; line++ {
+		row, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("tick file line %d: %w", line, err)
+		}
+		tick, quote, err := parseTick(row, col)
+		if err != nil {
+			return fmt.Errorf("tick file line %d: %w", line, err)
+		}
+
+		if r.speed > 0 && !prev.IsZero() && tick.Time.After(prev) {
+			wait := time.Duration(float64(tick.Time.Sub(prev)) / r.speed)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+		} else if err := ctx.Err(); err != nil {
+			return err
+		}
+		prev = tick.Time
+
+		if quote != nil {
+			r.PublishQuote(*quote)
+		}
+		r.PublishTrade(tick)
+	}
+}
+
+func parseTick(row []string, col map[string]int) (models.Trade, *models.Quote, error) {
+	field := func(name string) string {
+		if i, ok := col[name]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+	num := func(name string) (float64, error) {
+		s := field(name)
+		if s == "" {
+			return 0, nil
+		}
+		return strconv.ParseFloat(s, 64)
+	}
+
+	ts, err := time.Parse(time.RFC3339, field("time"))
+	if err != nil {
+		return models.Trade{}, nil, err
+	}
+	t := models.Trade{Symbol: strings.ToUpper(field("symbol")), Time: ts}
+	if t.Price, err = num("price"); err != nil {
+		return t, nil, err
+	}
+	if t.Quantity, err = num("quantity"); err != nil {
+		return t, nil, err
+	}
+
+	q := models.Quote{Symbol: t.Symbol, Time: ts}
+	for name, dst := range map[string]*float64{"bid": &q.Bid, "ask": &q.Ask, "bid_size": &q.BidSize, "ask_size": &q.AskSize} {
+		if *dst, err = num(name); err != nil {
+			return t, nil, err
+		}
+	}
+	if q.Bid == 0 && q.Ask == 0 {
+		return t, nil, nil
+	}
+	return t, &q, nil
+}
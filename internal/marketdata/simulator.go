@@ -0,0 +1,116 @@
+package marketdata
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+type SimConfig struct {
+	Seed       int64
+	Interval   time.Duration // time between steps
+	Steps      int           // 0 runs until the context is cancelled
+	Volatility float64       // per-step standard deviation of log returns
+	Realtime   bool          // sleep Interval between steps and stamp with the wall clock
+	Start      time.Time     // first timestamp when not Realtime
+}
+
+// Simulator is a deterministic random-walk feed: for a given seed and
+// instrument list it always produces the same price path.
+type Simulator struct {
+	Hub
+	cfg    SimConfig
+	rng    *rand.Rand
+	states []*simState
+}
+
+type simState struct {
+	inst  models.Instrument
+	price float64
+}
+
+func NewSimulator(cfg SimConfig, list []models.Instrument) *Simulator {
+	if cfg.Interval <= 0 {
+		cfg.Interval = time.Second
+	}
+	if cfg.Volatility <= 0 {
+		cfg.Volatility = 0.001
+	}
+	s := &Simulator{cfg: cfg, rng: rand.New(rand.NewSource(cfg.Seed))}
+	for _, inst := range list {
+		if inst.PrevClose <= 0 {
+			continue
+		}
+		s.states = append(s.states, &simState{inst: inst, price: inst.PrevClose})
+	}
+	sort.Slice(s.states, func(i, j int) bool { return s.states[i].inst.Symbol < s.states[j].inst.Symbol })
+	return s
+}
+
+func (s *Simulator) Run(ctx context.Context) error {
+	var ticker *time.Ticker
+	if s.cfg.Realtime {
+		ticker = time.NewTicker(s.cfg.Interval)
+		defer ticker.Stop()
+	}
+
+	for step := 0; s.cfg.Steps == 0 || step < s.cfg.Steps; step++ {
+		if ticker != nil {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ticker.C:
+			}
+		} else if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		now := s.cfg.Start.Add(time.Duration(step) * s.cfg.Interval)
+		if s.cfg.Realtime {
+			now = time.Now()
+		}
+		for _, st := range s.states {
+			s.step(st, now)
+		}
+	}
+	return nil
+}
+
+func (s *Simulator) step(st *simState, now time.Time) {
+	inst := st.inst
+	st.price *= math.Exp(s.cfg.Volatility * s.rng.NormFloat64())
+	if inst.LowerBand > 0 && st.price < inst.LowerBand {
+		st.price = inst.LowerBand
+	}
+	if inst.UpperBand > 0 && st.price > inst.UpperBand {
+		st.price = inst.UpperBand
+	}
+	last := roundTo(st.price, inst.TickSize)
+	lot := math.Max(inst.LotSize, 1)
+
+	s.PublishQuote(models.Quote{
+		Symbol:  inst.Symbol,
+		Bid:     last - inst.TickSize,
+		Ask:     last + inst.TickSize,
+		BidSize: lot * float64(1+s.rng.Intn(50)),
+		AskSize: lot * float64(1+s.rng.Intn(50)),
+		Time:    now,
+	})
+	s.PublishTrade(models.Trade{
+		Symbol:   inst.Symbol,
+		Price:    last,
+		Quantity: lot * float64(1+s.rng.Intn(20)),
+		Time:     now,
+	})
+}
+
+func roundTo(v, tick float64) float64 {
+	if tick <= 0 {
+		return v
+	}
+	return math.Round(v/tick) * tick
+}
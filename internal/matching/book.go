@@ -0,0 +1,134 @@
+package matching
+
+import (
+	"math"
+	"sort"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+const eps = 1e-9
+
+type level struct {
+	price  float64
+	orders []*models.Order // time priority, oldest first
+}
+
+// book holds the resting orders of one symbol. Bids are sorted best
+// (highest) first and asks best (lowest) first. An iceberg order only
+// shows its current slice; the rest of it is never visible in the book.
+type book struct {
+	bids  []*level
+	asks  []*level
+	shown map[string]float64 // visible slice left of each iceberg by order ID
+}
+
+func (b *book) side(side string) *[]*level {
+	if side == "buy" {
+		return &b.bids
+	}
+	return &b.asks
+}
+
+func (b *book) opposite(side string) *[]*level {
+	if side == "buy" {
+		return &b.asks
+	}
+	return &b.bids
+}
+
+// better reports whether price a ranks ahead of b on the given side.
+func better(side string, a, b float64) bool {
+	if side == "buy" {
+		return a > b
+	}
+	return a < b
+}
+
+func (b *book) add(o *models.Order) {
+	if o.DisplayQty > 0 {
+		if b.shown == nil {
+			b.shown = map[string]float64{}
+		}
+		b.shown[o.ID] = math.Min(o.DisplayQty, o.Remaining())
+	}
+	levels := b.side(o.Side)
+	i := sort.Search(len(*levels), func(i int) bool {
+		return !better(o.Side, (*levels)[i].price, o.Price)
+	})
+	if i < len(*levels) && (*levels)[i].price == o.Price {
+		(*levels)[i].orders = append((*levels)[i].orders, o)
+		return
+	}
+	*levels = append(*levels, nil)
+	copy((*levels)[i+1:], (*levels)[i:])
+	(*levels)[i] = &level{price: o.Price, orders: []*models.Order{o}}
+}
+
+func (b *book) remove(o *models.Order) bool {
+	delete(b.shown, o.ID)
+	levels := b.side(o.Side)
+	for i, lvl := range *levels {
+		if lvl.price != o.Price {
+			continue
+		}
+		for j, r := range lvl.orders {
+			if r.ID != o.ID {
+				continue
+			}
+			lvl.orders = append(lvl.orders[:j], lvl.orders[j+1:]...)
+			if len(lvl.orders) == 0 {
+				*levels = append((*levels)[:i], (*levels)[i+1:]...)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// levels aggregates up to n price levels of one side.
+func (b *book) levels(side string, n int) []models.PriceLevel {
+	var out []models.PriceLevel
+	for _, lvl := range *b.side(side) {
+		if n > 0 && len(out) == n {
+			break
+		}
+		pl := models.PriceLevel{Price: lvl.price, Orders: len(lvl.orders)}
+		for _, o := range lvl.orders {
+			pl.Quantity += b.visible(o)
+		}
+		out = append(out, pl)
+	}
+	return out
+}
+
+// visible is how much of a resting order others can see and trade against
+// before it changes: the current slice of an iceberg, else all of it.
+func (b *book) visible(o *models.Order) float64 {
+	if o.DisplayQty <= 0 {
+		return o.Remaining()
+	}
+	return b.shown[o.ID]
+}
+
+// took updates the head order of lvl after qty of it traded and reports
+// whether it left the book. An iceberg whose slice is used up shows a new
+// one from its reserve and goes to the back of the level, losing its time
+// priority as a newly placed order would.
+func (b *book) took(lvl *level, qty float64) bool {
+	o := lvl.orders[0]
+	if o.Remaining() <= eps {
+		lvl.orders = lvl.orders[1:]
+		delete(b.shown, o.ID)
+		return true
+	}
+	if o.DisplayQty <= 0 {
+		return false
+	}
+	if b.shown[o.ID] -= qty; b.shown[o.ID] > eps {
+		return false
+	}
+	b.shown[o.ID] = math.Min(o.DisplayQty, o.Remaining())
+	lvl.orders = append(lvl.orders[1:], o)
+	return false
+}
@@ -0,0 +1,417 @@
+package matching
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var ErrOrderNotFound = errors.New("order not found")
+
+// Listener receives engine events in the order they happened.
+type Listener interface {
+	OnOrder(o models.Order) // order state after every change
+	OnFill(f models.Fill)
+}
+
+// Engine is an in-memory price-time priority matching engine. Besides the
+// resting orders it treats the latest feed quote of each symbol as external
+// liquidity, so orders also fill against the simulated or replayed market.
+// Iceberg orders rest with only their display quantity visible, both to
+// depth and to what a single match can take.
+type Engine struct {
+	mu     sync.Mutex
+	books  map[string]*book
+	orders map[string]*models.Order // resting orders by ID
+	quotes map[string]models.Quote
+	now    func() time.Time
+	ids    func() string
+
+	emitMu    sync.Mutex
+	listeners []Listener
+
+	watchMu  sync.Mutex
+	watchers map[chan string]struct{}
+}
+
+func NewEngine() *Engine {
+	return &Engine{
+		books:    map[string]*book{},
+		orders:   map[string]*models.Order{},
+		quotes:   map[string]models.Quote{},
+		now:      time.Now,
+		ids:      func() string { return primitive.NewObjectID().Hex() },
+		watchers: map[chan string]struct{}{},
+	}
+}
+
+// SetClock overrides the time source used to stamp orders and fills.
+func (e *Engine) SetClock(now func() time.Time) {
+	e.now = now
+}
+
+// SetIDs overrides how fill IDs are generated, so that a replay can give
+// its fills the same IDs every run.
+func (e *Engine) SetIDs(next func() string) {
+	e.ids = next
+}
+
+func (e *Engine) AddListener(l Listener) {
+	e.emitMu.Lock()
+	e.listeners = append(e.listeners, l)
+	e.emitMu.Unlock()
+}
+
+// events collects what happened under e.mu so it can be delivered after.
+type events struct {
+	orders []models.Order
+	fills  []models.Fill
+}
+
+// emit delivers events while still holding e.mu, handing over to emitMu so
+// listeners observe events in engine order without blocking matching.
+func (e *Engine) emit(ev *events, symbol string) {
+	e.emitMu.Lock()
+	e.mu.Unlock()
+	defer e.emitMu.Unlock()
+	for _, l := range e.listeners {
+		for _, f := range ev.fills {
+			l.OnFill(f)
+		}
+		for _, o := range ev.orders {
+			l.OnOrder(o)
+		}
+	}
+	e.changed(symbol)
+}
+
+func (e *Engine) book(symbol string) *book {
+	b, ok := e.books[symbol]
+	if !ok {
+		b = &book{}
+		e.books[symbol] = b
+	}
+	return b
+}
+
+// Submit matches an order and rests any limit remainder. Unfilled market
+// quantity is cancelled. The returned order reflects its state afterwards.
+func (e *Engine) Submit(o models.Order) models.Order {
+	e.mu.Lock()
+	ev := &events{}
+	now := e.now()
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = now
+	}
+	o.UpdatedAt = now
+	o.Status = models.OrderOpen
+	ord := &o
+
+	e.match(ord, ev)
+	if ord.Remaining() > eps {
+		if ord.Type == models.OrderTypeMarket {
+			ord.Status = models.OrderCancelled
+		} else {
+			e.book(ord.Symbol).add(ord)
+			e.orders[ord.ID] = ord
+		}
+	}
+	ev.orders = append(ev.orders, *ord)
+	out := *ord
+	e.emit(ev, o.Symbol)
+	return out
+}
+
+// Allocate fills up to qty of o at price away from the book, as the house
+// account does for fractional orders and expiring contracts settle, and
+// cancels whatever is left. The price may be zero for an option expiring
+// worthless. The fill is reported to listeners like any other.
+func (e *Engine) Allocate(o models.Order, price, qty float64) models.Order {
+	e.mu.Lock()
+	ev := &events{}
+	now := e.now()
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = now
+	}
+	o.UpdatedAt = now
+	o.Status = models.OrderOpen
+	qty = math.Min(qty, o.Remaining())
+	if qty > eps && price >= 0 {
+		e.fill(&o, price, qty, ev)
+		ev.fills[len(ev.fills)-1].Allocation = true
+	}
+	if o.Remaining() > eps {
+		o.Status = models.OrderCancelled
+	}
+	ev.orders = append(ev.orders, o)
+	e.emit(ev, o.Symbol)
+	return o
+}
+
+// Load rests a previously accepted order without matching it, used to
+// rebuild the book after a restart.
+func (e *Engine) Load(o models.Order) {
+	if o.Remaining() <= eps || o.Type == models.OrderTypeMarket {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	ord := &o
+	e.book(ord.Symbol).add(ord)
+	e.orders[ord.ID] = ord
+}
+
+func (e *Engine) Cancel(orderID string) (models.Order, error) {
+	return e.remove(orderID, models.OrderCancelled)
+}
+
+// Expire pulls a DAY order whose session has ended.
+func (e *Engine) Expire(orderID string) (models.Order, error) {
+	return e.remove(orderID, models.OrderExpired)
+}
+
+func (e *Engine) remove(orderID, status string) (models.Order, error) {
+	e.mu.Lock()
+	o, ok := e.orders[orderID]
+	if !ok {
+		e.mu.Unlock()
+		return models.Order{}, ErrOrderNotFound
+	}
+	e.book(o.Symbol).remove(o)
+	delete(e.orders, orderID)
+	o.Status = status
+	o.UpdatedAt = e.now()
+	out := *o
+	e.emit(&events{orders: []models.Order{out}}, o.Symbol)
+	return out, nil
+}
+
+// Order returns a resting order.
+func (e *Engine) Order(orderID string) (models.Order, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	o, ok := e.orders[orderID]
+	if !ok {
+		return models.Order{}, false
+	}
+	return *o, true
+}
+
+// OpenOrders returns the resting orders of symbol.
+func (e *Engine) OpenOrders(symbol string) []models.Order {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var out []models.Order
+	for _, o := range e.orders {
+		if o.Symbol == symbol {
+			out = append(out, *o)
+		}
+	}
+	return out
+}
+
+// match fills o against the best of the resting book and the external quote
+// until it no longer crosses. Resting orders keep priority at equal prices.
+func (e *Engine) match(o *models.Order, ev *events) {
+	opp := e.book(o.Symbol).opposite(o.Side)
+	for o.Remaining() > eps {
+		var lvl *level
+		if len(*opp) > 0 && crosses(o, (*opp)[0].price) {
+			lvl = (*opp)[0]
+		}
+		extPrice, extQty := quoteSide(e.quotes[o.Symbol], o.Side)
+		ext := extQty > eps && crosses(o, extPrice)
+		if ext && lvl != nil && !better(other(o.Side), extPrice, lvl.price) {
+			ext = false
+		}
+
+		switch {
+		case ext:
+			qty := math.Min(o.Remaining(), extQty)
+			e.fill(o, extPrice, qty, ev)
+			e.takeQuote(o.Symbol, o.Side, qty)
+		case lvl != nil:
+			b := e.book(o.Symbol)
+			r := lvl.orders[0]
+			qty := math.Min(o.Remaining(), b.visible(r))
+			e.fill(r, lvl.price, qty, ev)
+			e.fill(o, lvl.price, qty, ev)
+			ev.orders = append(ev.orders, *r)
+			if b.took(lvl, qty) {
+				delete(e.orders, r.ID)
+			}
+			if len(lvl.orders) == 0 {
+				*opp = (*opp)[1:]
+			}
+		default:
+			return
+		}
+	}
+}
+
+func other(side string) string {
+	if side == "buy" {
+		return "sell"
+	}
+	return "buy"
+}
+
+// quoteSide is the external liquidity an incoming order on side can take.
+func quoteSide(q models.Quote, side string) (price, qty float64) {
+	if side == "buy" {
+		return q.Ask, q.AskSize
+	}
+	return q.Bid, q.BidSize
+}
+
+func (e *Engine) takeQuote(symbol, side string, qty float64) {
+	q := e.quotes[symbol]
+	if side == "buy" {
+		q.AskSize -= qty
+	} else {
+		q.BidSize -= qty
+	}
+	e.quotes[symbol] = q
+}
+
+func crosses(o *models.Order, price float64) bool {
+	if price <= 0 {
+		return false
+	}
+	if o.Type == models.OrderTypeMarket {
+		return true
+	}
+	if o.Side == "buy" {
+		return price <= o.Price+eps
+	}
+	return price >= o.Price-eps
+}
+
+func (e *Engine) fill(o *models.Order, price, qty float64, ev *events) {
+	now := e.now()
+	o.AvgFillPrice = (o.AvgFillPrice*o.FilledQty + price*qty) / (o.FilledQty + qty)
+	o.FilledQty += qty
+	o.UpdatedAt = now
+	if o.Remaining() <= eps {
+		o.Status = models.OrderFilled
+	} else {
+		o.Status = models.OrderPartiallyFilled
+	}
+	ev.fills = append(ev.fills, models.Fill{
+		ID:       e.ids(),
+		OrderID:  o.ID,
+		UserID:   o.UserID,
+		Symbol:   o.Symbol,
+		Exchange: o.Exchange,
+		Currency: o.Currency,
+		Side:     o.Side,
+		Product:  o.Product,
+		Price:    price,
+		Quantity: qty,
+		Time:     now,
+
+		StrategyID: o.StrategyID,
+	})
+}
+
+// OnQuote refreshes the external liquidity for a symbol and fills resting
+// orders that the new quote crosses.
+func (e *Engine) OnQuote(q models.Quote) {
+	e.mu.Lock()
+	e.quotes[q.Symbol] = q
+	ev := &events{}
+	b := e.book(q.Symbol)
+	for _, side := range []string{"buy", "sell"} {
+		levels := b.side(side)
+		for len(*levels) > 0 {
+			price, qty := quoteSide(e.quotes[q.Symbol], side)
+			lvl := (*levels)[0]
+			r := lvl.orders[0]
+			if qty <= eps || !crosses(r, price) {
+				break
+			}
+			n := math.Min(b.visible(r), qty)
+			e.fill(r, price, n, ev)
+			e.takeQuote(q.Symbol, side, n)
+			if b.took(lvl, n) {
+				delete(e.orders, r.ID)
+				if len(lvl.orders) == 0 {
+					*levels = (*levels)[1:]
+				}
+			}
+			ev.orders = append(ev.orders, *r)
+		}
+	}
+	e.emit(ev, q.Symbol)
+}
+
+func (e *Engine) OnTrade(models.Trade) {}
+
+// Depth returns the top n levels per side, merging resting orders with the
+// external quote. n <= 0 returns every level. It never creates a book, so
+// asking for symbols nobody trades costs no memory.
+func (e *Engine) Depth(symbol string, n int) models.Depth {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	q := e.quotes[symbol]
+	d := models.Depth{Symbol: symbol}
+	var bids, asks []models.PriceLevel
+	if b, ok := e.books[symbol]; ok {
+		bids, asks = b.levels("buy", 0), b.levels("sell", 0)
+	}
+	d.Bids = mergeLevel(bids, q.Bid, q.BidSize, "buy", n)
+	d.Asks = mergeLevel(asks, q.Ask, q.AskSize, "sell", n)
+	return d
+}
+
+func mergeLevel(levels []models.PriceLevel, price, qty float64, side string, n int) []models.PriceLevel {
+	if price > 0 && qty > eps {
+		merged := false
+		for i := range levels {
+			if levels[i].Price == price {
+				levels[i].Quantity += qty
+				levels[i].Orders++
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			levels = append(levels, models.PriceLevel{Price: price, Quantity: qty, Orders: 1})
+			sort.Slice(levels, func(i, j int) bool { return better(side, levels[i].Price, levels[j].Price) })
+		}
+	}
+	if n > 0 && len(levels) > n {
+		levels = levels[:n]
+	}
+	return levels
+}
+
+// Watch delivers the symbol of every book change. Notifications are dropped
+// for readers that fall behind; callers should treat them as "dirty" hints.
+func (e *Engine) Watch() (<-chan string, func()) {
+	ch := make(chan string, 256)
+	e.watchMu.Lock()
+	e.watchers[ch] = struct{}{}
+	e.watchMu.Unlock()
+	return ch, func() {
+		e.watchMu.Lock()
+		delete(e.watchers, ch)
+		e.watchMu.Unlock()
+	}
+}
+
+func (e *Engine) changed(symbol string) {
+	e.watchMu.Lock()
+	defer e.watchMu.Unlock()
+	for ch := range e.watchers {
+		select {
+		case ch <- symbol:
+		default:
+		}
+	}
+}
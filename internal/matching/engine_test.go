@@ -0,0 +1,91 @@
+package matching
+
+import (
+	"testing"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+// fills records the fills the engine reports.
+type fills []models.Fill
+
+func (f *fills) OnOrder(models.Order)  {}
+func (f *fills) OnFill(fl models.Fill) { *f = append(*f, fl) }
+
+func limit(id, side string, qty, price, display float64) models.Order {
+	return models.Order{ID: id, UserID: id, Symbol: "ACME", Side: side, Type: models.OrderTypeLimit, Quantity: qty, Price: price, DisplayQty: display}
+}
+
+func askQty(e *Engine) float64 {
+	d := e.Depth("ACME", 1)
+	if len(d.Asks) == 0 {
+		return 0
+	}
+	return d.Asks[0].Quantity
+}
+
+func TestIcebergRefreshesDisplay(t *testing.T) {
+	e := NewEngine()
+	e.Submit(limit("ice", "sell", 10, 100, 3))
+	if got := askQty(e); got != 3 {
+		t.Fatalf("depth shows %g, want the 3 displayed", got)
+	}
+
+	steps := []struct {
+		buy, filled, shown, left float64
+	}{
+		{3, 3, 3, 7}, // slice used up, a new one shows
+		{5, 5, 1, 2}, // takes the slice, refreshes and keeps matching
+		{1, 1, 1, 1}, // the last slice is what is left
+		{4, 1, 0, 0},
+	}
+	for i, st := range steps {
+		o := e.Submit(limit("b", "buy", st.buy, 100, 0))
+		if o.FilledQty != st.filled {
+			t.Errorf("step %d: buy filled %g, want %g", i, o.FilledQty, st.filled)
+		}
+		if o.Remaining() > eps {
+			e.Cancel(o.ID)
+		}
+		if got := askQty(e); got != st.shown {
+			t.Errorf("step %d: depth shows %g, want %g", i, got, st.shown)
+		}
+		ice, _ := e.Order("ice")
+		if got := ice.Remaining(); got != st.left {
+			t.Errorf("step %d: iceberg has %g left, want %g", i, got, st.left)
+		}
+	}
+}
+
+func TestIcebergLosesTimePriority(t *testing.T) {
+	e := NewEngine()
+	var got fills
+	e.AddListener(&got)
+	e.Submit(limit("ice", "sell", 10, 100, 2))
+	e.Submit(limit("plain", "sell", 5, 100, 0))
+
+	// The iceberg is first in line, but once its slice is gone the refresh
+	// goes behind the plain order.
+	e.Submit(limit("b1", "buy", 3, 100, 0))
+	e.Submit(limit("b2", "buy", 4, 100, 0))
+	e.Submit(limit("b3", "buy", 2, 100, 0))
+
+	want := []struct {
+		id  string
+		qty float64
+	}{{"ice", 2}, {"plain", 1}, {"plain", 4}, {"ice", 2}}
+	var resting []models.Fill
+	for _, f := range got {
+		if f.Side == "sell" {
+			resting = append(resting, f)
+		}
+	}
+	if len(resting) != len(want) {
+		t.Fatalf("got %d resting fills, want %d: %+v", len(resting), len(want), resting)
+	}
+	for i, w := range want {
+		if resting[i].OrderID != w.id || resting[i].Quantity != w.qty {
+			t.Errorf("fill %d: %s %g, want %s %g", i, resting[i].OrderID, resting[i].Quantity, w.id, w.qty)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hahahamid/broker-backend/config"
+)
+
+// AdminKey guards operator endpoints with the X-Admin-Key header. They are
+// disabled when ADMIN_API_KEY is not set.
+func AdminKey(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader("X-Admin-Key")
+		if cfg.AdminAPIKey == "" || subtle.ConstantTimeCompare([]byte(key), []byte(cfg.AdminAPIKey)) != 1 {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "admin key required"})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/hahahamid/broker-backend/config"
+	"github.com/hahahamid/broker-backend/internal/accounts"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/utils"
+)
+
+// AccountModes sends each request to the handler of the account mode it
+// selects with the X-Account-Mode header or its token's mode claim, live
+// by default. Token validity is left to the handlers.
+func AccountModes(cfg *config.Config, live, paper http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var claimed string
+		if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+			if token, err := utils.ValidateToken(strings.TrimPrefix(auth, "Bearer "), cfg.JWTSecret); err == nil && token.Valid {
+				claimed, _ = token.Claims.(jwt.MapClaims)["mode"].(string)
+			}
+		}
+		mode, err := utils.AccountMode(r.Header.Get("X-Account-Mode"), claimed)
+		if err != nil {
+			code := http.StatusBadRequest
+			if errors.Is(err, utils.ErrModeClaim) {
+				code = http.StatusForbidden
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(code)
+			_ = json.NewEncoder(w).Encode(gin.H{"error": err.Error()})
+			return
+		}
+		if mode == models.AccountPaper {
+			paper.ServeHTTP(w, r)
+			return
+		}
+		live.ServeHTTP(w, r)
+	})
+}
+
+// Account opens the caller's account in svc's mode the first time it is
+// used. It runs after JWTAuth.
+func Account(svc *accounts.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := svc.Open(c.Request.Context(), c.GetString("userID")); err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.Next()
+	}
+}
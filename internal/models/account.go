@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// Account modes. Each user has one account of each, kept in separate
+// databases with their own matching engine, so orders, holdings,
+// positions and cash never mix between them.
+const (
+	AccountLive  = "live"
+	AccountPaper = "paper" // virtual cash, for practice
+)
+
+// Account is a user's account in one mode. Paper accounts are opened with
+// virtual cash on first use and can have their balance reset.
+type Account struct {
+	UserID       string    `bson:"_id" json:"-"`
+	Mode         string    `bson:"mode" json:"mode"`
+	Currency     string    `bson:"currency" json:"currency"`
+	StartingCash float64   `bson:"starting_cash,omitempty" json:"starting_cash,omitempty"` // of the last opening or reset
+	Resets       int       `bson:"resets" json:"resets"`
+	Cash         float64   `bson:"-" json:"cash"`                  // in Currency, filled in when read
+	Unconverted  []string  `bson:"-" json:"unconverted,omitempty"` // cash currencies left out of Cash for want of a rate
+	OpenedAt     time.Time `bson:"opened_at" json:"opened_at,omitempty"`
+	ResetAt      time.Time `bson:"reset_at,omitempty" json:"reset_at,omitempty"`
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	AlertAbove         = "above"
+	AlertBelow         = "below"
+	AlertPercentChange = "percent_change" // move from previous close, either way
+	AlertVolumeSpike   = "volume_spike"   // last minute volume vs. recent average
+
+	AlertActive    = "active"
+	AlertTriggered = "triggered"
+
+	// RearmNone fires once; RearmAuto re-arms after the condition resets and
+	// the cooldown has passed.
+	RearmNone = "none"
+	RearmAuto = "auto"
+
+	ChannelInbox   = "inbox"
+	ChannelEmail   = "email"
+	ChannelWebhook = "webhook"
+)
+
+type Alert struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID          string             `bson:"user_id" json:"-"`
+	Symbol          string             `bson:"symbol" json:"symbol"`
+	Condition       string             `bson:"condition" json:"condition"`
+	Threshold       float64            `bson:"threshold" json:"threshold"`
+	Channels        []string           `bson:"channels" json:"channels"`
+	WebhookURL      string             `bson:"webhook_url,omitempty" json:"webhook_url,omitempty"`
+	Rearm           string             `bson:"rearm" json:"rearm"`
+	CooldownSec     int                `bson:"cooldown_sec" json:"cooldown_sec"`
+	Status          string             `bson:"status" json:"status"`
+	LastTriggeredAt time.Time          `bson:"last_triggered_at,omitempty" json:"last_triggered_at,omitempty"`
+	CreatedAt       time.Time          `bson:"created_at" json:"created_at"`
+}
+
+// AlertEvent records one firing of an alert.
+type AlertEvent struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	AlertID   primitive.ObjectID `bson:"alert_id" json:"alert_id"`
+	UserID    string             `bson:"user_id" json:"-"`
+	Symbol    string             `bson:"symbol" json:"symbol"`
+	Condition string             `bson:"condition" json:"condition"`
+	Threshold float64            `bson:"threshold" json:"threshold"`
+	Value     float64            `bson:"value" json:"value"` // price, % move or volume ratio that fired
+	Message   string             `bson:"message" json:"message"`
+	Time      time.Time          `bson:"time" json:"time"`
+}
+
+// Notification is an in-app inbox message.
+type Notification struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID    string             `bson:"user_id" json:"-"`
+	Title     string             `bson:"title" json:"title"`
+	Body      string             `bson:"body" json:"body"`
+	Read      bool               `bson:"read" json:"read"`
+	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
+}
@@ -0,0 +1,62 @@
+package models
+
+import "time"
+
+// BacktestFill is a fill from a backtest with what it cost and the cash
+// left afterwards.
+type BacktestFill struct {
+	Fill
+	Charges     float64 `json:"charges"`
+	RealizedPNL float64 `json:"realized_pnl"` // from the lots a sell closed
+	Cash        float64 `json:"cash"`
+}
+
+// EquityPoint is the account marked to market at one step of a backtest.
+type EquityPoint struct {
+	Time   time.Time `json:"time"`
+	Cash   float64   `json:"cash"`
+	Value  float64   `json:"value"` // open positions at their marks
+	Equity float64   `json:"equity"`
+	PNL    float64   `json:"pnl"` // equity less the starting cash
+}
+
+// BacktestPosition is a position still open when a backtest ended.
+type BacktestPosition struct {
+	Symbol        string  `json:"symbol"`
+	Quantity      float64 `json:"quantity"`
+	AvgPrice      float64 `json:"avg_price"`
+	LastPrice     float64 `json:"last_price"`
+	UnrealizedPNL float64 `json:"unrealized_pnl"`
+}
+
+// BacktestStats summarise a backtest. Sharpe is the mean over the standard
+// deviation of the returns between equity points, not annualised.
+type BacktestStats struct {
+	StartCash     float64 `json:"start_cash"`
+	FinalEquity   float64 `json:"final_equity"`
+	Return        float64 `json:"return"` // fraction of the starting cash
+	RealizedPNL   float64 `json:"realized_pnl"`
+	UnrealizedPNL float64 `json:"unrealized_pnl"`
+	Charges       float64 `json:"charges"`
+	NetPNL        float64 `json:"net_pnl"` // realized plus unrealized less charges
+	Orders        int     `json:"orders"`
+	Rejected      int     `json:"rejected"`
+	Fills         int     `json:"fills"`
+	Trades        int     `json:"trades"` // lots closed
+	Wins          int     `json:"wins"`   // lots closed at a profit
+	WinRate       float64 `json:"win_rate"`
+	MaxDrawdown   float64 `json:"max_drawdown"` // fraction of the peak equity
+	Sharpe        float64 `json:"sharpe"`
+	Events        int     `json:"events"` // quotes and trades replayed
+}
+
+type BacktestResult struct {
+	Strategy  string             `json:"strategy"`
+	Seed      int64              `json:"seed"`
+	Start     time.Time          `json:"start"`
+	End       time.Time          `json:"end"`
+	Stats     BacktestStats      `json:"stats"`
+	Positions []BacktestPosition `json:"positions"`
+	Fills     []BacktestFill     `json:"fills"`
+	Curve     []EquityPoint      `json:"curve"`
+}
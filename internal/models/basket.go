@@ -0,0 +1,72 @@
+package models
+
+import "time"
+
+// Basket is a set of orders placed together: either every leg passed
+// validation and was placed, or none was.
+type Basket struct {
+	ID        string    `bson:"_id" json:"id"`
+	UserID    string    `bson:"user_id" json:"user_id,omitempty"`
+	Name      string    `bson:"name,omitempty" json:"name,omitempty"`
+	OrderIDs  []string  `bson:"order_ids" json:"order_ids"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+	Orders    []Order   `bson:"-" json:"orders,omitempty"`
+}
+
+// BasketLeg is one leg's outcome in basket validation.
+type BasketLeg struct {
+	Order Order  `json:"order"`
+	Error string `json:"error,omitempty"`
+}
+
+// BasketFunds compares what a basket needs with the cash in one currency.
+type BasketFunds struct {
+	Currency  string  `json:"currency"`
+	Buys      float64 `json:"buys"`      // estimated cost of buys, with charges
+	Sells     float64 `json:"sells"`     // estimated proceeds of sells, net of charges
+	Required  float64 `json:"required"`  // buys less sells
+	Committed float64 `json:"committed"` // cash held by working buys
+	Available float64 `json:"available"` // cash less committed
+}
+
+// RebalanceTarget is the share of the portfolio, 0 to 1, a symbol should
+// make up.
+type RebalanceTarget struct {
+	Symbol string  `json:"symbol"`
+	Weight float64 `json:"weight"`
+}
+
+// RebalanceLeg is one symbol's move from its current to its target weight.
+// Values are in the base currency; quantities and prices in the
+// instrument's.
+type RebalanceLeg struct {
+	Symbol        string  `json:"symbol"`
+	Currency      string  `json:"currency"`
+	Price         float64 `json:"price"`
+	CurrentQty    float64 `json:"current_qty"`
+	CurrentValue  float64 `json:"current_value"`
+	CurrentWeight float64 `json:"current_weight"`
+	TargetWeight  float64 `json:"target_weight"`
+	TargetValue   float64 `json:"target_value"`
+	TargetQty     float64 `json:"target_qty"`
+	Side          string  `json:"side,omitempty"` // empty when no trade is needed
+	Quantity      float64 `json:"quantity"`
+}
+
+// RebalancePlan is the set of trades that moves a portfolio to its target
+// weights, and the basket they were placed as when executed.
+type RebalancePlan struct {
+	Currency   string         `json:"currency"`
+	TotalValue float64        `json:"total_value"` // holdings plus cash
+	Cash       float64        `json:"cash"`
+	CashWeight float64        `json:"cash_weight"` // what the targets leave in cash
+	Legs       []RebalanceLeg `json:"legs"`
+	Basket     *Basket        `json:"basket,omitempty"`
+}
+
+// BasketCheck is the outcome of validating a basket: every leg, with its
+// error if it failed, and the funds it needs per currency.
+type BasketCheck struct {
+	Legs  []BasketLeg   `json:"legs"`
+	Funds []BasketFunds `json:"funds"`
+}
@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+type Candle struct {
+	Symbol   string    `bson:"symbol" json:"symbol"`
+	Interval string    `bson:"interval" json:"interval"` // "1m", "5m", "15m", "1h" or "1d"
+	Start    time.Time `bson:"start" json:"start"`
+	Open     float64   `bson:"open" json:"open"`
+	High     float64   `bson:"high" json:"high"`
+	Low      float64   `bson:"low" json:"low"`
+	Close    float64   `bson:"close" json:"close"`
+	Volume   float64   `bson:"volume" json:"volume"`
+}
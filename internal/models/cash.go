@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+// Cash ledger entry types.
+const (
+	CashDividend   = "dividend"
+	CashDeposit    = "deposit"
+	CashWithdrawal = "withdrawal"
+	CashBuy        = "buy"          // trade consideration paid
+	CashSell       = "sell"         // trade consideration received
+	CashCharge     = "charge"       // fees on a trade; Note names the charge
+	CashFXOut      = "fx_out"       // currency sold in a conversion
+	CashFXIn       = "fx_in"        // currency bought in a conversion
+	CashInLieu     = "cash_in_lieu" // fractional shares sold off by a corporate action
+	CashBorrowFee  = "borrow_fee"   // daily fee on an open short
+	CashPaperFunds = "paper_funds"  // virtual cash set when a paper account is opened or reset
+
+	CashFundPurchase   = "fund_purchase"   // paid into a fund order, or refunded from one
+	CashFundRedemption = "fund_redemption" // proceeds of redeemed fund units
+)
+
+// CashEntry is one credit (positive Amount) or debit to a user's cash in
+// one currency's sub-ledger.
+type CashEntry struct {
+	ID        string    `bson:"_id" json:"id"`
+	UserID    string    `bson:"user_id" json:"-"`
+	Type      string    `bson:"type" json:"type"`
+	Currency  string    `bson:"currency,omitempty" json:"currency"`
+	Amount    float64   `bson:"amount" json:"amount"`
+	Symbol    string    `bson:"symbol,omitempty" json:"symbol,omitempty"`
+	Reference string    `bson:"reference,omitempty" json:"reference,omitempty"`
+	Note      string    `bson:"note,omitempty" json:"note,omitempty"`
+	Time      time.Time `bson:"time" json:"time"`
+}
+
+// FXConversion moves cash between two currency sub-ledgers at Rate units
+// of To per unit of From.
+type FXConversion struct {
+	ID        string    `json:"id"`
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Amount    float64   `json:"amount"` // debited, in From
+	Rate      float64   `json:"rate"`
+	Converted float64   `json:"converted"` // credited, in To
+	Time      time.Time `json:"time"`
+}
@@ -0,0 +1,37 @@
+package models
+
+// Order products; charges differ between them.
+const (
+	ProductDelivery = "delivery"
+	ProductIntraday = "intraday"
+)
+
+// Charge names, also used as the note on charge cash entries.
+const (
+	ChargeBrokerage      = "brokerage"
+	ChargeExchangeFee    = "exchange_fee"
+	ChargeStampDuty      = "stamp_duty"
+	ChargeTransactionTax = "transaction_tax"
+	ChargeGST            = "gst"
+)
+
+type Charge struct {
+	Name   string  `json:"name"`
+	Amount float64 `json:"amount"`
+}
+
+// ChargeEstimate breaks down what a trade of Value costs. NetAmount is
+// what the client pays for a buy or receives for a sell after charges.
+type ChargeEstimate struct {
+	Symbol    string   `json:"symbol"`
+	Currency  string   `json:"currency"`
+	Segment   string   `json:"segment"`
+	Product   string   `json:"product"`
+	Side      string   `json:"side"`
+	Quantity  float64  `json:"quantity"`
+	Price     float64  `json:"price"`
+	Value     float64  `json:"value"`
+	Charges   []Charge `json:"charges"`
+	Total     float64  `json:"total"`
+	NetAmount float64  `json:"net_amount"`
+}
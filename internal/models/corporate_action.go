@@ -0,0 +1,63 @@
+package models
+
+import "time"
+
+const (
+	ActionSplit        = "split"
+	ActionReverseSplit = "reverse_split"
+	ActionBonus        = "bonus"
+	ActionDividend     = "dividend"
+	ActionSymbolChange = "symbol_change"
+
+	ActionPending = "pending"
+	ActionApplied = "applied"
+)
+
+// CorporateAction is applied to every lot acquired before ExDate. Ratios
+// read "RatioNew for RatioOld": a 2-for-1 split is 2:1, a 1-for-10 reverse
+// split 1:10 and a 1:2 bonus issue one new share for every two held.
+type CorporateAction struct {
+	ID         string    `bson:"_id" json:"id"`
+	Symbol     string    `bson:"symbol" json:"symbol"`
+	Type       string    `bson:"type" json:"type"`
+	ExDate     time.Time `bson:"ex_date" json:"ex_date"`
+	RecordDate time.Time `bson:"record_date,omitempty" json:"record_date,omitempty"`
+	RatioNew   float64   `bson:"ratio_new,omitempty" json:"ratio_new,omitempty"`
+	RatioOld   float64   `bson:"ratio_old,omitempty" json:"ratio_old,omitempty"`
+	Amount     float64   `bson:"amount,omitempty" json:"amount,omitempty"` // dividend per share
+	NewSymbol  string    `bson:"new_symbol,omitempty" json:"new_symbol,omitempty"`
+	Status     string    `bson:"status" json:"status"`
+	AppliedAt  time.Time `bson:"applied_at,omitempty" json:"applied_at,omitempty"`
+	CreatedAt  time.Time `bson:"created_at" json:"created_at"`
+}
+
+// Factor is the multiplier the action applies to held quantity; cost per
+// share is divided by it so the total cost basis is unchanged.
+func (a CorporateAction) Factor() float64 {
+	switch a.Type {
+	case ActionSplit, ActionReverseSplit:
+		return a.RatioNew / a.RatioOld
+	case ActionBonus:
+		return (a.RatioOld + a.RatioNew) / a.RatioOld
+	}
+	return 1
+}
+
+// Adjustment is what one corporate action did to one user's holding.
+type Adjustment struct {
+	ID          string    `bson:"_id" json:"id"`
+	UserID      string    `bson:"user_id" json:"-"`
+	ActionID    string    `bson:"action_id" json:"action_id"`
+	Type        string    `bson:"type" json:"type"`
+	Symbol      string    `bson:"symbol" json:"symbol"`
+	NewSymbol   string    `bson:"new_symbol,omitempty" json:"new_symbol,omitempty"`
+	OldQty      float64   `bson:"old_qty" json:"old_qty"`
+	NewQty      float64   `bson:"new_qty" json:"new_qty"`
+	OldAvgPrice float64   `bson:"old_avg_price" json:"old_avg_price"`
+	NewAvgPrice float64   `bson:"new_avg_price" json:"new_avg_price"`
+	Cash        float64   `bson:"cash,omitempty" json:"cash,omitempty"`
+	Currency    string    `bson:"currency,omitempty" json:"currency,omitempty"` // of Cash and CashInLieu
+	FractionQty float64   `bson:"fraction_qty,omitempty" json:"fraction_qty,omitempty"`
+	CashInLieu  float64   `bson:"cash_in_lieu,omitempty" json:"cash_in_lieu,omitempty"` // for FractionQty
+	Time        time.Time `bson:"time" json:"time"`
+}
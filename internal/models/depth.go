@@ -0,0 +1,13 @@
+package models
+
+type PriceLevel struct {
+	Price    float64 `json:"price"`
+	Quantity float64 `json:"quantity"`
+	Orders   int     `json:"orders"`
+}
+
+type Depth struct {
+	Symbol string       `json:"symbol"`
+	Bids   []PriceLevel `json:"bids"` // best (highest) first
+	Asks   []PriceLevel `json:"asks"` // best (lowest) first
+}
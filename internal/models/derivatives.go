@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+// Greeks of one option per unit of the underlying. Theta is per calendar
+// day; vega and rho are per percentage point of volatility and rate.
+type Greeks struct {
+	Delta float64 `json:"delta"`
+	Gamma float64 `json:"gamma"`
+	Theta float64 `json:"theta"`
+	Vega  float64 `json:"vega"`
+	Rho   float64 `json:"rho"`
+}
+
+// OptionQuote is one side of an option chain row, priced at its mark.
+type OptionQuote struct {
+	Symbol     string  `json:"symbol"`
+	Bid        float64 `json:"bid"`
+	Ask        float64 `json:"ask"`
+	Mark       float64 `json:"mark"` // 0 when the option has no price yet
+	Intrinsic  float64 `json:"intrinsic"`
+	IV         float64 `json:"iv"`               // annual, 0.25 for 25%; 0 when the mark implies none
+	Greeks     *Greeks `json:"greeks,omitempty"` // at IV
+	Multiplier float64 `json:"multiplier"`
+}
+
+// OptionStrike pairs the call and put at one strike.
+type OptionStrike struct {
+	Strike float64      `json:"strike"`
+	Call   *OptionQuote `json:"call,omitempty"`
+	Put    *OptionQuote `json:"put,omitempty"`
+}
+
+// OptionChain lists an underlying's options for one expiry by strike.
+type OptionChain struct {
+	Underlying  string         `json:"underlying"`
+	Expiry      time.Time      `json:"expiry"`
+	Expiries    []time.Time    `json:"expiries"`         // every expiry with options listed
+	Spot        float64        `json:"spot"`             // the underlying's mark
+	Future      string         `json:"future,omitempty"` // the future of the same expiry
+	FuturePrice float64        `json:"future_price,omitempty"`
+	YearsLeft   float64        `json:"years_left"` // to the expiry session close
+	Rate        float64        `json:"rate"`       // risk-free rate used, continuously compounded
+	Strikes     []OptionStrike `json:"strikes"`
+}
@@ -0,0 +1,87 @@
+package models
+
+import "time"
+
+const (
+	AssetClassFund = "fund"
+
+	FundPurchase = "purchase"
+	FundRedeem   = "redeem"
+
+	FundOrderPending   = "pending"   // waiting for its trade date's NAV
+	FundOrderAllotting = "allotting" // priced at the NAV, units and cash being booked
+	FundOrderAllotted  = "allotted"  // units allotted or redeemed at the NAV
+	FundOrderCancelled = "cancelled"
+	FundOrderRejected  = "rejected"
+
+	SIPActive    = "active"
+	SIPPaused    = "paused"
+	SIPCancelled = "cancelled"
+)
+
+// Fund is a mutual fund scheme. Its units are held like shares, in lots
+// under Symbol, and are bought and redeemed at the NAV of the trade date
+// rather than on the book.
+type Fund struct {
+	Symbol      string  `bson:"_id" json:"symbol"`
+	Name        string  `bson:"name" json:"name"`
+	AMC         string  `bson:"amc,omitempty" json:"amc,omitempty"` // asset management company
+	Category    string  `bson:"category,omitempty" json:"category,omitempty"`
+	ISIN        string  `bson:"isin,omitempty" json:"isin,omitempty"`
+	Exchange    string  `bson:"exchange" json:"exchange"` // calendar whose trading days are NAV dates
+	Currency    string  `bson:"currency" json:"currency"`
+	Cutoff      string  `bson:"cutoff,omitempty" json:"cutoff,omitempty"` // HH:MM local; the session close when empty
+	MinPurchase float64 `bson:"min_purchase" json:"min_purchase"`
+	MinSIP      float64 `bson:"min_sip" json:"min_sip"`
+	NAV         float64 `bson:"nav" json:"nav"`                               // latest
+	NAVDate     string  `bson:"nav_date,omitempty" json:"nav_date,omitempty"` // YYYY-MM-DD
+}
+
+// NAV is a fund's net asset value per unit on one date.
+type NAV struct {
+	ID     string  `bson:"_id" json:"-"` // symbol:date
+	Symbol string  `bson:"symbol" json:"symbol"`
+	Date   string  `bson:"date" json:"date"` // YYYY-MM-DD
+	NAV    float64 `bson:"nav" json:"nav"`
+}
+
+// FundOrder is a purchase of Amount or a redemption of Units, processed at
+// the NAV of TradeDate: the request date when it comes in before the cut
+// off, else the next NAV date. A purchase's amount is debited up front and
+// anything not invested is refunded; a redemption's units are reserved
+// until they are sold.
+type FundOrder struct {
+	ID          string    `bson:"_id" json:"id"`
+	UserID      string    `bson:"user_id" json:"-"`
+	Symbol      string    `bson:"symbol" json:"symbol"`
+	Side        string    `bson:"side" json:"side"` // purchase or redeem
+	Amount      float64   `bson:"amount,omitempty" json:"amount,omitempty"`
+	Units       float64   `bson:"units,omitempty" json:"units,omitempty"`
+	NAV         float64   `bson:"nav,omitempty" json:"nav,omitempty"`
+	Currency    string    `bson:"currency" json:"currency"`
+	LotMethod   string    `bson:"lot_method,omitempty" json:"lot_method,omitempty"` // redemptions
+	TradeDate   string    `bson:"trade_date" json:"trade_date"`
+	Status      string    `bson:"status" json:"status"`
+	SIPID       string    `bson:"sip_id,omitempty" json:"sip_id,omitempty"`
+	Note        string    `bson:"note,omitempty" json:"note,omitempty"`
+	CreatedAt   time.Time `bson:"created_at" json:"created_at"`
+	ProcessedAt time.Time `bson:"processed_at,omitempty" json:"processed_at,omitempty"`
+}
+
+// SIP is a systematic investment plan: a purchase of Amount placed on Day
+// of every month.
+type SIP struct {
+	ID           string    `bson:"_id" json:"id"`
+	UserID       string    `bson:"user_id" json:"-"`
+	Symbol       string    `bson:"symbol" json:"symbol"`
+	Amount       float64   `bson:"amount" json:"amount"`
+	Day          int       `bson:"day" json:"day"` // 1-28
+	Status       string    `bson:"status" json:"status"`
+	NextDate     string    `bson:"next_date,omitempty" json:"next_date,omitempty"` // YYYY-MM-DD
+	Installments int       `bson:"installments" json:"installments"`               // purchases placed
+	Missed       int       `bson:"missed" json:"missed"`                           // due dates that failed
+	LastOrderID  string    `bson:"last_order_id,omitempty" json:"last_order_id,omitempty"`
+	LastError    string    `bson:"last_error,omitempty" json:"last_error,omitempty"`
+	CreatedAt    time.Time `bson:"created_at" json:"created_at"`
+	UpdatedAt    time.Time `bson:"updated_at" json:"updated_at"`
+}
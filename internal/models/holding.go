@@ -1,7 +1,23 @@
 package models
 
+// Holding is a symbol's open lots. Prices and PnL are in the instrument's
+// Currency; the Base fields convert them at FXRate to the base currency.
 type Holding struct {
-	Symbol   string  `json:"symbol"`
-	Quantity float64 `json:"quantity"`
-	AvgPrice float64 `json:"avg_price"`
+	Symbol            string  `json:"symbol"`
+	Currency          string  `json:"currency"`
+	Quantity          float64 `json:"quantity"`
+	SettledQty        float64 `json:"settled_qty"`
+	UnsettledQty      float64 `json:"unsettled_qty"` // bought, awaiting settlement
+	AvgPrice          float64 `json:"avg_price"`
+	LastPrice         float64 `json:"last_price"`
+	Value             float64 `json:"value"` // at LastPrice, or cost without a price
+	UnrealizedPNL     float64 `json:"unrealized_pnl"`
+	ShortTermQty      float64 `json:"short_term_qty"`
+	LongTermQty       float64 `json:"long_term_qty"`
+	FXRate            float64 `json:"fx_rate"` // base units per unit of Currency; 0 when unknown
+	BaseValue         float64 `json:"base_value"`
+	BaseUnrealizedPNL float64 `json:"base_unrealized_pnl"`
+	Multiplier        float64 `json:"multiplier,omitempty"` // units per contract, for futures and options
+	Contracts         float64 `json:"contracts,omitempty"`  // Quantity in contracts
+	Fund              bool    `json:"fund,omitempty"`       // Quantity is fund units and prices are NAVs
 }
@@ -0,0 +1,53 @@
+package models
+
+import "time"
+
+const (
+	InstrumentActive    = "active"
+	InstrumentHalted    = "halted"
+	InstrumentSuspended = "suspended"
+	InstrumentExpired   = "expired" // derivative past its expiry
+
+	AssetClassFuture = "future"
+	AssetClassOption = "option"
+
+	OptionCall = "CE"
+	OptionPut  = "PE"
+)
+
+type Instrument struct {
+	Symbol       string  `bson:"symbol" json:"symbol"`
+	Name         string  `bson:"name" json:"name"`
+	Exchange     string  `bson:"exchange" json:"exchange"`
+	ISIN         string  `bson:"isin" json:"isin"`
+	AssetClass   string  `bson:"asset_class" json:"asset_class"` // "equity", "etf", ...
+	Currency     string  `bson:"currency" json:"currency"`       // ISO code prices are quoted in
+	TickSize     float64 `bson:"tick_size" json:"tick_size"`
+	LotSize      float64 `bson:"lot_size" json:"lot_size"`
+	Fractional   bool    `bson:"fractional" json:"fractional"`                           // quantities below a whole share allowed
+	MinIncrement float64 `bson:"min_increment,omitempty" json:"min_increment,omitempty"` // fractional quantity step
+	PrevClose    float64 `bson:"prev_close" json:"prev_close"`
+	LowerBand    float64 `bson:"lower_band" json:"lower_band"`
+	UpperBand    float64 `bson:"upper_band" json:"upper_band"`
+	Status       string  `bson:"status" json:"status"` // "active", "halted", "suspended" or "expired"
+
+	// Futures and options. Quantities are in units of the underlying, and
+	// the contract multiplier, the units in one contract, is the lot size.
+	Underlying string    `bson:"underlying,omitempty" json:"underlying,omitempty"`
+	Expiry     time.Time `bson:"expiry,omitempty" json:"expiry,omitempty"` // last trading day, as a UTC date
+	Strike     float64   `bson:"strike,omitempty" json:"strike,omitempty"`
+	OptionType string    `bson:"option_type,omitempty" json:"option_type,omitempty"` // CE or PE
+	Multiplier float64   `bson:"multiplier,omitempty" json:"multiplier,omitempty"`
+}
+
+// IsDerivative reports whether inst is a future or an option.
+func (inst *Instrument) IsDerivative() bool {
+	return inst.AssetClass == AssetClassFuture || inst.AssetClass == AssetClassOption
+}
+
+// ExpiryDay is midday UTC on the expiry date, which falls on that date in
+// every exchange's time zone, for use with the trading calendar.
+func (inst *Instrument) ExpiryDay() time.Time {
+	y, m, d := inst.Expiry.UTC().Date()
+	return time.Date(y, m, d, 12, 0, 0, 0, time.UTC)
+}
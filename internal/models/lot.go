@@ -0,0 +1,69 @@
+package models
+
+import "time"
+
+// Lot selection methods for closing sells.
+const (
+	LotFIFO     = "fifo"
+	LotLIFO     = "lifo"
+	LotSpecific = "specific" // the order names the lots to close
+)
+
+// Holding period terms.
+const (
+	TermShort = "short_term"
+	TermLong  = "long_term"
+)
+
+// Lot is the open remainder of one buy fill, or for a short, of one short
+// sell fill with a negative Quantity. Its ID is the fill ID.
+type Lot struct {
+	ID         string    `bson:"_id" json:"id"`
+	UserID     string    `bson:"user_id" json:"-"`
+	Symbol     string    `bson:"symbol" json:"symbol"`
+	Exchange   string    `bson:"exchange,omitempty" json:"exchange,omitempty"`
+	Currency   string    `bson:"currency,omitempty" json:"currency,omitempty"`
+	OrderID    string    `bson:"order_id" json:"order_id"`
+	Quantity   float64   `bson:"quantity" json:"quantity"` // still open
+	OrigQty    float64   `bson:"orig_qty" json:"orig_qty"`
+	Price      float64   `bson:"price" json:"price"` // cost per unit; sale price for shorts
+	AcquiredAt time.Time `bson:"acquired_at" json:"acquired_at"`
+	SettleDate time.Time `bson:"settle_date,omitempty" json:"settle_date,omitempty"`
+	Settled    bool      `bson:"settled" json:"settled"`
+	Short      bool      `bson:"short,omitempty" json:"short,omitempty"`
+	Actions    []string  `bson:"actions,omitempty" json:"-"` // corporate actions applied
+	Term       string    `bson:"-" json:"term,omitempty"`
+}
+
+// ClosedLot records the part of a lot consumed by one sell fill, or of a
+// short covered by one buy fill. For a cover, CostPrice is the buy price,
+// SalePrice the short sale's and AcquiredAt when the short was opened.
+type ClosedLot struct {
+	ID          string    `bson:"_id" json:"id"`
+	UserID      string    `bson:"user_id" json:"-"`
+	Symbol      string    `bson:"symbol" json:"symbol"`
+	Currency    string    `bson:"currency,omitempty" json:"currency,omitempty"`
+	LotID       string    `bson:"lot_id" json:"lot_id"`
+	OrderID     string    `bson:"order_id" json:"order_id"` // the closing sell or cover
+	FillID      string    `bson:"fill_id" json:"fill_id"`
+	Quantity    float64   `bson:"quantity" json:"quantity"`
+	CostPrice   float64   `bson:"cost_price" json:"cost_price"`
+	SalePrice   float64   `bson:"sale_price" json:"sale_price"`
+	RealizedPNL float64   `bson:"realized_pnl" json:"realized_pnl"`
+	AcquiredAt  time.Time `bson:"acquired_at" json:"acquired_at"`
+	ClosedAt    time.Time `bson:"closed_at" json:"closed_at"`
+	Term        string    `bson:"term" json:"term"`
+	Short       bool      `bson:"short,omitempty" json:"short,omitempty"`
+}
+
+// PNLCard summarises a user's profit and loss in the base currency.
+type PNLCard struct {
+	Currency      string   `json:"currency"`
+	RealizedPNL   float64  `json:"realized_pnl"`
+	UnrealizedPNL float64  `json:"unrealized_pnl"`
+	ShortTermPNL  float64  `json:"short_term_pnl"`        // realized
+	LongTermPNL   float64  `json:"long_term_pnl"`         // realized
+	Charges       float64  `json:"charges"`               // all charges paid
+	NetPNL        float64  `json:"net_pnl"`               // realized + unrealized - charges
+	Unconverted   []string `json:"unconverted,omitempty"` // currencies left out for want of a rate
+}
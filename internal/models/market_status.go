@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+const (
+	SessionPreOpen   = "pre_open"
+	SessionRegular   = "regular"
+	SessionPostClose = "post_close"
+	SessionClosed    = "closed"
+)
+
+type MarketStatus struct {
+	Exchange   string    `json:"exchange"`
+	Session    string    `json:"session"`
+	IsOpen     bool      `json:"is_open"` // regular session in progress
+	TradingDay bool      `json:"trading_day"`
+	HalfDay    bool      `json:"half_day"`
+	NextOpen   time.Time `json:"next_open"`
+	NextClose  time.Time `json:"next_close"`
+}
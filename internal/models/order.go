@@ -1,11 +1,101 @@
 package models
 
+import "time"
+
+const (
+	OrderTypeLimit  = "limit"
+	OrderTypeMarket = "market"
+
+	ValidityDay = "day" // expires at the regular session close
+	ValidityGTC = "gtc"
+
+	OrderQueued          = "queued" // after-market order waiting for the open
+	OrderOpen            = "open"
+	OrderPartiallyFilled = "partially_filled"
+	OrderFilled          = "filled"
+	OrderCancelled       = "cancelled"
+	OrderRejected        = "rejected"
+	OrderExpired         = "expired"
+	OrderPaused          = "paused" // algo parent not slicing until resumed
+
+	AlgoTWAP = "twap" // even slices over the window
+	AlgoVWAP = "vwap" // slices following the symbol's intraday volume profile
+)
+
 type Order struct {
-	ID            string  `json:"id"`
-	Symbol        string  `json:"symbol"`
-	Side          string  `json:"side"` // "buy" or "sell"
-	Quantity      float64 `json:"quantity"`
-	Price         float64 `json:"price"`
-	RealizedPNL   float64 `json:"realized_pnl"`
-	UnrealizedPNL float64 `json:"unrealized_pnl"`
+	ID            string    `bson:"_id" json:"id"`
+	UserID        string    `bson:"user_id" json:"user_id,omitempty"`
+	Symbol        string    `bson:"symbol" json:"symbol"`
+	Exchange      string    `bson:"exchange,omitempty" json:"exchange,omitempty"`
+	Currency      string    `bson:"currency,omitempty" json:"currency,omitempty"`
+	Side          string    `bson:"side" json:"side"` // "buy" or "sell"
+	Type          string    `bson:"type" json:"type,omitempty"`
+	Quantity      float64   `bson:"quantity" json:"quantity"`
+	Notional      float64   `bson:"notional,omitempty" json:"notional,omitempty"` // amount to trade; Quantity is sized from it
+	Price         float64   `bson:"price" json:"price"`
+	DisplayQty    float64   `bson:"display_qty,omitempty" json:"display_qty,omitempty"` // iceberg: quantity shown in the book at a time
+	Validity      string    `bson:"validity" json:"validity,omitempty"`
+	AfterMarket   bool      `bson:"after_market" json:"after_market,omitempty"`
+	Product       string    `bson:"product,omitempty" json:"product,omitempty"`       // delivery or intraday
+	Short         bool      `bson:"short,omitempty" json:"short,omitempty"`           // sell borrowed shares
+	BuyIn         bool      `bson:"buy_in,omitempty" json:"buy_in,omitempty"`         // forced cover of a short
+	Settlement    bool      `bson:"settlement,omitempty" json:"settlement,omitempty"` // closes a contract at expiry
+	LotMethod     string    `bson:"lot_method,omitempty" json:"lot_method,omitempty"` // sells only
+	LotIDs        []string  `bson:"lot_ids,omitempty" json:"lot_ids,omitempty"`
+	ExpiresAt     time.Time `bson:"expires_at,omitempty" json:"expires_at,omitempty"`
+	Fractional    bool      `bson:"fractional,omitempty" json:"fractional,omitempty"`         // worked through the house account
+	HouseOrderID  string    `bson:"house_order_id,omitempty" json:"house_order_id,omitempty"` // house side of the allocation
+	BasketID      string    `bson:"basket_id,omitempty" json:"basket_id,omitempty"`
+	Algo          *Algo     `bson:"algo,omitempty" json:"algo,omitempty"`               // set on algo parent orders
+	ParentID      string    `bson:"parent_id,omitempty" json:"parent_id,omitempty"`     // algo parent of a child order
+	StrategyID    string    `bson:"strategy_id,omitempty" json:"strategy_id,omitempty"` // hosted strategy that placed it
+	FilledQty     float64   `bson:"filled_qty" json:"filled_qty"`
+	AvgFillPrice  float64   `bson:"avg_fill_price" json:"avg_fill_price"`
+	Status        string    `bson:"status" json:"status,omitempty"`
+	CreatedAt     time.Time `bson:"created_at" json:"created_at"`
+	UpdatedAt     time.Time `bson:"updated_at" json:"updated_at"`
+	RealizedPNL   float64   `bson:"-" json:"realized_pnl"`
+	UnrealizedPNL float64   `bson:"-" json:"unrealized_pnl"`
+}
+
+// Algo is how a parent order is worked: its window is cut into slices and
+// each slice sends one child order for the quantity the schedule calls for
+// by then, less what has filled. A child works until the next slice, when
+// whatever is left of it is cancelled and rolled into the schedule.
+type Algo struct {
+	Strategy         string    `bson:"strategy" json:"strategy"` // twap or vwap
+	StartAt          time.Time `bson:"start_at" json:"start_at"`
+	EndAt            time.Time `bson:"end_at" json:"end_at"`
+	SliceSeconds     int       `bson:"slice_seconds" json:"slice_seconds"`
+	MaxParticipation float64   `bson:"max_participation,omitempty" json:"max_participation,omitempty"` // cap on a slice as a share of market volume in the previous slice
+	Step             float64   `bson:"step" json:"step"`                                               // quantity children are rounded down to
+	Schedule         []float64 `bson:"schedule" json:"schedule"`                                       // cumulative share due by the end of each slice
+	Slice            int       `bson:"slice" json:"slice"`                                             // slices sent so far
+	ChildID          string    `bson:"child_id,omitempty" json:"child_id,omitempty"`                   // working child
+	Note             string    `bson:"note,omitempty" json:"note,omitempty"`                           // why the last slice sent nothing
+}
+
+// Remaining is the quantity still working in the book.
+func (o *Order) Remaining() float64 {
+	return o.Quantity - o.FilledQty
+}
+
+type Fill struct {
+	ID       string    `bson:"_id" json:"id"`
+	OrderID  string    `bson:"order_id" json:"order_id"`
+	UserID   string    `bson:"user_id" json:"user_id"`
+	Symbol   string    `bson:"symbol" json:"symbol"`
+	Exchange string    `bson:"exchange,omitempty" json:"exchange,omitempty"`
+	Currency string    `bson:"currency,omitempty" json:"currency,omitempty"`
+	Side     string    `bson:"side" json:"side"`
+	Product  string    `bson:"product,omitempty" json:"product,omitempty"`
+	Price    float64   `bson:"price" json:"price"`
+	Quantity float64   `bson:"quantity" json:"quantity"`
+	Time     time.Time `bson:"time" json:"time"`
+
+	StrategyID string `bson:"strategy_id,omitempty" json:"strategy_id,omitempty"` // copied from the order
+
+	// Allocation marks a transfer to or from the house account rather than
+	// an execution on the book.
+	Allocation bool `bson:"allocation,omitempty" json:"allocation,omitempty"`
 }
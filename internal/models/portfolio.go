@@ -0,0 +1,52 @@
+package models
+
+import "time"
+
+// PortfolioSnapshot is a user's portfolio as marked at the end of a trading
+// day. Totals are in the base Currency.
+type PortfolioSnapshot struct {
+	ID            string            `bson:"_id" json:"-"` // user:date
+	UserID        string            `bson:"user_id" json:"-"`
+	Date          string            `bson:"date" json:"date"` // YYYY-MM-DD
+	Currency      string            `bson:"currency,omitempty" json:"currency"`
+	Time          time.Time         `bson:"time" json:"time"`
+	Holdings      []SnapshotHolding `bson:"holdings" json:"holdings"`
+	HoldingsValue float64           `bson:"holdings_value" json:"holdings_value"`
+	Cash          float64           `bson:"cash" json:"cash"`
+	TotalValue    float64           `bson:"total_value" json:"total_value"`
+	NetFlow       float64           `bson:"net_flow" json:"net_flow"` // deposits less withdrawals since the previous snapshot
+	DayChange     float64           `bson:"day_change" json:"day_change"`
+	Unconverted   []string          `bson:"unconverted,omitempty" json:"unconverted,omitempty"` // currencies left out of the base totals for want of a rate
+}
+
+// SnapshotHolding is priced in its own Currency; BaseValue is in the
+// snapshot's.
+type SnapshotHolding struct {
+	Symbol    string  `bson:"symbol" json:"symbol"`
+	Currency  string  `bson:"currency,omitempty" json:"currency"`
+	Quantity  float64 `bson:"quantity" json:"quantity"`
+	AvgPrice  float64 `bson:"avg_price" json:"avg_price"`
+	Price     float64 `bson:"price" json:"price"`
+	Value     float64 `bson:"value" json:"value"`
+	BaseValue float64 `bson:"base_value" json:"base_value"`
+}
+
+// Performance summarises returns over a range of snapshots, adjusted for
+// deposits and withdrawals.
+type Performance struct {
+	From          time.Time `json:"from"`
+	To            time.Time `json:"to"`
+	StartValue    float64   `json:"start_value"`
+	EndValue      float64   `json:"end_value"`
+	NetFlows      float64   `json:"net_flows"`
+	TWR           float64   `json:"twr"`  // time-weighted return, as a fraction
+	XIRR          float64   `json:"xirr"` // annualised money-weighted return
+	MaxDrawdown   float64   `json:"max_drawdown"`
+	DrawdownPeak  time.Time `json:"drawdown_peak,omitempty"`
+	DrawdownLow   time.Time `json:"drawdown_low,omitempty"`
+	DayChange     float64   `json:"day_change"` // live value against the last snapshot
+	DayChangePct  float64   `json:"day_change_pct"`
+	CurrentValue  float64   `json:"current_value"`
+	SnapshotCount int       `json:"snapshot_count"`
+	Unconverted   []string  `json:"unconverted,omitempty"` // currencies left out of CurrentValue and DayChange
+}
@@ -1,8 +1,19 @@
 package models
 
+// Position is a symbol's trading activity for the current day. Open shorts
+// always show, with a negative quantity.
 type Position struct {
-	Symbol   string  `json:"symbol"`
-	Quantity float64 `json:"quantity"`
-	AvgPrice float64 `json:"avg_price"`
-	PNL      float64 `json:"pnl"`
+	Symbol        string  `json:"symbol"`
+	Currency      string  `json:"currency"`
+	Quantity      float64 `json:"quantity"`              // bought minus sold today, plus CarriedQty
+	CarriedQty    float64 `json:"carried_qty,omitempty"` // short quantity open at the start of the day
+	AvgPrice      float64 `json:"avg_price"`
+	PNL           float64 `json:"pnl"`
+	BuyQty        float64 `json:"buy_qty"`
+	SellQty       float64 `json:"sell_qty"`
+	RealizedPNL   float64 `json:"realized_pnl"`
+	UnrealizedPNL float64 `json:"unrealized_pnl"`
+	Multiplier    float64 `json:"multiplier,omitempty"` // units per contract, for futures and options
+	Contracts     float64 `json:"contracts,omitempty"`  // Quantity in contracts
+	Fund          bool    `json:"fund,omitempty"`       // Quantity is fund units and prices are NAVs
 }
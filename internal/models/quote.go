@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+type Quote struct {
+	Symbol  string    `json:"symbol"`
+	Bid     float64   `json:"bid"`
+	Ask     float64   `json:"ask"`
+	BidSize float64   `json:"bid_size"`
+	AskSize float64   `json:"ask_size"`
+	Time    time.Time `json:"time"`
+}
+
+type Trade struct {
+	Symbol   string    `bson:"symbol" json:"symbol"`
+	Price    float64   `bson:"price" json:"price"`
+	Quantity float64   `bson:"quantity" json:"quantity"`
+	Time     time.Time `bson:"time" json:"time"`
+}
@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// SettlementRun is one end-of-day settlement of an exchange's trading day.
+type SettlementRun struct {
+	ID          string             `bson:"_id" json:"id"` // exchange:date
+	Exchange    string             `bson:"exchange" json:"exchange"`
+	TradingDate string             `bson:"trading_date" json:"trading_date"` // YYYY-MM-DD
+	RanAt       time.Time          `bson:"ran_at" json:"ran_at"`
+	Results     []SettlementResult `bson:"results" json:"results"`
+}
+
+// SettlementResult is the quantity of one user's symbol that settled.
+type SettlementResult struct {
+	UserID   string  `bson:"user_id" json:"user_id"`
+	Symbol   string  `bson:"symbol" json:"symbol"`
+	Quantity float64 `bson:"quantity" json:"quantity"`
+	Value    float64 `bson:"value" json:"value"` // at cost
+}
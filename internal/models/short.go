@@ -0,0 +1,23 @@
+package models
+
+// Locate is how much of an instrument can be borrowed for short selling
+// and at what fee.
+type Locate struct {
+	Symbol    string  `bson:"symbol" json:"symbol"`
+	Quantity  float64 `bson:"quantity" json:"quantity"` // total borrowable
+	FeeRate   float64 `bson:"fee_rate" json:"fee_rate"` // annual %, on the short's market value
+	Borrowed  float64 `bson:"-" json:"borrowed"`        // open shorts and working short sells
+	Available float64 `bson:"-" json:"available"`
+}
+
+// ShortPosition is a user's open short in one symbol.
+type ShortPosition struct {
+	Symbol        string  `json:"symbol"`
+	Currency      string  `json:"currency"`
+	Quantity      float64 `json:"quantity"`  // negative
+	AvgPrice      float64 `json:"avg_price"` // average sale price
+	LastPrice     float64 `json:"last_price"`
+	UnrealizedPNL float64 `json:"unrealized_pnl"`
+	FeeRate       float64 `json:"fee_rate"`
+	AccruedFees   float64 `json:"accrued_fees"` // borrow fees charged so far on this symbol
+}
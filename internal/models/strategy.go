@@ -0,0 +1,61 @@
+package models
+
+import "time"
+
+const (
+	StrategyRunning = "running"
+	StrategyStopped = "stopped"
+	StrategyKilled  = "killed" // by its owner, the kill switch or a breached limit
+)
+
+// StrategyLimits bound a hosted strategy. Zero order counts and callback
+// time take the server defaults; zero value, position and loss limits
+// leave them uncapped.
+type StrategyLimits struct {
+	MaxOrdersPerMinute int     `bson:"max_orders_per_minute" json:"max_orders_per_minute"`
+	MaxOpenOrders      int     `bson:"max_open_orders" json:"max_open_orders"`
+	MaxOrderValue      float64 `bson:"max_order_value" json:"max_order_value"` // quantity times limit price or mark
+	MaxPosition        float64 `bson:"max_position" json:"max_position"`       // units per symbol, long or short
+	MaxLoss            float64 `bson:"max_loss" json:"max_loss"`               // killed once its PnL falls below -MaxLoss
+	CallbackMS         int     `bson:"callback_ms" json:"callback_ms"`         // killed when a callback runs longer
+}
+
+// StrategyPosition is what a strategy's own fills add up to in a symbol,
+// at average cost.
+type StrategyPosition struct {
+	Symbol        string  `bson:"symbol" json:"symbol"`
+	Quantity      float64 `bson:"quantity" json:"quantity"` // negative when net short
+	AvgPrice      float64 `bson:"avg_price" json:"avg_price"`
+	LastPrice     float64 `bson:"-" json:"last_price"`
+	RealizedPNL   float64 `bson:"realized_pnl" json:"realized_pnl"`
+	UnrealizedPNL float64 `bson:"-" json:"unrealized_pnl"`
+}
+
+// Strategy is one hosted run of a registered strategy on a user's account.
+// PnL is attributed from the fills of the orders it placed, apart from the
+// account's lots, so several strategies can share a symbol.
+type Strategy struct {
+	ID           string             `bson:"_id" json:"id"`
+	UserID       string             `bson:"user_id" json:"-"`
+	Name         string             `bson:"name" json:"name"`
+	Params       map[string]string  `bson:"params,omitempty" json:"params,omitempty"`
+	Symbols      []string           `bson:"symbols" json:"symbols"` // quotes it receives and may trade
+	Limits       StrategyLimits     `bson:"limits" json:"limits"`
+	TimerSeconds int                `bson:"timer_seconds" json:"timer_seconds"`
+	Seed         int64              `bson:"seed" json:"seed"`
+	Status       string             `bson:"status" json:"status"`
+	Reason       string             `bson:"reason,omitempty" json:"reason,omitempty"` // why it stopped
+	Orders       int                `bson:"orders" json:"orders"`
+	Rejected     int                `bson:"rejected" json:"rejected"` // by its limits or the order checks
+	Fills        int                `bson:"fills" json:"fills"`
+	Volume       float64            `bson:"volume" json:"volume"` // value traded
+	Positions    []StrategyPosition `bson:"positions" json:"positions"`
+	RealizedPNL  float64            `bson:"realized_pnl" json:"realized_pnl"`
+	Charges      float64            `bson:"charges" json:"charges"` // charged on its fills
+	// UnrealizedPNL and PNL, net of charges, are marked when read.
+	UnrealizedPNL float64   `bson:"-" json:"unrealized_pnl"`
+	PNL           float64   `bson:"-" json:"pnl"`
+	StartedAt     time.Time `bson:"started_at" json:"started_at"`
+	StoppedAt     time.Time `bson:"stopped_at,omitempty" json:"stopped_at,omitempty"`
+	UpdatedAt     time.Time `bson:"updated_at" json:"updated_at"`
+}
@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// CapitalGains is a user's realized gains for one financial year, split
+// into short and long term by holding period.
+type CapitalGains struct {
+	FinancialYear string            `json:"financial_year"` // e.g. "2025" or "2025-26"
+	From          string            `json:"from"`           // first day, YYYY-MM-DD
+	To            string            `json:"to"`             // last day, inclusive
+	ShortTermGain float64           `json:"short_term_gain"`
+	LongTermGain  float64           `json:"long_term_gain"`
+	TotalGain     float64           `json:"total_gain"`
+	Instruments   []InstrumentGains `json:"instruments"`
+	Entries       []GainEntry       `json:"entries"`
+}
+
+// InstrumentGains totals one symbol's realized lots in the year.
+type InstrumentGains struct {
+	Symbol        string  `json:"symbol"`
+	Quantity      float64 `json:"quantity"`
+	Cost          float64 `json:"cost"`
+	Proceeds      float64 `json:"proceeds"`
+	ShortTermGain float64 `json:"short_term_gain"`
+	LongTermGain  float64 `json:"long_term_gain"`
+	TotalGain     float64 `json:"total_gain"`
+}
+
+// GainEntry is one closed lot as reported for tax.
+type GainEntry struct {
+	Symbol      string    `json:"symbol"`
+	LotID       string    `json:"lot_id"`
+	OrderID     string    `json:"order_id"` // the sell
+	Quantity    float64   `json:"quantity"`
+	AcquiredAt  time.Time `json:"acquired_at"`
+	SoldAt      time.Time `json:"sold_at"`
+	HoldingDays int       `json:"holding_days"`
+	CostPrice   float64   `json:"cost_price"`
+	SalePrice   float64   `json:"sale_price"`
+	Cost        float64   `json:"cost"`
+	Proceeds    float64   `json:"proceeds"`
+	Gain        float64   `json:"gain"`
+	Term        string    `json:"term"`
+}
@@ -7,4 +7,5 @@ type User struct {
 	Email        string             `bson:"email" json:"email"`
 	PasswordHash string             `bson:"password_hash" json:"-"`
 	RefreshToken string             `bson:"refresh_token,omitempty" json:"-"`
+	Margin       bool               `bson:"margin,omitempty" json:"margin"` // may sell short
 }
@@ -0,0 +1,486 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/calendar"
+	"github.com/hahahamid/broker-backend/internal/fractional"
+	"github.com/hahahamid/broker-backend/internal/instruments"
+	"github.com/hahahamid/broker-backend/internal/lots"
+	"github.com/hahahamid/broker-backend/internal/matching"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+	"github.com/hahahamid/broker-backend/internal/shorts"
+	"github.com/hahahamid/broker-backend/internal/utils"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrRejected wraps every reason an order is refused before reaching the
+// matching engine.
+var ErrRejected = errors.New("order rejected")
+
+// Parents works algo parent orders, which never reach the engine.
+type Parents interface {
+	// Cancel cancels a parent order, reporting false when orderID is not
+	// one of the user's parents.
+	Cancel(ctx context.Context, userID, orderID string) (models.Order, bool, error)
+	CancelSymbol(ctx context.Context, symbol string) error
+}
+
+// Funds holds cash for buy orders while they work. Holds are released on
+// the order's own updates once it reaches the engine.
+type Funds interface {
+	// Reserve holds what the order is expected to cost, refusing it when
+	// the user's cash not already held cannot pay for it.
+	Reserve(ctx context.Context, o models.Order) error
+	// Track holds the cost of a restored order without checking it.
+	Track(ctx context.Context, o models.Order)
+	// Release frees what an order still holds.
+	Release(orderID string)
+}
+
+// Service validates orders, routes them to the matching engine and persists
+// the resulting order updates and fills. Orders placed outside the regular
+// session are rejected unless flagged after-market, in which case they are
+// queued until the exchange opens. DAY orders expire at the session close.
+// Sells must be covered by holdings not already reserved by other sells,
+// and buys by cash not already held by other buys; buy-ins are exempt.
+// Notional orders are sized from the current price, and orders for a
+// fraction of a share go to the house account instead of the book. Short
+// sells are approved against the locate list instead of holdings.
+type Service struct {
+	repo   repository.Repo
+	engine *matching.Engine
+	cal    *calendar.Calendar
+	lots   *lots.Service
+	house  *fractional.Service
+	shorts *shorts.Service
+	algos  Parents
+	funds  Funds
+	events *utils.Queue[interface{}]
+
+	mu      sync.Mutex
+	queued  map[string]models.Order // after-market orders by ID
+	expires map[string]time.Time    // resting DAY orders by ID
+}
+
+func NewService(repo repository.Repo, engine *matching.Engine, cal *calendar.Calendar, lotSvc *lots.Service, house *fractional.Service, shortSvc *shorts.Service) *Service {
+	s := &Service{
+		repo:    repo,
+		engine:  engine,
+		cal:     cal,
+		lots:    lotSvc,
+		house:   house,
+		shorts:  shortSvc,
+		events:  utils.NewQueue[interface{}](time.Second),
+		queued:  map[string]models.Order{},
+		expires: map[string]time.Time{},
+	}
+	engine.AddListener(s)
+	return s
+}
+
+// SetParents sets who works algo parent orders. The algo service places
+// its children through this one, so it is wired in after both are built.
+func (s *Service) SetParents(p Parents) {
+	s.algos = p
+}
+
+// SetFunds sets who holds cash for buy orders. It is set before Restore,
+// so restored orders hold their cost again; without it buys are not
+// checked against cash.
+func (s *Service) SetFunds(f Funds) {
+	s.funds = f
+}
+
+// Restore rests the stored open orders in the engine after a restart and
+// reloads the after-market queue.
+func (s *Service) Restore(ctx context.Context) error {
+	list, err := s.repo.ListOrdersByStatus(ctx, models.OrderOpen, models.OrderPartiallyFilled, models.OrderQueued)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, o := range list {
+		if o.Algo != nil {
+			continue // restored by the algo service
+		}
+		s.lots.Track(o)
+		if s.holdsCash(o) {
+			s.funds.Track(ctx, o)
+		}
+		if o.Status == models.OrderQueued {
+			s.queued[o.ID] = o
+			continue
+		}
+		if o.Fractional {
+			s.house.Add(o, o.UpdatedAt)
+			continue
+		}
+		s.engine.Load(o)
+		if !o.ExpiresAt.IsZero() {
+			s.expires[o.ID] = o.ExpiresAt
+		}
+	}
+	return nil
+}
+
+func (s *Service) Place(ctx context.Context, userID string, o models.Order) (models.Order, error) {
+	return s.place(ctx, userID, o, true)
+}
+
+// PlaceFunded places an order whose cash the caller has already checked: a
+// buy holds its cost without the balance being checked again. Baskets use
+// it, since their buys are checked together, net of their sells.
+func (s *Service) PlaceFunded(ctx context.Context, userID string, o models.Order) (models.Order, error) {
+	return s.place(ctx, userID, o, false)
+}
+
+func (s *Service) place(ctx context.Context, userID string, o models.Order, checkCash bool) (models.Order, error) {
+	o, err := s.Check(ctx, userID, o)
+	if err != nil {
+		return o, err
+	}
+	if o.Short {
+		err = s.shorts.Reserve(ctx, &o)
+	} else {
+		err = s.lots.Reserve(&o)
+	}
+	if err != nil {
+		return o, fmt.Errorf("%w: %v", ErrRejected, err)
+	}
+	switch {
+	case !s.holdsCash(o):
+	case checkCash:
+		if err := s.funds.Reserve(ctx, o); err != nil {
+			s.lots.Release(o.ID)
+			return o, fmt.Errorf("%w: %v", ErrRejected, err)
+		}
+	default:
+		s.funds.Track(ctx, o)
+	}
+	now := time.Now()
+	if !s.cal.Status(o.Exchange, now).IsOpen {
+		return s.queue(ctx, o, now)
+	}
+	return s.submit(o, now), nil
+}
+
+// holdsCash reports whether o holds cash while it works: buys do, apart
+// from forced buy-ins, which must go through whatever the balance.
+func (s *Service) holdsCash(o models.Order) bool {
+	return s.funds != nil && o.Side == "buy" && !o.BuyIn
+}
+
+// release frees what an order that never reached the engine reserved.
+func (s *Service) release(orderID string) {
+	s.lots.Release(orderID)
+	if s.funds != nil {
+		s.funds.Release(orderID)
+	}
+}
+
+// Check validates and fills in an order the way Place does, including
+// whether its market is open, but neither reserves holdings, borrow or
+// cash for it nor submits it. The order comes back with its ID assigned.
+func (s *Service) Check(ctx context.Context, userID string, o models.Order) (models.Order, error) {
+	if err := Normalize(&o); err != nil {
+		return o, err
+	}
+	inst, err := s.repo.GetInstrument(ctx, o.Symbol)
+	if errors.Is(err, repository.ErrNotFound) {
+		inst = nil
+	} else if err != nil {
+		return o, err
+	}
+	if inst != nil && inst.AssetClass == models.AssetClassFund {
+		return o, fmt.Errorf("%w: %s is a mutual fund; buy and redeem it through /fund-orders", ErrRejected, o.Symbol)
+	}
+	if inst != nil && o.Notional != 0 {
+		switch {
+		case o.Notional < 0:
+			return o, fmt.Errorf("%w: notional must be positive", ErrRejected)
+		case o.Quantity != 0:
+			return o, fmt.Errorf("%w: give either a quantity or a notional, not both", ErrRejected)
+		case o.Type != models.OrderTypeMarket:
+			return o, fmt.Errorf("%w: notional orders are market orders", ErrRejected)
+		}
+		if err := s.house.Size(&o, inst); err != nil {
+			return o, fmt.Errorf("%w: %v", ErrRejected, err)
+		}
+	}
+	now := time.Now()
+	if err := Validate(&o, inst, s.cal, now); err != nil {
+		return o, err
+	}
+
+	o.ID = primitive.NewObjectID().Hex()
+	o.UserID = userID
+	o.Exchange = inst.Exchange
+	o.Currency = inst.Currency
+	o.FilledQty, o.AvgFillPrice = 0, 0
+	o.ExpiresAt = time.Time{}
+	o.HouseOrderID = ""
+
+	st := s.cal.Status(o.Exchange, now)
+	if !st.IsOpen && !o.AfterMarket {
+		return o, fmt.Errorf("%w: %s is closed (%s), next open %s; place it as an after-market order to queue it",
+			ErrRejected, o.Exchange, st.Session, st.NextOpen.Format(time.RFC3339))
+	}
+	return o, nil
+}
+
+// Normalize upper-cases the symbol, lower-cases the side and fills in the
+// default type, product and validity, rejecting values it does not know.
+func Normalize(o *models.Order) error {
+	o.Symbol = strings.ToUpper(strings.TrimSpace(o.Symbol))
+	o.Side = strings.ToLower(o.Side)
+	if o.Side != "buy" && o.Side != "sell" {
+		return fmt.Errorf("%w: side must be buy or sell", ErrRejected)
+	}
+	if o.Type == "" {
+		o.Type = models.OrderTypeLimit
+		if o.Price == 0 {
+			o.Type = models.OrderTypeMarket
+		}
+	}
+	switch o.Type {
+	case models.OrderTypeLimit:
+		if o.Price <= 0 {
+			return fmt.Errorf("%w: limit orders need a price", ErrRejected)
+		}
+	case models.OrderTypeMarket:
+		o.Price = 0
+	default:
+		return fmt.Errorf("%w: unknown order type %q", ErrRejected, o.Type)
+	}
+	switch o.Product {
+	case "":
+		o.Product = models.ProductDelivery
+	case models.ProductDelivery, models.ProductIntraday:
+	default:
+		return fmt.Errorf("%w: product must be delivery or intraday", ErrRejected)
+	}
+	switch o.Validity {
+	case "":
+		o.Validity = models.ValidityDay
+	case models.ValidityDay, models.ValidityGTC:
+	default:
+		return fmt.Errorf("%w: validity must be day or gtc", ErrRejected)
+	}
+	return nil
+}
+
+// Validate checks a normalized order against its instrument, nil when the
+// symbol is unknown: price band, tick and lot size, and the rules for
+// fractional, short, iceberg and expired derivative orders. It sets
+// o.Fractional. Holdings, borrow and market hours are checked elsewhere.
+func Validate(o *models.Order, inst *models.Instrument, cal *calendar.Calendar, now time.Time) error {
+	if err := instruments.ValidateOrder(inst, o.Quantity, o.Price); err != nil {
+		return fmt.Errorf("%w: %v", ErrRejected, err)
+	}
+	o.Fractional = instruments.IsFractional(inst, o.Quantity)
+	if o.Short {
+		switch {
+		case o.Side != "sell":
+			return fmt.Errorf("%w: only sells can be short", ErrRejected)
+		case o.Fractional:
+			return fmt.Errorf("%w: short sells are whole shares", ErrRejected)
+		}
+	}
+	if o.DisplayQty != 0 {
+		switch {
+		case o.Type != models.OrderTypeLimit:
+			return fmt.Errorf("%w: iceberg orders are limit orders", ErrRejected)
+		case o.DisplayQty < 0 || o.DisplayQty >= o.Quantity:
+			return fmt.Errorf("%w: display_qty must be positive and below the quantity", ErrRejected)
+		case o.Fractional || instruments.IsFractional(inst, o.DisplayQty):
+			return fmt.Errorf("%w: iceberg quantities must be multiples of lot size %g", ErrRejected, inst.LotSize)
+		}
+	}
+	if o.Fractional {
+		if o.Type != models.OrderTypeMarket {
+			return fmt.Errorf("%w: fractional orders are market orders", ErrRejected)
+		}
+		if o.Product != models.ProductDelivery {
+			return fmt.Errorf("%w: fractional orders are delivery only", ErrRejected)
+		}
+	}
+
+	if inst.IsDerivative() && !now.Before(cal.SessionClose(inst.Exchange, inst.ExpiryDay())) {
+		return fmt.Errorf("%w: %s expired on %s", ErrRejected, o.Symbol, inst.Expiry.Format(time.DateOnly))
+	}
+	return nil
+}
+
+// queue stores an after-market order until the exchange's next open.
+func (s *Service) queue(ctx context.Context, o models.Order, now time.Time) (models.Order, error) {
+	o.Status = models.OrderQueued
+	o.CreatedAt, o.UpdatedAt = now, now
+	if err := s.repo.SaveOrder(ctx, o); err != nil {
+		s.release(o.ID)
+		return o, err
+	}
+	s.mu.Lock()
+	s.queued[o.ID] = o
+	s.mu.Unlock()
+	return o, nil
+}
+
+func (s *Service) submit(o models.Order, now time.Time) models.Order {
+	if o.Fractional {
+		o = s.house.Add(o, now)
+		s.enqueue(o)
+		return o
+	}
+	if o.Validity == models.ValidityDay && o.Type == models.OrderTypeLimit {
+		o.ExpiresAt = s.cal.SessionClose(o.Exchange, now)
+	}
+	out := s.engine.Submit(o)
+	if !o.ExpiresAt.IsZero() && (out.Status == models.OrderOpen || out.Status == models.OrderPartiallyFilled) {
+		s.mu.Lock()
+		s.expires[o.ID] = o.ExpiresAt
+		s.mu.Unlock()
+	}
+	return out
+}
+
+func (s *Service) Cancel(ctx context.Context, userID, orderID string) (models.Order, error) {
+	if s.algos != nil {
+		if p, ok, err := s.algos.Cancel(ctx, userID, orderID); ok {
+			return p, err
+		}
+	}
+	s.mu.Lock()
+	if q, ok := s.queued[orderID]; ok && q.UserID == userID {
+		delete(s.queued, orderID)
+		s.mu.Unlock()
+		s.release(orderID)
+		q.Status = models.OrderCancelled
+		q.UpdatedAt = time.Now()
+		return q, s.repo.SaveOrder(ctx, q)
+	}
+	s.mu.Unlock()
+	if f, ok := s.house.Cancel(userID, orderID); ok {
+		s.release(orderID)
+		f.Status = models.OrderCancelled
+		f.UpdatedAt = time.Now()
+		return f, s.repo.SaveOrder(ctx, f)
+	}
+
+	o, ok := s.engine.Order(orderID)
+	if !ok || o.UserID != userID {
+		return models.Order{}, matching.ErrOrderNotFound
+	}
+	s.mu.Lock()
+	delete(s.expires, orderID)
+	s.mu.Unlock()
+	return s.engine.Cancel(orderID)
+}
+
+// CancelSymbol cancels every working and queued order in symbol, as
+// exchanges do ahead of a corporate action that changes the share count.
+func (s *Service) CancelSymbol(ctx context.Context, symbol string) error {
+	// Parents first, so they send no more children.
+	if s.algos != nil {
+		if err := s.algos.CancelSymbol(ctx, symbol); err != nil {
+			return err
+		}
+	}
+	s.mu.Lock()
+	var queued []models.Order
+	for id, o := range s.queued {
+		if o.Symbol == symbol {
+			queued = append(queued, o)
+			delete(s.queued, id)
+		}
+	}
+	s.mu.Unlock()
+	queued = append(queued, s.house.CancelSymbol(symbol)...)
+	for _, q := range queued {
+		s.release(q.ID)
+		q.Status = models.OrderCancelled
+		q.UpdatedAt = time.Now()
+		if err := s.repo.SaveOrder(ctx, q); err != nil {
+			return err
+		}
+	}
+	for _, o := range s.engine.OpenOrders(symbol) {
+		s.mu.Lock()
+		delete(s.expires, o.ID)
+		s.mu.Unlock()
+		_, _ = s.engine.Cancel(o.ID)
+	}
+	return nil
+}
+
+// tick releases queued after-market orders whose exchange has opened and
+// expires DAY orders past their session close.
+func (s *Service) tick(now time.Time) {
+	s.mu.Lock()
+	var release []models.Order
+	for id, o := range s.queued {
+		if s.cal.Status(o.Exchange, now).IsOpen {
+			release = append(release, o)
+			delete(s.queued, id)
+		}
+	}
+	var expired []string
+	for id, at := range s.expires {
+		if !now.Before(at) {
+			expired = append(expired, id)
+			delete(s.expires, id)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, o := range release {
+		s.submit(o, now)
+	}
+	for _, id := range expired {
+		// The order may have filled or been cancelled in the meantime.
+		_, _ = s.engine.Expire(id)
+	}
+}
+
+func (s *Service) OnOrder(o models.Order) { s.enqueue(o) }
+func (s *Service) OnFill(f models.Fill)   { s.enqueue(f) }
+
+// enqueue queues an order or fill for persistence. It never drops one:
+// the stored order book and tradebook are what Restore reloads.
+func (s *Service) enqueue(ev interface{}) { s.events.Push(ev) }
+
+// Run persists engine events in the order they were produced and drives the
+// session scheduler.
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.tick(now)
+		case <-s.events.Ready():
+			err := s.events.Flush(func(ev interface{}) error {
+				switch ev := ev.(type) {
+				case models.Order:
+					return s.repo.SaveOrder(ctx, ev)
+				case models.Fill:
+					return s.repo.SaveFill(ctx, ev)
+				}
+				return nil
+			})
+			if err != nil {
+				log.Printf("orders: persist: %v; retrying", err)
+			}
+		}
+	}
+}
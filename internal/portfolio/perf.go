@@ -0,0 +1,115 @@
+package portfolio
+
+import (
+	"math"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+// TWR chains the daily returns between snapshots. Each day's net flow is
+// assumed to arrive at the start of the day, so deposits and withdrawals
+// never count as gains or losses. It also returns the growth index after
+// each snapshot, starting at 1.
+func TWR(snaps []models.PortfolioSnapshot) (float64, []float64) {
+	index := make([]float64, len(snaps))
+	growth := 1.0
+	for i := range snaps {
+		if i > 0 {
+			if base := snaps[i-1].TotalValue + snaps[i].NetFlow; base > 0 {
+				growth *= snaps[i].TotalValue / base
+			}
+		}
+		index[i] = growth
+	}
+	return growth - 1, index
+}
+
+// MaxDrawdown is the largest peak-to-trough fall of the growth index, as a
+// fraction of the peak, with the indexes of the peak and the trough.
+func MaxDrawdown(index []float64) (dd float64, peak, trough int) {
+	hi := 0
+	for i, v := range index {
+		if v > index[hi] {
+			hi = i
+		}
+		if index[hi] <= 0 {
+			continue
+		}
+		if d := (index[hi] - v) / index[hi]; d > dd {
+			dd, peak, trough = d, hi, i
+		}
+	}
+	return dd, peak, trough
+}
+
+// Cashflow is an amount received by the investor (negative when paid in).
+type Cashflow struct {
+	Time   time.Time
+	Amount float64
+}
+
+// XIRR is the annualised rate at which the cashflows' net present value is
+// zero. It returns NaN when no rate exists, e.g. all flows have one sign.
+func XIRR(flows []Cashflow) float64 {
+	if len(flows) < 2 {
+		return math.NaN()
+	}
+	var pos, neg bool
+	for _, f := range flows {
+		pos = pos || f.Amount > 0
+		neg = neg || f.Amount < 0
+	}
+	if !pos || !neg {
+		return math.NaN()
+	}
+	t0 := flows[0].Time
+	years := make([]float64, len(flows))
+	for i, f := range flows {
+		years[i] = f.Time.Sub(t0).Hours() / 24 / 365
+	}
+	npv := func(r float64) (v, dv float64) {
+		for i, f := range flows {
+			d := math.Pow(1+r, years[i])
+			v += f.Amount / d
+			dv -= years[i] * f.Amount / (d * (1 + r))
+		}
+		return v, dv
+	}
+
+	// Newton from 10%, falling back to bisection if it strays.
+	r := 0.1
+	for i := 0; i < 50; i++ {
+		v, dv := npv(r)
+		if math.Abs(v) < 1e-7 {
+			return r
+		}
+		if dv == 0 {
+			break
+		}
+		next := r - v/dv
+		if next <= -1 || math.IsNaN(next) || math.IsInf(next, 0) {
+			break
+		}
+		r = next
+	}
+	lo, hi := -0.9999, 10.0
+	vlo, _ := npv(lo)
+	vhi, _ := npv(hi)
+	if vlo*vhi > 0 {
+		return math.NaN()
+	}
+	for i := 0; i < 200; i++ {
+		mid := (lo + hi) / 2
+		v, _ := npv(mid)
+		if math.Abs(v) < 1e-7 {
+			return mid
+		}
+		if (v > 0) == (vlo > 0) {
+			lo, vlo = mid, v
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
@@ -0,0 +1,242 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/calendar"
+	"github.com/hahahamid/broker-backend/internal/cash"
+	"github.com/hahahamid/broker-backend/internal/fx"
+	"github.com/hahahamid/broker-backend/internal/lots"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+)
+
+// Service snapshots every user's portfolio once each trading day has closed
+// on all exchanges and computes performance from the snapshots.
+type Service struct {
+	repo repository.Repo
+	lots *lots.Service
+	cash *cash.Service
+	cal  *calendar.Calendar
+	fx   *fx.Converter
+
+	last string // date of the latest snapshot round
+}
+
+func NewService(repo repository.Repo, lotSvc *lots.Service, cashSvc *cash.Service, cal *calendar.Calendar, conv *fx.Converter) *Service {
+	return &Service{repo: repo, lots: lotSvc, cash: cashSvc, cal: cal, fx: conv}
+}
+
+// Run takes due snapshots at startup and then once a minute.
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		if err := s.SnapshotDue(ctx, time.Now()); err != nil {
+			log.Printf("portfolio snapshots: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// closedDay is the latest trading date that has closed on every exchange.
+func (s *Service) closedDay(now time.Time) string {
+	names := s.cal.Exchanges()
+	if len(names) == 0 {
+		names = []string{""}
+	}
+	var day string
+	for i, ex := range names {
+		d := s.cal.LastClosedDay(ex, now).Format(time.DateOnly)
+		if i == 0 || d < day {
+			day = d
+		}
+	}
+	return day
+}
+
+// SnapshotDue snapshots every user with holdings or cash for the latest
+// closed trading day, unless that day has already been taken.
+func (s *Service) SnapshotDue(ctx context.Context, now time.Time) error {
+	date := s.closedDay(now)
+	if s.last == "" {
+		last, err := s.repo.LatestSnapshotDate(ctx)
+		if err != nil {
+			return err
+		}
+		s.last = last
+	}
+	if date <= s.last {
+		return nil
+	}
+
+	users := map[string]bool{}
+	for _, id := range s.lots.Users() {
+		users[id] = true
+	}
+	ids, err := s.repo.CashUserIDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		users[id] = true
+	}
+	for id := range users {
+		if _, err := s.snapshot(ctx, id, date, now); err != nil {
+			return err
+		}
+	}
+	s.last = date
+	log.Printf("portfolio snapshots %s: %d users", date, len(users))
+	return nil
+}
+
+func (s *Service) snapshot(ctx context.Context, userID, date string, now time.Time) (models.PortfolioSnapshot, error) {
+	snap, err := s.value(ctx, userID)
+	if err != nil {
+		return snap, err
+	}
+	snap.ID = userID + ":" + date
+	snap.UserID = userID
+	snap.Date = date
+	snap.Time = now
+
+	var since time.Time
+	var prevValue float64
+	// Strictly before date: a retried round may already have saved this
+	// user's snapshot for date.
+	prev, err := s.repo.LatestSnapshot(ctx, userID, date)
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+	case err != nil:
+		return snap, err
+	default:
+		since, prevValue = prev.Time, prev.TotalValue
+	}
+	var unconverted []string
+	if snap.NetFlow, unconverted, err = s.cash.NetFlows(ctx, userID, since, now); err != nil {
+		return snap, err
+	}
+	snap.Unconverted = fx.Union(snap.Unconverted, unconverted)
+	snap.DayChange = snap.TotalValue - prevValue - snap.NetFlow
+	return snap, s.repo.SaveSnapshot(ctx, snap)
+}
+
+// value marks the user's holdings to market, falling back to cost when a
+// symbol has no price yet, and adds cash, all in the base currency.
+func (s *Service) value(ctx context.Context, userID string) (models.PortfolioSnapshot, error) {
+	snap := models.PortfolioSnapshot{Currency: s.fx.Base()}
+	snap.Holdings = []models.SnapshotHolding{}
+	for _, h := range s.lots.Holdings(userID) {
+		price := h.LastPrice
+		if price <= 0 {
+			price = h.AvgPrice
+		}
+		sh := models.SnapshotHolding{
+			Symbol:    h.Symbol,
+			Currency:  h.Currency,
+			Quantity:  h.Quantity,
+			AvgPrice:  h.AvgPrice,
+			Price:     price,
+			Value:     h.Value,
+			BaseValue: h.BaseValue,
+		}
+		snap.Holdings = append(snap.Holdings, sh)
+		snap.HoldingsValue += sh.BaseValue
+		if h.FXRate == 0 && h.Value != 0 {
+			snap.Unconverted = append(snap.Unconverted, s.fx.Currency(h.Currency))
+		}
+	}
+	bal, unconverted, err := s.cash.BaseBalance(ctx, userID)
+	if err != nil {
+		return snap, err
+	}
+	snap.Unconverted = fx.Union(snap.Unconverted, unconverted)
+	snap.Cash = bal
+	snap.TotalValue = snap.HoldingsValue + snap.Cash
+	return snap, nil
+}
+
+// History returns the user's snapshots dated within [from, to]
+// (YYYY-MM-DD, either may be empty), oldest first.
+func (s *Service) History(ctx context.Context, userID, from, to string) ([]models.PortfolioSnapshot, error) {
+	return s.repo.ListSnapshots(ctx, userID, from, to)
+}
+
+// Performance computes returns over the snapshots in [from, to] and the
+// live change since the latest snapshot.
+func (s *Service) Performance(ctx context.Context, userID, from, to string) (models.Performance, error) {
+	var p models.Performance
+	snaps, err := s.History(ctx, userID, from, to)
+	if err != nil {
+		return p, err
+	}
+	p.SnapshotCount = len(snaps)
+
+	if len(snaps) > 0 {
+		first, last := snaps[0], snaps[len(snaps)-1]
+		p.From, p.To = first.Time, last.Time
+		p.StartValue, p.EndValue = first.TotalValue, last.TotalValue
+
+		var twr float64
+		var index []float64
+		twr, index = TWR(snaps)
+		p.TWR = twr
+		var peak, low int
+		if p.MaxDrawdown, peak, low = MaxDrawdown(index); p.MaxDrawdown > 0 {
+			p.DrawdownPeak, p.DrawdownLow = snaps[peak].Time, snaps[low].Time
+		}
+
+		// The starting value counts as money paid in on the first day.
+		flows := []Cashflow{{Time: first.Time, Amount: -first.TotalValue}}
+		for _, snap := range snaps[1:] {
+			p.NetFlows += snap.NetFlow
+			if snap.NetFlow != 0 {
+				flows = append(flows, Cashflow{Time: snap.Time, Amount: -snap.NetFlow})
+			}
+		}
+		flows = append(flows, Cashflow{Time: last.Time, Amount: last.TotalValue})
+		sort.SliceStable(flows, func(i, j int) bool { return flows[i].Time.Before(flows[j].Time) })
+		if r := XIRR(flows); len(snaps) > 1 && !math.IsNaN(r) {
+			p.XIRR = r
+		}
+	}
+
+	// Day change is live: current value against the latest snapshot,
+	// whatever the requested range.
+	now := time.Now()
+	cur, err := s.value(ctx, userID)
+	if err != nil {
+		return p, err
+	}
+	p.CurrentValue = cur.TotalValue
+	var since time.Time
+	var base float64
+	latest, err := s.repo.LatestSnapshot(ctx, userID, "")
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+	case err != nil:
+		return p, err
+	default:
+		since, base = latest.Time, latest.TotalValue
+	}
+	flow, unconverted, err := s.cash.NetFlows(ctx, userID, since, now)
+	if err != nil {
+		return p, err
+	}
+	p.Unconverted = fx.Union(cur.Unconverted, unconverted)
+	p.DayChange = cur.TotalValue - base - flow
+	if base+flow > 0 {
+		p.DayChangePct = p.DayChange / (base + flow)
+	}
+	return p, nil
+}
@@ -0,0 +1,181 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+// TaxRules sets the financial year and the holding periods after which a
+// sale counts as long term.
+type TaxRules struct {
+	YearStartMonth time.Month
+	YearStartDay   int
+	LongTermDays   int            // default threshold
+	ByAssetClass   map[string]int // overrides keyed by instrument asset class
+}
+
+// ParseTaxRules reads a financial year start as "MM-DD" (empty means
+// January 1st) and per-asset-class thresholds as "etf=730,bond=1095".
+func ParseTaxRules(yearStart string, longTermDays int, byClass string) (TaxRules, error) {
+	r := TaxRules{YearStartMonth: time.January, YearStartDay: 1, LongTermDays: longTermDays, ByAssetClass: map[string]int{}}
+	if yearStart != "" {
+		d, err := time.Parse("01-02", yearStart)
+		if err != nil {
+			return r, fmt.Errorf("financial year start %q: must be MM-DD", yearStart)
+		}
+		r.YearStartMonth, r.YearStartDay = d.Month(), d.Day()
+	}
+	for _, kv := range strings.Split(byClass, ",") {
+		if kv = strings.TrimSpace(kv); kv == "" {
+			continue
+		}
+		class, days, ok := strings.Cut(kv, "=")
+		n, err := strconv.Atoi(strings.TrimSpace(days))
+		if !ok || err != nil || n < 0 {
+			return r, fmt.Errorf("holding period %q: want class=days", kv)
+		}
+		r.ByAssetClass[strings.ToLower(strings.TrimSpace(class))] = n
+	}
+	return r, nil
+}
+
+// Year returns the bounds [from, to) of the financial year starting in the
+// given calendar year, and its label.
+func (r TaxRules) Year(start int) (from, to time.Time, label string) {
+	from = time.Date(start, r.YearStartMonth, r.YearStartDay, 0, 0, 0, 0, time.Local)
+	to = from.AddDate(1, 0, 0)
+	label = strconv.Itoa(start)
+	if r.YearStartMonth != time.January || r.YearStartDay != 1 {
+		label += fmt.Sprintf("-%02d", (start+1)%100)
+	}
+	return from, to, label
+}
+
+// YearOf is the start year of the financial year containing t.
+func (r TaxRules) YearOf(t time.Time) int {
+	t = t.In(time.Local)
+	if from, _, _ := r.Year(t.Year()); t.Before(from) {
+		return t.Year() - 1
+	}
+	return t.Year()
+}
+
+func (r TaxRules) threshold(assetClass string) int {
+	if n, ok := r.ByAssetClass[strings.ToLower(assetClass)]; ok {
+		return n
+	}
+	return r.LongTermDays
+}
+
+// CapitalGains reports the lots the user closed during the financial year
+// starting in year (0 means the current one). Terms are assessed against
+// the configured thresholds, which may differ from the ledger's default.
+func (s *Service) CapitalGains(ctx context.Context, userID string, year int) (models.CapitalGains, error) {
+	if year == 0 {
+		year = s.tax.YearOf(s.now())
+	}
+	if year < 1900 || year > 9999 {
+		return models.CapitalGains{}, fmt.Errorf("%w: financial year %d", ErrInvalid, year)
+	}
+	from, to, label := s.tax.Year(year)
+	out := models.CapitalGains{
+		FinancialYear: label,
+		From:          from.Format(time.DateOnly),
+		To:            to.AddDate(0, 0, -1).Format(time.DateOnly),
+		Instruments:   []models.InstrumentGains{},
+		Entries:       []models.GainEntry{},
+	}
+
+	closed := s.lots.Realized(userID)
+	sort.SliceStable(closed, func(i, j int) bool { return closed[i].ClosedAt.Before(closed[j].ClosedAt) })
+	classes := map[string]string{}
+	bySymbol := map[string]*models.InstrumentGains{}
+	for _, c := range closed {
+		if !within(c.ClosedAt, from, to) {
+			continue
+		}
+		class, ok := classes[c.Symbol]
+		if !ok {
+			if inst, err := s.repo.GetInstrument(ctx, c.Symbol); err == nil {
+				class = inst.AssetClass
+			}
+			classes[c.Symbol] = class
+		}
+		e := models.GainEntry{
+			Symbol:      c.Symbol,
+			LotID:       c.LotID,
+			OrderID:     c.OrderID,
+			Quantity:    c.Quantity,
+			AcquiredAt:  c.AcquiredAt,
+			SoldAt:      c.ClosedAt,
+			HoldingDays: holdingDays(c.AcquiredAt, c.ClosedAt),
+			CostPrice:   c.CostPrice,
+			SalePrice:   c.SalePrice,
+			Cost:        c.CostPrice * c.Quantity,
+			Proceeds:    c.SalePrice * c.Quantity,
+			Term:        models.TermShort,
+		}
+		e.Gain = e.Proceeds - e.Cost
+		if e.HoldingDays > s.tax.threshold(class) {
+			e.Term = models.TermLong
+		}
+		out.Entries = append(out.Entries, e)
+
+		g, ok := bySymbol[c.Symbol]
+		if !ok {
+			g = &models.InstrumentGains{Symbol: c.Symbol}
+			bySymbol[c.Symbol] = g
+		}
+		g.Quantity += e.Quantity
+		g.Cost += e.Cost
+		g.Proceeds += e.Proceeds
+		g.TotalGain += e.Gain
+		if e.Term == models.TermLong {
+			g.LongTermGain += e.Gain
+			out.LongTermGain += e.Gain
+		} else {
+			g.ShortTermGain += e.Gain
+			out.ShortTermGain += e.Gain
+		}
+		out.TotalGain += e.Gain
+	}
+	for _, g := range bySymbol {
+		out.Instruments = append(out.Instruments, *g)
+	}
+	sort.Slice(out.Instruments, func(i, j int) bool { return out.Instruments[i].Symbol < out.Instruments[j].Symbol })
+	return out, nil
+}
+
+// holdingDays counts calendar days between acquisition and sale.
+func holdingDays(acquired, sold time.Time) int {
+	a, s := acquired.In(time.Local), sold.In(time.Local)
+	da := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	ds := time.Date(s.Year(), s.Month(), s.Day(), 0, 0, 0, 0, time.UTC)
+	return int(ds.Sub(da).Hours() / 24)
+}
+
+func gainsReport(g models.CapitalGains) []Table {
+	summary := Table{Title: "Summary", Header: []string{"Item", "Amount"}, Rows: [][]string{
+		{"Short-term gain", money(g.ShortTermGain)},
+		{"Long-term gain", money(g.LongTermGain)},
+		{"Total gain", money(g.TotalGain)},
+	}}
+	inst := Table{Title: "By Instrument", Header: []string{"Symbol", "Quantity", "Cost", "Proceeds", "Short-term", "Long-term", "Total"}}
+	for _, i := range g.Instruments {
+		inst.Rows = append(inst.Rows, []string{i.Symbol, qty(i.Quantity), money(i.Cost), money(i.Proceeds),
+			money(i.ShortTermGain), money(i.LongTermGain), money(i.TotalGain)})
+	}
+	lots := Table{Title: "Realized Lots", Header: []string{"Symbol", "Acquired", "Sold", "Days", "Quantity", "Cost Price", "Sale Price", "Cost", "Proceeds", "Gain", "Term"}}
+	for _, e := range g.Entries {
+		lots.Rows = append(lots.Rows, []string{e.Symbol, e.AcquiredAt.Local().Format(time.DateOnly), e.SoldAt.Local().Format(time.DateOnly),
+			strconv.Itoa(e.HoldingDays), qty(e.Quantity), money(e.CostPrice), money(e.SalePrice),
+			money(e.Cost), money(e.Proceeds), money(e.Gain), e.Term})
+	}
+	return []Table{summary, inst, lots}
+}
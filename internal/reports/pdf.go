@@ -0,0 +1,177 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A minimal PDF writer: A4 pages of left-aligned Courier text, which keeps
+// table columns aligned without font metrics and needs no dependencies.
+
+const (
+	pageWidth  = 595.0 // A4 in points
+	pageHeight = 842.0
+	margin     = 40.0
+	charWidth  = 0.6 // Courier advance width per point of font size
+	maxCell    = 40  // longest cell before truncation, in characters
+)
+
+type pdfLine struct {
+	text string
+	bold bool
+	size float64
+}
+
+// WritePDF lays the report out as text lines and paginates them.
+func WritePDF(w io.Writer, r Report) error {
+	lines := []pdfLine{{text: r.Title, bold: true, size: 14}}
+	for _, m := range r.Meta {
+		lines = append(lines, pdfLine{text: m, size: 9})
+	}
+	for _, t := range r.Tables {
+		widths := columnWidths(t)
+		total := 0
+		for _, n := range widths {
+			total += n + 2
+		}
+		// Shrink wide tables to fit the page, down to 6pt.
+		size := min(9, max(6, (pageWidth-2*margin)/(charWidth*float64(total))))
+
+		lines = append(lines, pdfLine{size: 9})
+		if t.Title != "" {
+			lines = append(lines, pdfLine{text: t.Title, bold: true, size: 11})
+		}
+		if len(t.Header) > 0 {
+			lines = append(lines, pdfLine{text: formatRow(t.Header, widths), bold: true, size: size})
+			lines = append(lines, pdfLine{text: strings.Repeat("-", total), size: size})
+		}
+		for _, row := range t.Rows {
+			lines = append(lines, pdfLine{text: formatRow(row, widths), size: size})
+		}
+		if len(t.Rows) == 0 {
+			lines = append(lines, pdfLine{text: "(none)", size: size})
+		}
+	}
+	return writePages(w, paginate(lines))
+}
+
+func columnWidths(t Table) []int {
+	n := len(t.Header)
+	for _, row := range t.Rows {
+		n = max(n, len(row))
+	}
+	widths := make([]int, n)
+	measure := func(row []string) {
+		for i, cell := range row {
+			widths[i] = min(maxCell, max(widths[i], len(cell)))
+		}
+	}
+	measure(t.Header)
+	for _, row := range t.Rows {
+		measure(row)
+	}
+	return widths
+}
+
+func formatRow(row []string, widths []int) string {
+	var b strings.Builder
+	for i, w := range widths {
+		var cell string
+		if i < len(row) {
+			cell = row[i]
+		}
+		if len(cell) > w {
+			cell = cell[:w-1] + "~"
+		}
+		b.WriteString(cell)
+		b.WriteString(strings.Repeat(" ", w-len(cell)+2))
+	}
+	return strings.TrimRight(b.String(), " ")
+}
+
+func paginate(lines []pdfLine) [][]pdfLine {
+	var pages [][]pdfLine
+	var page []pdfLine
+	y := pageHeight - margin
+	for _, l := range lines {
+		lead := l.size * 1.3
+		if y-lead < margin && len(page) > 0 {
+			pages = append(pages, page)
+			page, y = nil, pageHeight-margin
+		}
+		page = append(page, l)
+		y -= lead
+	}
+	return append(pages, page)
+}
+
+func writePages(w io.Writer, pages [][]pdfLine) error {
+	var buf bytes.Buffer
+	var offsets []int
+	obj := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+	// Objects 1-4 are fixed; each page then takes a page and a content
+	// object.
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	obj("<< /Type /Catalog /Pages 2 0 R >>")
+	obj(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	obj("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+	obj("<< /Type /Font /Subtype /Type1 /BaseFont /Courier-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, page := range pages {
+		var content bytes.Buffer
+		y := pageHeight - margin
+		for _, l := range page {
+			y -= l.size * 1.3
+			if l.text == "" {
+				continue
+			}
+			font := "F1"
+			if l.bold {
+				font = "F2"
+			}
+			fmt.Fprintf(&content, "BT /%s %.1f Tf %.1f %.1f Td (%s) Tj ET\n", font, l.size, margin, y, escape(l.text))
+		}
+		fmt.Fprintf(&content, "BT /F1 7 Tf %.1f %.1f Td (Page %d of %d) Tj ET\n", pageWidth-margin-60, margin/2, i+1, len(pages))
+
+		obj(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, 6+2*i))
+		obj(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// escape makes text safe for a PDF literal string, replacing characters
+// outside printable ASCII.
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 32 || r > 126:
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
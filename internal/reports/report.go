@@ -0,0 +1,66 @@
+package reports
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// Report is a format-neutral document: a title, a few header lines and a
+// sequence of tables. The CSV and PDF writers render the same value.
+type Report struct {
+	Title  string
+	Meta   []string // e.g. "Client: ...", "Date: ..."
+	Tables []Table
+}
+
+type Table struct {
+	Title  string
+	Header []string
+	Rows   [][]string
+}
+
+// Formats supported by Render.
+const (
+	FormatCSV = "csv"
+	FormatPDF = "pdf"
+)
+
+// ContentType returns the MIME type of a format.
+func ContentType(format string) string {
+	if format == FormatPDF {
+		return "application/pdf"
+	}
+	return "text/csv"
+}
+
+// Render writes the report in the given format.
+func Render(w io.Writer, r Report, format string) error {
+	if format == FormatPDF {
+		return WritePDF(w, r)
+	}
+	return WriteCSV(w, r)
+}
+
+// WriteCSV writes the title and meta lines as single-cell rows, then each
+// table preceded by its title and separated by a blank row.
+func WriteCSV(w io.Writer, r Report) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{r.Title})
+	for _, m := range r.Meta {
+		cw.Write([]string{m})
+	}
+	for _, t := range r.Tables {
+		cw.Write(nil)
+		if t.Title != "" {
+			cw.Write([]string{t.Title})
+		}
+		if len(t.Header) > 0 {
+			cw.Write(t.Header)
+		}
+		for _, row := range t.Rows {
+			cw.Write(row)
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
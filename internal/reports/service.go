@@ -0,0 +1,414 @@
+package reports
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/fx"
+	"github.com/hahahamid/broker-backend/internal/lots"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+)
+
+// ErrInvalid reports a bad date, period or format.
+var ErrInvalid = errors.New("invalid report request")
+
+// Report kinds.
+const (
+	KindContractNote = "contract-note"
+	KindStatement    = "statement"
+	KindTradebook    = "tradebook"
+	KindCapitalGains = "capital-gains"
+)
+
+// Request selects a report. Date is YYYY-MM-DD for contract notes, Month is
+// YYYY-MM for statements, From/To bound the tradebook (both optional) and
+// Year is the start year of a capital gains financial year (0 = current).
+type Request struct {
+	Kind   string
+	Date   string
+	Month  string
+	From   string
+	To     string
+	Year   int
+	Format string
+}
+
+// File is a rendered report.
+type File struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
+
+// Service builds contract notes, statements and tradebooks from fills, the
+// cash ledger and portfolio snapshots. Dates are in server local time, like
+// the trading calendar.
+type Service struct {
+	repo repository.Repo
+	lots *lots.Service
+	tax  TaxRules
+	fx   *fx.Converter
+	now  func() time.Time
+}
+
+func NewService(repo repository.Repo, lotSvc *lots.Service, tax TaxRules, conv *fx.Converter) *Service {
+	return &Service{repo: repo, lots: lotSvc, tax: tax, fx: conv, now: time.Now}
+}
+
+// Generate builds and renders the requested report.
+func (s *Service) Generate(ctx context.Context, userID string, req Request) (File, error) {
+	if req.Format == "" {
+		req.Format = FormatCSV
+	}
+	if req.Format != FormatCSV && req.Format != FormatPDF {
+		return File{}, fmt.Errorf("%w: format must be csv or pdf", ErrInvalid)
+	}
+	var (
+		r    Report
+		name string
+		err  error
+	)
+	switch req.Kind {
+	case KindContractNote:
+		r, err = s.ContractNote(ctx, userID, req.Date)
+		name = "contract-note-" + req.Date
+	case KindStatement:
+		r, err = s.Statement(ctx, userID, req.Month)
+		name = "statement-" + req.Month
+	case KindTradebook:
+		r, err = s.Tradebook(ctx, userID, req.From, req.To)
+		name = "tradebook"
+		if req.From != "" || req.To != "" {
+			name += "-" + req.From + "_" + req.To
+		}
+	case KindCapitalGains:
+		var g models.CapitalGains
+		if g, err = s.CapitalGains(ctx, userID, req.Year); err == nil {
+			r = Report{
+				Title:  "Capital Gains",
+				Meta:   s.meta(ctx, userID, "Financial year "+g.FinancialYear+": "+g.From+" to "+g.To),
+				Tables: gainsReport(g),
+			}
+			name = "capital-gains-" + g.FinancialYear
+		}
+	default:
+		return File{}, fmt.Errorf("%w: unknown report %q", ErrInvalid, req.Kind)
+	}
+	if err != nil {
+		return File{}, err
+	}
+	var buf bytes.Buffer
+	if err := Render(&buf, r, req.Format); err != nil {
+		return File{}, err
+	}
+	return File{Name: name + "." + req.Format, ContentType: ContentType(req.Format), Data: buf.Bytes()}, nil
+}
+
+// ContractNote lists the day's trades grouped by order, the charges levied
+// and the net amount payable or receivable.
+func (s *Service) ContractNote(ctx context.Context, userID, date string) (Report, error) {
+	from, err := parseDay(date)
+	if err != nil {
+		return Report{}, err
+	}
+	to := from.AddDate(0, 0, 1)
+	fills, err := s.fills(ctx, userID, from, to)
+	if err != nil {
+		return Report{}, err
+	}
+	entries, err := s.entries(ctx, userID, from, to)
+	if err != nil {
+		return Report{}, err
+	}
+
+	// Group fills by order, keeping the order of each order's first fill.
+	// Amounts stay in each instrument's currency and are totalled per
+	// currency.
+	type orderTotal struct {
+		symbol, side, exchange, currency string
+		qty, value                       float64
+	}
+	type obligation struct{ buys, sells, charges float64 }
+	var ids, currencies []string
+	totals := map[string]*orderTotal{}
+	net := map[string]*obligation{}
+	owed := func(ccy string) *obligation {
+		o, ok := net[ccy]
+		if !ok {
+			o = &obligation{}
+			net[ccy] = o
+			currencies = append(currencies, ccy)
+		}
+		return o
+	}
+	trades := Table{Title: "Trades", Header: []string{"Time", "Order", "Trade", "Symbol", "Exchange", "Side", "Quantity", "Price", "Value", "Currency"}}
+	for _, f := range fills {
+		ccy := s.fx.Currency(f.Currency)
+		value := f.Price * f.Quantity
+		trades.Rows = append(trades.Rows, []string{
+			f.Time.Local().Format(time.TimeOnly), f.OrderID, f.ID, f.Symbol, f.Exchange, f.Side,
+			qty(f.Quantity), money(f.Price), money(value), ccy,
+		})
+		t, ok := totals[f.OrderID]
+		if !ok {
+			t = &orderTotal{symbol: f.Symbol, side: f.Side, exchange: f.Exchange, currency: ccy}
+			totals[f.OrderID] = t
+			ids = append(ids, f.OrderID)
+		}
+		t.qty += f.Quantity
+		t.value += value
+		if f.Side == "buy" {
+			owed(ccy).buys += value
+		} else {
+			owed(ccy).sells += value
+		}
+	}
+	charges := Table{Title: "Charges", Header: []string{"Charge", "Currency", "Amount"}}
+	type chargeKey struct{ name, currency string }
+	byName := map[chargeKey]float64{}
+	byOrder := map[string]float64{}
+	var keys []chargeKey
+	for _, e := range entries {
+		if e.Type != models.CashCharge {
+			continue
+		}
+		k := chargeKey{e.Note, s.fx.Currency(e.Currency)}
+		if _, ok := byName[k]; !ok {
+			keys = append(keys, k)
+		}
+		byName[k] -= e.Amount
+		byOrder[e.Reference] -= e.Amount
+		owed(k.currency).charges -= e.Amount
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].currency != keys[j].currency {
+			return keys[i].currency < keys[j].currency
+		}
+		return keys[i].name < keys[j].name
+	})
+	for _, k := range keys {
+		charges.Rows = append(charges.Rows, []string{k.name, k.currency, money(byName[k])})
+	}
+
+	orders := Table{Title: "Orders", Header: []string{"Order", "Symbol", "Exchange", "Side", "Quantity", "Avg Price", "Value", "Charges", "Currency"}}
+	for _, id := range ids {
+		t := totals[id]
+		orders.Rows = append(orders.Rows, []string{id, t.symbol, t.exchange, t.side, qty(t.qty), money(t.value / t.qty), money(t.value), money(byOrder[id]), t.currency})
+	}
+
+	// Positive net obligation is owed to the client.
+	sort.Strings(currencies)
+	summary := Table{Title: "Net Obligation", Header: []string{"Item", "Currency", "Amount"}}
+	for _, ccy := range currencies {
+		o := net[ccy]
+		n := o.sells - o.buys - o.charges
+		direction := "Receivable"
+		if n < 0 {
+			direction = "Payable"
+		}
+		summary.Rows = append(summary.Rows,
+			[]string{"Purchases", ccy, money(-o.buys)},
+			[]string{"Sales", ccy, money(o.sells)},
+			[]string{"Charges", ccy, money(-o.charges)},
+			[]string{"Net " + direction, ccy, money(math.Abs(n))},
+		)
+	}
+
+	return Report{
+		Title:  "Contract Note",
+		Meta:   s.meta(ctx, userID, "Trade date: "+date),
+		Tables: []Table{orders, trades, charges, summary},
+	}, nil
+}
+
+// Statement covers one calendar month: the cash ledger with opening and
+// closing balances, and holdings as of the month's last snapshot (or live
+// holdings for the current month when it has no snapshot yet).
+func (s *Service) Statement(ctx context.Context, userID, month string) (Report, error) {
+	start, err := time.ParseInLocation("2006-01", month, time.Local)
+	if err != nil {
+		return Report{}, fmt.Errorf("%w: month must be YYYY-MM", ErrInvalid)
+	}
+	end := start.AddDate(0, 1, 0)
+	all, err := s.entries(ctx, userID, time.Time{}, end)
+	if err != nil {
+		return Report{}, err
+	}
+
+	// Running balances are kept per currency sub-ledger.
+	opening, balance := map[string]float64{}, map[string]float64{}
+	ledger := Table{Title: "Cash Ledger", Header: []string{"Date", "Type", "Symbol", "Reference", "Note", "Currency", "Debit", "Credit", "Balance"}}
+	for _, e := range all {
+		ccy := s.fx.Currency(e.Currency)
+		balance[ccy] += e.Amount
+		if e.Time.Before(start) {
+			opening[ccy] = balance[ccy]
+			continue
+		}
+		debit, credit := "", ""
+		if e.Amount < 0 {
+			debit = money(-e.Amount)
+		} else {
+			credit = money(e.Amount)
+		}
+		ledger.Rows = append(ledger.Rows, []string{
+			e.Time.Local().Format(time.DateOnly), e.Type, e.Symbol, e.Reference, e.Note, ccy, debit, credit, money(balance[ccy]),
+		})
+	}
+	balances := Table{Title: "Balances", Header: []string{"Currency", "Opening", "Closing"}}
+	currencies := make([]string, 0, len(balance))
+	for ccy := range balance {
+		currencies = append(currencies, ccy)
+	}
+	sort.Strings(currencies)
+	for _, ccy := range currencies {
+		balances.Rows = append(balances.Rows, []string{ccy, money(opening[ccy]), money(balance[ccy])})
+	}
+
+	holdings := Table{Header: []string{"Symbol", "Currency", "Quantity", "Avg Price", "Price", "Value", "Base Value"}}
+	snaps, err := s.repo.ListSnapshots(ctx, userID, start.Format(time.DateOnly), end.AddDate(0, 0, -1).Format(time.DateOnly))
+	if err != nil {
+		return Report{}, err
+	}
+	var asOf string
+	var total float64
+	if len(snaps) > 0 {
+		last := snaps[len(snaps)-1]
+		asOf = last.Date
+		for _, h := range last.Holdings {
+			holdings.Rows = append(holdings.Rows, []string{h.Symbol, s.fx.Currency(h.Currency), qty(h.Quantity),
+				money(h.AvgPrice), money(h.Price), money(h.Value), money(h.BaseValue)})
+		}
+		total = last.HoldingsValue
+	} else if now := s.now(); !now.Before(start) && now.Before(end) {
+		asOf = now.Format(time.DateOnly) + " (live)"
+		for _, h := range s.lots.Holdings(userID) {
+			price := h.LastPrice
+			if price <= 0 {
+				price = h.AvgPrice
+			}
+			holdings.Rows = append(holdings.Rows, []string{h.Symbol, h.Currency, qty(h.Quantity),
+				money(h.AvgPrice), money(price), money(h.Value), money(h.BaseValue)})
+			total += h.BaseValue
+		}
+	}
+	if asOf == "" {
+		holdings.Title = "Holdings (no snapshot for this month)"
+	} else {
+		holdings.Title = "Holdings as of " + asOf
+		holdings.Rows = append(holdings.Rows, []string{"Total", s.fx.Base(), "", "", "", "", money(total)})
+	}
+
+	return Report{
+		Title:  "Account Statement",
+		Meta:   s.meta(ctx, userID, "Period: "+start.Format(time.DateOnly)+" to "+end.AddDate(0, 0, -1).Format(time.DateOnly)),
+		Tables: []Table{balances, ledger, holdings},
+	}, nil
+}
+
+// Tradebook lists every fill dated within [from, to] (YYYY-MM-DD, either
+// may be empty), oldest first.
+func (s *Service) Tradebook(ctx context.Context, userID, from, to string) (Report, error) {
+	var start, end time.Time
+	var err error
+	if from != "" {
+		if start, err = parseDay(from); err != nil {
+			return Report{}, err
+		}
+	}
+	if to != "" {
+		if end, err = parseDay(to); err != nil {
+			return Report{}, err
+		}
+		end = end.AddDate(0, 0, 1)
+	}
+	fills, err := s.fills(ctx, userID, start, end)
+	if err != nil {
+		return Report{}, err
+	}
+	t := Table{Title: "Trades", Header: []string{"Date", "Time", "Order", "Trade", "Symbol", "Exchange", "Side", "Quantity", "Price", "Value", "Currency"}}
+	for _, f := range fills {
+		local := f.Time.Local()
+		t.Rows = append(t.Rows, []string{
+			local.Format(time.DateOnly), local.Format(time.TimeOnly), f.OrderID, f.ID, f.Symbol, f.Exchange, f.Side,
+			qty(f.Quantity), money(f.Price), money(f.Price * f.Quantity), s.fx.Currency(f.Currency),
+		})
+	}
+	period := "Period: all"
+	if from != "" || to != "" {
+		period = "Period: " + orDash(from) + " to " + orDash(to)
+	}
+	return Report{Title: "Tradebook", Meta: s.meta(ctx, userID, period), Tables: []Table{t}}, nil
+}
+
+func (s *Service) meta(ctx context.Context, userID, period string) []string {
+	client := userID
+	if u, err := s.repo.GetUserByID(ctx, userID); err == nil && u != nil {
+		client = u.Email + " (" + userID + ")"
+	}
+	return []string{
+		"Client: " + client,
+		period,
+		"Generated: " + s.now().Format(time.RFC3339),
+	}
+}
+
+// fills returns the user's fills in [from, to); zero bounds are open.
+func (s *Service) fills(ctx context.Context, userID string, from, to time.Time) ([]models.Fill, error) {
+	all, err := s.repo.ListFills(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var out []models.Fill
+	for _, f := range all {
+		if within(f.Time, from, to) {
+			out = append(out, f)
+		}
+	}
+	return out, nil
+}
+
+// entries returns the user's ledger entries in [from, to), oldest first.
+func (s *Service) entries(ctx context.Context, userID string, from, to time.Time) ([]models.CashEntry, error) {
+	all, err := s.repo.ListCashEntries(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var out []models.CashEntry
+	for _, e := range all {
+		if within(e.Time, from, to) {
+			out = append(out, e)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Time.Before(out[j].Time) })
+	return out, nil
+}
+
+func within(t, from, to time.Time) bool {
+	return (from.IsZero() || !t.Before(from)) && (to.IsZero() || t.Before(to))
+}
+
+func parseDay(s string) (time.Time, error) {
+	d, err := time.ParseInLocation(time.DateOnly, s, time.Local)
+	if err != nil {
+		return d, fmt.Errorf("%w: dates must be YYYY-MM-DD", ErrInvalid)
+	}
+	return d, nil
+}
+
+func money(v float64) string { return strconv.FormatFloat(v, 'f', 2, 64) }
+func qty(v float64) string   { return strconv.FormatFloat(v, 'f', -1, 64) }
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *MongoRepo) GetAccount(ctx context.Context, userID string) (*models.Account, error) {
+	var a models.Account
+
+	res, err := r.accountCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("accounts").FindOne(ctx, bson.M{"_id": userID}), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decodeOne(res, &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (r *MongoRepo) SaveAccount(ctx context.Context, a models.Account) error {
+	_, err := r.accountCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("accounts").ReplaceOne(ctx, bson.M{"_id": a.UserID}, a, options.Replace().SetUpsert(true))
+	})
+	return err
+}
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *MongoRepo) CreateAlert(ctx context.Context, a *models.Alert) error {
+	res, err := r.alertCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("alerts").InsertOne(ctx, a)
+	})
+	if err != nil {
+		return err
+	}
+	a.ID = res.(*mongo.InsertOneResult).InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *MongoRepo) GetAlert(ctx context.Context, userID, id string) (*models.Alert, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	var a models.Alert
+
+	res, err := r.alertCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("alerts").FindOne(ctx, bson.M{"_id": oid, "user_id": userID}), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decodeOne(res, &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (r *MongoRepo) ListAlerts(ctx context.Context, userID string) ([]models.Alert, error) {
+	return r.findAlerts(ctx, bson.M{"user_id": userID})
+}
+
+func (r *MongoRepo) ListActiveAlerts(ctx context.Context) ([]models.Alert, error) {
+	return r.findAlerts(ctx, bson.M{"status": models.AlertActive})
+}
+
+func (r *MongoRepo) findAlerts(ctx context.Context, filter bson.M) ([]models.Alert, error) {
+	res, err := r.alertCB.Execute(func() (interface{}, error) {
+		cur, err := r.db.Collection("alerts").Find(ctx, filter, options.Find().SetSort(bson.M{"created_at": 1}))
+		if err != nil {
+			return nil, err
+		}
+		var list []models.Alert
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.Alert), nil
+}
+
+func (r *MongoRepo) UpdateAlert(ctx context.Context, a *models.Alert) error {
+	res, err := r.alertCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("alerts").ReplaceOne(ctx, bson.M{"_id": a.ID, "user_id": a.UserID}, a)
+	})
+	if err != nil {
+		return err
+	}
+	if res.(*mongo.UpdateResult).MatchedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *MongoRepo) DeleteAlert(ctx context.Context, userID, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrNotFound
+	}
+	res, err := r.alertCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("alerts").DeleteOne(ctx, bson.M{"_id": oid, "user_id": userID})
+	})
+	if err != nil {
+		return err
+	}
+	if res.(*mongo.DeleteResult).DeletedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *MongoRepo) SaveAlertEvent(ctx context.Context, ev *models.AlertEvent) error {
+	res, err := r.alertCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("alert_events").InsertOne(ctx, ev)
+	})
+	if err != nil {
+		return err
+	}
+	ev.ID = res.(*mongo.InsertOneResult).InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *MongoRepo) ListAlertEvents(ctx context.Context, userID string, limit int) ([]models.AlertEvent, error) {
+	opts := options.Find().SetSort(bson.M{"time": -1})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	res, err := r.alertCB.Execute(func() (interface{}, error) {
+		cur, err := r.db.Collection("alert_events").Find(ctx, bson.M{"user_id": userID}, opts)
+		if err != nil {
+			return nil, err
+		}
+		var list []models.AlertEvent
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.AlertEvent), nil
+}
+
+func (r *MongoRepo) SaveNotification(ctx context.Context, n *models.Notification) error {
+	res, err := r.alertCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("notifications").InsertOne(ctx, n)
+	})
+	if err != nil {
+		return err
+	}
+	n.ID = res.(*mongo.InsertOneResult).InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *MongoRepo) ListNotifications(ctx context.Context, userID string, unreadOnly bool) ([]models.Notification, error) {
+	filter := bson.M{"user_id": userID}
+	if unreadOnly {
+		filter["read"] = false
+	}
+	res, err := r.alertCB.Execute(func() (interface{}, error) {
+		cur, err := r.db.Collection("notifications").Find(ctx, filter, options.Find().SetSort(bson.M{"created_at": -1}))
+		if err != nil {
+			return nil, err
+		}
+		var list []models.Notification
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.Notification), nil
+}
+
+// MarkNotificationsRead marks the given notifications, or all of the user's
+// notifications when ids is empty, as read.
+func (r *MongoRepo) MarkNotificationsRead(ctx context.Context, userID string, ids []string) error {
+	filter := bson.M{"user_id": userID}
+	if len(ids) > 0 {
+		oids := make(bson.A, 0, len(ids))
+		for _, id := range ids {
+			if oid, err := primitive.ObjectIDFromHex(id); err == nil {
+				oids = append(oids, oid)
+			}
+		}
+		filter["_id"] = bson.M{"$in": oids}
+	}
+	_, err := r.alertCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("notifications").UpdateMany(ctx, filter, bson.M{"$set": bson.M{"read": true}})
+	})
+	return err
+}
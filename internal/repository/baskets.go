@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *MongoRepo) SaveBasket(ctx context.Context, b models.Basket) error {
+	_, err := r.basketCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("baskets").ReplaceOne(ctx, bson.M{"_id": b.ID}, b, options.Replace().SetUpsert(true))
+	})
+	return err
+}
+
+func (r *MongoRepo) GetBasket(ctx context.Context, userID, id string) (*models.Basket, error) {
+	var b models.Basket
+
+	res, err := r.basketCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("baskets").FindOne(ctx, bson.M{"_id": id, "user_id": userID}), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decodeOne(res, &b); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func (r *MongoRepo) ListBaskets(ctx context.Context, userID string) ([]models.Basket, error) {
+	res, err := r.basketCB.Execute(func() (interface{}, error) {
+		cur, err := r.db.Collection("baskets").Find(ctx, bson.M{"user_id": userID}, options.Find().SetSort(bson.M{"created_at": -1}))
+		if err != nil {
+			return nil, err
+		}
+		var list []models.Basket
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.Basket), nil
+}
+
+func (r *MongoRepo) ListBasketOrders(ctx context.Context, basketID string) ([]models.Order, error) {
+	return r.findOrders(ctx, bson.M{"basket_id": basketID})
+}
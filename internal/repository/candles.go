@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *MongoRepo) SaveTicks(ctx context.Context, ticks []models.Trade) error {
+	if len(ticks) == 0 {
+		return nil
+	}
+	docs := make([]interface{}, len(ticks))
+	for i, t := range ticks {
+		docs[i] = t
+	}
+	_, err := r.candleCB.Execute(func() (interface{}, error) {
+		return r.shared.Collection("ticks").InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	})
+	return err
+}
+
+func (r *MongoRepo) ListTicks(ctx context.Context, symbol string, from, to time.Time) ([]models.Trade, error) {
+	filter := bson.M{"symbol": symbol, "time": bson.M{"$gte": from, "$lt": to}}
+	res, err := r.candleCB.Execute(func() (interface{}, error) {
+		cur, err := r.shared.Collection("ticks").Find(ctx, filter, options.Find().SetSort(bson.M{"time": 1}))
+		if err != nil {
+			return nil, err
+		}
+		var list []models.Trade
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.Trade), nil
+}
+
+func (r *MongoRepo) UpsertCandles(ctx context.Context, list []models.Candle) error {
+	if len(list) == 0 {
+		return nil
+	}
+	writes := make([]mongo.WriteModel, 0, len(list))
+	for _, c := range list {
+		writes = append(writes, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"symbol": c.Symbol, "interval": c.Interval, "start": c.Start}).
+			SetReplacement(c).
+			SetUpsert(true))
+	}
+	_, err := r.candleCB.Execute(func() (interface{}, error) {
+		return r.shared.Collection("candles").BulkWrite(ctx, writes)
+	})
+	return err
+}
+
+func (r *MongoRepo) DeleteCandles(ctx context.Context, symbol, interval string, from, to time.Time) error {
+	filter := bson.M{"symbol": symbol, "interval": interval, "start": bson.M{"$gte": from, "$lt": to}}
+	_, err := r.candleCB.Execute(func() (interface{}, error) {
+		return r.shared.Collection("candles").DeleteMany(ctx, filter)
+	})
+	return err
+}
+
+func (r *MongoRepo) ListCandles(ctx context.Context, symbol, interval string, from, to, after time.Time, limit int) ([]models.Candle, error) {
+	start := bson.M{"$gte": from, "$lt": to}
+	if !after.IsZero() {
+		start["$gt"] = after
+	}
+	filter := bson.M{"symbol": symbol, "interval": interval, "start": start}
+	opts := options.Find().SetSort(bson.M{"start": 1})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	res, err := r.candleCB.Execute(func() (interface{}, error) {
+		cur, err := r.shared.Collection("candles").Find(ctx, filter, opts)
+		if err != nil {
+			return nil, err
+		}
+		var list []models.Candle
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.Candle), nil
+}
@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *MongoRepo) SaveCashEntry(ctx context.Context, e models.CashEntry) error {
+	_, err := r.cashCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("cash_ledger").ReplaceOne(ctx, bson.M{"_id": e.ID}, e, options.Replace().SetUpsert(true))
+	})
+	return err
+}
+
+func (r *MongoRepo) SaveCashEntries(ctx context.Context, userID string, entries []models.CashEntry, check []string, floor float64) error {
+	res, err := r.cashCB.Execute(func() (interface{}, error) {
+		sess, err := r.client.StartSession()
+		if err != nil {
+			return nil, err
+		}
+		defer sess.EndSession(ctx)
+		_, err = sess.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+			// Writing the guard makes concurrent transactions for the user
+			// conflict, so the balance read below cannot be stale.
+			_, err := r.db.Collection("cash_guards").UpdateOne(sc, bson.M{"_id": userID},
+				bson.M{"$inc": bson.M{"seq": 1}}, options.Update().SetUpsert(true))
+			if err != nil {
+				return nil, err
+			}
+			for _, e := range entries {
+				_, err := r.db.Collection("cash_ledger").ReplaceOne(sc, bson.M{"_id": e.ID}, e, options.Replace().SetUpsert(true))
+				if err != nil {
+					return nil, err
+				}
+			}
+			if len(check) == 0 {
+				return nil, nil
+			}
+			sums, err := r.sumCashIn(sc, bson.M{"user_id": userID, "currency": currencyIn(check)})
+			if err != nil {
+				return nil, err
+			}
+			var bal float64
+			for _, v := range sums {
+				bal += v
+			}
+			if bal < floor-1e-9 {
+				return nil, ErrInsufficientCash
+			}
+			return nil, nil
+		})
+		// A refused debit is not a fault of the store; keep it away from
+		// the breaker.
+		if errors.Is(err, ErrInsufficientCash) {
+			return err, nil
+		}
+		return nil, err
+	})
+	if err != nil {
+		return err
+	}
+	if e, ok := res.(error); ok {
+		return e
+	}
+	return nil
+}
+
+// currencyIn matches the given currency codes, "" standing for entries
+// stored without one.
+func currencyIn(codes []string) bson.M {
+	var in bson.A
+	for _, c := range codes {
+		if c == "" {
+			in = append(in, nil)
+		}
+		in = append(in, c)
+	}
+	return bson.M{"$in": in}
+}
+
+func (r *MongoRepo) ListCashEntries(ctx context.Context, userID string) ([]models.CashEntry, error) {
+	res, err := r.cashCB.Execute(func() (interface{}, error) {
+		cur, err := r.db.Collection("cash_ledger").Find(ctx, bson.M{"user_id": userID}, options.Find().SetSort(bson.M{"time": -1}))
+		if err != nil {
+			return nil, err
+		}
+		var list []models.CashEntry
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.CashEntry), nil
+}
+
+func (r *MongoRepo) CashBalances(ctx context.Context, userID string) (map[string]float64, error) {
+	return r.sumCash(ctx, bson.M{"user_id": userID})
+}
+
+func (r *MongoRepo) SumCashEntries(ctx context.Context, userID string, types []string, from, to time.Time) (map[string]float64, error) {
+	return r.sumCash(ctx, bson.M{
+		"user_id": userID,
+		"type":    bson.M{"$in": types},
+		"time":    bson.M{"$gt": from, "$lte": to},
+	})
+}
+
+// sumCash totals matching entries per currency. Entries without a currency
+// are keyed by "".
+func (r *MongoRepo) sumCash(ctx context.Context, match bson.M) (map[string]float64, error) {
+	res, err := r.cashCB.Execute(func() (interface{}, error) {
+		return r.sumCashIn(ctx, match)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(map[string]float64), nil
+}
+
+// sumCashIn is sumCash without the breaker, for use inside a transaction.
+func (r *MongoRepo) sumCashIn(ctx context.Context, match bson.M) (map[string]float64, error) {
+	cur, err := r.db.Collection("cash_ledger").Aggregate(ctx, bson.A{
+		bson.M{"$match": match},
+		bson.M{"$group": bson.M{
+			"_id":   bson.M{"$ifNull": bson.A{"$currency", ""}},
+			"total": bson.M{"$sum": "$amount"},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var out []struct {
+		Currency string  `bson:"_id"`
+		Total    float64 `bson:"total"`
+	}
+	if err := cur.All(ctx, &out); err != nil {
+		return nil, err
+	}
+	sums := make(map[string]float64, len(out))
+	for _, o := range out {
+		sums[o.Currency] += o.Total
+	}
+	return sums, nil
+}
+
+func (r *MongoRepo) CashUserIDs(ctx context.Context) ([]string, error) {
+	res, err := r.cashCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("cash_ledger").Distinct(ctx, "user_id", bson.M{})
+	})
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, v := range res.([]interface{}) {
+		if id, ok := v.(string); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (r *MongoRepo) SaveBorrowAccrual(ctx context.Context, exchange, date string) error {
+	_, err := r.cashCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("borrow_accruals").UpdateOne(ctx, bson.M{"_id": exchange},
+			bson.M{"$set": bson.M{"date": date}}, options.Update().SetUpsert(true))
+	})
+	return err
+}
+
+func (r *MongoRepo) BorrowAccruals(ctx context.Context) (map[string]string, error) {
+	res, err := r.cashCB.Execute(func() (interface{}, error) {
+		cur, err := r.db.Collection("borrow_accruals").Find(ctx, bson.M{})
+		if err != nil {
+			return nil, err
+		}
+		var list []struct {
+			Exchange string `bson:"_id"`
+			Date     string `bson:"date"`
+		}
+		if err := cur.All(ctx, &list); err != nil {
+			return nil, err
+		}
+		out := make(map[string]string, len(list))
+		for _, a := range list {
+			out[a.Exchange] = a.Date
+		}
+		return out, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(map[string]string), nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *MongoRepo) InsertCorporateActions(ctx context.Context, list []models.CorporateAction) error {
+	if len(list) == 0 {
+		return nil
+	}
+	writes := make([]mongo.WriteModel, 0, len(list))
+	for _, a := range list {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": a.ID}).
+			SetUpdate(bson.M{"$setOnInsert": a}).
+			SetUpsert(true))
+	}
+	_, err := r.actionCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("corporate_actions").BulkWrite(ctx, writes)
+	})
+	return err
+}
+
+func (r *MongoRepo) ListCorporateActions(ctx context.Context, symbol, status string) ([]models.CorporateAction, error) {
+	filter := bson.M{}
+	if symbol != "" {
+		filter["symbol"] = symbol
+	}
+	if status != "" {
+		filter["status"] = status
+	}
+	res, err := r.actionCB.Execute(func() (interface{}, error) {
+		cur, err := r.db.Collection("corporate_actions").Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "ex_date", Value: 1}, {Key: "created_at", Value: 1}}))
+		if err != nil {
+			return nil, err
+		}
+		var list []models.CorporateAction
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.CorporateAction), nil
+}
+
+func (r *MongoRepo) UpdateCorporateAction(ctx context.Context, a models.CorporateAction) error {
+	_, err := r.actionCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("corporate_actions").ReplaceOne(ctx, bson.M{"_id": a.ID}, a)
+	})
+	return err
+}
+
+func (r *MongoRepo) SaveAdjustment(ctx context.Context, adj models.Adjustment) error {
+	_, err := r.actionCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("adjustments").ReplaceOne(ctx, bson.M{"_id": adj.ID}, adj, options.Replace().SetUpsert(true))
+	})
+	return err
+}
+
+func (r *MongoRepo) ListAdjustments(ctx context.Context, userID string) ([]models.Adjustment, error) {
+	res, err := r.actionCB.Execute(func() (interface{}, error) {
+		cur, err := r.db.Collection("adjustments").Find(ctx, bson.M{"user_id": userID}, options.Find().SetSort(bson.M{"time": -1}))
+		if err != nil {
+			return nil, err
+		}
+		var list []models.Adjustment
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.Adjustment), nil
+}
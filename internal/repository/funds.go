@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *MongoRepo) UpsertFunds(ctx context.Context, list []models.Fund) error {
+	if len(list) == 0 {
+		return nil
+	}
+	writes := make([]mongo.WriteModel, 0, len(list))
+	for _, f := range list {
+		writes = append(writes, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"_id": f.Symbol}).
+			SetReplacement(f).
+			SetUpsert(true))
+	}
+	_, err := r.fundCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("funds").BulkWrite(ctx, writes)
+	})
+	return err
+}
+
+func (r *MongoRepo) GetFund(ctx context.Context, symbol string) (*models.Fund, error) {
+	var f models.Fund
+
+	res, err := r.fundCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("funds").FindOne(ctx, bson.M{"_id": symbol}), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decodeOne(res, &f); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+func (r *MongoRepo) ListFunds(ctx context.Context) ([]models.Fund, error) {
+	res, err := r.fundCB.Execute(func() (interface{}, error) {
+		cur, err := r.db.Collection("funds").Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"_id": 1}))
+		if err != nil {
+			return nil, err
+		}
+		var list []models.Fund
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.Fund), nil
+}
+
+func (r *MongoRepo) SaveNAVs(ctx context.Context, list []models.NAV) error {
+	if len(list) == 0 {
+		return nil
+	}
+	writes := make([]mongo.WriteModel, 0, len(list))
+	for _, n := range list {
+		n.ID = n.Symbol + ":" + n.Date
+		writes = append(writes, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"_id": n.ID}).
+			SetReplacement(n).
+			SetUpsert(true))
+	}
+	_, err := r.fundCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("navs").BulkWrite(ctx, writes)
+	})
+	return err
+}
+
+func (r *MongoRepo) GetNAV(ctx context.Context, symbol, date string) (*models.NAV, error) {
+	var n models.NAV
+
+	res, err := r.fundCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("navs").FindOne(ctx, bson.M{"_id": symbol + ":" + date}), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decodeOne(res, &n); err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+func (r *MongoRepo) ListNAVs(ctx context.Context, symbol string, limit int) ([]models.NAV, error) {
+	res, err := r.fundCB.Execute(func() (interface{}, error) {
+		opts := options.Find().SetSort(bson.M{"date": -1}).SetLimit(int64(limit))
+		cur, err := r.db.Collection("navs").Find(ctx, bson.M{"symbol": symbol}, opts)
+		if err != nil {
+			return nil, err
+		}
+		var list []models.NAV
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.NAV), nil
+}
+
+func (r *MongoRepo) SaveFundOrder(ctx context.Context, o models.FundOrder) error {
+	_, err := r.fundCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("fund_orders").ReplaceOne(ctx, bson.M{"_id": o.ID}, o, options.Replace().SetUpsert(true))
+	})
+	return err
+}
+
+func (r *MongoRepo) GetFundOrder(ctx context.Context, userID, id string) (*models.FundOrder, error) {
+	var o models.FundOrder
+
+	res, err := r.fundCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("fund_orders").FindOne(ctx, bson.M{"_id": id, "user_id": userID}), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decodeOne(res, &o); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+func (r *MongoRepo) ListFundOrders(ctx context.Context, userID string) ([]models.FundOrder, error) {
+	return r.findFundOrders(ctx, bson.M{"user_id": userID}, -1)
+}
+
+func (r *MongoRepo) ListPendingFundOrders(ctx context.Context) ([]models.FundOrder, error) {
+	return r.findFundOrders(ctx, bson.M{"status": bson.M{"$in": bson.A{models.FundOrderPending, models.FundOrderAllotting}}}, 1)
+}
+
+func (r *MongoRepo) findFundOrders(ctx context.Context, filter bson.M, order int) ([]models.FundOrder, error) {
+	res, err := r.fundCB.Execute(func() (interface{}, error) {
+		cur, err := r.db.Collection("fund_orders").Find(ctx, filter, options.Find().SetSort(bson.M{"created_at": order}))
+		if err != nil {
+			return nil, err
+		}
+		var list []models.FundOrder
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.FundOrder), nil
+}
+
+func (r *MongoRepo) SaveSIP(ctx context.Context, sip models.SIP) error {
+	_, err := r.fundCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("sips").ReplaceOne(ctx, bson.M{"_id": sip.ID}, sip, options.Replace().SetUpsert(true))
+	})
+	return err
+}
+
+func (r *MongoRepo) GetSIP(ctx context.Context, userID, id string) (*models.SIP, error) {
+	var sip models.SIP
+
+	res, err := r.fundCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("sips").FindOne(ctx, bson.M{"_id": id, "user_id": userID}), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decodeOne(res, &sip); err != nil {
+		return nil, err
+	}
+	return &sip, nil
+}
+
+func (r *MongoRepo) ListSIPs(ctx context.Context, userID string) ([]models.SIP, error) {
+	return r.findSIPs(ctx, bson.M{"user_id": userID})
+}
+
+func (r *MongoRepo) ListActiveSIPs(ctx context.Context) ([]models.SIP, error) {
+	return r.findSIPs(ctx, bson.M{"status": models.SIPActive})
+}
+
+func (r *MongoRepo) findSIPs(ctx context.Context, filter bson.M) ([]models.SIP, error) {
+	res, err := r.fundCB.Execute(func() (interface{}, error) {
+		cur, err := r.db.Collection("sips").Find(ctx, filter, options.Find().SetSort(bson.M{"created_at": 1}))
+		if err != nil {
+			return nil, err
+		}
+		var list []models.SIP
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.SIP), nil
+}
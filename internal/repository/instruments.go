@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *MongoRepo) UpsertInstruments(ctx context.Context, list []models.Instrument) error {
+	if len(list) == 0 {
+		return nil
+	}
+	writes := make([]mongo.WriteModel, 0, len(list))
+	for _, inst := range list {
+		writes = append(writes, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"symbol": inst.Symbol}).
+			SetReplacement(inst).
+			SetUpsert(true))
+	}
+	_, err := r.instrumentCB.Execute(func() (interface{}, error) {
+		return r.shared.Collection("instruments").BulkWrite(ctx, writes)
+	})
+	return err
+}
+
+func (r *MongoRepo) GetInstrument(ctx context.Context, symbol string) (*models.Instrument, error) {
+	var inst models.Instrument
+
+	res, err := r.instrumentCB.Execute(func() (interface{}, error) {
+		return r.shared.Collection("instruments").FindOne(ctx, bson.M{"symbol": strings.ToUpper(symbol)}), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decodeOne(res, &inst); err != nil {
+		return nil, err
+	}
+	return &inst, nil
+}
+
+func (r *MongoRepo) ListInstruments(ctx context.Context, exchange, assetClass string) ([]models.Instrument, error) {
+	filter := bson.M{}
+	if exchange != "" {
+		filter["exchange"] = exchange
+	}
+	if assetClass != "" {
+		filter["asset_class"] = assetClass
+	}
+	return r.findInstruments(ctx, filter, options.Find().SetSort(bson.M{"symbol": 1}))
+}
+
+func (r *MongoRepo) SearchInstruments(ctx context.Context, query string, limit int) ([]models.Instrument, error) {
+	re := ciRegex("^" + regexp.QuoteMeta(query))
+	filter := bson.M{"$or": bson.A{
+		bson.M{"symbol": re},
+		bson.M{"name": ciRegex(regexp.QuoteMeta(query))},
+		bson.M{"isin": re},
+	}}
+	opts := options.Find().SetSort(bson.M{"symbol": 1})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	return r.findInstruments(ctx, filter, opts)
+}
+
+func (r *MongoRepo) SaveSettledContract(ctx context.Context, symbol string, at time.Time) error {
+	_, err := r.instrumentCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("settled_contracts").UpdateOne(ctx, bson.M{"_id": symbol},
+			bson.M{"$set": bson.M{"settled_at": at}}, options.Update().SetUpsert(true))
+	})
+	return err
+}
+
+func (r *MongoRepo) SettledContracts(ctx context.Context) (map[string]bool, error) {
+	res, err := r.instrumentCB.Execute(func() (interface{}, error) {
+		cur, err := r.db.Collection("settled_contracts").Find(ctx, bson.M{})
+		if err != nil {
+			return nil, err
+		}
+		var list []struct {
+			Symbol string `bson:"_id"`
+		}
+		if err := cur.All(ctx, &list); err != nil {
+			return nil, err
+		}
+		out := make(map[string]bool, len(list))
+		for _, c := range list {
+			out[c.Symbol] = true
+		}
+		return out, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(map[string]bool), nil
+}
+
+func (r *MongoRepo) findInstruments(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]models.Instrument, error) {
+	res, err := r.instrumentCB.Execute(func() (interface{}, error) {
+		cur, err := r.shared.Collection("instruments").Find(ctx, filter, opts)
+		if err != nil {
+			return nil, err
+		}
+		var list []models.Instrument
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.Instrument), nil
+}
+
+// ciRegex builds a case-insensitive match on the server side.
+func ciRegex(pattern string) primitive.Regex {
+	return primitive.Regex{Pattern: pattern, Options: "i"}
+}
+
+// decodeOne decodes a FindOne result, mapping a miss to ErrNotFound.
+func decodeOne(res interface{}, v interface{}) error {
+	err := res.(*mongo.SingleResult).Decode(v)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *MongoRepo) SaveLot(ctx context.Context, lot models.Lot) error {
+	_, err := r.lotCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("lots").ReplaceOne(ctx, bson.M{"_id": lot.ID}, lot, options.Replace().SetUpsert(true))
+	})
+	return err
+}
+
+func (r *MongoRepo) ListOpenLots(ctx context.Context) ([]models.Lot, error) {
+	res, err := r.lotCB.Execute(func() (interface{}, error) {
+		cur, err := r.db.Collection("lots").Find(ctx, bson.M{"quantity": bson.M{"$ne": 0}}, options.Find().SetSort(bson.M{"acquired_at": 1}))
+		if err != nil {
+			return nil, err
+		}
+		var list []models.Lot
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.Lot), nil
+}
+
+func (r *MongoRepo) SaveClosedLot(ctx context.Context, c models.ClosedLot) error {
+	_, err := r.lotCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("closed_lots").InsertOne(ctx, c)
+	})
+	return err
+}
+
+func (r *MongoRepo) ListClosedLots(ctx context.Context, userID string) ([]models.ClosedLot, error) {
+	filter := bson.M{}
+	if userID != "" {
+		filter["user_id"] = userID
+	}
+	res, err := r.lotCB.Execute(func() (interface{}, error) {
+		cur, err := r.db.Collection("closed_lots").Find(ctx, filter, options.Find().SetSort(bson.M{"closed_at": 1}))
+		if err != nil {
+			return nil, err
+		}
+		var list []models.ClosedLot
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.ClosedLot), nil
+}
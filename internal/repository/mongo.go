@@ -19,9 +19,24 @@ import (
 )
 
 type MongoRepo struct {
-	client *mongo.Client
-	db     *mongo.Database
-	userCB *gobreaker.CircuitBreaker
+	client       *mongo.Client
+	db           *mongo.Database
+	shared       *mongo.Database // users, instruments and market data
+	userCB       *gobreaker.CircuitBreaker
+	instrumentCB *gobreaker.CircuitBreaker
+	candleCB     *gobreaker.CircuitBreaker
+	orderCB      *gobreaker.CircuitBreaker
+	watchlistCB  *gobreaker.CircuitBreaker
+	alertCB      *gobreaker.CircuitBreaker
+	lotCB        *gobreaker.CircuitBreaker
+	actionCB     *gobreaker.CircuitBreaker
+	cashCB       *gobreaker.CircuitBreaker
+	settlementCB *gobreaker.CircuitBreaker
+	portfolioCB  *gobreaker.CircuitBreaker
+	basketCB     *gobreaker.CircuitBreaker
+	fundCB       *gobreaker.CircuitBreaker
+	strategyCB   *gobreaker.CircuitBreaker
+	accountCB    *gobreaker.CircuitBreaker
 }
 
 func NewMongoRepo(cfg *config.Config) (*MongoRepo, error) {
@@ -37,11 +52,38 @@ func NewMongoRepo(cfg *config.Config) (*MongoRepo, error) {
 		return nil, err
 	}
 
+	db := client.Database(cfg.DBName)
+	return newMongoRepo(client, db, db), nil
+}
+
+// Database returns a repo that keeps accounts in the named database on the
+// same connection. Users, instruments and market data stay where they are,
+// so both repos see the same ones.
+func (r *MongoRepo) Database(name string) *MongoRepo {
+	return newMongoRepo(r.client, r.client.Database(name), r.shared)
+}
+
+func newMongoRepo(client *mongo.Client, db, shared *mongo.Database) *MongoRepo {
 	return &MongoRepo{
-		client: client,
-		db:     client.Database(cfg.DBName),
-		userCB: utils.NewCB("mongo-users"),
-	}, nil
+		client:       client,
+		db:           db,
+		shared:       shared,
+		userCB:       utils.NewCB("mongo-users"),
+		instrumentCB: utils.NewCB("mongo-instruments"),
+		candleCB:     utils.NewCB("mongo-candles"),
+		orderCB:      utils.NewCB("mongo-orders"),
+		watchlistCB:  utils.NewCB("mongo-watchlists"),
+		alertCB:      utils.NewCB("mongo-alerts"),
+		lotCB:        utils.NewCB("mongo-lots"),
+		actionCB:     utils.NewCB("mongo-corporate-actions"),
+		cashCB:       utils.NewCB("mongo-cash"),
+		settlementCB: utils.NewCB("mongo-settlement"),
+		portfolioCB:  utils.NewCB("mongo-portfolio"),
+		basketCB:     utils.NewCB("mongo-baskets"),
+		fundCB:       utils.NewCB("mongo-funds"),
+		strategyCB:   utils.NewCB("mongo-strategies"),
+		accountCB:    utils.NewCB("mongo-accounts"),
+	}
 }
 
 func (r *MongoRepo) CreateUser(ctx context.Context, email, password string) error {
@@ -49,7 +91,7 @@ func (r *MongoRepo) CreateUser(ctx context.Context, email, password string) erro
 	user := models.User{Email: email, PasswordHash: string(pwHash)}
 
 	_, err := r.userCB.Execute(func() (interface{}, error) {
-		return r.db.Collection("users").InsertOne(ctx, user)
+		return r.shared.Collection("users").InsertOne(ctx, user)
 	})
 	if mongo.IsDuplicateKeyError(err) {
 		return errors.New("email already exists")
@@ -62,7 +104,7 @@ func (r *MongoRepo) GetUserByEmail(ctx context.Context, email string) (*models.U
 
 	res, err := r.userCB.Execute(func() (interface{}, error) {
 		// return both value and error
-		return r.db.Collection("users").FindOne(ctx, bson.M{"email": email}), nil
+		return r.shared.Collection("users").FindOne(ctx, bson.M{"email": email}), nil
 	})
 	if err != nil {
 		return nil, err
@@ -75,11 +117,47 @@ func (r *MongoRepo) GetUserByEmail(ctx context.Context, email string) (*models.U
 	return &user, nil
 }
 
+func (r *MongoRepo) GetUserByID(ctx context.Context, id string) (*models.User, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	var user models.User
+
+	res, err := r.userCB.Execute(func() (interface{}, error) {
+		return r.shared.Collection("users").FindOne(ctx, bson.M{"_id": oid}), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decodeOne(res, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *MongoRepo) SetMargin(ctx context.Context, userID string, enabled bool) error {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return ErrNotFound
+	}
+	res, err := r.userCB.Execute(func() (interface{}, error) {
+		return r.shared.Collection("users").UpdateByID(ctx, oid, bson.M{"$set": bson.M{"margin": enabled}})
+	})
+	if err != nil {
+		return err
+	}
+	if res.(*mongo.UpdateResult).MatchedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
 func (r *MongoRepo) SaveRefreshToken(ctx context.Context, userID, token string) error {
 	oid, err := primitive.ObjectIDFromHex(userID)
 	if err != nil {
 		return err
 	}
-	_, err = r.db.Collection("users").UpdateByID(ctx, oid, bson.M{"$set": bson.M{"refresh_token": token}})
+	_, err = r.shared.Collection("users").UpdateByID(ctx, oid, bson.M{"$set": bson.M{"refresh_token": token}})
 	return err
 }
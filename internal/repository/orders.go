@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *MongoRepo) SaveOrder(ctx context.Context, o models.Order) error {
+	_, err := r.orderCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("orders").ReplaceOne(ctx, bson.M{"_id": o.ID}, o, options.Replace().SetUpsert(true))
+	})
+	return err
+}
+
+func (r *MongoRepo) GetOrder(ctx context.Context, id string) (*models.Order, error) {
+	var o models.Order
+
+	res, err := r.orderCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("orders").FindOne(ctx, bson.M{"_id": id}), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decodeOne(res, &o); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+func (r *MongoRepo) ListOrders(ctx context.Context, userID string) ([]models.Order, error) {
+	return r.findOrders(ctx, bson.M{"user_id": userID})
+}
+
+func (r *MongoRepo) ListOrdersByStatus(ctx context.Context, statuses ...string) ([]models.Order, error) {
+	return r.findOrders(ctx, bson.M{"status": bson.M{"$in": statuses}})
+}
+
+func (r *MongoRepo) ListChildOrders(ctx context.Context, parentID string) ([]models.Order, error) {
+	return r.findOrders(ctx, bson.M{"parent_id": parentID})
+}
+
+func (r *MongoRepo) findOrders(ctx context.Context, filter bson.M) ([]models.Order, error) {
+	res, err := r.orderCB.Execute(func() (interface{}, error) {
+		cur, err := r.db.Collection("orders").Find(ctx, filter, options.Find().SetSort(bson.M{"created_at": 1}))
+		if err != nil {
+			return nil, err
+		}
+		var list []models.Order
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.Order), nil
+}
+
+func (r *MongoRepo) SaveFill(ctx context.Context, f models.Fill) error {
+	_, err := r.orderCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("fills").ReplaceOne(ctx, bson.M{"_id": f.ID}, f, options.Replace().SetUpsert(true))
+	})
+	return err
+}
+
+func (r *MongoRepo) ListFills(ctx context.Context, userID string) ([]models.Fill, error) {
+	return r.findFills(ctx, bson.M{"user_id": userID})
+}
+
+func (r *MongoRepo) ListOrderFills(ctx context.Context, orderID string) ([]models.Fill, error) {
+	return r.findFills(ctx, bson.M{"order_id": orderID})
+}
+
+func (r *MongoRepo) findFills(ctx context.Context, filter bson.M) ([]models.Fill, error) {
+	res, err := r.orderCB.Execute(func() (interface{}, error) {
+		cur, err := r.db.Collection("fills").Find(ctx, filter, options.Find().SetSort(bson.M{"time": 1}))
+		if err != nil {
+			return nil, err
+		}
+		var list []models.Fill
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.Fill), nil
+}
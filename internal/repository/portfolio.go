@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *MongoRepo) SaveSnapshot(ctx context.Context, snap models.PortfolioSnapshot) error {
+	_, err := r.portfolioCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("portfolio_snapshots").ReplaceOne(ctx, bson.M{"_id": snap.ID}, snap, options.Replace().SetUpsert(true))
+	})
+	return err
+}
+
+func (r *MongoRepo) LatestSnapshot(ctx context.Context, userID, before string) (*models.PortfolioSnapshot, error) {
+	var snap models.PortfolioSnapshot
+
+	filter := bson.M{"user_id": userID}
+	if before != "" {
+		filter["date"] = bson.M{"$lt": before}
+	}
+	res, err := r.portfolioCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("portfolio_snapshots").FindOne(ctx, filter,
+			options.FindOne().SetSort(bson.M{"date": -1})), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decodeOne(res, &snap); err != nil {
+		return nil, err
+	}
+	return &snap, nil
+}
+
+func (r *MongoRepo) ListSnapshots(ctx context.Context, userID, from, to string) ([]models.PortfolioSnapshot, error) {
+	filter := bson.M{"user_id": userID}
+	date := bson.M{}
+	if from != "" {
+		date["$gte"] = from
+	}
+	if to != "" {
+		date["$lte"] = to
+	}
+	if len(date) > 0 {
+		filter["date"] = date
+	}
+	res, err := r.portfolioCB.Execute(func() (interface{}, error) {
+		cur, err := r.db.Collection("portfolio_snapshots").Find(ctx, filter, options.Find().SetSort(bson.M{"date": 1}))
+		if err != nil {
+			return nil, err
+		}
+		var list []models.PortfolioSnapshot
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.PortfolioSnapshot), nil
+}
+
+func (r *MongoRepo) LatestSnapshotDate(ctx context.Context) (string, error) {
+	var snap models.PortfolioSnapshot
+
+	res, err := r.portfolioCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("portfolio_snapshots").FindOne(ctx, bson.M{},
+			options.FindOne().SetSort(bson.M{"date": -1})), nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if err := decodeOne(res, &snap); errors.Is(err, ErrNotFound) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	return snap.Date, nil
+}
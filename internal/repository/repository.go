@@ -2,12 +2,209 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/hahahamid/broker-backend/internal/models"
 )
 
+// ErrNotFound is returned by lookups that match no document.
+var ErrNotFound = errors.New("not found")
+
+// ErrInsufficientCash is returned by SaveCashEntries when a checked
+// sub-ledger would go below its floor.
+var ErrInsufficientCash = errors.New("insufficient cash")
+
 type UserRepo interface {
 	CreateUser(ctx context.Context, email, password string) error
 	GetUserByEmail(ctx context.Context, email string) (*models.User, error)
+	GetUserByID(ctx context.Context, id string) (*models.User, error)
 	SaveRefreshToken(ctx context.Context, userID, token string) error
+	// SetMargin enables or disables margin trading, and with it short
+	// selling, for a user.
+	SetMargin(ctx context.Context, userID string, enabled bool) error
+}
+
+type InstrumentRepo interface {
+	UpsertInstruments(ctx context.Context, list []models.Instrument) error
+	GetInstrument(ctx context.Context, symbol string) (*models.Instrument, error)
+	ListInstruments(ctx context.Context, exchange, assetClass string) ([]models.Instrument, error)
+	SearchInstruments(ctx context.Context, query string, limit int) ([]models.Instrument, error)
+	// SaveSettledContract records that the account mode's positions in a
+	// contract were settled at its expiry. Instruments are shared by both
+	// modes, so this is kept in the mode's own database.
+	SaveSettledContract(ctx context.Context, symbol string, at time.Time) error
+	// SettledContracts is the set of contracts the mode has settled.
+	SettledContracts(ctx context.Context) (map[string]bool, error)
+}
+
+type CandleRepo interface {
+	SaveTicks(ctx context.Context, ticks []models.Trade) error
+	ListTicks(ctx context.Context, symbol string, from, to time.Time) ([]models.Trade, error)
+	UpsertCandles(ctx context.Context, list []models.Candle) error
+	DeleteCandles(ctx context.Context, symbol, interval string, from, to time.Time) error
+	// ListCandles returns up to limit bars in [from, to) starting after the
+	// given bar start, oldest first.
+	ListCandles(ctx context.Context, symbol, interval string, from, to, after time.Time, limit int) ([]models.Candle, error)
+}
+
+type OrderRepo interface {
+	SaveOrder(ctx context.Context, o models.Order) error
+	GetOrder(ctx context.Context, id string) (*models.Order, error)
+	ListOrders(ctx context.Context, userID string) ([]models.Order, error)
+	ListOrdersByStatus(ctx context.Context, statuses ...string) ([]models.Order, error)
+	// ListChildOrders returns an algo parent's child orders, oldest first.
+	ListChildOrders(ctx context.Context, parentID string) ([]models.Order, error)
+	// SaveFill upserts by ID so a retried write is not doubled.
+	SaveFill(ctx context.Context, f models.Fill) error
+	ListFills(ctx context.Context, userID string) ([]models.Fill, error)
+	// ListOrderFills returns the order's fills, oldest first.
+	ListOrderFills(ctx context.Context, orderID string) ([]models.Fill, error)
+}
+
+type WatchlistRepo interface {
+	CreateWatchlist(ctx context.Context, w *models.Watchlist) error
+	GetWatchlist(ctx context.Context, userID, id string) (*models.Watchlist, error)
+	ListWatchlists(ctx context.Context, userID string) ([]models.Watchlist, error)
+	UpdateWatchlist(ctx context.Context, w *models.Watchlist) error
+	DeleteWatchlist(ctx context.Context, userID, id string) error
+}
+
+type AlertRepo interface {
+	CreateAlert(ctx context.Context, a *models.Alert) error
+	GetAlert(ctx context.Context, userID, id string) (*models.Alert, error)
+	ListAlerts(ctx context.Context, userID string) ([]models.Alert, error)
+	ListActiveAlerts(ctx context.Context) ([]models.Alert, error)
+	UpdateAlert(ctx context.Context, a *models.Alert) error
+	DeleteAlert(ctx context.Context, userID, id string) error
+	SaveAlertEvent(ctx context.Context, ev *models.AlertEvent) error
+	ListAlertEvents(ctx context.Context, userID string, limit int) ([]models.AlertEvent, error)
+	SaveNotification(ctx context.Context, n *models.Notification) error
+	ListNotifications(ctx context.Context, userID string, unreadOnly bool) ([]models.Notification, error)
+	MarkNotificationsRead(ctx context.Context, userID string, ids []string) error
+}
+
+type LotRepo interface {
+	SaveLot(ctx context.Context, lot models.Lot) error
+	ListOpenLots(ctx context.Context) ([]models.Lot, error)
+	SaveClosedLot(ctx context.Context, c models.ClosedLot) error
+	// ListClosedLots returns one user's closed lots, or everyone's when
+	// userID is empty, oldest first.
+	ListClosedLots(ctx context.Context, userID string) ([]models.ClosedLot, error)
+}
+
+type CorporateActionRepo interface {
+	// InsertCorporateActions stores new actions; IDs already present are
+	// left untouched so re-ingesting a file never resets applied actions.
+	InsertCorporateActions(ctx context.Context, list []models.CorporateAction) error
+	ListCorporateActions(ctx context.Context, symbol, status string) ([]models.CorporateAction, error)
+	UpdateCorporateAction(ctx context.Context, a models.CorporateAction) error
+	SaveAdjustment(ctx context.Context, adj models.Adjustment) error
+	ListAdjustments(ctx context.Context, userID string) ([]models.Adjustment, error)
+}
+
+type CashRepo interface {
+	// SaveCashEntry upserts by ID so replayed postings are not doubled.
+	SaveCashEntry(ctx context.Context, e models.CashEntry) error
+	// SaveCashEntries upserts entries, all for userID, in one transaction.
+	// Unless the user's entries in the check currencies ("" for entries
+	// without one) still sum to floor or more once they are in, nothing is
+	// written and ErrInsufficientCash is returned. Concurrent calls for the
+	// same user conflict and are retried, so two debits cannot both pass on
+	// the same balance.
+	SaveCashEntries(ctx context.Context, userID string, entries []models.CashEntry, check []string, floor float64) error
+	ListCashEntries(ctx context.Context, userID string) ([]models.CashEntry, error)
+	// CashBalances totals the user's entries per currency; entries without
+	// a currency are keyed by "".
+	CashBalances(ctx context.Context, userID string) (map[string]float64, error)
+	// SumCashEntries totals the user's entries of the given types with a
+	// time in (from, to], per currency like CashBalances.
+	SumCashEntries(ctx context.Context, userID string, types []string, from, to time.Time) (map[string]float64, error)
+	// CashUserIDs lists every user with at least one ledger entry.
+	CashUserIDs(ctx context.Context) ([]string, error)
+	// SaveBorrowAccrual records the latest date borrow fees were accrued
+	// for on exchange.
+	SaveBorrowAccrual(ctx context.Context, exchange, date string) error
+	// BorrowAccruals maps each exchange to its latest accrued date.
+	BorrowAccruals(ctx context.Context) (map[string]string, error)
+}
+
+type SettlementRepo interface {
+	SaveSettlementRun(ctx context.Context, run models.SettlementRun) error
+	LatestSettlementRun(ctx context.Context, exchange string) (*models.SettlementRun, error)
+	ListSettlementRuns(ctx context.Context, limit int) ([]models.SettlementRun, error)
+}
+
+type PortfolioRepo interface {
+	SaveSnapshot(ctx context.Context, snap models.PortfolioSnapshot) error
+	// LatestSnapshot is the user's most recent snapshot dated before the
+	// given date, or of any date when before is empty.
+	LatestSnapshot(ctx context.Context, userID, before string) (*models.PortfolioSnapshot, error)
+	// ListSnapshots returns the user's snapshots dated within [from, to],
+	// oldest first; empty bounds are open.
+	ListSnapshots(ctx context.Context, userID, from, to string) ([]models.PortfolioSnapshot, error)
+	// LatestSnapshotDate is the most recent date any snapshot was taken.
+	LatestSnapshotDate(ctx context.Context) (string, error)
+}
+
+type BasketRepo interface {
+	SaveBasket(ctx context.Context, b models.Basket) error
+	GetBasket(ctx context.Context, userID, id string) (*models.Basket, error)
+	// ListBaskets returns the user's baskets, newest first.
+	ListBaskets(ctx context.Context, userID string) ([]models.Basket, error)
+	ListBasketOrders(ctx context.Context, basketID string) ([]models.Order, error)
+}
+
+type FundRepo interface {
+	UpsertFunds(ctx context.Context, list []models.Fund) error
+	GetFund(ctx context.Context, symbol string) (*models.Fund, error)
+	ListFunds(ctx context.Context) ([]models.Fund, error)
+	// SaveNAVs upserts by symbol and date.
+	SaveNAVs(ctx context.Context, list []models.NAV) error
+	GetNAV(ctx context.Context, symbol, date string) (*models.NAV, error)
+	// ListNAVs returns up to limit of the fund's latest NAVs, newest first.
+	ListNAVs(ctx context.Context, symbol string, limit int) ([]models.NAV, error)
+	SaveFundOrder(ctx context.Context, o models.FundOrder) error
+	GetFundOrder(ctx context.Context, userID, id string) (*models.FundOrder, error)
+	// ListFundOrders returns the user's fund orders, newest first.
+	ListFundOrders(ctx context.Context, userID string) ([]models.FundOrder, error)
+	// ListPendingFundOrders returns every user's pending orders, and those
+	// whose allotment was interrupted, oldest first.
+	ListPendingFundOrders(ctx context.Context) ([]models.FundOrder, error)
+	SaveSIP(ctx context.Context, sip models.SIP) error
+	GetSIP(ctx context.Context, userID, id string) (*models.SIP, error)
+	ListSIPs(ctx context.Context, userID string) ([]models.SIP, error)
+	ListActiveSIPs(ctx context.Context) ([]models.SIP, error)
+}
+
+type StrategyRepo interface {
+	SaveStrategy(ctx context.Context, st models.Strategy) error
+	GetStrategy(ctx context.Context, userID, id string) (*models.Strategy, error)
+	// ListStrategies returns the user's strategies, newest first.
+	ListStrategies(ctx context.Context, userID string) ([]models.Strategy, error)
+	ListRunningStrategies(ctx context.Context) ([]models.Strategy, error)
+}
+
+type AccountRepo interface {
+	GetAccount(ctx context.Context, userID string) (*models.Account, error)
+	SaveAccount(ctx context.Context, a models.Account) error
+}
+
+// Repo is everything the services need from persistence.
+type Repo interface {
+	UserRepo
+	InstrumentRepo
+	CandleRepo
+	OrderRepo
+	WatchlistRepo
+	AlertRepo
+	LotRepo
+	CorporateActionRepo
+	CashRepo
+	SettlementRepo
+	PortfolioRepo
+	BasketRepo
+	FundRepo
+	StrategyRepo
+	AccountRepo
 }
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *MongoRepo) SaveSettlementRun(ctx context.Context, run models.SettlementRun) error {
+	_, err := r.settlementCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("settlement_runs").ReplaceOne(ctx, bson.M{"_id": run.ID}, run, options.Replace().SetUpsert(true))
+	})
+	return err
+}
+
+func (r *MongoRepo) LatestSettlementRun(ctx context.Context, exchange string) (*models.SettlementRun, error) {
+	var run models.SettlementRun
+
+	res, err := r.settlementCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("settlement_runs").FindOne(ctx, bson.M{"exchange": exchange},
+			options.FindOne().SetSort(bson.M{"trading_date": -1})), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decodeOne(res, &run); err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+func (r *MongoRepo) ListSettlementRuns(ctx context.Context, limit int) ([]models.SettlementRun, error) {
+	res, err := r.settlementCB.Execute(func() (interface{}, error) {
+		opts := options.Find().SetSort(bson.D{{Key: "ran_at", Value: -1}}).SetLimit(int64(limit))
+		cur, err := r.db.Collection("settlement_runs").Find(ctx, bson.M{}, opts)
+		if err != nil {
+			return nil, err
+		}
+		var list []models.SettlementRun
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.SettlementRun), nil
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *MongoRepo) SaveStrategy(ctx context.Context, st models.Strategy) error {
+	_, err := r.strategyCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("strategies").ReplaceOne(ctx, bson.M{"_id": st.ID}, st, options.Replace().SetUpsert(true))
+	})
+	return err
+}
+
+func (r *MongoRepo) GetStrategy(ctx context.Context, userID, id string) (*models.Strategy, error) {
+	var st models.Strategy
+
+	res, err := r.strategyCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("strategies").FindOne(ctx, bson.M{"_id": id, "user_id": userID}), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decodeOne(res, &st); err != nil {
+		return nil, err
+	}
+	return &st, nil
+}
+
+func (r *MongoRepo) ListStrategies(ctx context.Context, userID string) ([]models.Strategy, error) {
+	return r.findStrategies(ctx, bson.M{"user_id": userID}, -1)
+}
+
+func (r *MongoRepo) ListRunningStrategies(ctx context.Context) ([]models.Strategy, error) {
+	return r.findStrategies(ctx, bson.M{"status": models.StrategyRunning}, 1)
+}
+
+func (r *MongoRepo) findStrategies(ctx context.Context, filter bson.M, order int) ([]models.Strategy, error) {
+	res, err := r.strategyCB.Execute(func() (interface{}, error) {
+		cur, err := r.db.Collection("strategies").Find(ctx, filter, options.Find().SetSort(bson.M{"started_at": order}))
+		if err != nil {
+			return nil, err
+		}
+		var list []models.Strategy
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.Strategy), nil
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *MongoRepo) CreateWatchlist(ctx context.Context, w *models.Watchlist) error {
+	res, err := r.watchlistCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("watchlists").InsertOne(ctx, w)
+	})
+	if err != nil {
+		return err
+	}
+	w.ID = res.(*mongo.InsertOneResult).InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *MongoRepo) GetWatchlist(ctx context.Context, userID, id string) (*models.Watchlist, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	var w models.Watchlist
+
+	res, err := r.watchlistCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("watchlists").FindOne(ctx, bson.M{"_id": oid, "user_id": userID}), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decodeOne(res, &w); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+func (r *MongoRepo) ListWatchlists(ctx context.Context, userID string) ([]models.Watchlist, error) {
+	res, err := r.watchlistCB.Execute(func() (interface{}, error) {
+		cur, err := r.db.Collection("watchlists").Find(ctx, bson.M{"user_id": userID}, options.Find().SetSort(bson.M{"created_at": 1}))
+		if err != nil {
+			return nil, err
+		}
+		var list []models.Watchlist
+		err = cur.All(ctx, &list)
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]models.Watchlist), nil
+}
+
+func (r *MongoRepo) UpdateWatchlist(ctx context.Context, w *models.Watchlist) error {
+	res, err := r.watchlistCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("watchlists").ReplaceOne(ctx, bson.M{"_id": w.ID, "user_id": w.UserID}, w)
+	})
+	if err != nil {
+		return err
+	}
+	if res.(*mongo.UpdateResult).MatchedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *MongoRepo) DeleteWatchlist(ctx context.Context, userID, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrNotFound
+	}
+	res, err := r.watchlistCB.Execute(func() (interface{}, error) {
+		return r.db.Collection("watchlists").DeleteOne(ctx, bson.M{"_id": oid, "user_id": userID})
+	})
+	if err != nil {
+		return err
+	}
+	if res.(*mongo.DeleteResult).DeletedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
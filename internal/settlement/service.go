@@ -0,0 +1,146 @@
+package settlement
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/calendar"
+	"github.com/hahahamid/broker-backend/internal/lots"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+)
+
+// Service is the end-of-day settlement job. Once an exchange's regular
+// session has closed it settles every lot whose settlement date is that
+// trading day or earlier, turning the day's net delivery buys into settled
+// holdings, and stores the run. A missed day is caught up by the next run.
+// The run is stored before the lots are settled; lots a stored run covers
+// but that are still unsettled, after a crash between the two, are settled
+// at startup.
+type Service struct {
+	repo repository.SettlementRepo
+	lots *lots.Service
+	cal  *calendar.Calendar
+
+	last map[string]string // exchange -> last settled trading date
+}
+
+func NewService(repo repository.SettlementRepo, lotSvc *lots.Service, cal *calendar.Calendar) *Service {
+	return &Service{repo: repo, lots: lotSvc, cal: cal, last: map[string]string{}}
+}
+
+// Run checks for due settlements at startup and then once a minute.
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		if _, err := s.RunDue(ctx, time.Now()); err != nil {
+			log.Printf("settlement: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunDue settles each exchange whose latest closed trading day has not been
+// settled yet and returns the runs it stored.
+func (s *Service) RunDue(ctx context.Context, now time.Time) ([]models.SettlementRun, error) {
+	exchanges := map[string]bool{}
+	for _, ex := range s.cal.Exchanges() {
+		exchanges[ex] = true
+	}
+	for _, ex := range s.lots.UnsettledExchanges() {
+		exchanges[ex] = true
+	}
+	names := make([]string, 0, len(exchanges))
+	for ex := range exchanges {
+		names = append(names, ex)
+	}
+	sort.Strings(names)
+
+	var runs []models.SettlementRun
+	for _, ex := range names {
+		day := s.cal.LastClosedDay(ex, now)
+		date := day.Format(time.DateOnly)
+		last, err := s.lastDate(ctx, ex)
+		if err != nil {
+			return runs, err
+		}
+		if date <= last {
+			continue
+		}
+		run := s.preview(ex, day, now)
+		if err := s.repo.SaveSettlementRun(ctx, run); err != nil {
+			return runs, err
+		}
+		s.lots.Settle(ex, day)
+		s.last[ex] = date
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
+
+func (s *Service) lastDate(ctx context.Context, exchange string) (string, error) {
+	if d, ok := s.last[exchange]; ok {
+		return d, nil
+	}
+	run, err := s.repo.LatestSettlementRun(ctx, exchange)
+	if errors.Is(err, repository.ErrNotFound) {
+		s.last[exchange] = ""
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if day, err := time.ParseInLocation(time.DateOnly, run.TradingDate, s.cal.Location(exchange)); err == nil {
+		s.lots.Settle(exchange, day)
+	}
+	s.last[exchange] = run.TradingDate
+	return run.TradingDate, nil
+}
+
+// preview builds the run that settling the exchange's lots due by day
+// would record, without settling them.
+func (s *Service) preview(exchange string, day, now time.Time) models.SettlementRun {
+	date := day.Format(time.DateOnly)
+	run := models.SettlementRun{
+		ID:          exchange + ":" + date,
+		Exchange:    exchange,
+		TradingDate: date,
+		RanAt:       now,
+		Results:     []models.SettlementResult{},
+	}
+	type key struct{ user, symbol string }
+	index := map[key]int{}
+	for _, lot := range s.lots.Due(exchange, day) {
+		k := key{lot.UserID, lot.Symbol}
+		i, ok := index[k]
+		if !ok {
+			i = len(run.Results)
+			index[k] = i
+			run.Results = append(run.Results, models.SettlementResult{UserID: lot.UserID, Symbol: lot.Symbol})
+		}
+		run.Results[i].Quantity += lot.Quantity
+		run.Results[i].Value += lot.Quantity * lot.Price
+	}
+	sort.Slice(run.Results, func(i, j int) bool {
+		a, b := run.Results[i], run.Results[j]
+		if a.UserID != b.UserID {
+			return a.UserID < b.UserID
+		}
+		return a.Symbol < b.Symbol
+	})
+	log.Printf("settlement %s: %d results", run.ID, len(run.Results))
+	return run
+}
+
+// Runs returns the most recent settlement runs, newest first.
+func (s *Service) Runs(ctx context.Context, limit int) ([]models.SettlementRun, error) {
+	return s.repo.ListSettlementRuns(ctx, limit)
+}
@@ -0,0 +1,103 @@
+package shorts
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+// LoadFile reads the locate list from a .csv or .json file. CSV columns are
+// symbol, quantity and fee_rate, matched by header name.
+func LoadFile(path string) ([]models.Locate, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var list []models.Locate
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.NewDecoder(f).Decode(&list)
+	case ".csv":
+		list, err = readCSV(f)
+	default:
+		return nil, fmt.Errorf("unsupported locate file %q", path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := Normalize(list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func readCSV(r io.Reader) ([]models.Locate, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	col := map[string]int{}
+	for i, h := range rows[0] {
+		col[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	for _, name := range []string{"symbol", "quantity"} {
+		if _, ok := col[name]; !ok {
+			return nil, fmt.Errorf("locate csv: missing %s column", name)
+		}
+	}
+
+	var list []models.Locate
+	for n, row := range rows[1:] {
+		str := func(name string) string {
+			if i, ok := col[name]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		l := models.Locate{Symbol: str("symbol")}
+		for name, dst := range map[string]*float64{
+			"quantity": &l.Quantity,
+			"fee_rate": &l.FeeRate,
+		} {
+			if s := str(name); s != "" {
+				if *dst, err = strconv.ParseFloat(s, 64); err != nil {
+					return nil, fmt.Errorf("locate csv line %d: %s: %w", n+2, name, err)
+				}
+			}
+		}
+		list = append(list, l)
+	}
+	return list, nil
+}
+
+// Normalize upper-cases symbols and validates a locate list.
+func Normalize(list []models.Locate) error {
+	seen := map[string]bool{}
+	for i := range list {
+		l := &list[i]
+		l.Symbol = strings.ToUpper(strings.TrimSpace(l.Symbol))
+		switch {
+		case l.Symbol == "":
+			return fmt.Errorf("%w: locate %d has no symbol", ErrInvalid, i+1)
+		case seen[l.Symbol]:
+			return fmt.Errorf("%w: %s is listed twice", ErrInvalid, l.Symbol)
+		case l.Quantity < 0 || l.FeeRate < 0:
+			return fmt.Errorf("%w: %s quantity and fee rate must not be negative", ErrInvalid, l.Symbol)
+		}
+		seen[l.Symbol] = true
+	}
+	return nil
+}
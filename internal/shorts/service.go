@@ -0,0 +1,507 @@
+package shorts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/calendar"
+	"github.com/hahahamid/broker-backend/internal/cash"
+	"github.com/hahahamid/broker-backend/internal/lots"
+	"github.com/hahahamid/broker-backend/internal/marketdata"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+)
+
+var (
+	ErrInvalid        = errors.New("invalid short sale")
+	ErrMarginRequired = errors.New("short selling needs a margin account")
+	ErrNoLocate       = errors.New("not enough stock to borrow")
+)
+
+const eps = 1e-9
+
+// Placer places orders on a user's behalf; buy-ins go through it.
+type Placer interface {
+	Place(ctx context.Context, userID string, o models.Order) (models.Order, error)
+}
+
+// buyIn is a working forced cover.
+type buyIn struct {
+	userID, symbol string
+	qty            float64
+}
+
+// Service approves short sells against the locate list, accrues borrow
+// fees on open shorts once each trading day has closed, and forces
+// buy-ins when borrow is withdrawn or an account loses margin. Futures and
+// options are written rather than borrowed: selling them short takes
+// margin but no locate, and costs no borrow fee.
+type Service struct {
+	repo   repository.Repo
+	lots   *lots.Service
+	cash   *cash.Service
+	prices *marketdata.PriceCache
+	cal    *calendar.Calendar
+	orders Placer
+	peer   *Service // the other account mode's, see SetPeer
+
+	mu      sync.Mutex // serialises locate checks with their reservations
+	locates map[string]models.Locate
+	buyIns  map[string]buyIn  // by order ID
+	accrued map[string]string // exchange -> latest date fees were accrued for
+}
+
+func NewService(repo repository.Repo, lotSvc *lots.Service, cashSvc *cash.Service, prices *marketdata.PriceCache, cal *calendar.Calendar, locates []models.Locate) *Service {
+	s := &Service{
+		repo:    repo,
+		lots:    lotSvc,
+		cash:    cashSvc,
+		prices:  prices,
+		cal:     cal,
+		locates: map[string]models.Locate{},
+		buyIns:  map[string]buyIn{},
+		accrued: map[string]string{},
+	}
+	for _, l := range locates {
+		s.locates[l.Symbol] = l
+	}
+	return s
+}
+
+// SetOrders sets where buy-ins are placed. The orders service itself
+// depends on this one, so it is wired in after both are built.
+func (s *Service) SetOrders(p Placer) {
+	s.orders = p
+}
+
+// Restore reloads the buy-ins still working after a restart, so they are
+// not placed again, and the dates fees were last accrued for, so days the
+// server was down are charged on the next accrual.
+func (s *Service) Restore(ctx context.Context) error {
+	list, err := s.repo.ListOrdersByStatus(ctx, models.OrderOpen, models.OrderPartiallyFilled, models.OrderQueued)
+	if err != nil {
+		return err
+	}
+	accrued, err := s.repo.BorrowAccruals(ctx)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ex, date := range accrued {
+		s.accrued[ex] = date
+	}
+	for _, o := range list {
+		if o.BuyIn {
+			s.buyIns[o.ID] = buyIn{userID: o.UserID, symbol: o.Symbol, qty: o.Remaining()}
+		}
+	}
+	return nil
+}
+
+// Reserve approves a short sell and reserves its borrow: the account must
+// have margin, hold none of the symbol long, and unless it is a contract
+// the locate list must cover the quantity beyond what is already borrowed.
+func (s *Service) Reserve(ctx context.Context, o *models.Order) error {
+	user, err := s.repo.GetUserByID(ctx, o.UserID)
+	if err != nil {
+		return err
+	}
+	if !user.Margin {
+		return ErrMarginRequired
+	}
+	var long float64
+	for _, lot := range s.lots.Lots(o.UserID, o.Symbol) {
+		long += lot.Quantity
+	}
+	if long > eps {
+		return fmt.Errorf("%w: sell the %g %s held before selling short", ErrInvalid, long, o.Symbol)
+	}
+
+	written, err := s.contract(ctx, o.Symbol)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if written {
+		return s.lots.Reserve(o)
+	}
+	loc, ok := s.locates[o.Symbol]
+	if !ok {
+		return fmt.Errorf("%w: %s is not on the locate list", ErrNoLocate, o.Symbol)
+	}
+	if avail := loc.Quantity - s.lots.ShortInterest(o.Symbol); avail+eps < o.Quantity {
+		return fmt.Errorf("%w: %g %s available", ErrNoLocate, max(avail, 0), o.Symbol)
+	}
+	return s.lots.Reserve(o)
+}
+
+// contract reports whether symbol is a future or option, which are short
+// without borrowing anything.
+func (s *Service) contract(ctx context.Context, symbol string) (bool, error) {
+	inst, err := s.repo.GetInstrument(ctx, symbol)
+	if errors.Is(err, repository.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return inst.IsDerivative(), nil
+}
+
+// Locates lists the locate list with what is borrowed against it.
+func (s *Service) Locates() []models.Locate {
+	s.mu.Lock()
+	out := make([]models.Locate, 0, len(s.locates))
+	for _, l := range s.locates {
+		out = append(out, l)
+	}
+	s.mu.Unlock()
+	for i := range out {
+		out[i].Borrowed = s.lots.ShortInterest(out[i].Symbol)
+		out[i].Available = max(out[i].Quantity-out[i].Borrowed, 0)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Symbol < out[j].Symbol })
+	return out
+}
+
+// SetPeer links the other account mode's service. Locates and margin are
+// set for both modes, and shorts in either that lose them are bought in.
+func (s *Service) SetPeer(p *Service) {
+	s.peer = p
+}
+
+// SetLocates replaces the locate list in both account modes. Where open
+// shorts now exceed what can be borrowed, the newest shorts are bought in.
+func (s *Service) SetLocates(ctx context.Context, list []models.Locate) error {
+	if err := Normalize(list); err != nil {
+		return err
+	}
+	if err := s.setLocates(ctx, list); err != nil {
+		return err
+	}
+	if s.peer != nil {
+		return s.peer.setLocates(ctx, list)
+	}
+	return nil
+}
+
+func (s *Service) setLocates(ctx context.Context, list []models.Locate) error {
+	s.mu.Lock()
+	s.locates = make(map[string]models.Locate, len(list))
+	for _, l := range list {
+		s.locates[l.Symbol] = l
+	}
+	s.mu.Unlock()
+	return s.enforce(ctx)
+}
+
+// SetMargin enables or disables margin for a user. Users are shared by
+// both account modes; disabling it buys in all of the user's open shorts
+// in either.
+func (s *Service) SetMargin(ctx context.Context, userID string, enabled bool) error {
+	if err := s.repo.SetMargin(ctx, userID, enabled); err != nil {
+		return err
+	}
+	if enabled {
+		return nil
+	}
+	if err := s.buyInAll(ctx, userID); err != nil {
+		return err
+	}
+	if s.peer != nil {
+		return s.peer.buyInAll(ctx, userID)
+	}
+	return nil
+}
+
+// buyInAll buys in every open short of the user.
+func (s *Service) buyInAll(ctx context.Context, userID string) error {
+	open := map[string]float64{}
+	for _, lot := range s.lots.Shorts() {
+		if lot.UserID == userID {
+			open[lot.Symbol] -= lot.Quantity
+		}
+	}
+	for sym, qty := range open {
+		if err := s.buyIn(ctx, userID, sym, qty-s.working(userID, sym), "margin disabled"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enforce buys in shorts that the locate list no longer covers, newest
+// first.
+func (s *Service) enforce(ctx context.Context) error {
+	shorts := s.lots.Shorts()
+	borrowed := map[string]float64{}
+	for _, lot := range shorts {
+		borrowed[lot.Symbol] -= lot.Quantity
+	}
+	for sym, qty := range borrowed {
+		if written, err := s.contract(ctx, sym); err != nil {
+			return err
+		} else if written {
+			continue
+		}
+		s.mu.Lock()
+		shortfall := qty - s.locates[sym].Quantity
+		s.mu.Unlock()
+		shortfall -= s.working("", sym)
+		if shortfall <= eps {
+			continue
+		}
+		due := map[string]float64{}
+		var users []string
+		for i := len(shorts) - 1; i >= 0 && shortfall > eps; i-- {
+			lot := shorts[i]
+			if lot.Symbol != sym {
+				continue
+			}
+			q := min(-lot.Quantity, shortfall)
+			if _, ok := due[lot.UserID]; !ok {
+				users = append(users, lot.UserID)
+			}
+			due[lot.UserID] += q
+			shortfall -= q
+		}
+		for _, u := range users {
+			if err := s.buyIn(ctx, u, sym, due[u], "borrow recalled"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// working is the quantity of open buy-ins in symbol, for one user or, with
+// an empty userID, for everyone.
+func (s *Service) working(userID, symbol string) float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var q float64
+	for _, b := range s.buyIns {
+		if b.symbol == symbol && (userID == "" || b.userID == userID) {
+			q += b.qty
+		}
+	}
+	return q
+}
+
+// buyIn places a market buy covering qty of the user's short, queued for
+// the open when the market is closed.
+func (s *Service) buyIn(ctx context.Context, userID, symbol string, qty float64, reason string) error {
+	qty = math.Ceil(qty - eps)
+	if qty <= 0 {
+		return nil
+	}
+	if s.orders == nil {
+		return errors.New("shorts: no order placer for buy-ins")
+	}
+	o, err := s.orders.Place(ctx, userID, models.Order{
+		Symbol:      symbol,
+		Side:        "buy",
+		Type:        models.OrderTypeMarket,
+		Quantity:    qty,
+		Validity:    models.ValidityGTC,
+		AfterMarket: true,
+		BuyIn:       true,
+	})
+	if err != nil {
+		return fmt.Errorf("buy-in %g %s for %s: %w", qty, symbol, userID, err)
+	}
+	log.Printf("shorts: buy-in %s of %g %s for %s: %s", o.ID, qty, symbol, userID, reason)
+	// A market order that met the book is already done by now.
+	switch o.Status {
+	case models.OrderQueued, models.OrderOpen, models.OrderPartiallyFilled:
+		s.mu.Lock()
+		s.buyIns[o.ID] = buyIn{userID: userID, symbol: symbol, qty: o.Remaining()}
+		s.mu.Unlock()
+	}
+	return nil
+}
+
+func (s *Service) OnOrder(o models.Order) {
+	if !o.BuyIn {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch o.Status {
+	case models.OrderFilled, models.OrderCancelled, models.OrderRejected, models.OrderExpired:
+		delete(s.buyIns, o.ID)
+	default:
+		if b, ok := s.buyIns[o.ID]; ok {
+			b.qty = o.Remaining()
+			s.buyIns[o.ID] = b
+		}
+	}
+}
+
+func (s *Service) OnFill(models.Fill) {}
+
+// Positions lists the user's open shorts with the borrow fees charged on
+// each symbol so far.
+func (s *Service) Positions(ctx context.Context, userID string) ([]models.ShortPosition, error) {
+	var out []models.ShortPosition
+	bySymbol := map[string]int{}
+	for _, lot := range s.lots.Lots(userID, "") {
+		if lot.Quantity >= 0 {
+			continue
+		}
+		i, ok := bySymbol[lot.Symbol]
+		if !ok {
+			i = len(out)
+			bySymbol[lot.Symbol] = i
+			out = append(out, models.ShortPosition{Symbol: lot.Symbol, Currency: lot.Currency})
+		}
+		p := &out[i]
+		p.AvgPrice = (p.AvgPrice*p.Quantity + lot.Price*lot.Quantity) / (p.Quantity + lot.Quantity)
+		p.Quantity += lot.Quantity
+	}
+	if len(out) == 0 {
+		return out, nil
+	}
+	entries, err := s.repo.ListCashEntries(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	fees := map[string]float64{}
+	for _, e := range entries {
+		if e.Type == models.CashBorrowFee {
+			fees[e.Symbol] -= e.Amount
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range out {
+		p := &out[i]
+		p.LastPrice, _ = s.prices.Mark(p.Symbol)
+		p.UnrealizedPNL = s.prices.UnrealizedPNL(p.Symbol, p.Quantity, p.AvgPrice)
+		p.FeeRate = s.locates[p.Symbol].FeeRate
+		p.AccruedFees = fees[p.Symbol]
+	}
+	return out, nil
+}
+
+// Run accrues due borrow fees at startup and then once a minute.
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		if err := s.Accrue(ctx, time.Now()); err != nil {
+			log.Printf("shorts: borrow fees: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Accrue charges borrow fees on shorts open at the close of each exchange's
+// latest closed trading day: the short's market value times the annual fee
+// rate over 365, for each calendar day since the previous accrual or since
+// the short was opened, if later. The date accrued for is stored per
+// exchange, so days the server was down are charged once it is back.
+// Entries are keyed by user, symbol and date, so a rerun replaces rather
+// than doubles them.
+func (s *Service) Accrue(ctx context.Context, now time.Time) error {
+	type key struct{ userID, symbol, exchange, currency string }
+	open := map[key]float64{}
+	cost := map[key]float64{}
+	since := map[key]string{} // date the oldest open lot was sold short
+	var exchanges []string
+	closes := map[string]time.Time{}
+	for _, lot := range s.lots.Shorts() {
+		if _, ok := closes[lot.Exchange]; !ok {
+			day := s.cal.LastClosedDay(lot.Exchange, now)
+			closes[lot.Exchange] = s.cal.SessionClose(lot.Exchange, day)
+			exchanges = append(exchanges, lot.Exchange)
+		}
+		if !lot.AcquiredAt.Before(closes[lot.Exchange]) {
+			continue
+		}
+		k := key{lot.UserID, lot.Symbol, lot.Exchange, lot.Currency}
+		open[k] -= lot.Quantity
+		cost[k] -= lot.Quantity * lot.Price
+		date := lot.AcquiredAt.In(s.cal.Location(lot.Exchange)).Format(time.DateOnly)
+		if d, ok := since[k]; !ok || date < d {
+			since[k] = date
+		}
+	}
+
+	s.mu.Lock()
+	days := map[string]int{}
+	dates := map[string]string{}
+	for _, ex := range exchanges {
+		date := s.cal.LastClosedDay(ex, now).Format(time.DateOnly)
+		prev := s.accrued[ex]
+		if prev != "" && date <= prev {
+			continue
+		}
+		days[ex], dates[ex] = 1, date
+		if prev != "" {
+			days[ex] = daysBetween(prev, date)
+		}
+	}
+	rates := map[string]float64{}
+	for sym, l := range s.locates {
+		rates[sym] = l.FeeRate
+	}
+	s.mu.Unlock()
+
+	for k, qty := range open {
+		n, ok := days[k.exchange]
+		if !ok || rates[k.symbol] <= 0 {
+			continue
+		}
+		// A short opened since the previous accrual owes only the days held.
+		n = min(n, daysBetween(since[k], dates[k.exchange])+1)
+		price, ok := s.prices.Mark(k.symbol)
+		if !ok {
+			price = cost[k] / qty
+		}
+		fee := qty * price * rates[k.symbol] / 100 / 365 * float64(n)
+		err := s.cash.Post(ctx, models.CashEntry{
+			ID:        "borrow_fee:" + k.userID + ":" + k.symbol + ":" + dates[k.exchange],
+			UserID:    k.userID,
+			Type:      models.CashBorrowFee,
+			Currency:  k.currency,
+			Amount:    -fee,
+			Symbol:    k.symbol,
+			Reference: dates[k.exchange],
+			Note:      fmt.Sprintf("borrow %g @ %g, %g%%/yr x %d days", qty, price, rates[k.symbol], n),
+			Time:      now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for ex, date := range dates {
+		if err := s.repo.SaveBorrowAccrual(ctx, ex, date); err != nil {
+			return err
+		}
+		s.mu.Lock()
+		s.accrued[ex] = date
+		s.mu.Unlock()
+	}
+	return nil
+}
+
+// daysBetween counts the calendar days from one date to a later one.
+func daysBetween(from, to string) int {
+	a, _ := time.Parse(time.DateOnly, from)
+	b, _ := time.Parse(time.DateOnly, to)
+	return int(b.Sub(a).Hours()/24 + 0.5)
+}
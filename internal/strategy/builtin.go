@@ -0,0 +1,153 @@
+package strategy
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+func init() {
+	Register("sma_cross", newSMACross)
+	Register("random", newRandom)
+}
+
+func symbolParam(p Params) (string, error) {
+	sym := strings.ToUpper(strings.TrimSpace(p.String("symbol", "")))
+	if sym == "" {
+		return "", fmt.Errorf("%w: symbol is required", ErrInvalid)
+	}
+	return sym, nil
+}
+
+func mid(q models.Quote) float64 {
+	if q.Bid > 0 && q.Ask > 0 {
+		return (q.Bid + q.Ask) / 2
+	}
+	return q.Bid + q.Ask
+}
+
+// smaCross goes long qty when the fast moving average of the quote mid
+// crosses above the slow one and sells the whole position when it crosses
+// back below.
+type smaCross struct {
+	symbol     string
+	fast, slow int
+	qty        float64
+	mids       []float64
+	above      int // 1 when fast was above slow, -1 below, 0 not yet known
+}
+
+func newSMACross(p Params) (Strategy, error) {
+	s := &smaCross{}
+	var err error
+	if s.symbol, err = symbolParam(p); err != nil {
+		return nil, err
+	}
+	if s.fast, err = p.Int("fast", 5); err != nil {
+		return nil, err
+	}
+	if s.slow, err = p.Int("slow", 20); err != nil {
+		return nil, err
+	}
+	if s.qty, err = p.Float("qty", 1); err != nil {
+		return nil, err
+	}
+	if s.fast <= 0 || s.slow <= s.fast {
+		return nil, fmt.Errorf("%w: need 0 < fast < slow", ErrInvalid)
+	}
+	if s.qty <= 0 {
+		return nil, fmt.Errorf("%w: qty must be positive", ErrInvalid)
+	}
+	return s, nil
+}
+
+func (s *smaCross) OnQuote(b Broker, q models.Quote) {
+	if q.Symbol != s.symbol {
+		return
+	}
+	if m := mid(q); m > 0 {
+		s.mids = append(s.mids, m)
+	}
+	if len(s.mids) > s.slow {
+		s.mids = s.mids[1:]
+	}
+	if len(s.mids) < s.slow {
+		return
+	}
+	fast, slow := average(s.mids[s.slow-s.fast:]), average(s.mids)
+	above := -1
+	if fast > slow {
+		above = 1
+	}
+	prev := s.above
+	s.above = above
+	if prev == 0 || prev == above {
+		return
+	}
+	pos := b.Position(s.symbol)
+	switch {
+	case above > 0 && pos <= 0:
+		b.Place(models.Order{Symbol: s.symbol, Side: "buy", Type: models.OrderTypeMarket, Quantity: s.qty})
+	case above < 0 && pos > 0:
+		b.Place(models.Order{Symbol: s.symbol, Side: "sell", Type: models.OrderTypeMarket, Quantity: pos})
+	}
+}
+
+func (s *smaCross) OnFill(Broker, models.Fill) {}
+
+func (s *smaCross) OnTimer(Broker, time.Time) {}
+
+func average(v []float64) float64 {
+	var sum float64
+	for _, x := range v {
+		sum += x
+	}
+	return sum / float64(len(v))
+}
+
+// random buys or sells qty at market on a quote with probability prob,
+// never selling more than it holds. It exists to exercise the runtime and
+// to show that a seeded run repeats exactly.
+type random struct {
+	symbol string
+	qty    float64
+	prob   float64
+}
+
+func newRandom(p Params) (Strategy, error) {
+	s := &random{}
+	var err error
+	if s.symbol, err = symbolParam(p); err != nil {
+		return nil, err
+	}
+	if s.qty, err = p.Float("qty", 1); err != nil {
+		return nil, err
+	}
+	if s.prob, err = p.Float("prob", 0.05); err != nil {
+		return nil, err
+	}
+	if s.qty <= 0 || s.prob < 0 || s.prob > 1 {
+		return nil, fmt.Errorf("%w: need qty > 0 and prob between 0 and 1", ErrInvalid)
+	}
+	return s, nil
+}
+
+func (s *random) OnQuote(b Broker, q models.Quote) {
+	if q.Symbol != s.symbol || b.Rand().Float64() >= s.prob {
+		return
+	}
+	side := "buy"
+	if b.Rand().Intn(2) == 0 {
+		if b.Position(s.symbol) < s.qty {
+			return
+		}
+		side = "sell"
+	}
+	b.Place(models.Order{Symbol: s.symbol, Side: side, Type: models.OrderTypeMarket, Quantity: s.qty})
+}
+
+func (s *random) OnFill(Broker, models.Fill) {}
+
+func (s *random) OnTimer(Broker, time.Time) {}
@@ -0,0 +1,294 @@
+package strategy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+const (
+	// startTimeout bounds starting a worker and building its strategy.
+	startTimeout = 5 * time.Second
+	// cpuWindow is the span a worker's CPU use is averaged over.
+	cpuWindow = 10 * time.Second
+)
+
+// WorkerConfig is how hosted strategies' worker processes are run.
+type WorkerConfig struct {
+	// Path is the binary run with WorkerCommand; the running one when empty.
+	Path string
+	// MemoryMB caps a worker's resident memory; 0 leaves it unbounded.
+	MemoryMB int
+	// CPUPercent caps a worker's CPU use, as a share of one core averaged
+	// over cpuWindow; 0 leaves it unbounded.
+	CPUPercent int
+}
+
+// account is the part of a Broker a worker's calls are served from.
+type account interface {
+	Place(o models.Order) (models.Order, error)
+	Cancel(orderID string) error
+	OpenOrders() []models.Order
+	Position(symbol string) float64
+	Cash() float64
+	Mark(symbol string) (float64, bool)
+}
+
+// sandbox is one strategy running in a worker process. The worker gets an
+// empty environment and one CPU, a Go memory limit just under MemoryMB,
+// and its own process group, which kill takes down whatever it is doing.
+// Its events are only sent from the instance's goroutine.
+type sandbox struct {
+	cmd    *exec.Cmd
+	enc    *json.Encoder
+	dec    *json.Decoder
+	stderr *head
+	exited chan struct{}
+	err    error // how it exited, set before exited is closed
+
+	cpu []cpuSample // check only
+}
+
+type cpuSample struct {
+	at  time.Time
+	cpu time.Duration
+}
+
+// spawn starts a worker running the strategy st.
+func spawn(cfg WorkerConfig, st models.Strategy) (*sandbox, error) {
+	path := cfg.Path
+	if path == "" {
+		var err error
+		if path, err = os.Executable(); err != nil {
+			return nil, err
+		}
+	}
+	events, toWorker, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	fromWorker, calls, err := os.Pipe()
+	if err != nil {
+		events.Close()
+		toWorker.Close()
+		return nil, err
+	}
+	b := &sandbox{
+		cmd:    exec.Command(path, WorkerCommand),
+		enc:    json.NewEncoder(toWorker),
+		dec:    json.NewDecoder(fromWorker),
+		stderr: &head{max: 4096},
+		exited: make(chan struct{}),
+	}
+	b.cmd.Env = []string{"GOMAXPROCS=1"}
+	if cfg.MemoryMB > 0 {
+		b.cmd.Env = append(b.cmd.Env, fmt.Sprintf("GOMEMLIMIT=%dMiB", cfg.MemoryMB*9/10))
+	}
+	b.cmd.ExtraFiles = []*os.File{events, calls}
+	b.cmd.Stderr = b.stderr
+	b.cmd.WaitDelay = time.Second
+	isolate(b.cmd)
+	err = b.cmd.Start()
+	events.Close()
+	calls.Close()
+	if err != nil {
+		toWorker.Close()
+		fromWorker.Close()
+		return nil, fmt.Errorf("start worker: %w", err)
+	}
+	go func() {
+		b.err = b.cmd.Wait()
+		toWorker.Close()
+		fromWorker.Close()
+		close(b.exited)
+	}()
+
+	started := make(chan error, 1)
+	go func() {
+		started <- b.event(nil, message{Type: "start", Name: st.Name, Params: Params(st.Params), Seed: st.Seed})
+	}()
+	select {
+	case err = <-started:
+	case <-time.After(startTimeout):
+		err = fmt.Errorf("worker did not start within %s", startTimeout)
+	}
+	if err != nil {
+		b.kill()
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *sandbox) OnQuote(a account, q models.Quote) error {
+	return b.event(a, message{Type: "quote", Quote: &q})
+}
+
+func (b *sandbox) OnFill(a account, f models.Fill) error {
+	return b.event(a, message{Type: "fill", Fill: &f})
+}
+
+func (b *sandbox) OnTimer(a account, now time.Time) error {
+	return b.event(a, message{Type: "timer", Time: now})
+}
+
+// event sends the worker one event and serves its calls on a until it is
+// done, returning the callback's panic or why the worker could not run it.
+func (b *sandbox) event(a account, m message) error {
+	if err := b.enc.Encode(m); err != nil {
+		return b.failed(err)
+	}
+	for {
+		var req message
+		if err := b.dec.Decode(&req); err != nil {
+			return b.failed(err)
+		}
+		switch {
+		case req.Type == "done":
+			return req.err()
+		case req.Type == "call" && a != nil:
+			if err := b.enc.Encode(serve(a, req)); err != nil {
+				return b.failed(err)
+			}
+		default:
+			b.kill()
+			return fmt.Errorf("worker sent an unexpected %s", req.Type)
+		}
+	}
+}
+
+// serve answers one call from the worker.
+func serve(a account, req message) message {
+	rep := message{Type: "reply"}
+	switch req.Method {
+	case "place":
+		if req.Order == nil {
+			rep.setError(fmt.Errorf("%w: no order", ErrInvalid))
+			break
+		}
+		o, err := a.Place(*req.Order)
+		rep.Order = &o
+		rep.setError(err)
+	case "cancel":
+		rep.setError(a.Cancel(req.OrderID))
+	case "open_orders":
+		rep.Orders = a.OpenOrders()
+	case "position":
+		rep.Value = a.Position(req.Symbol)
+	case "cash":
+		rep.Value = a.Cash()
+	case "mark":
+		rep.Value, rep.OK = a.Mark(req.Symbol)
+	default:
+		rep.setError(fmt.Errorf("unknown call %q", req.Method))
+	}
+	return rep
+}
+
+// failed explains a broken pipe by how the worker exited, if it has.
+func (b *sandbox) failed(err error) error {
+	select {
+	case <-b.exited:
+	case <-time.After(time.Second):
+		b.kill()
+		return fmt.Errorf("worker: %w", err)
+	}
+	return errors.New(b.reason())
+}
+
+// dead reports whether the worker has exited.
+func (b *sandbox) dead() bool {
+	select {
+	case <-b.exited:
+		return true
+	default:
+		return false
+	}
+}
+
+// reason describes how an exited worker ended, with the panic or fatal
+// error it reported, such as one from a goroutine the strategy started.
+func (b *sandbox) reason() string {
+	msg := "worker exited"
+	if b.err != nil {
+		msg += ": " + b.err.Error()
+	}
+	if cause := b.stderr.cause(); cause != "" {
+		msg += ": " + cause
+	}
+	return msg
+}
+
+// kill ends the worker's process group at once; a callback it is running
+// is stopped with it.
+func (b *sandbox) kill() {
+	if b.dead() {
+		return
+	}
+	killGroup(b.cmd.Process)
+}
+
+// overLimit samples the worker's resident memory and CPU time and says
+// which limit, if any, it is over. Only check calls it.
+func (b *sandbox) overLimit(cfg WorkerConfig, now time.Time) string {
+	u, ok := usage(b.cmd.Process.Pid)
+	if !ok {
+		return ""
+	}
+	if cfg.MemoryMB > 0 && u.rss > int64(cfg.MemoryMB)<<20 {
+		return fmt.Sprintf("worker used %dMB of memory, over %dMB", u.rss>>20, cfg.MemoryMB)
+	}
+	b.cpu = append(b.cpu, cpuSample{at: now, cpu: u.cpu})
+	for len(b.cpu) > 1 && now.Sub(b.cpu[1].at) >= cpuWindow {
+		b.cpu = b.cpu[1:]
+	}
+	first := b.cpu[0]
+	span := now.Sub(first.at)
+	if cfg.CPUPercent <= 0 || span < cpuWindow {
+		return ""
+	}
+	if pct := 100 * float64(u.cpu-first.cpu) / float64(span); pct > float64(cfg.CPUPercent) {
+		return fmt.Sprintf("worker used %.0f%% of a CPU over %s, over %d%%", pct, span.Round(time.Second), cfg.CPUPercent)
+	}
+	return ""
+}
+
+type procUsage struct {
+	rss int64 // bytes
+	cpu time.Duration
+}
+
+// head keeps the first max bytes written to it.
+type head struct {
+	max int
+
+	mu  sync.Mutex
+	buf []byte
+}
+
+func (h *head) Write(p []byte) (int, error) {
+	h.mu.Lock()
+	if n := h.max - len(h.buf); n > 0 {
+		h.buf = append(h.buf, p[:min(n, len(p))]...)
+	}
+	h.mu.Unlock()
+	return len(p), nil
+}
+
+// cause is the first panic or fatal error line written.
+func (h *head) cause() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, line := range strings.Split(string(h.buf), "\n") {
+		if strings.HasPrefix(line, "panic: ") || strings.HasPrefix(line, "fatal error: ") {
+			return strings.TrimSpace(line)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,49 @@
+package strategy
+
+import (
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// isolate puts the worker in a process group of its own, so kill reaches
+// anything it starts, and has it killed if the server dies.
+func isolate(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true, Pdeathsig: syscall.SIGKILL}
+}
+
+func killGroup(p *os.Process) {
+	syscall.Kill(-p.Pid, syscall.SIGKILL)
+}
+
+// usage reads a process's resident memory and CPU time from /proc.
+func usage(pid int) (procUsage, bool) {
+	b, err := os.ReadFile("/proc/" + strconv.Itoa(pid) + "/stat")
+	if err != nil {
+		return procUsage{}, false
+	}
+	// Fields after the command name, which may hold spaces, start at the
+	// state, field 3 in proc(5).
+	i := strings.LastIndexByte(string(b), ')')
+	if i < 0 {
+		return procUsage{}, false
+	}
+	f := strings.Fields(string(b[i+1:]))
+	if len(f) < 22 {
+		return procUsage{}, false
+	}
+	utime, err1 := strconv.ParseInt(f[11], 10, 64)
+	stime, err2 := strconv.ParseInt(f[12], 10, 64)
+	rss, err3 := strconv.ParseInt(f[21], 10, 64)
+	if err1 != nil || err2 != nil || err3 != nil {
+		return procUsage{}, false
+	}
+	// /proc counts CPU time in USER_HZ ticks, 100 a second.
+	return procUsage{
+		rss: rss * int64(os.Getpagesize()),
+		cpu: time.Duration(utime+stime) * 10 * time.Millisecond,
+	}, true
+}
@@ -0,0 +1,22 @@
+//go:build !linux
+
+package strategy
+
+import (
+	"os"
+	"os/exec"
+)
+
+// isolate leaves the worker in the server's process group; kill only
+// reaches the worker itself.
+func isolate(cmd *exec.Cmd) {}
+
+func killGroup(p *os.Process) {
+	p.Kill()
+}
+
+// usage is only read on Linux, so MemoryMB and CPUPercent are not
+// enforced elsewhere beyond the worker's Go memory limit.
+func usage(pid int) (procUsage, bool) {
+	return procUsage{}, false
+}
@@ -0,0 +1,160 @@
+package strategy
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+// The test binary doubles as the worker, so spawn runs it with
+// WorkerCommand.
+func TestMain(m *testing.M) {
+	if len(os.Args) > 1 && os.Args[1] == WorkerCommand {
+		if err := ServeWorker(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+var ballast []byte
+
+// testStrategy places on a quote and cancels when refused, panics on a
+// fill, and on the timer either spins or grabs memory.
+type testStrategy struct{ timer string }
+
+func (s testStrategy) OnQuote(b Broker, q models.Quote) {
+	_, err := b.Place(models.Order{Symbol: q.Symbol, Side: "buy", Quantity: 1})
+	if errors.Is(err, ErrLimit) {
+		b.Cancel("refused:" + err.Error())
+	}
+}
+
+func (s testStrategy) OnFill(b Broker, f models.Fill) {
+	panic("bad fill " + f.ID)
+}
+
+func (s testStrategy) OnTimer(b Broker, now time.Time) {
+	switch s.timer {
+	case "spin":
+		for {
+		}
+	case "grab":
+		ballast = make([]byte, 64<<20)
+		for i := range ballast {
+			ballast[i] = 1
+		}
+	}
+}
+
+func init() {
+	Register("test", func(p Params) (Strategy, error) {
+		return testStrategy{timer: p.String("timer", "")}, nil
+	})
+}
+
+type testAccount struct {
+	placed    []models.Order
+	cancelled []string
+}
+
+func (a *testAccount) Place(o models.Order) (models.Order, error) {
+	a.placed = append(a.placed, o)
+	return o, fmt.Errorf("%w: 1 working orders", ErrLimit)
+}
+
+func (a *testAccount) Cancel(orderID string) error {
+	a.cancelled = append(a.cancelled, orderID)
+	return nil
+}
+
+func (a *testAccount) OpenOrders() []models.Order         { return nil }
+func (a *testAccount) Position(symbol string) float64     { return 0 }
+func (a *testAccount) Cash() float64                      { return 0 }
+func (a *testAccount) Mark(symbol string) (float64, bool) { return 0, false }
+
+func startTest(t *testing.T, cfg WorkerConfig, timer string) *sandbox {
+	t.Helper()
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.Path = exe
+	b, err := spawn(cfg, models.Strategy{Name: "test", Params: map[string]string{"timer": timer}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(b.kill)
+	return b
+}
+
+func TestSandboxCalls(t *testing.T) {
+	b := startTest(t, WorkerConfig{}, "")
+	a := &testAccount{}
+	if err := b.OnQuote(a, models.Quote{Symbol: "AAPL", Bid: 10}); err != nil {
+		t.Fatal(err)
+	}
+	if len(a.placed) != 1 || a.placed[0].Symbol != "AAPL" {
+		t.Fatalf("placed %+v", a.placed)
+	}
+	// The refusal reached the strategy still matching ErrLimit.
+	if len(a.cancelled) != 1 || a.cancelled[0] != "refused:strategy limit reached: 1 working orders" {
+		t.Fatalf("cancelled %v", a.cancelled)
+	}
+
+	err := b.OnFill(a, models.Fill{ID: "f1"})
+	if err == nil || err.Error() != "panic: bad fill f1" {
+		t.Fatalf("fill: %v", err)
+	}
+	// A panic is reported, not fatal to the worker.
+	if err := b.OnQuote(a, models.Quote{Symbol: "AAPL"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSandboxKill(t *testing.T) {
+	b := startTest(t, WorkerConfig{}, "spin")
+	done := make(chan error, 1)
+	go func() { done <- b.OnTimer(&testAccount{}, time.Now()) }()
+	select {
+	case err := <-done:
+		t.Fatalf("spinning callback returned: %v", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+	b.kill()
+	select {
+	case err := <-done:
+		if err == nil || !strings.Contains(err.Error(), "killed") {
+			t.Fatalf("got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("callback still running after kill")
+	}
+	if !b.dead() {
+		t.Fatal("worker still running")
+	}
+}
+
+func TestSandboxMemoryLimit(t *testing.T) {
+	cfg := WorkerConfig{MemoryMB: 32}
+	b := startTest(t, cfg, "grab")
+	if _, ok := usage(b.cmd.Process.Pid); !ok {
+		t.Skip("process usage is not read on this system")
+	}
+	if over := b.overLimit(cfg, time.Now()); over != "" {
+		t.Fatalf("over before allocating: %s", over)
+	}
+	if err := b.OnTimer(&testAccount{}, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if over := b.overLimit(cfg, time.Now()); !strings.Contains(over, "over 32MB") {
+		t.Fatalf("got %q", over)
+	}
+}
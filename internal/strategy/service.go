@@ -0,0 +1,800 @@
+package strategy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/cash"
+	"github.com/hahahamid/broker-backend/internal/marketdata"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/orders"
+	"github.com/hahahamid/broker-backend/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	ErrNotFound = errors.New("strategy not found")
+	ErrState    = errors.New("strategy cannot do that now")
+	// ErrLimit is returned to a strategy placing an order beyond its limits.
+	ErrLimit = errors.New("strategy limit reached")
+	// ErrStopped is returned to a strategy that acts after being stopped.
+	ErrStopped = errors.New("strategy is not running")
+)
+
+const (
+	eps = 1e-9
+
+	// MaxSymbols caps the symbols one strategy subscribes to.
+	MaxSymbols = 20
+
+	defaultOrdersPerMinute = 30
+	defaultOpenOrders      = 10
+	defaultCallbackMS      = 1000
+	defaultTimerSeconds    = 60
+
+	// callTimeout bounds each order placed or cancelled for a strategy.
+	callTimeout = 10 * time.Second
+)
+
+// Service hosts users' strategies on the live feed. Each runs in a worker
+// process of its own, fed by a goroutine here, so one slow strategy never
+// holds up the feed, the engine or another strategy. Quotes for its
+// symbols are coalesced to the latest per symbol while it is busy; its
+// fills are all delivered, before quotes. A strategy only reaches the
+// account through its Broker, whose calls the worker makes over a pipe:
+// orders go through orders.Service with every usual check, and it sees and
+// cancels only the orders it placed. A callback that panics or overruns
+// its time limit, a worker that exits or goes over its memory or CPU
+// limit, or a loss beyond the strategy's limit kills it, and killing a
+// strategy kills its worker.
+type Service struct {
+	repo       repository.Repo
+	orders     *orders.Service
+	cash       *cash.Service
+	prices     *marketdata.PriceCache
+	maxPerUser int
+	worker     WorkerConfig
+
+	peer *Service // the other account mode's, see SetPeer
+
+	mu      sync.Mutex
+	running map[string]*instance // by ID
+}
+
+// NewService lets each user run up to maxPerUser strategies at once, each
+// in a worker run per worker.
+func NewService(repo repository.Repo, orderSvc *orders.Service, cashSvc *cash.Service, prices *marketdata.PriceCache, maxPerUser int, worker WorkerConfig) *Service {
+	if maxPerUser <= 0 {
+		maxPerUser = 5
+	}
+	return &Service{
+		repo:       repo,
+		orders:     orderSvc,
+		cash:       cashSvc,
+		prices:     prices,
+		maxPerUser: maxPerUser,
+		worker:     worker,
+		running:    map[string]*instance{},
+	}
+}
+
+// Restore restarts the strategies that were running before a restart,
+// with their attributed positions and working orders.
+func (s *Service) Restore(ctx context.Context) error {
+	list, err := s.repo.ListRunningStrategies(ctx)
+	if err != nil {
+		return err
+	}
+	if len(list) == 0 {
+		return nil
+	}
+	working, err := s.repo.ListOrdersByStatus(ctx, models.OrderOpen, models.OrderPartiallyFilled, models.OrderQueued)
+	if err != nil {
+		return err
+	}
+	for _, st := range list {
+		box, err := spawn(s.worker, st)
+		if err != nil {
+			st.Status, st.Reason, st.StoppedAt = models.StrategyStopped, "not restarted: "+err.Error(), time.Now()
+			if err := s.repo.SaveStrategy(ctx, st); err != nil {
+				return err
+			}
+			continue
+		}
+		in := s.newInstance(st, box)
+		for _, o := range working {
+			if o.StrategyID == st.ID {
+				in.open[o.ID] = o
+			}
+		}
+		s.mu.Lock()
+		s.running[st.ID] = in
+		s.mu.Unlock()
+		go in.run()
+	}
+	return nil
+}
+
+// Start runs the strategy registered as st.Name on the user's account.
+func (s *Service) Start(ctx context.Context, userID string, st models.Strategy) (models.Strategy, error) {
+	st.Name = strings.TrimSpace(st.Name)
+	if _, err := New(st.Name, Params(st.Params)); err != nil {
+		return st, err
+	}
+	seen := map[string]bool{}
+	var symbols []string
+	for _, sym := range st.Symbols {
+		sym = strings.ToUpper(strings.TrimSpace(sym))
+		if sym != "" && !seen[sym] {
+			seen[sym] = true
+			symbols = append(symbols, sym)
+		}
+	}
+	l := &st.Limits
+	switch {
+	case len(symbols) == 0:
+		return st, fmt.Errorf("%w: give the symbols it trades", ErrInvalid)
+	case len(symbols) > MaxSymbols:
+		return st, fmt.Errorf("%w: at most %d symbols", ErrInvalid, MaxSymbols)
+	case st.TimerSeconds < 0:
+		return st, fmt.Errorf("%w: timer_seconds must be positive", ErrInvalid)
+	case l.MaxOrdersPerMinute < 0, l.MaxOpenOrders < 0, l.MaxOrderValue < 0, l.MaxPosition < 0, l.MaxLoss < 0, l.CallbackMS < 0:
+		return st, fmt.Errorf("%w: limits cannot be negative", ErrInvalid)
+	}
+	if l.MaxOrdersPerMinute == 0 {
+		l.MaxOrdersPerMinute = defaultOrdersPerMinute
+	}
+	if l.MaxOpenOrders == 0 {
+		l.MaxOpenOrders = defaultOpenOrders
+	}
+	if l.CallbackMS == 0 {
+		l.CallbackMS = defaultCallbackMS
+	}
+	if st.TimerSeconds == 0 {
+		st.TimerSeconds = defaultTimerSeconds
+	}
+	now := time.Now()
+	if st.Seed == 0 {
+		st.Seed = now.UnixNano()
+	}
+	st = models.Strategy{
+		ID:           primitive.NewObjectID().Hex(),
+		UserID:       userID,
+		Name:         st.Name,
+		Params:       st.Params,
+		Symbols:      symbols,
+		Limits:       st.Limits,
+		TimerSeconds: st.TimerSeconds,
+		Seed:         st.Seed,
+		Status:       models.StrategyRunning,
+		Positions:    []models.StrategyPosition{},
+		StartedAt:    now,
+		UpdatedAt:    now,
+	}
+	s.mu.Lock()
+	n := s.count(userID)
+	s.mu.Unlock()
+	if n >= s.maxPerUser {
+		return st, fmt.Errorf("%w: already running %d strategies", ErrState, n)
+	}
+	// The worker starts outside the lock so quotes keep flowing to the
+	// others; the count is checked again once it is up.
+	box, err := spawn(s.worker, st)
+	if err != nil {
+		return st, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if n := s.count(userID); n >= s.maxPerUser {
+		box.kill()
+		return st, fmt.Errorf("%w: already running %d strategies", ErrState, n)
+	}
+	if err := s.repo.SaveStrategy(ctx, st); err != nil {
+		box.kill()
+		return st, err
+	}
+	in := s.newInstance(st, box)
+	s.running[st.ID] = in
+	go in.run()
+	return in.snapshot(), nil
+}
+
+// count is how many strategies the user is running. The caller holds mu.
+func (s *Service) count(userID string) int {
+	var n int
+	for _, in := range s.running {
+		if in.userID == userID {
+			n++
+		}
+	}
+	return n
+}
+
+// Stop stops a running strategy and cancels its working orders.
+func (s *Service) Stop(ctx context.Context, userID, id string) (models.Strategy, error) {
+	return s.halt(ctx, userID, id, models.StrategyStopped, "stopped by user")
+}
+
+// Kill is the kill switch for one strategy. It halts it like Stop but
+// records it as killed.
+func (s *Service) Kill(ctx context.Context, userID, id string) (models.Strategy, error) {
+	return s.halt(ctx, userID, id, models.StrategyKilled, "killed by user")
+}
+
+func (s *Service) halt(ctx context.Context, userID, id, status, reason string) (models.Strategy, error) {
+	s.mu.Lock()
+	in, ok := s.running[id]
+	s.mu.Unlock()
+	if !ok || in.userID != userID {
+		st, err := s.Get(ctx, userID, id)
+		if err != nil {
+			return st, err
+		}
+		return st, fmt.Errorf("%w: it is %s", ErrState, st.Status)
+	}
+	return s.stop(in, status, reason), nil
+}
+
+// SetPeer links the other account mode's service, so the kill switch
+// stops the strategies of both modes.
+func (s *Service) SetPeer(p *Service) {
+	s.peer = p
+}
+
+// KillAll is the kill switch for every hosted strategy, in both account
+// modes, and returns how many were running.
+func (s *Service) KillAll(ctx context.Context) int {
+	n := s.killAll()
+	if s.peer != nil {
+		n += s.peer.killAll()
+	}
+	return n
+}
+
+func (s *Service) killAll() int {
+	s.mu.Lock()
+	list := make([]*instance, 0, len(s.running))
+	for _, in := range s.running {
+		list = append(list, in)
+	}
+	s.mu.Unlock()
+	for _, in := range list {
+		s.stop(in, models.StrategyKilled, "kill switch")
+	}
+	return len(list)
+}
+
+// stop ends an instance's callbacks, killing its worker, cancels its
+// working orders and stores its final state. Only the first call for an instance does
+// anything.
+func (s *Service) stop(in *instance, status, reason string) models.Strategy {
+	in.mu.Lock()
+	if in.st.Status != models.StrategyRunning {
+		in.mu.Unlock()
+		return in.snapshot()
+	}
+	now := time.Now()
+	in.st.Status, in.st.Reason, in.st.StoppedAt, in.st.UpdatedAt = status, reason, now, now
+	close(in.done)
+	in.box.kill()
+	ids := make([]string, 0, len(in.open))
+	for id := range in.open {
+		ids = append(ids, id)
+	}
+	in.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	for _, id := range ids {
+		if _, err := s.orders.Cancel(ctx, in.userID, id); err != nil {
+			log.Printf("strategy %s: cancel %s: %v", in.id, id, err)
+		}
+	}
+	s.mu.Lock()
+	delete(s.running, in.id)
+	s.mu.Unlock()
+	st := in.snapshot()
+	if err := s.repo.SaveStrategy(ctx, st); err != nil {
+		log.Printf("strategy %s: save: %v", in.id, err)
+	}
+	log.Printf("strategy %s (%s) %s: %s", in.id, st.Name, status, reason)
+	return st
+}
+
+// Get returns a strategy with its PnL marked to market.
+func (s *Service) Get(ctx context.Context, userID, id string) (models.Strategy, error) {
+	s.mu.Lock()
+	in, ok := s.running[id]
+	s.mu.Unlock()
+	if ok && in.userID == userID {
+		return in.snapshot(), nil
+	}
+	st, err := s.repo.GetStrategy(ctx, userID, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return models.Strategy{}, ErrNotFound
+	}
+	if err != nil {
+		return models.Strategy{}, err
+	}
+	s.mark(st)
+	return *st, nil
+}
+
+// List returns the user's strategies, newest first.
+func (s *Service) List(ctx context.Context, userID string) ([]models.Strategy, error) {
+	list, err := s.repo.ListStrategies(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range list {
+		if in, ok := s.running[list[i].ID]; ok {
+			list[i] = in.snapshot()
+		} else {
+			s.mark(&list[i])
+		}
+	}
+	return list, nil
+}
+
+// mark fills in unrealized PnL at the current marks.
+func (s *Service) mark(st *models.Strategy) {
+	st.UnrealizedPNL = 0
+	for i := range st.Positions {
+		p := &st.Positions[i]
+		p.LastPrice, _ = s.prices.Mark(p.Symbol)
+		p.UnrealizedPNL = s.prices.UnrealizedPNL(p.Symbol, p.Quantity, p.AvgPrice)
+		st.UnrealizedPNL += p.UnrealizedPNL
+	}
+	st.PNL = st.RealizedPNL + st.UnrealizedPNL - st.Charges
+}
+
+func (s *Service) instance(id string) *instance {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running[id]
+}
+
+func (s *Service) OnQuote(q models.Quote) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, in := range s.running {
+		if in.symbols[q.Symbol] {
+			in.pushQuote(q)
+		}
+	}
+}
+
+func (s *Service) OnTrade(models.Trade) {}
+
+func (s *Service) OnOrder(o models.Order) {
+	if o.StrategyID == "" {
+		return
+	}
+	if in := s.instance(o.StrategyID); in != nil {
+		in.onOrder(o)
+	}
+}
+
+func (s *Service) OnFill(f models.Fill) {
+	if f.StrategyID == "" {
+		return
+	}
+	if in := s.instance(f.StrategyID); in != nil {
+		in.onFill(f)
+	}
+}
+
+// OnCharged counts a fill's charges against the strategy that placed it.
+func (s *Service) OnCharged(f models.Fill, total float64) {
+	if f.StrategyID == "" || total == 0 {
+		return
+	}
+	if in := s.instance(f.StrategyID); in != nil {
+		in.mu.Lock()
+		in.st.Charges += total
+		in.dirty = true
+		in.mu.Unlock()
+	}
+}
+
+// Run enforces callback time, worker and loss limits and stores the state
+// of strategies that changed, every second.
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.check(ctx, now)
+		}
+	}
+}
+
+func (s *Service) check(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	list := make([]*instance, 0, len(s.running))
+	for _, in := range s.running {
+		list = append(list, in)
+	}
+	s.mu.Unlock()
+	for _, in := range list {
+		st := in.snapshot()
+		in.mu.Lock()
+		busy, dirty := in.busySince, in.dirty
+		in.dirty = false
+		in.mu.Unlock()
+		limit := time.Duration(st.Limits.CallbackMS) * time.Millisecond
+		var over string
+		if !in.box.dead() {
+			over = in.box.overLimit(s.worker, now)
+		}
+		switch {
+		case !busy.IsZero() && now.Sub(busy) > limit:
+			s.stop(in, models.StrategyKilled, fmt.Sprintf("callback ran over %dms", st.Limits.CallbackMS))
+		case in.box.dead():
+			s.stop(in, models.StrategyKilled, in.box.reason())
+		case over != "":
+			s.stop(in, models.StrategyKilled, over)
+		case st.Limits.MaxLoss > 0 && st.PNL < -st.Limits.MaxLoss:
+			s.stop(in, models.StrategyKilled, fmt.Sprintf("loss of %.2f beyond max_loss", -st.PNL))
+		case dirty:
+			if err := s.repo.SaveStrategy(ctx, st); err != nil {
+				log.Printf("strategy %s: save: %v", in.id, err)
+			}
+		}
+	}
+}
+
+// instance is a running strategy and the account its worker's Broker
+// calls are served from.
+type instance struct {
+	svc     *Service
+	id      string
+	userID  string
+	symbols map[string]bool
+	box     *sandbox
+	timer   time.Duration
+	done    chan struct{}
+	wake    chan struct{}
+
+	mu        sync.Mutex
+	st        models.Strategy
+	positions map[string]*models.StrategyPosition
+	open      map[string]models.Order // working orders by ID
+	placed    []time.Time             // orders placed in the last minute
+	quotes    map[string]models.Quote // latest undelivered quote per symbol
+	fills     []models.Fill           // undelivered fills
+	busySince time.Time               // start of the running callback
+	dirty     bool
+}
+
+func (s *Service) newInstance(st models.Strategy, box *sandbox) *instance {
+	in := &instance{
+		svc:       s,
+		id:        st.ID,
+		userID:    st.UserID,
+		symbols:   map[string]bool{},
+		box:       box,
+		timer:     time.Duration(st.TimerSeconds) * time.Second,
+		done:      make(chan struct{}),
+		wake:      make(chan struct{}, 1),
+		st:        st,
+		positions: map[string]*models.StrategyPosition{},
+		open:      map[string]models.Order{},
+		quotes:    map[string]models.Quote{},
+	}
+	for _, sym := range st.Symbols {
+		in.symbols[sym] = true
+	}
+	for _, p := range st.Positions {
+		p := p
+		in.positions[p.Symbol] = &p
+	}
+	return in
+}
+
+func (in *instance) run() {
+	ticker := time.NewTicker(in.timer)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-in.done:
+			return
+		case <-in.wake:
+			in.deliver()
+		case now := <-ticker.C:
+			in.call(func() error { return in.box.OnTimer(in, now) })
+		}
+	}
+}
+
+// deliver hands over the fills and then the latest quotes queued since
+// the last delivery.
+func (in *instance) deliver() {
+	in.mu.Lock()
+	fills := in.fills
+	quotes := make([]models.Quote, 0, len(in.quotes))
+	for _, q := range in.quotes {
+		quotes = append(quotes, q)
+	}
+	in.fills, in.quotes = nil, map[string]models.Quote{}
+	in.mu.Unlock()
+	sort.Slice(quotes, func(i, j int) bool { return quotes[i].Symbol < quotes[j].Symbol })
+
+	for _, f := range fills {
+		if !in.call(func() error { return in.box.OnFill(in, f) }) {
+			return
+		}
+	}
+	for _, q := range quotes {
+		if !in.call(func() error { return in.box.OnQuote(in, q) }) {
+			return
+		}
+	}
+}
+
+// call runs one callback in the worker, killing the strategy if it panics
+// or the worker fails, and reports whether the strategy is still running
+// afterwards.
+func (in *instance) call(fn func() error) bool {
+	if !in.active() {
+		return false
+	}
+	in.mu.Lock()
+	in.busySince = time.Now()
+	in.mu.Unlock()
+	err := fn()
+	in.mu.Lock()
+	in.busySince = time.Time{}
+	in.mu.Unlock()
+	if err != nil {
+		// A strategy already stopped, say for overrunning, keeps its reason.
+		in.svc.stop(in, models.StrategyKilled, err.Error())
+		return false
+	}
+	return in.active()
+}
+
+func (in *instance) active() bool {
+	select {
+	case <-in.done:
+		return false
+	default:
+		return true
+	}
+}
+
+func (in *instance) signal() {
+	select {
+	case in.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (in *instance) pushQuote(q models.Quote) {
+	in.mu.Lock()
+	in.quotes[q.Symbol] = q
+	in.mu.Unlock()
+	in.signal()
+}
+
+func (in *instance) onOrder(o models.Order) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	switch o.Status {
+	case models.OrderOpen, models.OrderPartiallyFilled, models.OrderQueued:
+		in.open[o.ID] = o
+	default:
+		delete(in.open, o.ID)
+	}
+}
+
+// onFill attributes a fill to the strategy's position at average cost and
+// queues it for the strategy.
+func (in *instance) onFill(f models.Fill) {
+	in.mu.Lock()
+	p, ok := in.positions[f.Symbol]
+	if !ok {
+		p = &models.StrategyPosition{Symbol: f.Symbol}
+		in.positions[f.Symbol] = p
+	}
+	qty := f.Quantity
+	if f.Side == "sell" {
+		qty = -qty
+	}
+	var realized float64
+	switch {
+	case math.Abs(p.Quantity) <= eps || (p.Quantity > 0) == (qty > 0):
+		p.AvgPrice = (math.Abs(p.Quantity)*p.AvgPrice + f.Quantity*f.Price) / (math.Abs(p.Quantity) + f.Quantity)
+		p.Quantity += qty
+	default:
+		closing := math.Min(f.Quantity, math.Abs(p.Quantity))
+		realized = closing * (f.Price - p.AvgPrice)
+		if p.Quantity < 0 {
+			realized = -realized
+		}
+		p.Quantity += qty
+		if math.Abs(p.Quantity) <= eps {
+			p.Quantity, p.AvgPrice = 0, 0
+		} else if (p.Quantity > 0) == (qty > 0) {
+			p.AvgPrice = f.Price // flipped through flat
+		}
+	}
+	p.RealizedPNL += realized
+	in.st.RealizedPNL += realized
+	in.st.Fills++
+	in.st.Volume += f.Quantity * f.Price
+	in.st.UpdatedAt = f.Time
+	in.fills = append(in.fills, f)
+	in.dirty = true
+	in.mu.Unlock()
+	in.signal()
+}
+
+// snapshot is the strategy's state with PnL marked to market.
+func (in *instance) snapshot() models.Strategy {
+	in.mu.Lock()
+	st := in.st
+	st.Positions = make([]models.StrategyPosition, 0, len(in.positions))
+	for _, p := range in.positions {
+		st.Positions = append(st.Positions, *p)
+	}
+	in.mu.Unlock()
+	sort.Slice(st.Positions, func(i, j int) bool { return st.Positions[i].Symbol < st.Positions[j].Symbol })
+	in.svc.mark(&st)
+	return st
+}
+
+func (in *instance) Mark(symbol string) (float64, bool) {
+	return in.svc.prices.Mark(symbol)
+}
+
+// Cash is the account's cash in the base currency, shared with whatever
+// else trades on it.
+func (in *instance) Cash() float64 {
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	// Cash in a currency with no rate is left out, understating it.
+	bal, _, err := in.svc.cash.BaseBalance(ctx, in.userID)
+	if err != nil {
+		log.Printf("strategy %s: cash: %v", in.id, err)
+	}
+	return bal
+}
+
+func (in *instance) Position(symbol string) float64 {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	if p, ok := in.positions[symbol]; ok {
+		return p.Quantity
+	}
+	return 0
+}
+
+func (in *instance) OpenOrders() []models.Order {
+	in.mu.Lock()
+	out := make([]models.Order, 0, len(in.open))
+	for _, o := range in.open {
+		out = append(out, o)
+	}
+	in.mu.Unlock()
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].CreatedAt.Before(out[j].CreatedAt)
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
+
+func (in *instance) Cancel(orderID string) error {
+	if !in.active() {
+		return ErrStopped
+	}
+	in.mu.Lock()
+	_, ok := in.open[orderID]
+	in.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("%w: %s is not a working order of this strategy", ErrNotFound, orderID)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	_, err := in.svc.orders.Cancel(ctx, in.userID, orderID)
+	return err
+}
+
+func (in *instance) Place(o models.Order) (models.Order, error) {
+	if !in.active() {
+		return o, ErrStopped
+	}
+	o.Symbol = strings.ToUpper(strings.TrimSpace(o.Symbol))
+	if err := in.admit(o); err != nil {
+		in.mu.Lock()
+		in.st.Orders++
+		in.st.Rejected++
+		in.dirty = true
+		in.mu.Unlock()
+		return o, err
+	}
+	o.StrategyID = in.id
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	out, err := in.svc.orders.Place(ctx, in.userID, o)
+	in.mu.Lock()
+	in.st.Orders++
+	if err != nil {
+		in.st.Rejected++
+	}
+	in.dirty = true
+	in.mu.Unlock()
+	return out, err
+}
+
+// admit checks an order against the strategy's limits and, if it passes,
+// counts it towards the per-minute rate.
+func (in *instance) admit(o models.Order) error {
+	switch {
+	case !in.symbols[o.Symbol]:
+		return fmt.Errorf("%w: %s is not one of the strategy's symbols", ErrLimit, o.Symbol)
+	case o.Notional != 0 || o.Algo != nil || o.AfterMarket:
+		return fmt.Errorf("%w: strategies place quantity orders; notional, algo and after-market orders are not allowed", ErrLimit)
+	}
+	price := o.Price
+	if price <= 0 {
+		price, _ = in.svc.prices.Mark(o.Symbol)
+	}
+	if price <= 0 {
+		return fmt.Errorf("%w: no price for %s to check the order against", ErrLimit, o.Symbol)
+	}
+
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	l := in.st.Limits
+	now := time.Now()
+	recent := in.placed[:0]
+	for _, t := range in.placed {
+		if now.Sub(t) < time.Minute {
+			recent = append(recent, t)
+		}
+	}
+	in.placed = recent
+	var pos float64
+	if p, ok := in.positions[o.Symbol]; ok {
+		pos = p.Quantity
+	}
+	// Working orders on the same side may fill too.
+	qty := o.Quantity
+	for _, w := range in.open {
+		if w.Symbol == o.Symbol && strings.EqualFold(w.Side, o.Side) {
+			qty += w.Remaining()
+		}
+	}
+	after := pos + qty
+	if strings.EqualFold(o.Side, "sell") {
+		after = pos - qty
+	}
+	switch {
+	case len(in.placed) >= l.MaxOrdersPerMinute:
+		return fmt.Errorf("%w: %d orders a minute", ErrLimit, l.MaxOrdersPerMinute)
+	case len(in.open) >= l.MaxOpenOrders:
+		return fmt.Errorf("%w: %d working orders", ErrLimit, l.MaxOpenOrders)
+	case l.MaxOrderValue > 0 && o.Quantity*price > l.MaxOrderValue:
+		return fmt.Errorf("%w: order value %.2f over %.2f", ErrLimit, o.Quantity*price, l.MaxOrderValue)
+	case l.MaxPosition > 0 && math.Abs(after) > l.MaxPosition && math.Abs(after) > math.Abs(pos):
+		return fmt.Errorf("%w: position of %g %s over %g", ErrLimit, after, o.Symbol, l.MaxPosition)
+	}
+	in.placed = append(in.placed, now)
+	return nil
+}
@@ -0,0 +1,120 @@
+package strategy
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+var (
+	ErrUnknown = errors.New("unknown strategy")
+	ErrInvalid = errors.New("invalid strategy parameters")
+)
+
+// Strategy trades through a Broker in response to quotes, its own fills
+// and a periodic timer. Callbacks for one strategy are never concurrent,
+// so implementations need no locking of their own.
+type Strategy interface {
+	OnQuote(b Broker, q models.Quote)
+	OnFill(b Broker, f models.Fill)
+	OnTimer(b Broker, now time.Time)
+}
+
+// Broker is a strategy's view of its account. Orders go through the same
+// risk rules as any other; a rejected order comes back as an error. Fills
+// are delivered to OnFill after the callback that caused them returns.
+type Broker interface {
+	Now() time.Time
+	Place(o models.Order) (models.Order, error)
+	Cancel(orderID string) error
+	OpenOrders() []models.Order // oldest first
+	Position(symbol string) float64
+	Cash() float64
+	Mark(symbol string) (float64, bool)
+	// Rand is seeded by the runtime, so a strategy drawing only from it
+	// behaves the same on every run with the same seed.
+	Rand() *rand.Rand
+}
+
+// Params are a strategy's settings by name, as given on the command line
+// or in an API request.
+type Params map[string]string
+
+// String is the value of key, or def when it is not set.
+func (p Params) String(key, def string) string {
+	if v, ok := p[key]; ok && v != "" {
+		return v
+	}
+	return def
+}
+
+// Float is the value of key as a number, or def when it is not set.
+func (p Params) Float(key string, def float64) (float64, error) {
+	v, ok := p[key]
+	if !ok || v == "" {
+		return def, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s must be a number", ErrInvalid, key)
+	}
+	return f, nil
+}
+
+// Int is the value of key as a whole number, or def when it is not set.
+func (p Params) Int(key string, def int) (int, error) {
+	v, ok := p[key]
+	if !ok || v == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s must be a whole number", ErrInvalid, key)
+	}
+	return n, nil
+}
+
+// Factory builds a strategy from its parameters.
+type Factory func(p Params) (Strategy, error)
+
+var (
+	mu        sync.RWMutex
+	factories = map[string]Factory{}
+)
+
+// Register makes a strategy available by name, typically from an init
+// function. Registering a name twice replaces the earlier factory.
+func Register(name string, f Factory) {
+	mu.Lock()
+	factories[name] = f
+	mu.Unlock()
+}
+
+// New builds the strategy registered as name.
+func New(name string, p Params) (Strategy, error) {
+	mu.RLock()
+	f, ok := factories[name]
+	mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknown, name)
+	}
+	return f(p)
+}
+
+// Names lists the registered strategies in order.
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	out := make([]string, 0, len(factories))
+	for name := range factories {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,227 @@
+package strategy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/cash"
+	"github.com/hahahamid/broker-backend/internal/matching"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/orders"
+)
+
+// WorkerCommand is the argument the server binary is run with to host one
+// strategy in a worker process; see ServeWorker.
+const WorkerCommand = "strategy-worker"
+
+// message is one line of the protocol between the server and a worker,
+// JSON on the pipes at fds 3 (to the worker) and 4 (from it). The server
+// sends start or an event (quote, fill, timer); the worker answers with
+// any number of calls on its Broker, each answered by a reply, and then
+// done. Stdout and stderr stay free for the strategy's own output.
+type message struct {
+	Type string `json:"type"`
+
+	Name   string        `json:"name,omitempty"`
+	Params Params        `json:"params,omitempty"`
+	Seed   int64         `json:"seed,omitempty"`
+	Quote  *models.Quote `json:"quote,omitempty"`
+	Fill   *models.Fill  `json:"fill,omitempty"`
+	Time   time.Time     `json:"time"`
+
+	Method  string         `json:"method,omitempty"`
+	Symbol  string         `json:"symbol,omitempty"`
+	OrderID string         `json:"order_id,omitempty"`
+	Order   *models.Order  `json:"order,omitempty"`
+	Orders  []models.Order `json:"orders,omitempty"`
+	Value   float64        `json:"value,omitempty"`
+	OK      bool           `json:"ok,omitempty"`
+
+	Kind  string `json:"kind,omitempty"` // the error's sentinel, see kinds
+	Error string `json:"error,omitempty"`
+}
+
+// kinds are the errors a strategy may test for with errors.Is, by the name
+// they cross the pipe under.
+var kinds = map[string]error{
+	"limit":           ErrLimit,
+	"stopped":         ErrStopped,
+	"not_found":       ErrNotFound,
+	"rejected":        orders.ErrRejected,
+	"insufficient":    cash.ErrInsufficientFunds,
+	"order_not_found": matching.ErrOrderNotFound,
+}
+
+// setError puts err on a reply.
+func (m *message) setError(err error) {
+	if err == nil {
+		return
+	}
+	m.Error = err.Error()
+	for kind, target := range kinds {
+		if errors.Is(err, target) {
+			m.Kind = kind
+			return
+		}
+	}
+}
+
+// err is the error a reply carries, matching its sentinel.
+func (m message) err() error {
+	if m.Error == "" {
+		return nil
+	}
+	return remoteError{kind: kinds[m.Kind], msg: m.Error}
+}
+
+type remoteError struct {
+	kind error
+	msg  string
+}
+
+func (e remoteError) Error() string { return e.msg }
+func (e remoteError) Unwrap() error { return e.kind }
+
+// ServeWorker runs the strategy the server starts on the worker's pipes
+// until the server closes them. The server runs its own binary with
+// WorkerCommand for each hosted strategy, so strategies registered in init
+// functions are available here too.
+func ServeWorker() error {
+	w := &worker{
+		dec: json.NewDecoder(os.NewFile(3, "events")),
+		enc: json.NewEncoder(os.NewFile(4, "calls")),
+	}
+	return w.serve()
+}
+
+// worker is the strategy's side of the pipe and the Broker it trades
+// through, each call forwarded to the server.
+type worker struct {
+	dec   *json.Decoder
+	enc   *json.Encoder
+	strat Strategy
+	rng   *rand.Rand
+
+	mu     sync.Mutex // held for the whole of a call on the pipe
+	active bool       // in a callback; calls outside one are refused
+}
+
+func (w *worker) serve() error {
+	for {
+		var m message
+		if err := w.dec.Decode(&m); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		var err error
+		switch {
+		case m.Type == "start":
+			w.strat, err = New(m.Name, m.Params)
+			w.rng = rand.New(rand.NewSource(m.Seed))
+		case w.strat == nil:
+			err = fmt.Errorf("%s before start", m.Type)
+		case m.Type == "quote" && m.Quote != nil:
+			err = w.callback(func() { w.strat.OnQuote(w, *m.Quote) })
+		case m.Type == "fill" && m.Fill != nil:
+			err = w.callback(func() { w.strat.OnFill(w, *m.Fill) })
+		case m.Type == "timer":
+			err = w.callback(func() { w.strat.OnTimer(w, m.Time) })
+		default:
+			err = fmt.Errorf("unexpected %s", m.Type)
+		}
+		done := message{Type: "done"}
+		done.setError(err)
+		if err := w.enc.Encode(done); err != nil {
+			return err
+		}
+	}
+}
+
+// callback runs fn with the Broker open to it, turning a panic into an
+// error. Calls still in flight from goroutines the strategy started finish
+// before it returns; later ones get ErrStopped.
+func (w *worker) callback(fn func()) (err error) {
+	w.mu.Lock()
+	w.active = true
+	w.mu.Unlock()
+	defer func() {
+		w.mu.Lock()
+		w.active = false
+		w.mu.Unlock()
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	fn()
+	return nil
+}
+
+// call forwards one Broker call to the server and waits for its reply.
+func (w *worker) call(req message) (message, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if !w.active {
+		return message{}, fmt.Errorf("%w: broker used outside a callback", ErrStopped)
+	}
+	req.Type = "call"
+	if err := w.enc.Encode(req); err != nil {
+		return message{}, err
+	}
+	var rep message
+	if err := w.dec.Decode(&rep); err != nil {
+		return message{}, err
+	}
+	if rep.Type != "reply" {
+		return message{}, fmt.Errorf("unexpected %s", rep.Type)
+	}
+	return rep, rep.err()
+}
+
+func (w *worker) Now() time.Time {
+	return time.Now()
+}
+
+func (w *worker) Rand() *rand.Rand {
+	return w.rng
+}
+
+func (w *worker) Place(o models.Order) (models.Order, error) {
+	rep, err := w.call(message{Method: "place", Order: &o})
+	if rep.Order != nil {
+		o = *rep.Order
+	}
+	return o, err
+}
+
+func (w *worker) Cancel(orderID string) error {
+	_, err := w.call(message{Method: "cancel", OrderID: orderID})
+	return err
+}
+
+func (w *worker) OpenOrders() []models.Order {
+	rep, _ := w.call(message{Method: "open_orders"})
+	return rep.Orders
+}
+
+func (w *worker) Position(symbol string) float64 {
+	rep, _ := w.call(message{Method: "position", Symbol: symbol})
+	return rep.Value
+}
+
+func (w *worker) Cash() float64 {
+	rep, _ := w.call(message{Method: "cash"})
+	return rep.Value
+}
+
+func (w *worker) Mark(symbol string) (float64, bool) {
+	rep, _ := w.call(message{Method: "mark", Symbol: symbol})
+	return rep.Value, rep.OK
+}
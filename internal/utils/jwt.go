@@ -6,12 +6,18 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 )
 
-func GenerateTokens(userID, secret, refreshSecret string, accessExpMin int) (accessToken, refreshToken string, err error) {
+// GenerateTokens issues an access and refresh token for the user. A
+// non-empty mode is added as a "mode" claim that limits both tokens to
+// that account mode.
+func GenerateTokens(userID, mode, secret, refreshSecret string, accessExpMin int) (accessToken, refreshToken string, err error) {
 	// Access token
 	atClaims := jwt.MapClaims{
 		"sub": userID,
 		"exp": time.Now().Add(time.Duration(accessExpMin) * time.Minute).Unix(),
 	}
+	if mode != "" {
+		atClaims["mode"] = mode
+	}
 	at := jwt.NewWithClaims(jwt.SigningMethodHS256, atClaims)
 	accessToken, err = at.SignedString([]byte(secret))
 	if err != nil {
@@ -23,6 +29,9 @@ func GenerateTokens(userID, secret, refreshSecret string, accessExpMin int) (acc
 		"sub": userID,
 		"exp": time.Now().Add(7 * 24 * time.Hour).Unix(),
 	}
+	if mode != "" {
+		rtClaims["mode"] = mode
+	}
 	rt := jwt.NewWithClaims(jwt.SigningMethodHS256, rtClaims)
 	refreshToken, err = rt.SignedString([]byte(refreshSecret))
 	return
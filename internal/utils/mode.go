@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/hahahamid/broker-backend/internal/models"
+)
+
+var (
+	ErrUnknownMode = errors.New("account mode must be live or paper")
+	ErrModeClaim   = errors.New("token is limited to another account mode")
+)
+
+// AccountMode picks the account a request acts on: the mode it asks for,
+// else the token's mode claim, else live. A token with a mode claim only
+// works in that mode.
+func AccountMode(requested, claimed string) (string, error) {
+	requested = strings.ToLower(strings.TrimSpace(requested))
+	for _, m := range []string{requested, claimed} {
+		if m != "" && m != models.AccountLive && m != models.AccountPaper {
+			return "", ErrUnknownMode
+		}
+	}
+	switch {
+	case claimed != "" && requested != "" && requested != claimed:
+		return "", ErrModeClaim
+	case requested != "":
+		return requested, nil
+	case claimed != "":
+		return claimed, nil
+	}
+	return models.AccountLive, nil
+}
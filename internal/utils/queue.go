@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+// Queue is an unbounded FIFO that never blocks or drops on Push. Services
+// use it to hand writes from engine callbacks to their Run loop: a callback
+// may run on the Run goroutine itself, so a bounded channel would either
+// drop the write or deadlock when full.
+type Queue[T any] struct {
+	retry time.Duration
+	ready chan struct{}
+
+	mu    sync.Mutex
+	items []T
+}
+
+// NewQueue returns an empty queue that, after a failed Flush, signals Ready
+// again once retry has passed.
+func NewQueue[T any](retry time.Duration) *Queue[T] {
+	return &Queue[T]{retry: retry, ready: make(chan struct{}, 1)}
+}
+
+// Push appends v and signals Ready.
+func (q *Queue[T]) Push(v T) {
+	q.mu.Lock()
+	q.items = append(q.items, v)
+	q.mu.Unlock()
+	q.signal()
+}
+
+// Ready receives when there may be items to flush.
+func (q *Queue[T]) Ready() <-chan struct{} { return q.ready }
+
+// Len is the number of items waiting.
+func (q *Queue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.items)
+}
+
+// Flush hands queued items to fn in order, removing each once fn accepts
+// it. When fn fails the item and everything after it stay queued, Ready is
+// signalled again after the retry delay, and the error is returned. Only
+// one goroutine may flush a queue.
+func (q *Queue[T]) Flush(fn func(T) error) error {
+	for {
+		q.mu.Lock()
+		if len(q.items) == 0 {
+			q.items = nil
+			q.mu.Unlock()
+			return nil
+		}
+		v := q.items[0]
+		q.mu.Unlock()
+
+		if err := fn(v); err != nil {
+			time.AfterFunc(q.retry, q.signal)
+			return err
+		}
+
+		q.mu.Lock()
+		var zero T
+		q.items[0] = zero
+		q.items = q.items[1:]
+		q.mu.Unlock()
+	}
+}
+
+func (q *Queue[T]) signal() {
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
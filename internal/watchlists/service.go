@@ -0,0 +1,196 @@
+package watchlists
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hahahamid/broker-backend/internal/marketdata"
+	"github.com/hahahamid/broker-backend/internal/models"
+	"github.com/hahahamid/broker-backend/internal/repository"
+)
+
+var (
+	ErrInvalid = errors.New("invalid watchlist request")
+	ErrLimit   = errors.New("watchlist limit reached")
+)
+
+// Service applies per-user limits and symbol validation on top of the
+// watchlist store.
+type Service struct {
+	repo       repository.Repo
+	prices     *marketdata.PriceCache
+	maxLists   int
+	maxSymbols int
+}
+
+func NewService(repo repository.Repo, prices *marketdata.PriceCache, maxLists, maxSymbols int) *Service {
+	return &Service{repo: repo, prices: prices, maxLists: maxLists, maxSymbols: maxSymbols}
+}
+
+func (s *Service) List(ctx context.Context, userID string) ([]models.Watchlist, error) {
+	return s.repo.ListWatchlists(ctx, userID)
+}
+
+func (s *Service) Get(ctx context.Context, userID, id string) (*models.Watchlist, error) {
+	return s.repo.GetWatchlist(ctx, userID, id)
+}
+
+func (s *Service) Create(ctx context.Context, userID, name string, symbols []string) (*models.Watchlist, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalid)
+	}
+	lists, err := s.repo.ListWatchlists(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(lists) >= s.maxLists {
+		return nil, fmt.Errorf("%w: at most %d watchlists", ErrLimit, s.maxLists)
+	}
+
+	w := &models.Watchlist{UserID: userID, Name: name}
+	if w.Symbols, err = s.merge(ctx, nil, symbols, count(lists, "")); err != nil {
+		return nil, err
+	}
+	w.CreatedAt = time.Now()
+	w.UpdatedAt = w.CreatedAt
+	if err := s.repo.CreateWatchlist(ctx, w); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (s *Service) Rename(ctx context.Context, userID, id, name string) (*models.Watchlist, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalid)
+	}
+	return s.update(ctx, userID, id, func(w *models.Watchlist) error {
+		w.Name = name
+		return nil
+	})
+}
+
+// Add appends symbols that are not already on the list.
+func (s *Service) Add(ctx context.Context, userID, id string, symbols []string) (*models.Watchlist, error) {
+	lists, err := s.repo.ListWatchlists(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.update(ctx, userID, id, func(w *models.Watchlist) (err error) {
+		w.Symbols, err = s.merge(ctx, w.Symbols, symbols, count(lists, id))
+		return err
+	})
+}
+
+func (s *Service) Remove(ctx context.Context, userID, id, symbol string) (*models.Watchlist, error) {
+	symbol = strings.ToUpper(symbol)
+	return s.update(ctx, userID, id, func(w *models.Watchlist) error {
+		for i, sym := range w.Symbols {
+			if sym == symbol {
+				w.Symbols = append(w.Symbols[:i], w.Symbols[i+1:]...)
+				return nil
+			}
+		}
+		return repository.ErrNotFound
+	})
+}
+
+// Reorder replaces the display order. The new order must contain exactly
+// the symbols already on the list.
+func (s *Service) Reorder(ctx context.Context, userID, id string, symbols []string) (*models.Watchlist, error) {
+	return s.update(ctx, userID, id, func(w *models.Watchlist) error {
+		if len(symbols) != len(w.Symbols) {
+			return fmt.Errorf("%w: reorder must list every symbol once", ErrInvalid)
+		}
+		have := map[string]bool{}
+		for _, sym := range w.Symbols {
+			have[sym] = true
+		}
+		order := make([]string, 0, len(symbols))
+		for _, sym := range symbols {
+			sym = strings.ToUpper(sym)
+			if !have[sym] {
+				return fmt.Errorf("%w: reorder must list every symbol once", ErrInvalid)
+			}
+			delete(have, sym)
+			order = append(order, sym)
+		}
+		w.Symbols = order
+		return nil
+	})
+}
+
+func (s *Service) Delete(ctx context.Context, userID, id string) error {
+	return s.repo.DeleteWatchlist(ctx, userID, id)
+}
+
+// Items returns the list's symbols with their latest cached prices.
+func (s *Service) Items(w *models.Watchlist) []models.WatchlistItem {
+	items := make([]models.WatchlistItem, 0, len(w.Symbols))
+	for _, sym := range w.Symbols {
+		it := models.WatchlistItem{Symbol: sym}
+		it.Last, _ = s.prices.Mark(sym)
+		if q, ok := s.prices.Quote(sym); ok {
+			it.Bid, it.Ask = q.Bid, q.Ask
+		}
+		items = append(items, it)
+	}
+	return items
+}
+
+func (s *Service) update(ctx context.Context, userID, id string, fn func(w *models.Watchlist) error) (*models.Watchlist, error) {
+	w, err := s.repo.GetWatchlist(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := fn(w); err != nil {
+		return nil, err
+	}
+	w.UpdatedAt = time.Now()
+	if err := s.repo.UpdateWatchlist(ctx, w); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// count is the number of symbols on the lists other than skip.
+func count(lists []models.Watchlist, skip string) int {
+	n := 0
+	for _, w := range lists {
+		if w.ID.Hex() != skip {
+			n += len(w.Symbols)
+		}
+	}
+	return n
+}
+
+// merge appends new, known symbols to list, skipping duplicates and
+// enforcing the per-user limit given the user's other lists hold others
+// symbols.
+func (s *Service) merge(ctx context.Context, list, add []string, others int) ([]string, error) {
+	seen := map[string]bool{}
+	for _, sym := range list {
+		seen[sym] = true
+	}
+	for _, sym := range add {
+		sym = strings.ToUpper(strings.TrimSpace(sym))
+		if sym == "" || seen[sym] {
+			continue
+		}
+		if _, err := s.repo.GetInstrument(ctx, sym); errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("%w: unknown symbol %s", ErrInvalid, sym)
+		} else if err != nil {
+			return nil, err
+		}
+		seen[sym] = true
+		list = append(list, sym)
+	}
+	if others+len(list) > s.maxSymbols {
+		return nil, fmt.Errorf("%w: at most %d symbols across all watchlists", ErrLimit, s.maxSymbols)
+	}
+	return list, nil
+}
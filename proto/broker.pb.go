@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -114,6 +115,7 @@ type LoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"` // live or paper: limits the tokens to that account mode
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -162,6 +164,13 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
+func (x *LoginRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
 type RefreshRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
@@ -259,12 +268,26 @@ func (x *AuthResponse) GetRefreshToken() string {
 }
 
 type Holding struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	AvgPrice      float64                `protobuf:"fixed64,3,opt,name=avg_price,json=avgPrice,proto3" json:"avg_price,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Symbol            string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Quantity          float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	AvgPrice          float64                `protobuf:"fixed64,3,opt,name=avg_price,json=avgPrice,proto3" json:"avg_price,omitempty"`
+	LastPrice         float64                `protobuf:"fixed64,4,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+	UnrealizedPnl     float64                `protobuf:"fixed64,5,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`
+	ShortTermQty      float64                `protobuf:"fixed64,6,opt,name=short_term_qty,json=shortTermQty,proto3" json:"short_term_qty,omitempty"`
+	LongTermQty       float64                `protobuf:"fixed64,7,opt,name=long_term_qty,json=longTermQty,proto3" json:"long_term_qty,omitempty"`
+	SettledQty        float64                `protobuf:"fixed64,8,opt,name=settled_qty,json=settledQty,proto3" json:"settled_qty,omitempty"`
+	UnsettledQty      float64                `protobuf:"fixed64,9,opt,name=unsettled_qty,json=unsettledQty,proto3" json:"unsettled_qty,omitempty"` // bought, awaiting settlement
+	Currency          string                 `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	Value             float64                `protobuf:"fixed64,11,opt,name=value,proto3" json:"value,omitempty"`
+	FxRate            float64                `protobuf:"fixed64,12,opt,name=fx_rate,json=fxRate,proto3" json:"fx_rate,omitempty"` // base units per unit of currency
+	BaseValue         float64                `protobuf:"fixed64,13,opt,name=base_value,json=baseValue,proto3" json:"base_value,omitempty"`
+	BaseUnrealizedPnl float64                `protobuf:"fixed64,14,opt,name=base_unrealized_pnl,json=baseUnrealizedPnl,proto3" json:"base_unrealized_pnl,omitempty"`
+	Multiplier        float64                `protobuf:"fixed64,15,opt,name=multiplier,proto3" json:"multiplier,omitempty"` // units per contract, for futures and options
+	Contracts         float64                `protobuf:"fixed64,16,opt,name=contracts,proto3" json:"contracts,omitempty"`
+	Fund              bool                   `protobuf:"varint,17,opt,name=fund,proto3" json:"fund,omitempty"` // quantity is fund units and prices are NAVs
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Holding) Reset() {
@@ -318,6 +341,104 @@ func (x *Holding) GetAvgPrice() float64 {
 	return 0
 }
 
+func (x *Holding) GetLastPrice() float64 {
+	if x != nil {
+		return x.LastPrice
+	}
+	return 0
+}
+
+func (x *Holding) GetUnrealizedPnl() float64 {
+	if x != nil {
+		return x.UnrealizedPnl
+	}
+	return 0
+}
+
+func (x *Holding) GetShortTermQty() float64 {
+	if x != nil {
+		return x.ShortTermQty
+	}
+	return 0
+}
+
+func (x *Holding) GetLongTermQty() float64 {
+	if x != nil {
+		return x.LongTermQty
+	}
+	return 0
+}
+
+func (x *Holding) GetSettledQty() float64 {
+	if x != nil {
+		return x.SettledQty
+	}
+	return 0
+}
+
+func (x *Holding) GetUnsettledQty() float64 {
+	if x != nil {
+		return x.UnsettledQty
+	}
+	return 0
+}
+
+func (x *Holding) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Holding) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Holding) GetFxRate() float64 {
+	if x != nil {
+		return x.FxRate
+	}
+	return 0
+}
+
+func (x *Holding) GetBaseValue() float64 {
+	if x != nil {
+		return x.BaseValue
+	}
+	return 0
+}
+
+func (x *Holding) GetBaseUnrealizedPnl() float64 {
+	if x != nil {
+		return x.BaseUnrealizedPnl
+	}
+	return 0
+}
+
+func (x *Holding) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *Holding) GetContracts() float64 {
+	if x != nil {
+		return x.Contracts
+	}
+	return 0
+}
+
+func (x *Holding) GetFund() bool {
+	if x != nil {
+		return x.Fund
+	}
+	return false
+}
+
 type HoldingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Holdings      []*Holding             `protobuf:"bytes,1,rep,name=holdings,proto3" json:"holdings,omitempty"`
@@ -371,6 +492,29 @@ type Order struct {
 	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
 	RealizedPnl   float64                `protobuf:"fixed64,6,opt,name=realized_pnl,json=realizedPnl,proto3" json:"realized_pnl,omitempty"`
 	UnrealizedPnl float64                `protobuf:"fixed64,7,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`
+	Type          string                 `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	FilledQty     float64                `protobuf:"fixed64,10,opt,name=filled_qty,json=filledQty,proto3" json:"filled_qty,omitempty"`
+	AvgFillPrice  float64                `protobuf:"fixed64,11,opt,name=avg_fill_price,json=avgFillPrice,proto3" json:"avg_fill_price,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Validity      string                 `protobuf:"bytes,13,opt,name=validity,proto3" json:"validity,omitempty"`
+	AfterMarket   bool                   `protobuf:"varint,14,opt,name=after_market,json=afterMarket,proto3" json:"after_market,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LotMethod     string                 `protobuf:"bytes,16,opt,name=lot_method,json=lotMethod,proto3" json:"lot_method,omitempty"`
+	LotIds        []string               `protobuf:"bytes,17,rep,name=lot_ids,json=lotIds,proto3" json:"lot_ids,omitempty"`
+	Product       string                 `protobuf:"bytes,18,opt,name=product,proto3" json:"product,omitempty"`
+	Currency      string                 `protobuf:"bytes,19,opt,name=currency,proto3" json:"currency,omitempty"`
+	Notional      float64                `protobuf:"fixed64,20,opt,name=notional,proto3" json:"notional,omitempty"`
+	Fractional    bool                   `protobuf:"varint,21,opt,name=fractional,proto3" json:"fractional,omitempty"`
+	HouseOrderId  string                 `protobuf:"bytes,22,opt,name=house_order_id,json=houseOrderId,proto3" json:"house_order_id,omitempty"`
+	Short         bool                   `protobuf:"varint,23,opt,name=short,proto3" json:"short,omitempty"`
+	BuyIn         bool                   `protobuf:"varint,24,opt,name=buy_in,json=buyIn,proto3" json:"buy_in,omitempty"`
+	BasketId      string                 `protobuf:"bytes,25,opt,name=basket_id,json=basketId,proto3" json:"basket_id,omitempty"`
+	Algo          *Algo                  `protobuf:"bytes,26,opt,name=algo,proto3" json:"algo,omitempty"`                                 // set on algo parent orders
+	ParentId      string                 `protobuf:"bytes,27,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`         // algo parent of a child order
+	DisplayQty    float64                `protobuf:"fixed64,28,opt,name=display_qty,json=displayQty,proto3" json:"display_qty,omitempty"` // iceberg: quantity shown in the book at a time
+	Settlement    bool                   `protobuf:"varint,29,opt,name=settlement,proto3" json:"settlement,omitempty"`                    // closes a contract at expiry
+	StrategyId    string                 `protobuf:"bytes,30,opt,name=strategy_id,json=strategyId,proto3" json:"strategy_id,omitempty"`   // the hosted strategy that placed it
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -454,140 +598,195 @@ func (x *Order) GetUnrealizedPnl() float64 {
 	return 0
 }
 
-type OrderbookResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Order) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-func (x *OrderbookResponse) Reset() {
-	*x = OrderbookResponse{}
-	mi := &file_broker_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Order) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *OrderbookResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Order) GetFilledQty() float64 {
+	if x != nil {
+		return x.FilledQty
+	}
+	return 0
 }
 
-func (*OrderbookResponse) ProtoMessage() {}
+func (x *Order) GetAvgFillPrice() float64 {
+	if x != nil {
+		return x.AvgFillPrice
+	}
+	return 0
+}
 
-func (x *OrderbookResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[8]
+func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreatedAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use OrderbookResponse.ProtoReflect.Descriptor instead.
-func (*OrderbookResponse) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{8}
+func (x *Order) GetValidity() string {
+	if x != nil {
+		return x.Validity
+	}
+	return ""
 }
 
-func (x *OrderbookResponse) GetOrders() []*Order {
+func (x *Order) GetAfterMarket() bool {
 	if x != nil {
-		return x.Orders
+		return x.AfterMarket
+	}
+	return false
+}
+
+func (x *Order) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-type Position struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	AvgPrice      float64                `protobuf:"fixed64,3,opt,name=avg_price,json=avgPrice,proto3" json:"avg_price,omitempty"`
-	Pnl           float64                `protobuf:"fixed64,4,opt,name=pnl,proto3" json:"pnl,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Order) GetLotMethod() string {
+	if x != nil {
+		return x.LotMethod
+	}
+	return ""
 }
 
-func (x *Position) Reset() {
-	*x = Position{}
-	mi := &file_broker_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Order) GetLotIds() []string {
+	if x != nil {
+		return x.LotIds
+	}
+	return nil
 }
 
-func (x *Position) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Order) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
 }
 
-func (*Position) ProtoMessage() {}
+func (x *Order) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
 
-func (x *Position) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[9]
+func (x *Order) GetNotional() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Notional
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Position.ProtoReflect.Descriptor instead.
-func (*Position) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{9}
+func (x *Order) GetFractional() bool {
+	if x != nil {
+		return x.Fractional
+	}
+	return false
 }
 
-func (x *Position) GetSymbol() string {
+func (x *Order) GetHouseOrderId() string {
 	if x != nil {
-		return x.Symbol
+		return x.HouseOrderId
 	}
 	return ""
 }
 
-func (x *Position) GetQuantity() float64 {
+func (x *Order) GetShort() bool {
 	if x != nil {
-		return x.Quantity
+		return x.Short
 	}
-	return 0
+	return false
 }
 
-func (x *Position) GetAvgPrice() float64 {
+func (x *Order) GetBuyIn() bool {
 	if x != nil {
-		return x.AvgPrice
+		return x.BuyIn
 	}
-	return 0
+	return false
 }
 
-func (x *Position) GetPnl() float64 {
+func (x *Order) GetBasketId() string {
 	if x != nil {
-		return x.Pnl
+		return x.BasketId
+	}
+	return ""
+}
+
+func (x *Order) GetAlgo() *Algo {
+	if x != nil {
+		return x.Algo
+	}
+	return nil
+}
+
+func (x *Order) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Order) GetDisplayQty() float64 {
+	if x != nil {
+		return x.DisplayQty
 	}
 	return 0
 }
 
-type PositionsResponse struct {
+func (x *Order) GetSettlement() bool {
+	if x != nil {
+		return x.Settlement
+	}
+	return false
+}
+
+func (x *Order) GetStrategyId() string {
+	if x != nil {
+		return x.StrategyId
+	}
+	return ""
+}
+
+type PnlCard struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Positions     []*Position            `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	RealizedPnl   float64                `protobuf:"fixed64,1,opt,name=realized_pnl,json=realizedPnl,proto3" json:"realized_pnl,omitempty"`
+	UnrealizedPnl float64                `protobuf:"fixed64,2,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`
+	ShortTermPnl  float64                `protobuf:"fixed64,3,opt,name=short_term_pnl,json=shortTermPnl,proto3" json:"short_term_pnl,omitempty"`
+	LongTermPnl   float64                `protobuf:"fixed64,4,opt,name=long_term_pnl,json=longTermPnl,proto3" json:"long_term_pnl,omitempty"`
+	Charges       float64                `protobuf:"fixed64,5,opt,name=charges,proto3" json:"charges,omitempty"`
+	NetPnl        float64                `protobuf:"fixed64,6,opt,name=net_pnl,json=netPnl,proto3" json:"net_pnl,omitempty"`
+	Currency      string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PositionsResponse) Reset() {
-	*x = PositionsResponse{}
-	mi := &file_broker_proto_msgTypes[10]
+func (x *PnlCard) Reset() {
+	*x = PnlCard{}
+	mi := &file_broker_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PositionsResponse) String() string {
+func (x *PnlCard) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PositionsResponse) ProtoMessage() {}
+func (*PnlCard) ProtoMessage() {}
 
-func (x *PositionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[10]
+func (x *PnlCard) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -598,58 +797,10275 @@ func (x *PositionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PositionsResponse.ProtoReflect.Descriptor instead.
-func (*PositionsResponse) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use PnlCard.ProtoReflect.Descriptor instead.
+func (*PnlCard) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PositionsResponse) GetPositions() []*Position {
+func (x *PnlCard) GetRealizedPnl() float64 {
 	if x != nil {
-		return x.Positions
+		return x.RealizedPnl
 	}
-	return nil
+	return 0
 }
 
-var File_broker_proto protoreflect.FileDescriptor
+func (x *PnlCard) GetUnrealizedPnl() float64 {
+	if x != nil {
+		return x.UnrealizedPnl
+	}
+	return 0
+}
 
-const file_broker_proto_rawDesc = "" +
-	"\n" +
-	"\fbroker.proto\x12\x06broker\x1a\x1cgoogle/api/annotations.proto\"\a\n" +
-	"\x05Empty\"A\n" +
-	"\rSignupRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"@\n" +
-	"\fLoginRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"5\n" +
-	"\x0eRefreshRequest\x12#\n" +
-	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"V\n" +
-	"\fAuthResponse\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"Z\n" +
-	"\aHolding\x12\x16\n" +
-	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x01R\bquantity\x12\x1b\n" +
-	"\tavg_price\x18\x03 \x01(\x01R\bavgPrice\"?\n" +
-	"\x10HoldingsResponse\x12+\n" +
-	"\bholdings\x18\x01 \x03(\v2\x0f.broker.HoldingR\bholdings\"\xbf\x01\n" +
-	"\x05Order\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
-	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x12\n" +
-	"\x04side\x18\x03 \x01(\tR\x04side\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x01R\bquantity\x12\x14\n" +
-	"\x05price\x18\x05 \x01(\x01R\x05price\x12!\n" +
-	"\frealized_pnl\x18\x06 \x01(\x01R\vrealizedPnl\x12%\n" +
-	"\x0eunrealized_pnl\x18\a \x01(\x01R\runrealizedPnl\":\n" +
-	"\x11OrderbookResponse\x12%\n" +
-	"\x06orders\x18\x01 \x03(\v2\r.broker.OrderR\x06orders\"m\n" +
-	"\bPosition\x12\x16\n" +
-	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+func (x *PnlCard) GetShortTermPnl() float64 {
+	if x != nil {
+		return x.ShortTermPnl
+	}
+	return 0
+}
+
+func (x *PnlCard) GetLongTermPnl() float64 {
+	if x != nil {
+		return x.LongTermPnl
+	}
+	return 0
+}
+
+func (x *PnlCard) GetCharges() float64 {
+	if x != nil {
+		return x.Charges
+	}
+	return 0
+}
+
+func (x *PnlCard) GetNetPnl() float64 {
+	if x != nil {
+		return x.NetPnl
+	}
+	return 0
+}
+
+func (x *PnlCard) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type OrderbookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Card          *PnlCard               `protobuf:"bytes,2,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderbookResponse) Reset() {
+	*x = OrderbookResponse{}
+	mi := &file_broker_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderbookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderbookResponse) ProtoMessage() {}
+
+func (x *OrderbookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderbookResponse.ProtoReflect.Descriptor instead.
+func (*OrderbookResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OrderbookResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *OrderbookResponse) GetCard() *PnlCard {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+type Position struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	AvgPrice      float64                `protobuf:"fixed64,3,opt,name=avg_price,json=avgPrice,proto3" json:"avg_price,omitempty"`
+	Pnl           float64                `protobuf:"fixed64,4,opt,name=pnl,proto3" json:"pnl,omitempty"`
+	BuyQty        float64                `protobuf:"fixed64,5,opt,name=buy_qty,json=buyQty,proto3" json:"buy_qty,omitempty"`
+	SellQty       float64                `protobuf:"fixed64,6,opt,name=sell_qty,json=sellQty,proto3" json:"sell_qty,omitempty"`
+	RealizedPnl   float64                `protobuf:"fixed64,7,opt,name=realized_pnl,json=realizedPnl,proto3" json:"realized_pnl,omitempty"`
+	UnrealizedPnl float64                `protobuf:"fixed64,8,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`
+	Currency      string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	CarriedQty    float64                `protobuf:"fixed64,10,opt,name=carried_qty,json=carriedQty,proto3" json:"carried_qty,omitempty"` // shorts open from earlier days (negative)
+	Multiplier    float64                `protobuf:"fixed64,11,opt,name=multiplier,proto3" json:"multiplier,omitempty"`                   // units per contract, for futures and options
+	Contracts     float64                `protobuf:"fixed64,12,opt,name=contracts,proto3" json:"contracts,omitempty"`
+	Fund          bool                   `protobuf:"varint,13,opt,name=fund,proto3" json:"fund,omitempty"` // quantity is fund units and prices are NAVs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	mi := &file_broker_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Position) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Position) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Position) GetAvgPrice() float64 {
+	if x != nil {
+		return x.AvgPrice
+	}
+	return 0
+}
+
+func (x *Position) GetPnl() float64 {
+	if x != nil {
+		return x.Pnl
+	}
+	return 0
+}
+
+func (x *Position) GetBuyQty() float64 {
+	if x != nil {
+		return x.BuyQty
+	}
+	return 0
+}
+
+func (x *Position) GetSellQty() float64 {
+	if x != nil {
+		return x.SellQty
+	}
+	return 0
+}
+
+func (x *Position) GetRealizedPnl() float64 {
+	if x != nil {
+		return x.RealizedPnl
+	}
+	return 0
+}
+
+func (x *Position) GetUnrealizedPnl() float64 {
+	if x != nil {
+		return x.UnrealizedPnl
+	}
+	return 0
+}
+
+func (x *Position) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Position) GetCarriedQty() float64 {
+	if x != nil {
+		return x.CarriedQty
+	}
+	return 0
+}
+
+func (x *Position) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *Position) GetContracts() float64 {
+	if x != nil {
+		return x.Contracts
+	}
+	return 0
+}
+
+func (x *Position) GetFund() bool {
+	if x != nil {
+		return x.Fund
+	}
+	return false
+}
+
+type PositionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Positions     []*Position            `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	Card          *PnlCard               `protobuf:"bytes,2,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PositionsResponse) Reset() {
+	*x = PositionsResponse{}
+	mi := &file_broker_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PositionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionsResponse) ProtoMessage() {}
+
+func (x *PositionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionsResponse.ProtoReflect.Descriptor instead.
+func (*PositionsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PositionsResponse) GetPositions() []*Position {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *PositionsResponse) GetCard() *PnlCard {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+type Instrument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Exchange      string                 `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Isin          string                 `protobuf:"bytes,4,opt,name=isin,proto3" json:"isin,omitempty"`
+	AssetClass    string                 `protobuf:"bytes,5,opt,name=asset_class,json=assetClass,proto3" json:"asset_class,omitempty"`
+	TickSize      float64                `protobuf:"fixed64,6,opt,name=tick_size,json=tickSize,proto3" json:"tick_size,omitempty"`
+	LotSize       float64                `protobuf:"fixed64,7,opt,name=lot_size,json=lotSize,proto3" json:"lot_size,omitempty"`
+	PrevClose     float64                `protobuf:"fixed64,8,opt,name=prev_close,json=prevClose,proto3" json:"prev_close,omitempty"`
+	LowerBand     float64                `protobuf:"fixed64,9,opt,name=lower_band,json=lowerBand,proto3" json:"lower_band,omitempty"`
+	UpperBand     float64                `protobuf:"fixed64,10,opt,name=upper_band,json=upperBand,proto3" json:"upper_band,omitempty"`
+	Status        string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	Currency      string                 `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
+	Fractional    bool                   `protobuf:"varint,13,opt,name=fractional,proto3" json:"fractional,omitempty"`
+	MinIncrement  float64                `protobuf:"fixed64,14,opt,name=min_increment,json=minIncrement,proto3" json:"min_increment,omitempty"`
+	Underlying    string                 `protobuf:"bytes,15,opt,name=underlying,proto3" json:"underlying,omitempty"` // futures and options
+	Expiry        *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Strike        float64                `protobuf:"fixed64,17,opt,name=strike,proto3" json:"strike,omitempty"`
+	OptionType    string                 `protobuf:"bytes,18,opt,name=option_type,json=optionType,proto3" json:"option_type,omitempty"` // CE or PE
+	Multiplier    float64                `protobuf:"fixed64,19,opt,name=multiplier,proto3" json:"multiplier,omitempty"`                 // units per contract; the lot size
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Instrument) Reset() {
+	*x = Instrument{}
+	mi := &file_broker_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Instrument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Instrument) ProtoMessage() {}
+
+func (x *Instrument) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Instrument.ProtoReflect.Descriptor instead.
+func (*Instrument) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Instrument) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Instrument) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Instrument) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *Instrument) GetIsin() string {
+	if x != nil {
+		return x.Isin
+	}
+	return ""
+}
+
+func (x *Instrument) GetAssetClass() string {
+	if x != nil {
+		return x.AssetClass
+	}
+	return ""
+}
+
+func (x *Instrument) GetTickSize() float64 {
+	if x != nil {
+		return x.TickSize
+	}
+	return 0
+}
+
+func (x *Instrument) GetLotSize() float64 {
+	if x != nil {
+		return x.LotSize
+	}
+	return 0
+}
+
+func (x *Instrument) GetPrevClose() float64 {
+	if x != nil {
+		return x.PrevClose
+	}
+	return 0
+}
+
+func (x *Instrument) GetLowerBand() float64 {
+	if x != nil {
+		return x.LowerBand
+	}
+	return 0
+}
+
+func (x *Instrument) GetUpperBand() float64 {
+	if x != nil {
+		return x.UpperBand
+	}
+	return 0
+}
+
+func (x *Instrument) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Instrument) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Instrument) GetFractional() bool {
+	if x != nil {
+		return x.Fractional
+	}
+	return false
+}
+
+func (x *Instrument) GetMinIncrement() float64 {
+	if x != nil {
+		return x.MinIncrement
+	}
+	return 0
+}
+
+func (x *Instrument) GetUnderlying() string {
+	if x != nil {
+		return x.Underlying
+	}
+	return ""
+}
+
+func (x *Instrument) GetExpiry() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiry
+	}
+	return nil
+}
+
+func (x *Instrument) GetStrike() float64 {
+	if x != nil {
+		return x.Strike
+	}
+	return 0
+}
+
+func (x *Instrument) GetOptionType() string {
+	if x != nil {
+		return x.OptionType
+	}
+	return ""
+}
+
+func (x *Instrument) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+type ListInstrumentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetClass    string                 `protobuf:"bytes,2,opt,name=asset_class,json=assetClass,proto3" json:"asset_class,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInstrumentsRequest) Reset() {
+	*x = ListInstrumentsRequest{}
+	mi := &file_broker_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstrumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstrumentsRequest) ProtoMessage() {}
+
+func (x *ListInstrumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstrumentsRequest.ProtoReflect.Descriptor instead.
+func (*ListInstrumentsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListInstrumentsRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ListInstrumentsRequest) GetAssetClass() string {
+	if x != nil {
+		return x.AssetClass
+	}
+	return ""
+}
+
+type GetInstrumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstrumentRequest) Reset() {
+	*x = GetInstrumentRequest{}
+	mi := &file_broker_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstrumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstrumentRequest) ProtoMessage() {}
+
+func (x *GetInstrumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstrumentRequest.ProtoReflect.Descriptor instead.
+func (*GetInstrumentRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetInstrumentRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type SearchInstrumentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchInstrumentsRequest) Reset() {
+	*x = SearchInstrumentsRequest{}
+	mi := &file_broker_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchInstrumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchInstrumentsRequest) ProtoMessage() {}
+
+func (x *SearchInstrumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchInstrumentsRequest.ProtoReflect.Descriptor instead.
+func (*SearchInstrumentsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchInstrumentsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchInstrumentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type InstrumentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instruments   []*Instrument          `protobuf:"bytes,1,rep,name=instruments,proto3" json:"instruments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstrumentsResponse) Reset() {
+	*x = InstrumentsResponse{}
+	mi := &file_broker_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstrumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstrumentsResponse) ProtoMessage() {}
+
+func (x *InstrumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstrumentsResponse.ProtoReflect.Descriptor instead.
+func (*InstrumentsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *InstrumentsResponse) GetInstruments() []*Instrument {
+	if x != nil {
+		return x.Instruments
+	}
+	return nil
+}
+
+type Candle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Interval      string                 `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	Open          float64                `protobuf:"fixed64,4,opt,name=open,proto3" json:"open,omitempty"`
+	High          float64                `protobuf:"fixed64,5,opt,name=high,proto3" json:"high,omitempty"`
+	Low           float64                `protobuf:"fixed64,6,opt,name=low,proto3" json:"low,omitempty"`
+	Close         float64                `protobuf:"fixed64,7,opt,name=close,proto3" json:"close,omitempty"`
+	Volume        float64                `protobuf:"fixed64,8,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Candle) Reset() {
+	*x = Candle{}
+	mi := &file_broker_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Candle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Candle) ProtoMessage() {}
+
+func (x *Candle) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
+func (*Candle) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Candle) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Candle) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *Candle) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Candle) GetOpen() float64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *Candle) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *Candle) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *Candle) GetClose() float64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *Candle) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+type GetCandlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Interval      string                 `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCandlesRequest) Reset() {
+	*x = GetCandlesRequest{}
+	mi := &file_broker_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCandlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCandlesRequest) ProtoMessage() {}
+
+func (x *GetCandlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCandlesRequest.ProtoReflect.Descriptor instead.
+func (*GetCandlesRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetCandlesRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetCandlesRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *GetCandlesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetCandlesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetCandlesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCandlesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type CandlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candles       []*Candle              `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CandlesResponse) Reset() {
+	*x = CandlesResponse{}
+	mi := &file_broker_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CandlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CandlesResponse) ProtoMessage() {}
+
+func (x *CandlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CandlesResponse.ProtoReflect.Descriptor instead.
+func (*CandlesResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CandlesResponse) GetCandles() []*Candle {
+	if x != nil {
+		return x.Candles
+	}
+	return nil
+}
+
+func (x *CandlesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type StreamCandlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Interval      string                 `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamCandlesRequest) Reset() {
+	*x = StreamCandlesRequest{}
+	mi := &file_broker_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamCandlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamCandlesRequest) ProtoMessage() {}
+
+func (x *StreamCandlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamCandlesRequest.ProtoReflect.Descriptor instead.
+func (*StreamCandlesRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StreamCandlesRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *StreamCandlesRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+type RebuildCandlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Interval      string                 `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebuildCandlesRequest) Reset() {
+	*x = RebuildCandlesRequest{}
+	mi := &file_broker_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebuildCandlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebuildCandlesRequest) ProtoMessage() {}
+
+func (x *RebuildCandlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebuildCandlesRequest.ProtoReflect.Descriptor instead.
+func (*RebuildCandlesRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RebuildCandlesRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *RebuildCandlesRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *RebuildCandlesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *RebuildCandlesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type RebuildCandlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bars          int32                  `protobuf:"varint,1,opt,name=bars,proto3" json:"bars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebuildCandlesResponse) Reset() {
+	*x = RebuildCandlesResponse{}
+	mi := &file_broker_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebuildCandlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebuildCandlesResponse) ProtoMessage() {}
+
+func (x *RebuildCandlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebuildCandlesResponse.ProtoReflect.Descriptor instead.
+func (*RebuildCandlesResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RebuildCandlesResponse) GetBars() int32 {
+	if x != nil {
+		return x.Bars
+	}
+	return 0
+}
+
+type PlaceOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Side          string                 `protobuf:"bytes,2,opt,name=side,proto3" json:"side,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	Validity      string                 `protobuf:"bytes,6,opt,name=validity,proto3" json:"validity,omitempty"`                           // day (default) or gtc
+	AfterMarket   bool                   `protobuf:"varint,7,opt,name=after_market,json=afterMarket,proto3" json:"after_market,omitempty"` // queue until the next open when the market is closed
+	LotMethod     string                 `protobuf:"bytes,8,opt,name=lot_method,json=lotMethod,proto3" json:"lot_method,omitempty"`        // sells: fifo, lifo or specific
+	LotIds        []string               `protobuf:"bytes,9,rep,name=lot_ids,json=lotIds,proto3" json:"lot_ids,omitempty"`                 // sells with lot_method specific
+	Product       string                 `protobuf:"bytes,10,opt,name=product,proto3" json:"product,omitempty"`                            // delivery (default) or intraday
+	Notional      float64                `protobuf:"fixed64,11,opt,name=notional,proto3" json:"notional,omitempty"`                        // market orders: amount to trade instead of a quantity
+	Short         bool                   `protobuf:"varint,12,opt,name=short,proto3" json:"short,omitempty"`                               // sells: borrow against the locate list; needs margin
+	DisplayQty    float64                `protobuf:"fixed64,13,opt,name=display_qty,json=displayQty,proto3" json:"display_qty,omitempty"`  // limit orders: iceberg slice shown in the book
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceOrderRequest) Reset() {
+	*x = PlaceOrderRequest{}
+	mi := &file_broker_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceOrderRequest) ProtoMessage() {}
+
+func (x *PlaceOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceOrderRequest.ProtoReflect.Descriptor instead.
+func (*PlaceOrderRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PlaceOrderRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PlaceOrderRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PlaceOrderRequest) GetValidity() string {
+	if x != nil {
+		return x.Validity
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetAfterMarket() bool {
+	if x != nil {
+		return x.AfterMarket
+	}
+	return false
+}
+
+func (x *PlaceOrderRequest) GetLotMethod() string {
+	if x != nil {
+		return x.LotMethod
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetLotIds() []string {
+	if x != nil {
+		return x.LotIds
+	}
+	return nil
+}
+
+func (x *PlaceOrderRequest) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetNotional() float64 {
+	if x != nil {
+		return x.Notional
+	}
+	return 0
+}
+
+func (x *PlaceOrderRequest) GetShort() bool {
+	if x != nil {
+		return x.Short
+	}
+	return false
+}
+
+func (x *PlaceOrderRequest) GetDisplayQty() float64 {
+	if x != nil {
+		return x.DisplayQty
+	}
+	return 0
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_broker_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CancelOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PriceLevel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Price         float64                `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Orders        int32                  `protobuf:"varint,3,opt,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceLevel) Reset() {
+	*x = PriceLevel{}
+	mi := &file_broker_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceLevel) ProtoMessage() {}
+
+func (x *PriceLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceLevel.ProtoReflect.Descriptor instead.
+func (*PriceLevel) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PriceLevel) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PriceLevel) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PriceLevel) GetOrders() int32 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+type MarketDepth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Bids          []*PriceLevel          `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids,omitempty"`
+	Asks          []*PriceLevel          `protobuf:"bytes,3,rep,name=asks,proto3" json:"asks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketDepth) Reset() {
+	*x = MarketDepth{}
+	mi := &file_broker_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketDepth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketDepth) ProtoMessage() {}
+
+func (x *MarketDepth) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketDepth.ProtoReflect.Descriptor instead.
+func (*MarketDepth) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MarketDepth) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *MarketDepth) GetBids() []*PriceLevel {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *MarketDepth) GetAsks() []*PriceLevel {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+type GetMarketDepthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Levels        int32                  `protobuf:"varint,2,opt,name=levels,proto3" json:"levels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketDepthRequest) Reset() {
+	*x = GetMarketDepthRequest{}
+	mi := &file_broker_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketDepthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketDepthRequest) ProtoMessage() {}
+
+func (x *GetMarketDepthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketDepthRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketDepthRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetMarketDepthRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetMarketDepthRequest) GetLevels() int32 {
+	if x != nil {
+		return x.Levels
+	}
+	return 0
+}
+
+type SubscribeQuotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbols       []string               `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	Levels        int32                  `protobuf:"varint,2,opt,name=levels,proto3" json:"levels,omitempty"`                           // depth levels per side, 0 for top of book only
+	IntervalMs    int32                  `protobuf:"varint,3,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"` // minimum time between updates per symbol
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeQuotesRequest) Reset() {
+	*x = SubscribeQuotesRequest{}
+	mi := &file_broker_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeQuotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeQuotesRequest) ProtoMessage() {}
+
+func (x *SubscribeQuotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeQuotesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeQuotesRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SubscribeQuotesRequest) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *SubscribeQuotesRequest) GetLevels() int32 {
+	if x != nil {
+		return x.Levels
+	}
+	return 0
+}
+
+func (x *SubscribeQuotesRequest) GetIntervalMs() int32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+type QuoteUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Bid           float64                `protobuf:"fixed64,2,opt,name=bid,proto3" json:"bid,omitempty"`
+	BidSize       float64                `protobuf:"fixed64,3,opt,name=bid_size,json=bidSize,proto3" json:"bid_size,omitempty"`
+	Ask           float64                `protobuf:"fixed64,4,opt,name=ask,proto3" json:"ask,omitempty"`
+	AskSize       float64                `protobuf:"fixed64,5,opt,name=ask_size,json=askSize,proto3" json:"ask_size,omitempty"`
+	Last          float64                `protobuf:"fixed64,6,opt,name=last,proto3" json:"last,omitempty"`
+	Bids          []*PriceLevel          `protobuf:"bytes,7,rep,name=bids,proto3" json:"bids,omitempty"`
+	Asks          []*PriceLevel          `protobuf:"bytes,8,rep,name=asks,proto3" json:"asks,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteUpdate) Reset() {
+	*x = QuoteUpdate{}
+	mi := &file_broker_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteUpdate) ProtoMessage() {}
+
+func (x *QuoteUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteUpdate.ProtoReflect.Descriptor instead.
+func (*QuoteUpdate) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *QuoteUpdate) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *QuoteUpdate) GetBid() float64 {
+	if x != nil {
+		return x.Bid
+	}
+	return 0
+}
+
+func (x *QuoteUpdate) GetBidSize() float64 {
+	if x != nil {
+		return x.BidSize
+	}
+	return 0
+}
+
+func (x *QuoteUpdate) GetAsk() float64 {
+	if x != nil {
+		return x.Ask
+	}
+	return 0
+}
+
+func (x *QuoteUpdate) GetAskSize() float64 {
+	if x != nil {
+		return x.AskSize
+	}
+	return 0
+}
+
+func (x *QuoteUpdate) GetLast() float64 {
+	if x != nil {
+		return x.Last
+	}
+	return 0
+}
+
+func (x *QuoteUpdate) GetBids() []*PriceLevel {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *QuoteUpdate) GetAsks() []*PriceLevel {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+func (x *QuoteUpdate) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type WatchlistItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Last          float64                `protobuf:"fixed64,2,opt,name=last,proto3" json:"last,omitempty"`
+	Bid           float64                `protobuf:"fixed64,3,opt,name=bid,proto3" json:"bid,omitempty"`
+	Ask           float64                `protobuf:"fixed64,4,opt,name=ask,proto3" json:"ask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchlistItem) Reset() {
+	*x = WatchlistItem{}
+	mi := &file_broker_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistItem) ProtoMessage() {}
+
+func (x *WatchlistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistItem.ProtoReflect.Descriptor instead.
+func (*WatchlistItem) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *WatchlistItem) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetLast() float64 {
+	if x != nil {
+		return x.Last
+	}
+	return 0
+}
+
+func (x *WatchlistItem) GetBid() float64 {
+	if x != nil {
+		return x.Bid
+	}
+	return 0
+}
+
+func (x *WatchlistItem) GetAsk() float64 {
+	if x != nil {
+		return x.Ask
+	}
+	return 0
+}
+
+type Watchlist struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Items         []*WatchlistItem       `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Watchlist) Reset() {
+	*x = Watchlist{}
+	mi := &file_broker_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Watchlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Watchlist) ProtoMessage() {}
+
+func (x *Watchlist) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Watchlist.ProtoReflect.Descriptor instead.
+func (*Watchlist) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Watchlist) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Watchlist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Watchlist) GetItems() []*WatchlistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Watchlist) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Watchlist) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type WatchlistsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Watchlists    []*Watchlist           `protobuf:"bytes,1,rep,name=watchlists,proto3" json:"watchlists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchlistsResponse) Reset() {
+	*x = WatchlistsResponse{}
+	mi := &file_broker_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistsResponse) ProtoMessage() {}
+
+func (x *WatchlistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistsResponse.ProtoReflect.Descriptor instead.
+func (*WatchlistsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WatchlistsResponse) GetWatchlists() []*Watchlist {
+	if x != nil {
+		return x.Watchlists
+	}
+	return nil
+}
+
+type CreateWatchlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Symbols       []string               `protobuf:"bytes,2,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWatchlistRequest) Reset() {
+	*x = CreateWatchlistRequest{}
+	mi := &file_broker_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWatchlistRequest) ProtoMessage() {}
+
+func (x *CreateWatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWatchlistRequest.ProtoReflect.Descriptor instead.
+func (*CreateWatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateWatchlistRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWatchlistRequest) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+type WatchlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchlistRequest) Reset() {
+	*x = WatchlistRequest{}
+	mi := &file_broker_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistRequest) ProtoMessage() {}
+
+func (x *WatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistRequest.ProtoReflect.Descriptor instead.
+func (*WatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WatchlistRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RenameWatchlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameWatchlistRequest) Reset() {
+	*x = RenameWatchlistRequest{}
+	mi := &file_broker_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameWatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameWatchlistRequest) ProtoMessage() {}
+
+func (x *RenameWatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameWatchlistRequest.ProtoReflect.Descriptor instead.
+func (*RenameWatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RenameWatchlistRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RenameWatchlistRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type WatchlistSymbolsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbols       []string               `protobuf:"bytes,2,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchlistSymbolsRequest) Reset() {
+	*x = WatchlistSymbolsRequest{}
+	mi := &file_broker_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistSymbolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistSymbolsRequest) ProtoMessage() {}
+
+func (x *WatchlistSymbolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistSymbolsRequest.ProtoReflect.Descriptor instead.
+func (*WatchlistSymbolsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *WatchlistSymbolsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WatchlistSymbolsRequest) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+type RemoveWatchlistSymbolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWatchlistSymbolRequest) Reset() {
+	*x = RemoveWatchlistSymbolRequest{}
+	mi := &file_broker_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWatchlistSymbolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWatchlistSymbolRequest) ProtoMessage() {}
+
+func (x *RemoveWatchlistSymbolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWatchlistSymbolRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWatchlistSymbolRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RemoveWatchlistSymbolRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoveWatchlistSymbolRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type Alert struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbol          string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Condition       string                 `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	Threshold       float64                `protobuf:"fixed64,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Channels        []string               `protobuf:"bytes,5,rep,name=channels,proto3" json:"channels,omitempty"`
+	WebhookUrl      string                 `protobuf:"bytes,6,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	Rearm           string                 `protobuf:"bytes,7,opt,name=rearm,proto3" json:"rearm,omitempty"`
+	CooldownSec     int32                  `protobuf:"varint,8,opt,name=cooldown_sec,json=cooldownSec,proto3" json:"cooldown_sec,omitempty"`
+	Status          string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	LastTriggeredAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_triggered_at,json=lastTriggeredAt,proto3" json:"last_triggered_at,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	mi := &file_broker_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Alert) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Alert) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Alert) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *Alert) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *Alert) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *Alert) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *Alert) GetRearm() string {
+	if x != nil {
+		return x.Rearm
+	}
+	return ""
+}
+
+func (x *Alert) GetCooldownSec() int32 {
+	if x != nil {
+		return x.CooldownSec
+	}
+	return 0
+}
+
+func (x *Alert) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Alert) GetLastTriggeredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTriggeredAt
+	}
+	return nil
+}
+
+func (x *Alert) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateAlertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Condition     string                 `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"` // above, below, percent_change, volume_spike
+	Threshold     float64                `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Channels      []string               `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"` // inbox, email, webhook
+	WebhookUrl    string                 `protobuf:"bytes,5,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	Rearm         string                 `protobuf:"bytes,6,opt,name=rearm,proto3" json:"rearm,omitempty"` // none or auto
+	CooldownSec   int32                  `protobuf:"varint,7,opt,name=cooldown_sec,json=cooldownSec,proto3" json:"cooldown_sec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertRequest) Reset() {
+	*x = CreateAlertRequest{}
+	mi := &file_broker_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertRequest) ProtoMessage() {}
+
+func (x *CreateAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlertRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateAlertRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CreateAlertRequest) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *CreateAlertRequest) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *CreateAlertRequest) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *CreateAlertRequest) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *CreateAlertRequest) GetRearm() string {
+	if x != nil {
+		return x.Rearm
+	}
+	return ""
+}
+
+func (x *CreateAlertRequest) GetCooldownSec() int32 {
+	if x != nil {
+		return x.CooldownSec
+	}
+	return 0
+}
+
+type AlertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertRequest) Reset() {
+	*x = AlertRequest{}
+	mi := &file_broker_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRequest) ProtoMessage() {}
+
+func (x *AlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRequest.ProtoReflect.Descriptor instead.
+func (*AlertRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AlertRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*Alert               `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertsResponse) Reset() {
+	*x = AlertsResponse{}
+	mi := &file_broker_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertsResponse) ProtoMessage() {}
+
+func (x *AlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertsResponse.ProtoReflect.Descriptor instead.
+func (*AlertsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AlertsResponse) GetAlerts() []*Alert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+type AlertEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AlertId       string                 `protobuf:"bytes,2,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	Symbol        string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Condition     string                 `protobuf:"bytes,4,opt,name=condition,proto3" json:"condition,omitempty"`
+	Threshold     float64                `protobuf:"fixed64,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Value         float64                `protobuf:"fixed64,6,opt,name=value,proto3" json:"value,omitempty"`
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertEvent) Reset() {
+	*x = AlertEvent{}
+	mi := &file_broker_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertEvent) ProtoMessage() {}
+
+func (x *AlertEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertEvent.ProtoReflect.Descriptor instead.
+func (*AlertEvent) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AlertEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetAlertId() string {
+	if x != nil {
+		return x.AlertId
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *AlertEvent) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *AlertEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type AlertHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertHistoryRequest) Reset() {
+	*x = AlertHistoryRequest{}
+	mi := &file_broker_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertHistoryRequest) ProtoMessage() {}
+
+func (x *AlertHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertHistoryRequest.ProtoReflect.Descriptor instead.
+func (*AlertHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AlertHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AlertHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AlertEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertHistoryResponse) Reset() {
+	*x = AlertHistoryResponse{}
+	mi := &file_broker_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertHistoryResponse) ProtoMessage() {}
+
+func (x *AlertHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertHistoryResponse.ProtoReflect.Descriptor instead.
+func (*AlertHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AlertHistoryResponse) GetEvents() []*AlertEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Read          bool                   `protobuf:"varint,4,opt,name=read,proto3" json:"read,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_broker_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Notification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type NotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnreadOnly    bool                   `protobuf:"varint,1,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationsRequest) Reset() {
+	*x = NotificationsRequest{}
+	mi := &file_broker_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationsRequest) ProtoMessage() {}
+
+func (x *NotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationsRequest.ProtoReflect.Descriptor instead.
+func (*NotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *NotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+type NotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationsResponse) Reset() {
+	*x = NotificationsResponse{}
+	mi := &file_broker_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationsResponse) ProtoMessage() {}
+
+func (x *NotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationsResponse.ProtoReflect.Descriptor instead.
+func (*NotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *NotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+type MarkNotificationsReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"` // empty marks everything read
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationsReadRequest) Reset() {
+	*x = MarkNotificationsReadRequest{}
+	mi := &file_broker_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadRequest) ProtoMessage() {}
+
+func (x *MarkNotificationsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MarkNotificationsReadRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetMarketStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"` // empty for every configured exchange
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketStatusRequest) Reset() {
+	*x = GetMarketStatusRequest{}
+	mi := &file_broker_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketStatusRequest) ProtoMessage() {}
+
+func (x *GetMarketStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketStatusRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetMarketStatusRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+type MarketStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Session       string                 `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	IsOpen        bool                   `protobuf:"varint,3,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
+	TradingDay    bool                   `protobuf:"varint,4,opt,name=trading_day,json=tradingDay,proto3" json:"trading_day,omitempty"`
+	HalfDay       bool                   `protobuf:"varint,5,opt,name=half_day,json=halfDay,proto3" json:"half_day,omitempty"`
+	NextOpen      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=next_open,json=nextOpen,proto3" json:"next_open,omitempty"`
+	NextClose     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=next_close,json=nextClose,proto3" json:"next_close,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketStatus) Reset() {
+	*x = MarketStatus{}
+	mi := &file_broker_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketStatus) ProtoMessage() {}
+
+func (x *MarketStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketStatus.ProtoReflect.Descriptor instead.
+func (*MarketStatus) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MarketStatus) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *MarketStatus) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *MarketStatus) GetIsOpen() bool {
+	if x != nil {
+		return x.IsOpen
+	}
+	return false
+}
+
+func (x *MarketStatus) GetTradingDay() bool {
+	if x != nil {
+		return x.TradingDay
+	}
+	return false
+}
+
+func (x *MarketStatus) GetHalfDay() bool {
+	if x != nil {
+		return x.HalfDay
+	}
+	return false
+}
+
+func (x *MarketStatus) GetNextOpen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextOpen
+	}
+	return nil
+}
+
+func (x *MarketStatus) GetNextClose() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextClose
+	}
+	return nil
+}
+
+type MarketStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*MarketStatus        `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketStatusResponse) Reset() {
+	*x = MarketStatusResponse{}
+	mi := &file_broker_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketStatusResponse) ProtoMessage() {}
+
+func (x *MarketStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketStatusResponse.ProtoReflect.Descriptor instead.
+func (*MarketStatusResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MarketStatusResponse) GetStatuses() []*MarketStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type Lot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	OrderId       string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	OrigQty       float64                `protobuf:"fixed64,5,opt,name=orig_qty,json=origQty,proto3" json:"orig_qty,omitempty"`
+	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	AcquiredAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=acquired_at,json=acquiredAt,proto3" json:"acquired_at,omitempty"`
+	Term          string                 `protobuf:"bytes,8,opt,name=term,proto3" json:"term,omitempty"`
+	Exchange      string                 `protobuf:"bytes,9,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	SettleDate    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=settle_date,json=settleDate,proto3" json:"settle_date,omitempty"`
+	Settled       bool                   `protobuf:"varint,11,opt,name=settled,proto3" json:"settled,omitempty"`
+	Currency      string                 `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
+	Short         bool                   `protobuf:"varint,13,opt,name=short,proto3" json:"short,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Lot) Reset() {
+	*x = Lot{}
+	mi := &file_broker_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lot) ProtoMessage() {}
+
+func (x *Lot) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lot.ProtoReflect.Descriptor instead.
+func (*Lot) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Lot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Lot) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Lot) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Lot) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Lot) GetOrigQty() float64 {
+	if x != nil {
+		return x.OrigQty
+	}
+	return 0
+}
+
+func (x *Lot) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Lot) GetAcquiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcquiredAt
+	}
+	return nil
+}
+
+func (x *Lot) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *Lot) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *Lot) GetSettleDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettleDate
+	}
+	return nil
+}
+
+func (x *Lot) GetSettled() bool {
+	if x != nil {
+		return x.Settled
+	}
+	return false
+}
+
+func (x *Lot) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Lot) GetShort() bool {
+	if x != nil {
+		return x.Short
+	}
+	return false
+}
+
+type GetLotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLotsRequest) Reset() {
+	*x = GetLotsRequest{}
+	mi := &file_broker_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLotsRequest) ProtoMessage() {}
+
+func (x *GetLotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLotsRequest.ProtoReflect.Descriptor instead.
+func (*GetLotsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetLotsRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type LotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lots          []*Lot                 `protobuf:"bytes,1,rep,name=lots,proto3" json:"lots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LotsResponse) Reset() {
+	*x = LotsResponse{}
+	mi := &file_broker_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LotsResponse) ProtoMessage() {}
+
+func (x *LotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LotsResponse.ProtoReflect.Descriptor instead.
+func (*LotsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *LotsResponse) GetLots() []*Lot {
+	if x != nil {
+		return x.Lots
+	}
+	return nil
+}
+
+type ClosedLot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	LotId         string                 `protobuf:"bytes,3,opt,name=lot_id,json=lotId,proto3" json:"lot_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	CostPrice     float64                `protobuf:"fixed64,6,opt,name=cost_price,json=costPrice,proto3" json:"cost_price,omitempty"`
+	SalePrice     float64                `protobuf:"fixed64,7,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`
+	RealizedPnl   float64                `protobuf:"fixed64,8,opt,name=realized_pnl,json=realizedPnl,proto3" json:"realized_pnl,omitempty"`
+	AcquiredAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=acquired_at,json=acquiredAt,proto3" json:"acquired_at,omitempty"`
+	ClosedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	Term          string                 `protobuf:"bytes,11,opt,name=term,proto3" json:"term,omitempty"`
+	Currency      string                 `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
+	Short         bool                   `protobuf:"varint,13,opt,name=short,proto3" json:"short,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClosedLot) Reset() {
+	*x = ClosedLot{}
+	mi := &file_broker_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClosedLot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosedLot) ProtoMessage() {}
+
+func (x *ClosedLot) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosedLot.ProtoReflect.Descriptor instead.
+func (*ClosedLot) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ClosedLot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClosedLot) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ClosedLot) GetLotId() string {
+	if x != nil {
+		return x.LotId
+	}
+	return ""
+}
+
+func (x *ClosedLot) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ClosedLot) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ClosedLot) GetCostPrice() float64 {
+	if x != nil {
+		return x.CostPrice
+	}
+	return 0
+}
+
+func (x *ClosedLot) GetSalePrice() float64 {
+	if x != nil {
+		return x.SalePrice
+	}
+	return 0
+}
+
+func (x *ClosedLot) GetRealizedPnl() float64 {
+	if x != nil {
+		return x.RealizedPnl
+	}
+	return 0
+}
+
+func (x *ClosedLot) GetAcquiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcquiredAt
+	}
+	return nil
+}
+
+func (x *ClosedLot) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *ClosedLot) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *ClosedLot) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ClosedLot) GetShort() bool {
+	if x != nil {
+		return x.Short
+	}
+	return false
+}
+
+type ClosedLotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClosedLots    []*ClosedLot           `protobuf:"bytes,1,rep,name=closed_lots,json=closedLots,proto3" json:"closed_lots,omitempty"`
+	Card          *PnlCard               `protobuf:"bytes,2,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClosedLotsResponse) Reset() {
+	*x = ClosedLotsResponse{}
+	mi := &file_broker_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClosedLotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosedLotsResponse) ProtoMessage() {}
+
+func (x *ClosedLotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosedLotsResponse.ProtoReflect.Descriptor instead.
+func (*ClosedLotsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ClosedLotsResponse) GetClosedLots() []*ClosedLot {
+	if x != nil {
+		return x.ClosedLots
+	}
+	return nil
+}
+
+func (x *ClosedLotsResponse) GetCard() *PnlCard {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+type CorporateAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // split, reverse_split, bonus, dividend, symbol_change
+	ExDate        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ex_date,json=exDate,proto3" json:"ex_date,omitempty"`
+	RecordDate    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=record_date,json=recordDate,proto3" json:"record_date,omitempty"`
+	RatioNew      float64                `protobuf:"fixed64,6,opt,name=ratio_new,json=ratioNew,proto3" json:"ratio_new,omitempty"`
+	RatioOld      float64                `protobuf:"fixed64,7,opt,name=ratio_old,json=ratioOld,proto3" json:"ratio_old,omitempty"`
+	Amount        float64                `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	NewSymbol     string                 `protobuf:"bytes,9,opt,name=new_symbol,json=newSymbol,proto3" json:"new_symbol,omitempty"`
+	Status        string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	AppliedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CorporateAction) Reset() {
+	*x = CorporateAction{}
+	mi := &file_broker_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CorporateAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorporateAction) ProtoMessage() {}
+
+func (x *CorporateAction) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorporateAction.ProtoReflect.Descriptor instead.
+func (*CorporateAction) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CorporateAction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CorporateAction) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CorporateAction) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CorporateAction) GetExDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExDate
+	}
+	return nil
+}
+
+func (x *CorporateAction) GetRecordDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordDate
+	}
+	return nil
+}
+
+func (x *CorporateAction) GetRatioNew() float64 {
+	if x != nil {
+		return x.RatioNew
+	}
+	return 0
+}
+
+func (x *CorporateAction) GetRatioOld() float64 {
+	if x != nil {
+		return x.RatioOld
+	}
+	return 0
+}
+
+func (x *CorporateAction) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CorporateAction) GetNewSymbol() string {
+	if x != nil {
+		return x.NewSymbol
+	}
+	return ""
+}
+
+func (x *CorporateAction) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CorporateAction) GetAppliedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return nil
+}
+
+type ListCorporateActionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCorporateActionsRequest) Reset() {
+	*x = ListCorporateActionsRequest{}
+	mi := &file_broker_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCorporateActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCorporateActionsRequest) ProtoMessage() {}
+
+func (x *ListCorporateActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCorporateActionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCorporateActionsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListCorporateActionsRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type CreateCorporateActionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actions       []*CorporateAction     `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCorporateActionsRequest) Reset() {
+	*x = CreateCorporateActionsRequest{}
+	mi := &file_broker_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCorporateActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCorporateActionsRequest) ProtoMessage() {}
+
+func (x *CreateCorporateActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCorporateActionsRequest.ProtoReflect.Descriptor instead.
+func (*CreateCorporateActionsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateCorporateActionsRequest) GetActions() []*CorporateAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type CorporateActionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actions       []*CorporateAction     `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CorporateActionsResponse) Reset() {
+	*x = CorporateActionsResponse{}
+	mi := &file_broker_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CorporateActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorporateActionsResponse) ProtoMessage() {}
+
+func (x *CorporateActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorporateActionsResponse.ProtoReflect.Descriptor instead.
+func (*CorporateActionsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CorporateActionsResponse) GetActions() []*CorporateAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type Adjustment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActionId      string                 `protobuf:"bytes,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Symbol        string                 `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	NewSymbol     string                 `protobuf:"bytes,5,opt,name=new_symbol,json=newSymbol,proto3" json:"new_symbol,omitempty"`
+	OldQty        float64                `protobuf:"fixed64,6,opt,name=old_qty,json=oldQty,proto3" json:"old_qty,omitempty"`
+	NewQty        float64                `protobuf:"fixed64,7,opt,name=new_qty,json=newQty,proto3" json:"new_qty,omitempty"`
+	OldAvgPrice   float64                `protobuf:"fixed64,8,opt,name=old_avg_price,json=oldAvgPrice,proto3" json:"old_avg_price,omitempty"`
+	NewAvgPrice   float64                `protobuf:"fixed64,9,opt,name=new_avg_price,json=newAvgPrice,proto3" json:"new_avg_price,omitempty"`
+	Cash          float64                `protobuf:"fixed64,10,opt,name=cash,proto3" json:"cash,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=time,proto3" json:"time,omitempty"`
+	Currency      string                 `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
+	FractionQty   float64                `protobuf:"fixed64,13,opt,name=fraction_qty,json=fractionQty,proto3" json:"fraction_qty,omitempty"`
+	CashInLieu    float64                `protobuf:"fixed64,14,opt,name=cash_in_lieu,json=cashInLieu,proto3" json:"cash_in_lieu,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Adjustment) Reset() {
+	*x = Adjustment{}
+	mi := &file_broker_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Adjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Adjustment) ProtoMessage() {}
+
+func (x *Adjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Adjustment.ProtoReflect.Descriptor instead.
+func (*Adjustment) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Adjustment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Adjustment) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
+func (x *Adjustment) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Adjustment) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Adjustment) GetNewSymbol() string {
+	if x != nil {
+		return x.NewSymbol
+	}
+	return ""
+}
+
+func (x *Adjustment) GetOldQty() float64 {
+	if x != nil {
+		return x.OldQty
+	}
+	return 0
+}
+
+func (x *Adjustment) GetNewQty() float64 {
+	if x != nil {
+		return x.NewQty
+	}
+	return 0
+}
+
+func (x *Adjustment) GetOldAvgPrice() float64 {
+	if x != nil {
+		return x.OldAvgPrice
+	}
+	return 0
+}
+
+func (x *Adjustment) GetNewAvgPrice() float64 {
+	if x != nil {
+		return x.NewAvgPrice
+	}
+	return 0
+}
+
+func (x *Adjustment) GetCash() float64 {
+	if x != nil {
+		return x.Cash
+	}
+	return 0
+}
+
+func (x *Adjustment) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Adjustment) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Adjustment) GetFractionQty() float64 {
+	if x != nil {
+		return x.FractionQty
+	}
+	return 0
+}
+
+func (x *Adjustment) GetCashInLieu() float64 {
+	if x != nil {
+		return x.CashInLieu
+	}
+	return 0
+}
+
+type AdjustmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Adjustments   []*Adjustment          `protobuf:"bytes,1,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustmentsResponse) Reset() {
+	*x = AdjustmentsResponse{}
+	mi := &file_broker_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustmentsResponse) ProtoMessage() {}
+
+func (x *AdjustmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustmentsResponse.ProtoReflect.Descriptor instead.
+func (*AdjustmentsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AdjustmentsResponse) GetAdjustments() []*Adjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+type CashEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Symbol        string                 `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Reference     string                 `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"`
+	Currency      string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CashEntry) Reset() {
+	*x = CashEntry{}
+	mi := &file_broker_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CashEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashEntry) ProtoMessage() {}
+
+func (x *CashEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashEntry.ProtoReflect.Descriptor instead.
+func (*CashEntry) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CashEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CashEntry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CashEntry) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CashEntry) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CashEntry) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *CashEntry) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CashEntry) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *CashEntry) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type CashLedgerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balance       float64                `protobuf:"fixed64,1,opt,name=balance,proto3" json:"balance,omitempty"` // all currencies in the base currency
+	Entries       []*CashEntry           `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	Balances      map[string]float64     `protobuf:"bytes,3,rep,name=balances,proto3" json:"balances,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"` // per currency
+	BaseCurrency  string                 `protobuf:"bytes,4,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CashLedgerResponse) Reset() {
+	*x = CashLedgerResponse{}
+	mi := &file_broker_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CashLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashLedgerResponse) ProtoMessage() {}
+
+func (x *CashLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashLedgerResponse.ProtoReflect.Descriptor instead.
+func (*CashLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CashLedgerResponse) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *CashLedgerResponse) GetEntries() []*CashEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *CashLedgerResponse) GetBalances() map[string]float64 {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *CashLedgerResponse) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+type SettlementResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Value         float64                `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettlementResult) Reset() {
+	*x = SettlementResult{}
+	mi := &file_broker_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettlementResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementResult) ProtoMessage() {}
+
+func (x *SettlementResult) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementResult.ProtoReflect.Descriptor instead.
+func (*SettlementResult) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SettlementResult) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SettlementResult) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *SettlementResult) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *SettlementResult) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type SettlementRun struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange      string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	TradingDate   string                 `protobuf:"bytes,3,opt,name=trading_date,json=tradingDate,proto3" json:"trading_date,omitempty"`
+	RanAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ran_at,json=ranAt,proto3" json:"ran_at,omitempty"`
+	Results       []*SettlementResult    `protobuf:"bytes,5,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettlementRun) Reset() {
+	*x = SettlementRun{}
+	mi := &file_broker_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettlementRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementRun) ProtoMessage() {}
+
+func (x *SettlementRun) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementRun.ProtoReflect.Descriptor instead.
+func (*SettlementRun) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SettlementRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SettlementRun) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *SettlementRun) GetTradingDate() string {
+	if x != nil {
+		return x.TradingDate
+	}
+	return ""
+}
+
+func (x *SettlementRun) GetRanAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RanAt
+	}
+	return nil
+}
+
+func (x *SettlementRun) GetResults() []*SettlementResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ListSettlementRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSettlementRunsRequest) Reset() {
+	*x = ListSettlementRunsRequest{}
+	mi := &file_broker_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSettlementRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSettlementRunsRequest) ProtoMessage() {}
+
+func (x *ListSettlementRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSettlementRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListSettlementRunsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListSettlementRunsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SettlementRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*SettlementRun       `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettlementRunsResponse) Reset() {
+	*x = SettlementRunsResponse{}
+	mi := &file_broker_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettlementRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementRunsResponse) ProtoMessage() {}
+
+func (x *SettlementRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementRunsResponse.ProtoReflect.Descriptor instead.
+func (*SettlementRunsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SettlementRunsResponse) GetRuns() []*SettlementRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type CashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        float64                `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"` // base currency when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CashRequest) Reset() {
+	*x = CashRequest{}
+	mi := &file_broker_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashRequest) ProtoMessage() {}
+
+func (x *CashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashRequest.ProtoReflect.Descriptor instead.
+func (*CashRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CashRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CashRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CashRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type SnapshotHolding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	AvgPrice      float64                `protobuf:"fixed64,3,opt,name=avg_price,json=avgPrice,proto3" json:"avg_price,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Value         float64                `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	BaseValue     float64                `protobuf:"fixed64,7,opt,name=base_value,json=baseValue,proto3" json:"base_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotHolding) Reset() {
+	*x = SnapshotHolding{}
+	mi := &file_broker_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotHolding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotHolding) ProtoMessage() {}
+
+func (x *SnapshotHolding) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotHolding.ProtoReflect.Descriptor instead.
+func (*SnapshotHolding) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SnapshotHolding) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *SnapshotHolding) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *SnapshotHolding) GetAvgPrice() float64 {
+	if x != nil {
+		return x.AvgPrice
+	}
+	return 0
+}
+
+func (x *SnapshotHolding) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SnapshotHolding) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *SnapshotHolding) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SnapshotHolding) GetBaseValue() float64 {
+	if x != nil {
+		return x.BaseValue
+	}
+	return 0
+}
+
+type PortfolioSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Holdings      []*SnapshotHolding     `protobuf:"bytes,3,rep,name=holdings,proto3" json:"holdings,omitempty"`
+	HoldingsValue float64                `protobuf:"fixed64,4,opt,name=holdings_value,json=holdingsValue,proto3" json:"holdings_value,omitempty"`
+	Cash          float64                `protobuf:"fixed64,5,opt,name=cash,proto3" json:"cash,omitempty"`
+	TotalValue    float64                `protobuf:"fixed64,6,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	NetFlow       float64                `protobuf:"fixed64,7,opt,name=net_flow,json=netFlow,proto3" json:"net_flow,omitempty"`
+	DayChange     float64                `protobuf:"fixed64,8,opt,name=day_change,json=dayChange,proto3" json:"day_change,omitempty"`
+	Currency      string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortfolioSnapshot) Reset() {
+	*x = PortfolioSnapshot{}
+	mi := &file_broker_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioSnapshot) ProtoMessage() {}
+
+func (x *PortfolioSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioSnapshot.ProtoReflect.Descriptor instead.
+func (*PortfolioSnapshot) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *PortfolioSnapshot) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PortfolioSnapshot) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *PortfolioSnapshot) GetHoldings() []*SnapshotHolding {
+	if x != nil {
+		return x.Holdings
+	}
+	return nil
+}
+
+func (x *PortfolioSnapshot) GetHoldingsValue() float64 {
+	if x != nil {
+		return x.HoldingsValue
+	}
+	return 0
+}
+
+func (x *PortfolioSnapshot) GetCash() float64 {
+	if x != nil {
+		return x.Cash
+	}
+	return 0
+}
+
+func (x *PortfolioSnapshot) GetTotalValue() float64 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
+func (x *PortfolioSnapshot) GetNetFlow() float64 {
+	if x != nil {
+		return x.NetFlow
+	}
+	return 0
+}
+
+func (x *PortfolioSnapshot) GetDayChange() float64 {
+	if x != nil {
+		return x.DayChange
+	}
+	return 0
+}
+
+func (x *PortfolioSnapshot) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type PortfolioRangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"` // YYYY-MM-DD, inclusive
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortfolioRangeRequest) Reset() {
+	*x = PortfolioRangeRequest{}
+	mi := &file_broker_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioRangeRequest) ProtoMessage() {}
+
+func (x *PortfolioRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioRangeRequest.ProtoReflect.Descriptor instead.
+func (*PortfolioRangeRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PortfolioRangeRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PortfolioRangeRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type PortfolioHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshots     []*PortfolioSnapshot   `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortfolioHistoryResponse) Reset() {
+	*x = PortfolioHistoryResponse{}
+	mi := &file_broker_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioHistoryResponse) ProtoMessage() {}
+
+func (x *PortfolioHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioHistoryResponse.ProtoReflect.Descriptor instead.
+func (*PortfolioHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *PortfolioHistoryResponse) GetSnapshots() []*PortfolioSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type Performance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	StartValue    float64                `protobuf:"fixed64,3,opt,name=start_value,json=startValue,proto3" json:"start_value,omitempty"`
+	EndValue      float64                `protobuf:"fixed64,4,opt,name=end_value,json=endValue,proto3" json:"end_value,omitempty"`
+	NetFlows      float64                `protobuf:"fixed64,5,opt,name=net_flows,json=netFlows,proto3" json:"net_flows,omitempty"`
+	Twr           float64                `protobuf:"fixed64,6,opt,name=twr,proto3" json:"twr,omitempty"`
+	Xirr          float64                `protobuf:"fixed64,7,opt,name=xirr,proto3" json:"xirr,omitempty"`
+	MaxDrawdown   float64                `protobuf:"fixed64,8,opt,name=max_drawdown,json=maxDrawdown,proto3" json:"max_drawdown,omitempty"`
+	DrawdownPeak  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=drawdown_peak,json=drawdownPeak,proto3" json:"drawdown_peak,omitempty"`
+	DrawdownLow   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=drawdown_low,json=drawdownLow,proto3" json:"drawdown_low,omitempty"`
+	DayChange     float64                `protobuf:"fixed64,11,opt,name=day_change,json=dayChange,proto3" json:"day_change,omitempty"`
+	DayChangePct  float64                `protobuf:"fixed64,12,opt,name=day_change_pct,json=dayChangePct,proto3" json:"day_change_pct,omitempty"`
+	CurrentValue  float64                `protobuf:"fixed64,13,opt,name=current_value,json=currentValue,proto3" json:"current_value,omitempty"`
+	SnapshotCount int32                  `protobuf:"varint,14,opt,name=snapshot_count,json=snapshotCount,proto3" json:"snapshot_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Performance) Reset() {
+	*x = Performance{}
+	mi := &file_broker_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Performance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Performance) ProtoMessage() {}
+
+func (x *Performance) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Performance.ProtoReflect.Descriptor instead.
+func (*Performance) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Performance) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *Performance) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *Performance) GetStartValue() float64 {
+	if x != nil {
+		return x.StartValue
+	}
+	return 0
+}
+
+func (x *Performance) GetEndValue() float64 {
+	if x != nil {
+		return x.EndValue
+	}
+	return 0
+}
+
+func (x *Performance) GetNetFlows() float64 {
+	if x != nil {
+		return x.NetFlows
+	}
+	return 0
+}
+
+func (x *Performance) GetTwr() float64 {
+	if x != nil {
+		return x.Twr
+	}
+	return 0
+}
+
+func (x *Performance) GetXirr() float64 {
+	if x != nil {
+		return x.Xirr
+	}
+	return 0
+}
+
+func (x *Performance) GetMaxDrawdown() float64 {
+	if x != nil {
+		return x.MaxDrawdown
+	}
+	return 0
+}
+
+func (x *Performance) GetDrawdownPeak() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DrawdownPeak
+	}
+	return nil
+}
+
+func (x *Performance) GetDrawdownLow() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DrawdownLow
+	}
+	return nil
+}
+
+func (x *Performance) GetDayChange() float64 {
+	if x != nil {
+		return x.DayChange
+	}
+	return 0
+}
+
+func (x *Performance) GetDayChangePct() float64 {
+	if x != nil {
+		return x.DayChangePct
+	}
+	return 0
+}
+
+func (x *Performance) GetCurrentValue() float64 {
+	if x != nil {
+		return x.CurrentValue
+	}
+	return 0
+}
+
+func (x *Performance) GetSnapshotCount() int32 {
+	if x != nil {
+		return x.SnapshotCount
+	}
+	return 0
+}
+
+type ReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`   // contract-note, statement or tradebook
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`   // YYYY-MM-DD, contract notes
+	Month         string                 `protobuf:"bytes,3,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM, statements
+	From          string                 `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`   // YYYY-MM-DD, tradebook (optional)
+	To            string                 `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Format        string                 `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`                                     // csv (default) or pdf
+	FinancialYear int32                  `protobuf:"varint,7,opt,name=financial_year,json=financialYear,proto3" json:"financial_year,omitempty"` // capital-gains: start year, 0 = current
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportRequest) Reset() {
+	*x = ReportRequest{}
+	mi := &file_broker_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportRequest) ProtoMessage() {}
+
+func (x *ReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportRequest.ProtoReflect.Descriptor instead.
+func (*ReportRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ReportRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReportRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ReportRequest) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *ReportRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ReportRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ReportRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ReportRequest) GetFinancialYear() int32 {
+	if x != nil {
+		return x.FinancialYear
+	}
+	return 0
+}
+
+type ReportFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportFile) Reset() {
+	*x = ReportFile{}
+	mi := &file_broker_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportFile) ProtoMessage() {}
+
+func (x *ReportFile) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportFile.ProtoReflect.Descriptor instead.
+func (*ReportFile) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ReportFile) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ReportFile) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ReportFile) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CapitalGainsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FinancialYear int32                  `protobuf:"varint,1,opt,name=financial_year,json=financialYear,proto3" json:"financial_year,omitempty"` // year the financial year starts in, 0 = current
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CapitalGainsRequest) Reset() {
+	*x = CapitalGainsRequest{}
+	mi := &file_broker_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapitalGainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapitalGainsRequest) ProtoMessage() {}
+
+func (x *CapitalGainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapitalGainsRequest.ProtoReflect.Descriptor instead.
+func (*CapitalGainsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CapitalGainsRequest) GetFinancialYear() int32 {
+	if x != nil {
+		return x.FinancialYear
+	}
+	return 0
+}
+
+type InstrumentGains struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Cost          float64                `protobuf:"fixed64,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	Proceeds      float64                `protobuf:"fixed64,4,opt,name=proceeds,proto3" json:"proceeds,omitempty"`
+	ShortTermGain float64                `protobuf:"fixed64,5,opt,name=short_term_gain,json=shortTermGain,proto3" json:"short_term_gain,omitempty"`
+	LongTermGain  float64                `protobuf:"fixed64,6,opt,name=long_term_gain,json=longTermGain,proto3" json:"long_term_gain,omitempty"`
+	TotalGain     float64                `protobuf:"fixed64,7,opt,name=total_gain,json=totalGain,proto3" json:"total_gain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstrumentGains) Reset() {
+	*x = InstrumentGains{}
+	mi := &file_broker_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstrumentGains) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstrumentGains) ProtoMessage() {}
+
+func (x *InstrumentGains) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstrumentGains.ProtoReflect.Descriptor instead.
+func (*InstrumentGains) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *InstrumentGains) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *InstrumentGains) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InstrumentGains) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *InstrumentGains) GetProceeds() float64 {
+	if x != nil {
+		return x.Proceeds
+	}
+	return 0
+}
+
+func (x *InstrumentGains) GetShortTermGain() float64 {
+	if x != nil {
+		return x.ShortTermGain
+	}
+	return 0
+}
+
+func (x *InstrumentGains) GetLongTermGain() float64 {
+	if x != nil {
+		return x.LongTermGain
+	}
+	return 0
+}
+
+func (x *InstrumentGains) GetTotalGain() float64 {
+	if x != nil {
+		return x.TotalGain
+	}
+	return 0
+}
+
+type GainEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	LotId         string                 `protobuf:"bytes,2,opt,name=lot_id,json=lotId,proto3" json:"lot_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	AcquiredAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=acquired_at,json=acquiredAt,proto3" json:"acquired_at,omitempty"`
+	SoldAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=sold_at,json=soldAt,proto3" json:"sold_at,omitempty"`
+	HoldingDays   int32                  `protobuf:"varint,7,opt,name=holding_days,json=holdingDays,proto3" json:"holding_days,omitempty"`
+	CostPrice     float64                `protobuf:"fixed64,8,opt,name=cost_price,json=costPrice,proto3" json:"cost_price,omitempty"`
+	SalePrice     float64                `protobuf:"fixed64,9,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`
+	Cost          float64                `protobuf:"fixed64,10,opt,name=cost,proto3" json:"cost,omitempty"`
+	Proceeds      float64                `protobuf:"fixed64,11,opt,name=proceeds,proto3" json:"proceeds,omitempty"`
+	Gain          float64                `protobuf:"fixed64,12,opt,name=gain,proto3" json:"gain,omitempty"`
+	Term          string                 `protobuf:"bytes,13,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GainEntry) Reset() {
+	*x = GainEntry{}
+	mi := &file_broker_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GainEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GainEntry) ProtoMessage() {}
+
+func (x *GainEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GainEntry.ProtoReflect.Descriptor instead.
+func (*GainEntry) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GainEntry) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GainEntry) GetLotId() string {
+	if x != nil {
+		return x.LotId
+	}
+	return ""
+}
+
+func (x *GainEntry) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GainEntry) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *GainEntry) GetAcquiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcquiredAt
+	}
+	return nil
+}
+
+func (x *GainEntry) GetSoldAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SoldAt
+	}
+	return nil
+}
+
+func (x *GainEntry) GetHoldingDays() int32 {
+	if x != nil {
+		return x.HoldingDays
+	}
+	return 0
+}
+
+func (x *GainEntry) GetCostPrice() float64 {
+	if x != nil {
+		return x.CostPrice
+	}
+	return 0
+}
+
+func (x *GainEntry) GetSalePrice() float64 {
+	if x != nil {
+		return x.SalePrice
+	}
+	return 0
+}
+
+func (x *GainEntry) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *GainEntry) GetProceeds() float64 {
+	if x != nil {
+		return x.Proceeds
+	}
+	return 0
+}
+
+func (x *GainEntry) GetGain() float64 {
+	if x != nil {
+		return x.Gain
+	}
+	return 0
+}
+
+func (x *GainEntry) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+type CapitalGainsReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FinancialYear string                 `protobuf:"bytes,1,opt,name=financial_year,json=financialYear,proto3" json:"financial_year,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	ShortTermGain float64                `protobuf:"fixed64,4,opt,name=short_term_gain,json=shortTermGain,proto3" json:"short_term_gain,omitempty"`
+	LongTermGain  float64                `protobuf:"fixed64,5,opt,name=long_term_gain,json=longTermGain,proto3" json:"long_term_gain,omitempty"`
+	TotalGain     float64                `protobuf:"fixed64,6,opt,name=total_gain,json=totalGain,proto3" json:"total_gain,omitempty"`
+	Instruments   []*InstrumentGains     `protobuf:"bytes,7,rep,name=instruments,proto3" json:"instruments,omitempty"`
+	Entries       []*GainEntry           `protobuf:"bytes,8,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CapitalGainsReport) Reset() {
+	*x = CapitalGainsReport{}
+	mi := &file_broker_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapitalGainsReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapitalGainsReport) ProtoMessage() {}
+
+func (x *CapitalGainsReport) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapitalGainsReport.ProtoReflect.Descriptor instead.
+func (*CapitalGainsReport) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CapitalGainsReport) GetFinancialYear() string {
+	if x != nil {
+		return x.FinancialYear
+	}
+	return ""
+}
+
+func (x *CapitalGainsReport) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *CapitalGainsReport) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *CapitalGainsReport) GetShortTermGain() float64 {
+	if x != nil {
+		return x.ShortTermGain
+	}
+	return 0
+}
+
+func (x *CapitalGainsReport) GetLongTermGain() float64 {
+	if x != nil {
+		return x.LongTermGain
+	}
+	return 0
+}
+
+func (x *CapitalGainsReport) GetTotalGain() float64 {
+	if x != nil {
+		return x.TotalGain
+	}
+	return 0
+}
+
+func (x *CapitalGainsReport) GetInstruments() []*InstrumentGains {
+	if x != nil {
+		return x.Instruments
+	}
+	return nil
+}
+
+func (x *CapitalGainsReport) GetEntries() []*GainEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type CalculateChargesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Side          string                 `protobuf:"bytes,2,opt,name=side,proto3" json:"side,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`   // 0 uses the current mark
+	Product       string                 `protobuf:"bytes,5,opt,name=product,proto3" json:"product,omitempty"` // delivery (default) or intraday
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateChargesRequest) Reset() {
+	*x = CalculateChargesRequest{}
+	mi := &file_broker_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateChargesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateChargesRequest) ProtoMessage() {}
+
+func (x *CalculateChargesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateChargesRequest.ProtoReflect.Descriptor instead.
+func (*CalculateChargesRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CalculateChargesRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CalculateChargesRequest) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *CalculateChargesRequest) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CalculateChargesRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CalculateChargesRequest) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+type Charge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Charge) Reset() {
+	*x = Charge{}
+	mi := &file_broker_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Charge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Charge) ProtoMessage() {}
+
+func (x *Charge) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Charge.ProtoReflect.Descriptor instead.
+func (*Charge) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Charge) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Charge) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type ChargeEstimate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Segment       string                 `protobuf:"bytes,2,opt,name=segment,proto3" json:"segment,omitempty"`
+	Product       string                 `protobuf:"bytes,3,opt,name=product,proto3" json:"product,omitempty"`
+	Side          string                 `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	Value         float64                `protobuf:"fixed64,7,opt,name=value,proto3" json:"value,omitempty"`
+	Charges       []*Charge              `protobuf:"bytes,8,rep,name=charges,proto3" json:"charges,omitempty"`
+	Total         float64                `protobuf:"fixed64,9,opt,name=total,proto3" json:"total,omitempty"`
+	NetAmount     float64                `protobuf:"fixed64,10,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChargeEstimate) Reset() {
+	*x = ChargeEstimate{}
+	mi := &file_broker_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChargeEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChargeEstimate) ProtoMessage() {}
+
+func (x *ChargeEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChargeEstimate.ProtoReflect.Descriptor instead.
+func (*ChargeEstimate) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ChargeEstimate) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ChargeEstimate) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *ChargeEstimate) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *ChargeEstimate) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *ChargeEstimate) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ChargeEstimate) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ChargeEstimate) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ChargeEstimate) GetCharges() []*Charge {
+	if x != nil {
+		return x.Charges
+	}
+	return nil
+}
+
+func (x *ChargeEstimate) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ChargeEstimate) GetNetAmount() float64 {
+	if x != nil {
+		return x.NetAmount
+	}
+	return 0
+}
+
+func (x *ChargeEstimate) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type FXRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrency  string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	AsOf          string                 `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	Rates         map[string]float64     `protobuf:"bytes,3,rep,name=rates,proto3" json:"rates,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"` // base units per unit of each currency
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FXRatesResponse) Reset() {
+	*x = FXRatesResponse{}
+	mi := &file_broker_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FXRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FXRatesResponse) ProtoMessage() {}
+
+func (x *FXRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FXRatesResponse.ProtoReflect.Descriptor instead.
+func (*FXRatesResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *FXRatesResponse) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *FXRatesResponse) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+func (x *FXRatesResponse) GetRates() map[string]float64 {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type ConvertCurrencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"` // in from
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertCurrencyRequest) Reset() {
+	*x = ConvertCurrencyRequest{}
+	mi := &file_broker_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertCurrencyRequest) ProtoMessage() {}
+
+func (x *ConvertCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*ConvertCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ConvertCurrencyRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ConvertCurrencyRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ConvertCurrencyRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type FXConversion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Rate          float64                `protobuf:"fixed64,5,opt,name=rate,proto3" json:"rate,omitempty"`
+	Converted     float64                `protobuf:"fixed64,6,opt,name=converted,proto3" json:"converted,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FXConversion) Reset() {
+	*x = FXConversion{}
+	mi := &file_broker_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FXConversion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FXConversion) ProtoMessage() {}
+
+func (x *FXConversion) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FXConversion.ProtoReflect.Descriptor instead.
+func (*FXConversion) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *FXConversion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FXConversion) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *FXConversion) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *FXConversion) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FXConversion) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *FXConversion) GetConverted() float64 {
+	if x != nil {
+		return x.Converted
+	}
+	return 0
+}
+
+func (x *FXConversion) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type HouseAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Holdings      []*Holding             `protobuf:"bytes,2,rep,name=holdings,proto3" json:"holdings,omitempty"` // inventory left over from allocations
+	Pending       []*Order               `protobuf:"bytes,3,rep,name=pending,proto3" json:"pending,omitempty"`   // fractional orders waiting for the next batch
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HouseAccountResponse) Reset() {
+	*x = HouseAccountResponse{}
+	mi := &file_broker_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HouseAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseAccountResponse) ProtoMessage() {}
+
+func (x *HouseAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseAccountResponse.ProtoReflect.Descriptor instead.
+func (*HouseAccountResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *HouseAccountResponse) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *HouseAccountResponse) GetHoldings() []*Holding {
+	if x != nil {
+		return x.Holdings
+	}
+	return nil
+}
+
+func (x *HouseAccountResponse) GetPending() []*Order {
+	if x != nil {
+		return x.Pending
+	}
+	return nil
+}
+
+type Locate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	FeeRate       float64                `protobuf:"fixed64,3,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"` // annual %, on the short's market value
+	Borrowed      float64                `protobuf:"fixed64,4,opt,name=borrowed,proto3" json:"borrowed,omitempty"`
+	Available     float64                `protobuf:"fixed64,5,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Locate) Reset() {
+	*x = Locate{}
+	mi := &file_broker_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Locate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Locate) ProtoMessage() {}
+
+func (x *Locate) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Locate.ProtoReflect.Descriptor instead.
+func (*Locate) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *Locate) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Locate) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Locate) GetFeeRate() float64 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+func (x *Locate) GetBorrowed() float64 {
+	if x != nil {
+		return x.Borrowed
+	}
+	return 0
+}
+
+func (x *Locate) GetAvailable() float64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+type LocatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locates       []*Locate              `protobuf:"bytes,1,rep,name=locates,proto3" json:"locates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocatesResponse) Reset() {
+	*x = LocatesResponse{}
+	mi := &file_broker_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocatesResponse) ProtoMessage() {}
+
+func (x *LocatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocatesResponse.ProtoReflect.Descriptor instead.
+func (*LocatesResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *LocatesResponse) GetLocates() []*Locate {
+	if x != nil {
+		return x.Locates
+	}
+	return nil
+}
+
+type SetLocatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locates       []*Locate              `protobuf:"bytes,1,rep,name=locates,proto3" json:"locates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLocatesRequest) Reset() {
+	*x = SetLocatesRequest{}
+	mi := &file_broker_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLocatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLocatesRequest) ProtoMessage() {}
+
+func (x *SetLocatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLocatesRequest.ProtoReflect.Descriptor instead.
+func (*SetLocatesRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SetLocatesRequest) GetLocates() []*Locate {
+	if x != nil {
+		return x.Locates
+	}
+	return nil
+}
+
+type ShortPosition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	AvgPrice      float64                `protobuf:"fixed64,4,opt,name=avg_price,json=avgPrice,proto3" json:"avg_price,omitempty"`
+	LastPrice     float64                `protobuf:"fixed64,5,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+	UnrealizedPnl float64                `protobuf:"fixed64,6,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`
+	FeeRate       float64                `protobuf:"fixed64,7,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	AccruedFees   float64                `protobuf:"fixed64,8,opt,name=accrued_fees,json=accruedFees,proto3" json:"accrued_fees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortPosition) Reset() {
+	*x = ShortPosition{}
+	mi := &file_broker_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortPosition) ProtoMessage() {}
+
+func (x *ShortPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortPosition.ProtoReflect.Descriptor instead.
+func (*ShortPosition) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ShortPosition) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ShortPosition) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ShortPosition) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ShortPosition) GetAvgPrice() float64 {
+	if x != nil {
+		return x.AvgPrice
+	}
+	return 0
+}
+
+func (x *ShortPosition) GetLastPrice() float64 {
+	if x != nil {
+		return x.LastPrice
+	}
+	return 0
+}
+
+func (x *ShortPosition) GetUnrealizedPnl() float64 {
+	if x != nil {
+		return x.UnrealizedPnl
+	}
+	return 0
+}
+
+func (x *ShortPosition) GetFeeRate() float64 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+func (x *ShortPosition) GetAccruedFees() float64 {
+	if x != nil {
+		return x.AccruedFees
+	}
+	return 0
+}
+
+type ShortPositionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shorts        []*ShortPosition       `protobuf:"bytes,1,rep,name=shorts,proto3" json:"shorts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortPositionsResponse) Reset() {
+	*x = ShortPositionsResponse{}
+	mi := &file_broker_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortPositionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortPositionsResponse) ProtoMessage() {}
+
+func (x *ShortPositionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortPositionsResponse.ProtoReflect.Descriptor instead.
+func (*ShortPositionsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ShortPositionsResponse) GetShorts() []*ShortPosition {
+	if x != nil {
+		return x.Shorts
+	}
+	return nil
+}
+
+type SetMarginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMarginRequest) Reset() {
+	*x = SetMarginRequest{}
+	mi := &file_broker_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMarginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMarginRequest) ProtoMessage() {}
+
+func (x *SetMarginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMarginRequest.ProtoReflect.Descriptor instead.
+func (*SetMarginRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *SetMarginRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetMarginRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetMarginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Margin        bool                   `protobuf:"varint,2,opt,name=margin,proto3" json:"margin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMarginResponse) Reset() {
+	*x = SetMarginResponse{}
+	mi := &file_broker_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMarginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMarginResponse) ProtoMessage() {}
+
+func (x *SetMarginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMarginResponse.ProtoReflect.Descriptor instead.
+func (*SetMarginResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SetMarginResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetMarginResponse) GetMargin() bool {
+	if x != nil {
+		return x.Margin
+	}
+	return false
+}
+
+type BasketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Legs          []*PlaceOrderRequest   `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BasketRequest) Reset() {
+	*x = BasketRequest{}
+	mi := &file_broker_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BasketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BasketRequest) ProtoMessage() {}
+
+func (x *BasketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BasketRequest.ProtoReflect.Descriptor instead.
+func (*BasketRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *BasketRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BasketRequest) GetLegs() []*PlaceOrderRequest {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+type Basket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OrderIds      []string               `protobuf:"bytes,3,rep,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Orders        []*Order               `protobuf:"bytes,5,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Basket) Reset() {
+	*x = Basket{}
+	mi := &file_broker_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Basket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Basket) ProtoMessage() {}
+
+func (x *Basket) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Basket.ProtoReflect.Descriptor instead.
+func (*Basket) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *Basket) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Basket) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Basket) GetOrderIds() []string {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+func (x *Basket) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Basket) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type BasketLeg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BasketLeg) Reset() {
+	*x = BasketLeg{}
+	mi := &file_broker_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BasketLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BasketLeg) ProtoMessage() {}
+
+func (x *BasketLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BasketLeg.ProtoReflect.Descriptor instead.
+func (*BasketLeg) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *BasketLeg) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *BasketLeg) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BasketFunds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Buys          float64                `protobuf:"fixed64,2,opt,name=buys,proto3" json:"buys,omitempty"`   // estimated cost of buys, with charges
+	Sells         float64                `protobuf:"fixed64,3,opt,name=sells,proto3" json:"sells,omitempty"` // estimated proceeds of sells, net of charges
+	Required      float64                `protobuf:"fixed64,4,opt,name=required,proto3" json:"required,omitempty"`
+	Available     float64                `protobuf:"fixed64,5,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BasketFunds) Reset() {
+	*x = BasketFunds{}
+	mi := &file_broker_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BasketFunds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BasketFunds) ProtoMessage() {}
+
+func (x *BasketFunds) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BasketFunds.ProtoReflect.Descriptor instead.
+func (*BasketFunds) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *BasketFunds) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BasketFunds) GetBuys() float64 {
+	if x != nil {
+		return x.Buys
+	}
+	return 0
+}
+
+func (x *BasketFunds) GetSells() float64 {
+	if x != nil {
+		return x.Sells
+	}
+	return 0
+}
+
+func (x *BasketFunds) GetRequired() float64 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+func (x *BasketFunds) GetAvailable() float64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+type BasketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Basket        *Basket                `protobuf:"bytes,1,opt,name=basket,proto3" json:"basket,omitempty"` // unset when only validating
+	Legs          []*BasketLeg           `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs,omitempty"`
+	Funds         []*BasketFunds         `protobuf:"bytes,3,rep,name=funds,proto3" json:"funds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BasketResponse) Reset() {
+	*x = BasketResponse{}
+	mi := &file_broker_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BasketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BasketResponse) ProtoMessage() {}
+
+func (x *BasketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BasketResponse.ProtoReflect.Descriptor instead.
+func (*BasketResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *BasketResponse) GetBasket() *Basket {
+	if x != nil {
+		return x.Basket
+	}
+	return nil
+}
+
+func (x *BasketResponse) GetLegs() []*BasketLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *BasketResponse) GetFunds() []*BasketFunds {
+	if x != nil {
+		return x.Funds
+	}
+	return nil
+}
+
+type BasketsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Baskets       []*Basket              `protobuf:"bytes,1,rep,name=baskets,proto3" json:"baskets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BasketsResponse) Reset() {
+	*x = BasketsResponse{}
+	mi := &file_broker_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BasketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BasketsResponse) ProtoMessage() {}
+
+func (x *BasketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BasketsResponse.ProtoReflect.Descriptor instead.
+func (*BasketsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *BasketsResponse) GetBaskets() []*Basket {
+	if x != nil {
+		return x.Baskets
+	}
+	return nil
+}
+
+type GetBasketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBasketRequest) Reset() {
+	*x = GetBasketRequest{}
+	mi := &file_broker_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBasketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBasketRequest) ProtoMessage() {}
+
+func (x *GetBasketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBasketRequest.ProtoReflect.Descriptor instead.
+func (*GetBasketRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetBasketRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RebalanceTarget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Weight        float64                `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"` // 0 to 1 of holdings plus cash
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebalanceTarget) Reset() {
+	*x = RebalanceTarget{}
+	mi := &file_broker_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebalanceTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalanceTarget) ProtoMessage() {}
+
+func (x *RebalanceTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalanceTarget.ProtoReflect.Descriptor instead.
+func (*RebalanceTarget) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *RebalanceTarget) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *RebalanceTarget) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type RebalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Targets       []*RebalanceTarget     `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+	Execute       bool                   `protobuf:"varint,2,opt,name=execute,proto3" json:"execute,omitempty"` // place the orders as a basket
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebalanceRequest) Reset() {
+	*x = RebalanceRequest{}
+	mi := &file_broker_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalanceRequest) ProtoMessage() {}
+
+func (x *RebalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalanceRequest.ProtoReflect.Descriptor instead.
+func (*RebalanceRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *RebalanceRequest) GetTargets() []*RebalanceTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *RebalanceRequest) GetExecute() bool {
+	if x != nil {
+		return x.Execute
+	}
+	return false
+}
+
+type RebalanceLeg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	CurrentQty    float64                `protobuf:"fixed64,4,opt,name=current_qty,json=currentQty,proto3" json:"current_qty,omitempty"`
+	CurrentValue  float64                `protobuf:"fixed64,5,opt,name=current_value,json=currentValue,proto3" json:"current_value,omitempty"`
+	CurrentWeight float64                `protobuf:"fixed64,6,opt,name=current_weight,json=currentWeight,proto3" json:"current_weight,omitempty"`
+	TargetWeight  float64                `protobuf:"fixed64,7,opt,name=target_weight,json=targetWeight,proto3" json:"target_weight,omitempty"`
+	TargetValue   float64                `protobuf:"fixed64,8,opt,name=target_value,json=targetValue,proto3" json:"target_value,omitempty"`
+	TargetQty     float64                `protobuf:"fixed64,9,opt,name=target_qty,json=targetQty,proto3" json:"target_qty,omitempty"`
+	Side          string                 `protobuf:"bytes,10,opt,name=side,proto3" json:"side,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,11,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebalanceLeg) Reset() {
+	*x = RebalanceLeg{}
+	mi := &file_broker_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebalanceLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalanceLeg) ProtoMessage() {}
+
+func (x *RebalanceLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalanceLeg.ProtoReflect.Descriptor instead.
+func (*RebalanceLeg) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *RebalanceLeg) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *RebalanceLeg) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RebalanceLeg) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *RebalanceLeg) GetCurrentQty() float64 {
+	if x != nil {
+		return x.CurrentQty
+	}
+	return 0
+}
+
+func (x *RebalanceLeg) GetCurrentValue() float64 {
+	if x != nil {
+		return x.CurrentValue
+	}
+	return 0
+}
+
+func (x *RebalanceLeg) GetCurrentWeight() float64 {
+	if x != nil {
+		return x.CurrentWeight
+	}
+	return 0
+}
+
+func (x *RebalanceLeg) GetTargetWeight() float64 {
+	if x != nil {
+		return x.TargetWeight
+	}
+	return 0
+}
+
+func (x *RebalanceLeg) GetTargetValue() float64 {
+	if x != nil {
+		return x.TargetValue
+	}
+	return 0
+}
+
+func (x *RebalanceLeg) GetTargetQty() float64 {
+	if x != nil {
+		return x.TargetQty
+	}
+	return 0
+}
+
+func (x *RebalanceLeg) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *RebalanceLeg) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type RebalancePlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	TotalValue    float64                `protobuf:"fixed64,2,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	Cash          float64                `protobuf:"fixed64,3,opt,name=cash,proto3" json:"cash,omitempty"`
+	CashWeight    float64                `protobuf:"fixed64,4,opt,name=cash_weight,json=cashWeight,proto3" json:"cash_weight,omitempty"`
+	Legs          []*RebalanceLeg        `protobuf:"bytes,5,rep,name=legs,proto3" json:"legs,omitempty"`
+	Basket        *Basket                `protobuf:"bytes,6,opt,name=basket,proto3" json:"basket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebalancePlan) Reset() {
+	*x = RebalancePlan{}
+	mi := &file_broker_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebalancePlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalancePlan) ProtoMessage() {}
+
+func (x *RebalancePlan) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalancePlan.ProtoReflect.Descriptor instead.
+func (*RebalancePlan) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *RebalancePlan) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RebalancePlan) GetTotalValue() float64 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
+func (x *RebalancePlan) GetCash() float64 {
+	if x != nil {
+		return x.Cash
+	}
+	return 0
+}
+
+func (x *RebalancePlan) GetCashWeight() float64 {
+	if x != nil {
+		return x.CashWeight
+	}
+	return 0
+}
+
+func (x *RebalancePlan) GetLegs() []*RebalanceLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *RebalancePlan) GetBasket() *Basket {
+	if x != nil {
+		return x.Basket
+	}
+	return nil
+}
+
+type Algo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Strategy         string                 `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"` // twap or vwap
+	StartAt          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	SliceSeconds     int32                  `protobuf:"varint,4,opt,name=slice_seconds,json=sliceSeconds,proto3" json:"slice_seconds,omitempty"`
+	MaxParticipation float64                `protobuf:"fixed64,5,opt,name=max_participation,json=maxParticipation,proto3" json:"max_participation,omitempty"` // 0 to 1 of market volume per slice; 0 for none
+	Step             float64                `protobuf:"fixed64,6,opt,name=step,proto3" json:"step,omitempty"`
+	Schedule         []float64              `protobuf:"fixed64,7,rep,packed,name=schedule,proto3" json:"schedule,omitempty"` // cumulative share due by the end of each slice
+	Slice            int32                  `protobuf:"varint,8,opt,name=slice,proto3" json:"slice,omitempty"`               // slices sent so far
+	ChildId          string                 `protobuf:"bytes,9,opt,name=child_id,json=childId,proto3" json:"child_id,omitempty"`
+	Note             string                 `protobuf:"bytes,10,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Algo) Reset() {
+	*x = Algo{}
+	mi := &file_broker_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algo) ProtoMessage() {}
+
+func (x *Algo) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algo.ProtoReflect.Descriptor instead.
+func (*Algo) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *Algo) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *Algo) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *Algo) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *Algo) GetSliceSeconds() int32 {
+	if x != nil {
+		return x.SliceSeconds
+	}
+	return 0
+}
+
+func (x *Algo) GetMaxParticipation() float64 {
+	if x != nil {
+		return x.MaxParticipation
+	}
+	return 0
+}
+
+func (x *Algo) GetStep() float64 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *Algo) GetSchedule() []float64 {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *Algo) GetSlice() int32 {
+	if x != nil {
+		return x.Slice
+	}
+	return 0
+}
+
+func (x *Algo) GetChildId() string {
+	if x != nil {
+		return x.ChildId
+	}
+	return ""
+}
+
+func (x *Algo) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AlgoOrderRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Symbol           string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Side             string                 `protobuf:"bytes,2,opt,name=side,proto3" json:"side,omitempty"`
+	Quantity         float64                `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price            float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"` // limit for every child
+	Product          string                 `protobuf:"bytes,5,opt,name=product,proto3" json:"product,omitempty"`
+	LotMethod        string                 `protobuf:"bytes,6,opt,name=lot_method,json=lotMethod,proto3" json:"lot_method,omitempty"`
+	Short            bool                   `protobuf:"varint,7,opt,name=short,proto3" json:"short,omitempty"`
+	Strategy         string                 `protobuf:"bytes,8,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	StartAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	SliceSeconds     int32                  `protobuf:"varint,11,opt,name=slice_seconds,json=sliceSeconds,proto3" json:"slice_seconds,omitempty"`
+	MaxParticipation float64                `protobuf:"fixed64,12,opt,name=max_participation,json=maxParticipation,proto3" json:"max_participation,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AlgoOrderRequest) Reset() {
+	*x = AlgoOrderRequest{}
+	mi := &file_broker_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlgoOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlgoOrderRequest) ProtoMessage() {}
+
+func (x *AlgoOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlgoOrderRequest.ProtoReflect.Descriptor instead.
+func (*AlgoOrderRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *AlgoOrderRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *AlgoOrderRequest) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *AlgoOrderRequest) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AlgoOrderRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *AlgoOrderRequest) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *AlgoOrderRequest) GetLotMethod() string {
+	if x != nil {
+		return x.LotMethod
+	}
+	return ""
+}
+
+func (x *AlgoOrderRequest) GetShort() bool {
+	if x != nil {
+		return x.Short
+	}
+	return false
+}
+
+func (x *AlgoOrderRequest) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *AlgoOrderRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *AlgoOrderRequest) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *AlgoOrderRequest) GetSliceSeconds() int32 {
+	if x != nil {
+		return x.SliceSeconds
+	}
+	return 0
+}
+
+func (x *AlgoOrderRequest) GetMaxParticipation() float64 {
+	if x != nil {
+		return x.MaxParticipation
+	}
+	return 0
+}
+
+type AlgoOrderID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlgoOrderID) Reset() {
+	*x = AlgoOrderID{}
+	mi := &file_broker_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlgoOrderID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlgoOrderID) ProtoMessage() {}
+
+func (x *AlgoOrderID) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlgoOrderID.ProtoReflect.Descriptor instead.
+func (*AlgoOrderID) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *AlgoOrderID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AlgoOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parent        *Order                 `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	Children      []*Order               `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlgoOrderResponse) Reset() {
+	*x = AlgoOrderResponse{}
+	mi := &file_broker_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlgoOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlgoOrderResponse) ProtoMessage() {}
+
+func (x *AlgoOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlgoOrderResponse.ProtoReflect.Descriptor instead.
+func (*AlgoOrderResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *AlgoOrderResponse) GetParent() *Order {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *AlgoOrderResponse) GetChildren() []*Order {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type Greeks struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delta         float64                `protobuf:"fixed64,1,opt,name=delta,proto3" json:"delta,omitempty"`
+	Gamma         float64                `protobuf:"fixed64,2,opt,name=gamma,proto3" json:"gamma,omitempty"`
+	Theta         float64                `protobuf:"fixed64,3,opt,name=theta,proto3" json:"theta,omitempty"` // per calendar day
+	Vega          float64                `protobuf:"fixed64,4,opt,name=vega,proto3" json:"vega,omitempty"`   // per volatility point
+	Rho           float64                `protobuf:"fixed64,5,opt,name=rho,proto3" json:"rho,omitempty"`     // per rate point
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Greeks) Reset() {
+	*x = Greeks{}
+	mi := &file_broker_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Greeks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Greeks) ProtoMessage() {}
+
+func (x *Greeks) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Greeks.ProtoReflect.Descriptor instead.
+func (*Greeks) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *Greeks) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *Greeks) GetGamma() float64 {
+	if x != nil {
+		return x.Gamma
+	}
+	return 0
+}
+
+func (x *Greeks) GetTheta() float64 {
+	if x != nil {
+		return x.Theta
+	}
+	return 0
+}
+
+func (x *Greeks) GetVega() float64 {
+	if x != nil {
+		return x.Vega
+	}
+	return 0
+}
+
+func (x *Greeks) GetRho() float64 {
+	if x != nil {
+		return x.Rho
+	}
+	return 0
+}
+
+type OptionQuote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Bid           float64                `protobuf:"fixed64,2,opt,name=bid,proto3" json:"bid,omitempty"`
+	Ask           float64                `protobuf:"fixed64,3,opt,name=ask,proto3" json:"ask,omitempty"`
+	Mark          float64                `protobuf:"fixed64,4,opt,name=mark,proto3" json:"mark,omitempty"`
+	Intrinsic     float64                `protobuf:"fixed64,5,opt,name=intrinsic,proto3" json:"intrinsic,omitempty"`
+	Iv            float64                `protobuf:"fixed64,6,opt,name=iv,proto3" json:"iv,omitempty"` // annual, 0.25 for 25%
+	Greeks        *Greeks                `protobuf:"bytes,7,opt,name=greeks,proto3" json:"greeks,omitempty"`
+	Multiplier    float64                `protobuf:"fixed64,8,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptionQuote) Reset() {
+	*x = OptionQuote{}
+	mi := &file_broker_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionQuote) ProtoMessage() {}
+
+func (x *OptionQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionQuote.ProtoReflect.Descriptor instead.
+func (*OptionQuote) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *OptionQuote) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *OptionQuote) GetBid() float64 {
+	if x != nil {
+		return x.Bid
+	}
+	return 0
+}
+
+func (x *OptionQuote) GetAsk() float64 {
+	if x != nil {
+		return x.Ask
+	}
+	return 0
+}
+
+func (x *OptionQuote) GetMark() float64 {
+	if x != nil {
+		return x.Mark
+	}
+	return 0
+}
+
+func (x *OptionQuote) GetIntrinsic() float64 {
+	if x != nil {
+		return x.Intrinsic
+	}
+	return 0
+}
+
+func (x *OptionQuote) GetIv() float64 {
+	if x != nil {
+		return x.Iv
+	}
+	return 0
+}
+
+func (x *OptionQuote) GetGreeks() *Greeks {
+	if x != nil {
+		return x.Greeks
+	}
+	return nil
+}
+
+func (x *OptionQuote) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+type OptionStrike struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Strike        float64                `protobuf:"fixed64,1,opt,name=strike,proto3" json:"strike,omitempty"`
+	Call          *OptionQuote           `protobuf:"bytes,2,opt,name=call,proto3" json:"call,omitempty"`
+	Put           *OptionQuote           `protobuf:"bytes,3,opt,name=put,proto3" json:"put,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptionStrike) Reset() {
+	*x = OptionStrike{}
+	mi := &file_broker_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionStrike) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionStrike) ProtoMessage() {}
+
+func (x *OptionStrike) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionStrike.ProtoReflect.Descriptor instead.
+func (*OptionStrike) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *OptionStrike) GetStrike() float64 {
+	if x != nil {
+		return x.Strike
+	}
+	return 0
+}
+
+func (x *OptionStrike) GetCall() *OptionQuote {
+	if x != nil {
+		return x.Call
+	}
+	return nil
+}
+
+func (x *OptionStrike) GetPut() *OptionQuote {
+	if x != nil {
+		return x.Put
+	}
+	return nil
+}
+
+type GetOptionChainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Underlying    string                 `protobuf:"bytes,1,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	Expiry        string                 `protobuf:"bytes,2,opt,name=expiry,proto3" json:"expiry,omitempty"` // YYYY-MM-DD; the nearest when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOptionChainRequest) Reset() {
+	*x = GetOptionChainRequest{}
+	mi := &file_broker_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOptionChainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOptionChainRequest) ProtoMessage() {}
+
+func (x *GetOptionChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOptionChainRequest.ProtoReflect.Descriptor instead.
+func (*GetOptionChainRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetOptionChainRequest) GetUnderlying() string {
+	if x != nil {
+		return x.Underlying
+	}
+	return ""
+}
+
+func (x *GetOptionChainRequest) GetExpiry() string {
+	if x != nil {
+		return x.Expiry
+	}
+	return ""
+}
+
+type OptionChain struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Underlying    string                   `protobuf:"bytes,1,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	Expiry        *timestamppb.Timestamp   `protobuf:"bytes,2,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Expiries      []*timestamppb.Timestamp `protobuf:"bytes,3,rep,name=expiries,proto3" json:"expiries,omitempty"`
+	Spot          float64                  `protobuf:"fixed64,4,opt,name=spot,proto3" json:"spot,omitempty"`
+	Future        string                   `protobuf:"bytes,5,opt,name=future,proto3" json:"future,omitempty"`
+	FuturePrice   float64                  `protobuf:"fixed64,6,opt,name=future_price,json=futurePrice,proto3" json:"future_price,omitempty"`
+	YearsLeft     float64                  `protobuf:"fixed64,7,opt,name=years_left,json=yearsLeft,proto3" json:"years_left,omitempty"`
+	Rate          float64                  `protobuf:"fixed64,8,opt,name=rate,proto3" json:"rate,omitempty"`
+	Strikes       []*OptionStrike          `protobuf:"bytes,9,rep,name=strikes,proto3" json:"strikes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptionChain) Reset() {
+	*x = OptionChain{}
+	mi := &file_broker_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionChain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionChain) ProtoMessage() {}
+
+func (x *OptionChain) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionChain.ProtoReflect.Descriptor instead.
+func (*OptionChain) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *OptionChain) GetUnderlying() string {
+	if x != nil {
+		return x.Underlying
+	}
+	return ""
+}
+
+func (x *OptionChain) GetExpiry() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiry
+	}
+	return nil
+}
+
+func (x *OptionChain) GetExpiries() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiries
+	}
+	return nil
+}
+
+func (x *OptionChain) GetSpot() float64 {
+	if x != nil {
+		return x.Spot
+	}
+	return 0
+}
+
+func (x *OptionChain) GetFuture() string {
+	if x != nil {
+		return x.Future
+	}
+	return ""
+}
+
+func (x *OptionChain) GetFuturePrice() float64 {
+	if x != nil {
+		return x.FuturePrice
+	}
+	return 0
+}
+
+func (x *OptionChain) GetYearsLeft() float64 {
+	if x != nil {
+		return x.YearsLeft
+	}
+	return 0
+}
+
+func (x *OptionChain) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *OptionChain) GetStrikes() []*OptionStrike {
+	if x != nil {
+		return x.Strikes
+	}
+	return nil
+}
+
+type Fund struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Amc           string                 `protobuf:"bytes,3,opt,name=amc,proto3" json:"amc,omitempty"`
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Isin          string                 `protobuf:"bytes,5,opt,name=isin,proto3" json:"isin,omitempty"`
+	Exchange      string                 `protobuf:"bytes,6,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Currency      string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Cutoff        string                 `protobuf:"bytes,8,opt,name=cutoff,proto3" json:"cutoff,omitempty"` // HH:MM local; the session close when empty
+	MinPurchase   float64                `protobuf:"fixed64,9,opt,name=min_purchase,json=minPurchase,proto3" json:"min_purchase,omitempty"`
+	MinSip        float64                `protobuf:"fixed64,10,opt,name=min_sip,json=minSip,proto3" json:"min_sip,omitempty"`
+	Nav           float64                `protobuf:"fixed64,11,opt,name=nav,proto3" json:"nav,omitempty"` // latest
+	NavDate       string                 `protobuf:"bytes,12,opt,name=nav_date,json=navDate,proto3" json:"nav_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Fund) Reset() {
+	*x = Fund{}
+	mi := &file_broker_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fund) ProtoMessage() {}
+
+func (x *Fund) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fund.ProtoReflect.Descriptor instead.
+func (*Fund) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *Fund) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Fund) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Fund) GetAmc() string {
+	if x != nil {
+		return x.Amc
+	}
+	return ""
+}
+
+func (x *Fund) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Fund) GetIsin() string {
+	if x != nil {
+		return x.Isin
+	}
+	return ""
+}
+
+func (x *Fund) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *Fund) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Fund) GetCutoff() string {
+	if x != nil {
+		return x.Cutoff
+	}
+	return ""
+}
+
+func (x *Fund) GetMinPurchase() float64 {
+	if x != nil {
+		return x.MinPurchase
+	}
+	return 0
+}
+
+func (x *Fund) GetMinSip() float64 {
+	if x != nil {
+		return x.MinSip
+	}
+	return 0
+}
+
+func (x *Fund) GetNav() float64 {
+	if x != nil {
+		return x.Nav
+	}
+	return 0
+}
+
+func (x *Fund) GetNavDate() string {
+	if x != nil {
+		return x.NavDate
+	}
+	return ""
+}
+
+type FundsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Funds         []*Fund                `protobuf:"bytes,1,rep,name=funds,proto3" json:"funds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FundsResponse) Reset() {
+	*x = FundsResponse{}
+	mi := &file_broker_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundsResponse) ProtoMessage() {}
+
+func (x *FundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundsResponse.ProtoReflect.Descriptor instead.
+func (*FundsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *FundsResponse) GetFunds() []*Fund {
+	if x != nil {
+		return x.Funds
+	}
+	return nil
+}
+
+type Nav struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	Nav           float64                `protobuf:"fixed64,3,opt,name=nav,proto3" json:"nav,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Nav) Reset() {
+	*x = Nav{}
+	mi := &file_broker_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Nav) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nav) ProtoMessage() {}
+
+func (x *Nav) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nav.ProtoReflect.Descriptor instead.
+func (*Nav) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *Nav) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Nav) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Nav) GetNav() float64 {
+	if x != nil {
+		return x.Nav
+	}
+	return 0
+}
+
+type GetFundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFundRequest) Reset() {
+	*x = GetFundRequest{}
+	mi := &file_broker_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFundRequest) ProtoMessage() {}
+
+func (x *GetFundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFundRequest.ProtoReflect.Descriptor instead.
+func (*GetFundRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetFundRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type FundResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fund          *Fund                  `protobuf:"bytes,1,opt,name=fund,proto3" json:"fund,omitempty"`
+	Navs          []*Nav                 `protobuf:"bytes,2,rep,name=navs,proto3" json:"navs,omitempty"` // newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FundResponse) Reset() {
+	*x = FundResponse{}
+	mi := &file_broker_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundResponse) ProtoMessage() {}
+
+func (x *FundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundResponse.ProtoReflect.Descriptor instead.
+func (*FundResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *FundResponse) GetFund() *Fund {
+	if x != nil {
+		return x.Fund
+	}
+	return nil
+}
+
+func (x *FundResponse) GetNavs() []*Nav {
+	if x != nil {
+		return x.Navs
+	}
+	return nil
+}
+
+type PostNavsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Navs          []*Nav                 `protobuf:"bytes,1,rep,name=navs,proto3" json:"navs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostNavsRequest) Reset() {
+	*x = PostNavsRequest{}
+	mi := &file_broker_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostNavsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostNavsRequest) ProtoMessage() {}
+
+func (x *PostNavsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostNavsRequest.ProtoReflect.Descriptor instead.
+func (*PostNavsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *PostNavsRequest) GetNavs() []*Nav {
+	if x != nil {
+		return x.Navs
+	}
+	return nil
+}
+
+type PostNavsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stored        int32                  `protobuf:"varint,1,opt,name=stored,proto3" json:"stored,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostNavsResponse) Reset() {
+	*x = PostNavsResponse{}
+	mi := &file_broker_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostNavsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostNavsResponse) ProtoMessage() {}
+
+func (x *PostNavsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostNavsResponse.ProtoReflect.Descriptor instead.
+func (*PostNavsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *PostNavsResponse) GetStored() int32 {
+	if x != nil {
+		return x.Stored
+	}
+	return 0
+}
+
+type FundOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Side          string                 `protobuf:"bytes,2,opt,name=side,proto3" json:"side,omitempty"`       // purchase or redeem
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"` // purchases
+	Units         float64                `protobuf:"fixed64,4,opt,name=units,proto3" json:"units,omitempty"`   // redemptions
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FundOrderRequest) Reset() {
+	*x = FundOrderRequest{}
+	mi := &file_broker_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FundOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundOrderRequest) ProtoMessage() {}
+
+func (x *FundOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundOrderRequest.ProtoReflect.Descriptor instead.
+func (*FundOrderRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *FundOrderRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *FundOrderRequest) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *FundOrderRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FundOrderRequest) GetUnits() float64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+type FundOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Side          string                 `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Units         float64                `protobuf:"fixed64,5,opt,name=units,proto3" json:"units,omitempty"`
+	Nav           float64                `protobuf:"fixed64,6,opt,name=nav,proto3" json:"nav,omitempty"`
+	Currency      string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	TradeDate     string                 `protobuf:"bytes,8,opt,name=trade_date,json=tradeDate,proto3" json:"trade_date,omitempty"`
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	SipId         string                 `protobuf:"bytes,10,opt,name=sip_id,json=sipId,proto3" json:"sip_id,omitempty"`
+	Note          string                 `protobuf:"bytes,11,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ProcessedAt   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=processed_at,json=processedAt,proto3" json:"processed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FundOrder) Reset() {
+	*x = FundOrder{}
+	mi := &file_broker_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FundOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundOrder) ProtoMessage() {}
+
+func (x *FundOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundOrder.ProtoReflect.Descriptor instead.
+func (*FundOrder) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *FundOrder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FundOrder) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *FundOrder) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *FundOrder) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FundOrder) GetUnits() float64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *FundOrder) GetNav() float64 {
+	if x != nil {
+		return x.Nav
+	}
+	return 0
+}
+
+func (x *FundOrder) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *FundOrder) GetTradeDate() string {
+	if x != nil {
+		return x.TradeDate
+	}
+	return ""
+}
+
+func (x *FundOrder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FundOrder) GetSipId() string {
+	if x != nil {
+		return x.SipId
+	}
+	return ""
+}
+
+func (x *FundOrder) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *FundOrder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FundOrder) GetProcessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProcessedAt
+	}
+	return nil
+}
+
+type FundOrderID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FundOrderID) Reset() {
+	*x = FundOrderID{}
+	mi := &file_broker_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FundOrderID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundOrderID) ProtoMessage() {}
+
+func (x *FundOrderID) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundOrderID.ProtoReflect.Descriptor instead.
+func (*FundOrderID) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *FundOrderID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type FundOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*FundOrder           `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FundOrdersResponse) Reset() {
+	*x = FundOrdersResponse{}
+	mi := &file_broker_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FundOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundOrdersResponse) ProtoMessage() {}
+
+func (x *FundOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundOrdersResponse.ProtoReflect.Descriptor instead.
+func (*FundOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *FundOrdersResponse) GetOrders() []*FundOrder {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type SipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Day           int32                  `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"` // of the month, 1 to 28
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SipRequest) Reset() {
+	*x = SipRequest{}
+	mi := &file_broker_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SipRequest) ProtoMessage() {}
+
+func (x *SipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SipRequest.ProtoReflect.Descriptor instead.
+func (*SipRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *SipRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *SipRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SipRequest) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+type Sip struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Day           int32                  `protobuf:"varint,4,opt,name=day,proto3" json:"day,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	NextDate      string                 `protobuf:"bytes,6,opt,name=next_date,json=nextDate,proto3" json:"next_date,omitempty"`
+	Installments  int32                  `protobuf:"varint,7,opt,name=installments,proto3" json:"installments,omitempty"`
+	Missed        int32                  `protobuf:"varint,8,opt,name=missed,proto3" json:"missed,omitempty"`
+	LastOrderId   string                 `protobuf:"bytes,9,opt,name=last_order_id,json=lastOrderId,proto3" json:"last_order_id,omitempty"`
+	LastError     string                 `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sip) Reset() {
+	*x = Sip{}
+	mi := &file_broker_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sip) ProtoMessage() {}
+
+func (x *Sip) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sip.ProtoReflect.Descriptor instead.
+func (*Sip) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *Sip) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Sip) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Sip) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Sip) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *Sip) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Sip) GetNextDate() string {
+	if x != nil {
+		return x.NextDate
+	}
+	return ""
+}
+
+func (x *Sip) GetInstallments() int32 {
+	if x != nil {
+		return x.Installments
+	}
+	return 0
+}
+
+func (x *Sip) GetMissed() int32 {
+	if x != nil {
+		return x.Missed
+	}
+	return 0
+}
+
+func (x *Sip) GetLastOrderId() string {
+	if x != nil {
+		return x.LastOrderId
+	}
+	return ""
+}
+
+func (x *Sip) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Sip) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SipID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SipID) Reset() {
+	*x = SipID{}
+	mi := &file_broker_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SipID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SipID) ProtoMessage() {}
+
+func (x *SipID) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SipID.ProtoReflect.Descriptor instead.
+func (*SipID) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *SipID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SipsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sips          []*Sip                 `protobuf:"bytes,1,rep,name=sips,proto3" json:"sips,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SipsResponse) Reset() {
+	*x = SipsResponse{}
+	mi := &file_broker_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SipsResponse) ProtoMessage() {}
+
+func (x *SipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SipsResponse.ProtoReflect.Descriptor instead.
+func (*SipsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *SipsResponse) GetSips() []*Sip {
+	if x != nil {
+		return x.Sips
+	}
+	return nil
+}
+
+type StrategyLimits struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MaxOrdersPerMinute int32                  `protobuf:"varint,1,opt,name=max_orders_per_minute,json=maxOrdersPerMinute,proto3" json:"max_orders_per_minute,omitempty"` // server default when zero
+	MaxOpenOrders      int32                  `protobuf:"varint,2,opt,name=max_open_orders,json=maxOpenOrders,proto3" json:"max_open_orders,omitempty"`                  // server default when zero
+	MaxOrderValue      float64                `protobuf:"fixed64,3,opt,name=max_order_value,json=maxOrderValue,proto3" json:"max_order_value,omitempty"`
+	MaxPosition        float64                `protobuf:"fixed64,4,opt,name=max_position,json=maxPosition,proto3" json:"max_position,omitempty"`
+	MaxLoss            float64                `protobuf:"fixed64,5,opt,name=max_loss,json=maxLoss,proto3" json:"max_loss,omitempty"`
+	CallbackMs         int32                  `protobuf:"varint,6,opt,name=callback_ms,json=callbackMs,proto3" json:"callback_ms,omitempty"` // server default when zero
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *StrategyLimits) Reset() {
+	*x = StrategyLimits{}
+	mi := &file_broker_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrategyLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrategyLimits) ProtoMessage() {}
+
+func (x *StrategyLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrategyLimits.ProtoReflect.Descriptor instead.
+func (*StrategyLimits) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *StrategyLimits) GetMaxOrdersPerMinute() int32 {
+	if x != nil {
+		return x.MaxOrdersPerMinute
+	}
+	return 0
+}
+
+func (x *StrategyLimits) GetMaxOpenOrders() int32 {
+	if x != nil {
+		return x.MaxOpenOrders
+	}
+	return 0
+}
+
+func (x *StrategyLimits) GetMaxOrderValue() float64 {
+	if x != nil {
+		return x.MaxOrderValue
+	}
+	return 0
+}
+
+func (x *StrategyLimits) GetMaxPosition() float64 {
+	if x != nil {
+		return x.MaxPosition
+	}
+	return 0
+}
+
+func (x *StrategyLimits) GetMaxLoss() float64 {
+	if x != nil {
+		return x.MaxLoss
+	}
+	return 0
+}
+
+func (x *StrategyLimits) GetCallbackMs() int32 {
+	if x != nil {
+		return x.CallbackMs
+	}
+	return 0
+}
+
+type StrategyPosition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	AvgPrice      float64                `protobuf:"fixed64,3,opt,name=avg_price,json=avgPrice,proto3" json:"avg_price,omitempty"`
+	LastPrice     float64                `protobuf:"fixed64,4,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+	RealizedPnl   float64                `protobuf:"fixed64,5,opt,name=realized_pnl,json=realizedPnl,proto3" json:"realized_pnl,omitempty"`
+	UnrealizedPnl float64                `protobuf:"fixed64,6,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrategyPosition) Reset() {
+	*x = StrategyPosition{}
+	mi := &file_broker_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrategyPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrategyPosition) ProtoMessage() {}
+
+func (x *StrategyPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrategyPosition.ProtoReflect.Descriptor instead.
+func (*StrategyPosition) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *StrategyPosition) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *StrategyPosition) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StrategyPosition) GetAvgPrice() float64 {
+	if x != nil {
+		return x.AvgPrice
+	}
+	return 0
+}
+
+func (x *StrategyPosition) GetLastPrice() float64 {
+	if x != nil {
+		return x.LastPrice
+	}
+	return 0
+}
+
+func (x *StrategyPosition) GetRealizedPnl() float64 {
+	if x != nil {
+		return x.RealizedPnl
+	}
+	return 0
+}
+
+func (x *StrategyPosition) GetUnrealizedPnl() float64 {
+	if x != nil {
+		return x.UnrealizedPnl
+	}
+	return 0
+}
+
+type Strategy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Params        map[string]string      `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Symbols       []string               `protobuf:"bytes,4,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	Limits        *StrategyLimits        `protobuf:"bytes,5,opt,name=limits,proto3" json:"limits,omitempty"`
+	TimerSeconds  int32                  `protobuf:"varint,6,opt,name=timer_seconds,json=timerSeconds,proto3" json:"timer_seconds,omitempty"`
+	Seed          int64                  `protobuf:"varint,7,opt,name=seed,proto3" json:"seed,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	Orders        int32                  `protobuf:"varint,10,opt,name=orders,proto3" json:"orders,omitempty"`
+	Rejected      int32                  `protobuf:"varint,11,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	Fills         int32                  `protobuf:"varint,12,opt,name=fills,proto3" json:"fills,omitempty"`
+	Volume        float64                `protobuf:"fixed64,13,opt,name=volume,proto3" json:"volume,omitempty"`
+	Positions     []*StrategyPosition    `protobuf:"bytes,14,rep,name=positions,proto3" json:"positions,omitempty"`
+	RealizedPnl   float64                `protobuf:"fixed64,15,opt,name=realized_pnl,json=realizedPnl,proto3" json:"realized_pnl,omitempty"`
+	UnrealizedPnl float64                `protobuf:"fixed64,16,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`
+	Pnl           float64                `protobuf:"fixed64,17,opt,name=pnl,proto3" json:"pnl,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	StoppedAt     *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=stopped_at,json=stoppedAt,proto3" json:"stopped_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Strategy) Reset() {
+	*x = Strategy{}
+	mi := &file_broker_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Strategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Strategy) ProtoMessage() {}
+
+func (x *Strategy) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Strategy.ProtoReflect.Descriptor instead.
+func (*Strategy) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *Strategy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Strategy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Strategy) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *Strategy) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *Strategy) GetLimits() *StrategyLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *Strategy) GetTimerSeconds() int32 {
+	if x != nil {
+		return x.TimerSeconds
+	}
+	return 0
+}
+
+func (x *Strategy) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *Strategy) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Strategy) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Strategy) GetOrders() int32 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+func (x *Strategy) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *Strategy) GetFills() int32 {
+	if x != nil {
+		return x.Fills
+	}
+	return 0
+}
+
+func (x *Strategy) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *Strategy) GetPositions() []*StrategyPosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *Strategy) GetRealizedPnl() float64 {
+	if x != nil {
+		return x.RealizedPnl
+	}
+	return 0
+}
+
+func (x *Strategy) GetUnrealizedPnl() float64 {
+	if x != nil {
+		return x.UnrealizedPnl
+	}
+	return 0
+}
+
+func (x *Strategy) GetPnl() float64 {
+	if x != nil {
+		return x.Pnl
+	}
+	return 0
+}
+
+func (x *Strategy) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Strategy) GetStoppedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StoppedAt
+	}
+	return nil
+}
+
+type StartStrategyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Params        map[string]string      `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Symbols       []string               `protobuf:"bytes,3,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	Limits        *StrategyLimits        `protobuf:"bytes,4,opt,name=limits,proto3" json:"limits,omitempty"`
+	TimerSeconds  int32                  `protobuf:"varint,5,opt,name=timer_seconds,json=timerSeconds,proto3" json:"timer_seconds,omitempty"`
+	Seed          int64                  `protobuf:"varint,6,opt,name=seed,proto3" json:"seed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartStrategyRequest) Reset() {
+	*x = StartStrategyRequest{}
+	mi := &file_broker_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartStrategyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartStrategyRequest) ProtoMessage() {}
+
+func (x *StartStrategyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartStrategyRequest.ProtoReflect.Descriptor instead.
+func (*StartStrategyRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *StartStrategyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartStrategyRequest) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *StartStrategyRequest) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *StartStrategyRequest) GetLimits() *StrategyLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *StartStrategyRequest) GetTimerSeconds() int32 {
+	if x != nil {
+		return x.TimerSeconds
+	}
+	return 0
+}
+
+func (x *StartStrategyRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type StrategyID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrategyID) Reset() {
+	*x = StrategyID{}
+	mi := &file_broker_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrategyID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrategyID) ProtoMessage() {}
+
+func (x *StrategyID) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrategyID.ProtoReflect.Descriptor instead.
+func (*StrategyID) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *StrategyID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StrategiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Strategies    []*Strategy            `protobuf:"bytes,1,rep,name=strategies,proto3" json:"strategies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrategiesResponse) Reset() {
+	*x = StrategiesResponse{}
+	mi := &file_broker_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrategiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrategiesResponse) ProtoMessage() {}
+
+func (x *StrategiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrategiesResponse.ProtoReflect.Descriptor instead.
+func (*StrategiesResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *StrategiesResponse) GetStrategies() []*Strategy {
+	if x != nil {
+		return x.Strategies
+	}
+	return nil
+}
+
+type StrategyTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Strategies    []string               `protobuf:"bytes,1,rep,name=strategies,proto3" json:"strategies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrategyTypesResponse) Reset() {
+	*x = StrategyTypesResponse{}
+	mi := &file_broker_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrategyTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrategyTypesResponse) ProtoMessage() {}
+
+func (x *StrategyTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrategyTypesResponse.ProtoReflect.Descriptor instead.
+func (*StrategyTypesResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *StrategyTypesResponse) GetStrategies() []string {
+	if x != nil {
+		return x.Strategies
+	}
+	return nil
+}
+
+type KillAllStrategiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Killed        int32                  `protobuf:"varint,1,opt,name=killed,proto3" json:"killed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KillAllStrategiesResponse) Reset() {
+	*x = KillAllStrategiesResponse{}
+	mi := &file_broker_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KillAllStrategiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillAllStrategiesResponse) ProtoMessage() {}
+
+func (x *KillAllStrategiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillAllStrategiesResponse.ProtoReflect.Descriptor instead.
+func (*KillAllStrategiesResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *KillAllStrategiesResponse) GetKilled() int32 {
+	if x != nil {
+		return x.Killed
+	}
+	return 0
+}
+
+type Account struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"` // live or paper
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	StartingCash  float64                `protobuf:"fixed64,3,opt,name=starting_cash,json=startingCash,proto3" json:"starting_cash,omitempty"` // paper: of the last opening or reset
+	Resets        int32                  `protobuf:"varint,4,opt,name=resets,proto3" json:"resets,omitempty"`
+	Cash          float64                `protobuf:"fixed64,5,opt,name=cash,proto3" json:"cash,omitempty"`
+	OpenedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	ResetAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=reset_at,json=resetAt,proto3" json:"reset_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_broker_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *Account) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Account) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Account) GetStartingCash() float64 {
+	if x != nil {
+		return x.StartingCash
+	}
+	return 0
+}
+
+func (x *Account) GetResets() int32 {
+	if x != nil {
+		return x.Resets
+	}
+	return 0
+}
+
+func (x *Account) GetCash() float64 {
+	if x != nil {
+		return x.Cash
+	}
+	return 0
+}
+
+func (x *Account) GetOpenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return nil
+}
+
+func (x *Account) GetResetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResetAt
+	}
+	return nil
+}
+
+type ResetBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        float64                `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"` // the starting cash when zero
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetBalanceRequest) Reset() {
+	*x = ResetBalanceRequest{}
+	mi := &file_broker_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetBalanceRequest) ProtoMessage() {}
+
+func (x *ResetBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetBalanceRequest.ProtoReflect.Descriptor instead.
+func (*ResetBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ResetBalanceRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+var File_broker_proto protoreflect.FileDescriptor
+
+const file_broker_proto_rawDesc = "" +
+	"\n" +
+	"\fbroker.proto\x12\x06broker\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\a\n" +
+	"\x05Empty\"A\n" +
+	"\rSignupRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"T\n" +
+	"\fLoginRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\"5\n" +
+	"\x0eRefreshRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"V\n" +
+	"\fAuthResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"\x9c\x04\n" +
+	"\aHolding\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\x12\x1b\n" +
+	"\tavg_price\x18\x03 \x01(\x01R\bavgPrice\x12\x1d\n" +
+	"\n" +
+	"last_price\x18\x04 \x01(\x01R\tlastPrice\x12%\n" +
+	"\x0eunrealized_pnl\x18\x05 \x01(\x01R\runrealizedPnl\x12$\n" +
+	"\x0eshort_term_qty\x18\x06 \x01(\x01R\fshortTermQty\x12\"\n" +
+	"\rlong_term_qty\x18\a \x01(\x01R\vlongTermQty\x12\x1f\n" +
+	"\vsettled_qty\x18\b \x01(\x01R\n" +
+	"settledQty\x12#\n" +
+	"\runsettled_qty\x18\t \x01(\x01R\funsettledQty\x12\x1a\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05value\x18\v \x01(\x01R\x05value\x12\x17\n" +
+	"\afx_rate\x18\f \x01(\x01R\x06fxRate\x12\x1d\n" +
+	"\n" +
+	"base_value\x18\r \x01(\x01R\tbaseValue\x12.\n" +
+	"\x13base_unrealized_pnl\x18\x0e \x01(\x01R\x11baseUnrealizedPnl\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x0f \x01(\x01R\n" +
+	"multiplier\x12\x1c\n" +
+	"\tcontracts\x18\x10 \x01(\x01R\tcontracts\x12\x12\n" +
+	"\x04fund\x18\x11 \x01(\bR\x04fund\"?\n" +
+	"\x10HoldingsResponse\x12+\n" +
+	"\bholdings\x18\x01 \x03(\v2\x0f.broker.HoldingR\bholdings\"\xa0\a\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04side\x18\x03 \x01(\tR\x04side\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x01R\bquantity\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12!\n" +
+	"\frealized_pnl\x18\x06 \x01(\x01R\vrealizedPnl\x12%\n" +
+	"\x0eunrealized_pnl\x18\a \x01(\x01R\runrealizedPnl\x12\x12\n" +
+	"\x04type\x18\b \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"filled_qty\x18\n" +
+	" \x01(\x01R\tfilledQty\x12$\n" +
+	"\x0eavg_fill_price\x18\v \x01(\x01R\favgFillPrice\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1a\n" +
+	"\bvalidity\x18\r \x01(\tR\bvalidity\x12!\n" +
+	"\fafter_market\x18\x0e \x01(\bR\vafterMarket\x129\n" +
+	"\n" +
+	"expires_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"lot_method\x18\x10 \x01(\tR\tlotMethod\x12\x17\n" +
+	"\alot_ids\x18\x11 \x03(\tR\x06lotIds\x12\x18\n" +
+	"\aproduct\x18\x12 \x01(\tR\aproduct\x12\x1a\n" +
+	"\bcurrency\x18\x13 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bnotional\x18\x14 \x01(\x01R\bnotional\x12\x1e\n" +
+	"\n" +
+	"fractional\x18\x15 \x01(\bR\n" +
+	"fractional\x12$\n" +
+	"\x0ehouse_order_id\x18\x16 \x01(\tR\fhouseOrderId\x12\x14\n" +
+	"\x05short\x18\x17 \x01(\bR\x05short\x12\x15\n" +
+	"\x06buy_in\x18\x18 \x01(\bR\x05buyIn\x12\x1b\n" +
+	"\tbasket_id\x18\x19 \x01(\tR\bbasketId\x12 \n" +
+	"\x04algo\x18\x1a \x01(\v2\f.broker.AlgoR\x04algo\x12\x1b\n" +
+	"\tparent_id\x18\x1b \x01(\tR\bparentId\x12\x1f\n" +
+	"\vdisplay_qty\x18\x1c \x01(\x01R\n" +
+	"displayQty\x12\x1e\n" +
+	"\n" +
+	"settlement\x18\x1d \x01(\bR\n" +
+	"settlement\x12\x1f\n" +
+	"\vstrategy_id\x18\x1e \x01(\tR\n" +
+	"strategyId\"\xec\x01\n" +
+	"\aPnlCard\x12!\n" +
+	"\frealized_pnl\x18\x01 \x01(\x01R\vrealizedPnl\x12%\n" +
+	"\x0eunrealized_pnl\x18\x02 \x01(\x01R\runrealizedPnl\x12$\n" +
+	"\x0eshort_term_pnl\x18\x03 \x01(\x01R\fshortTermPnl\x12\"\n" +
+	"\rlong_term_pnl\x18\x04 \x01(\x01R\vlongTermPnl\x12\x18\n" +
+	"\acharges\x18\x05 \x01(\x01R\acharges\x12\x17\n" +
+	"\anet_pnl\x18\x06 \x01(\x01R\x06netPnl\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\"_\n" +
+	"\x11OrderbookResponse\x12%\n" +
+	"\x06orders\x18\x01 \x03(\v2\r.broker.OrderR\x06orders\x12#\n" +
+	"\x04card\x18\x02 \x01(\v2\x0f.broker.PnlCardR\x04card\"\xfa\x02\n" +
+	"\bPosition\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
 	"\bquantity\x18\x02 \x01(\x01R\bquantity\x12\x1b\n" +
 	"\tavg_price\x18\x03 \x01(\x01R\bavgPrice\x12\x10\n" +
-	"\x03pnl\x18\x04 \x01(\x01R\x03pnl\"C\n" +
+	"\x03pnl\x18\x04 \x01(\x01R\x03pnl\x12\x17\n" +
+	"\abuy_qty\x18\x05 \x01(\x01R\x06buyQty\x12\x19\n" +
+	"\bsell_qty\x18\x06 \x01(\x01R\asellQty\x12!\n" +
+	"\frealized_pnl\x18\a \x01(\x01R\vrealizedPnl\x12%\n" +
+	"\x0eunrealized_pnl\x18\b \x01(\x01R\runrealizedPnl\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vcarried_qty\x18\n" +
+	" \x01(\x01R\n" +
+	"carriedQty\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\v \x01(\x01R\n" +
+	"multiplier\x12\x1c\n" +
+	"\tcontracts\x18\f \x01(\x01R\tcontracts\x12\x12\n" +
+	"\x04fund\x18\r \x01(\bR\x04fund\"h\n" +
 	"\x11PositionsResponse\x12.\n" +
-	"\tpositions\x18\x01 \x03(\v2\x10.broker.PositionR\tpositions2\xc9\x03\n" +
+	"\tpositions\x18\x01 \x03(\v2\x10.broker.PositionR\tpositions\x12#\n" +
+	"\x04card\x18\x02 \x01(\v2\x0f.broker.PnlCardR\x04card\"\xc4\x04\n" +
+	"\n" +
+	"Instrument\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bexchange\x18\x03 \x01(\tR\bexchange\x12\x12\n" +
+	"\x04isin\x18\x04 \x01(\tR\x04isin\x12\x1f\n" +
+	"\vasset_class\x18\x05 \x01(\tR\n" +
+	"assetClass\x12\x1b\n" +
+	"\ttick_size\x18\x06 \x01(\x01R\btickSize\x12\x19\n" +
+	"\blot_size\x18\a \x01(\x01R\alotSize\x12\x1d\n" +
+	"\n" +
+	"prev_close\x18\b \x01(\x01R\tprevClose\x12\x1d\n" +
+	"\n" +
+	"lower_band\x18\t \x01(\x01R\tlowerBand\x12\x1d\n" +
+	"\n" +
+	"upper_band\x18\n" +
+	" \x01(\x01R\tupperBand\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x1a\n" +
+	"\bcurrency\x18\f \x01(\tR\bcurrency\x12\x1e\n" +
+	"\n" +
+	"fractional\x18\r \x01(\bR\n" +
+	"fractional\x12#\n" +
+	"\rmin_increment\x18\x0e \x01(\x01R\fminIncrement\x12\x1e\n" +
+	"\n" +
+	"underlying\x18\x0f \x01(\tR\n" +
+	"underlying\x122\n" +
+	"\x06expiry\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\x06expiry\x12\x16\n" +
+	"\x06strike\x18\x11 \x01(\x01R\x06strike\x12\x1f\n" +
+	"\voption_type\x18\x12 \x01(\tR\n" +
+	"optionType\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x13 \x01(\x01R\n" +
+	"multiplier\"U\n" +
+	"\x16ListInstrumentsRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1f\n" +
+	"\vasset_class\x18\x02 \x01(\tR\n" +
+	"assetClass\".\n" +
+	"\x14GetInstrumentRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\"F\n" +
+	"\x18SearchInstrumentsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"K\n" +
+	"\x13InstrumentsResponse\x124\n" +
+	"\vinstruments\x18\x01 \x03(\v2\x12.broker.InstrumentR\vinstruments\"\xd6\x01\n" +
+	"\x06Candle\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\tR\binterval\x120\n" +
+	"\x05start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12\x12\n" +
+	"\x04open\x18\x04 \x01(\x01R\x04open\x12\x12\n" +
+	"\x04high\x18\x05 \x01(\x01R\x04high\x12\x10\n" +
+	"\x03low\x18\x06 \x01(\x01R\x03low\x12\x14\n" +
+	"\x05close\x18\a \x01(\x01R\x05close\x12\x16\n" +
+	"\x06volume\x18\b \x01(\x01R\x06volume\"\xdf\x01\n" +
+	"\x11GetCandlesRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\tR\binterval\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"c\n" +
+	"\x0fCandlesResponse\x12(\n" +
+	"\acandles\x18\x01 \x03(\v2\x0e.broker.CandleR\acandles\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"J\n" +
+	"\x14StreamCandlesRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\tR\binterval\"\xa7\x01\n" +
+	"\x15RebuildCandlesRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\tR\binterval\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\",\n" +
+	"\x16RebuildCandlesResponse\x12\x12\n" +
+	"\x04bars\x18\x01 \x01(\x05R\x04bars\"\xe9\x02\n" +
+	"\x11PlaceOrderRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04side\x18\x02 \x01(\tR\x04side\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x01R\bquantity\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1a\n" +
+	"\bvalidity\x18\x06 \x01(\tR\bvalidity\x12!\n" +
+	"\fafter_market\x18\a \x01(\bR\vafterMarket\x12\x1d\n" +
+	"\n" +
+	"lot_method\x18\b \x01(\tR\tlotMethod\x12\x17\n" +
+	"\alot_ids\x18\t \x03(\tR\x06lotIds\x12\x18\n" +
+	"\aproduct\x18\n" +
+	" \x01(\tR\aproduct\x12\x1a\n" +
+	"\bnotional\x18\v \x01(\x01R\bnotional\x12\x14\n" +
+	"\x05short\x18\f \x01(\bR\x05short\x12\x1f\n" +
+	"\vdisplay_qty\x18\r \x01(\x01R\n" +
+	"displayQty\"$\n" +
+	"\x12CancelOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"V\n" +
+	"\n" +
+	"PriceLevel\x12\x14\n" +
+	"\x05price\x18\x01 \x01(\x01R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\x12\x16\n" +
+	"\x06orders\x18\x03 \x01(\x05R\x06orders\"u\n" +
+	"\vMarketDepth\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12&\n" +
+	"\x04bids\x18\x02 \x03(\v2\x12.broker.PriceLevelR\x04bids\x12&\n" +
+	"\x04asks\x18\x03 \x03(\v2\x12.broker.PriceLevelR\x04asks\"G\n" +
+	"\x15GetMarketDepthRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x16\n" +
+	"\x06levels\x18\x02 \x01(\x05R\x06levels\"k\n" +
+	"\x16SubscribeQuotesRequest\x12\x18\n" +
+	"\asymbols\x18\x01 \x03(\tR\asymbols\x12\x16\n" +
+	"\x06levels\x18\x02 \x01(\x05R\x06levels\x12\x1f\n" +
+	"\vinterval_ms\x18\x03 \x01(\x05R\n" +
+	"intervalMs\"\x93\x02\n" +
+	"\vQuoteUpdate\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x10\n" +
+	"\x03bid\x18\x02 \x01(\x01R\x03bid\x12\x19\n" +
+	"\bbid_size\x18\x03 \x01(\x01R\abidSize\x12\x10\n" +
+	"\x03ask\x18\x04 \x01(\x01R\x03ask\x12\x19\n" +
+	"\bask_size\x18\x05 \x01(\x01R\aaskSize\x12\x12\n" +
+	"\x04last\x18\x06 \x01(\x01R\x04last\x12&\n" +
+	"\x04bids\x18\a \x03(\v2\x12.broker.PriceLevelR\x04bids\x12&\n" +
+	"\x04asks\x18\b \x03(\v2\x12.broker.PriceLevelR\x04asks\x12.\n" +
+	"\x04time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"_\n" +
+	"\rWatchlistItem\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04last\x18\x02 \x01(\x01R\x04last\x12\x10\n" +
+	"\x03bid\x18\x03 \x01(\x01R\x03bid\x12\x10\n" +
+	"\x03ask\x18\x04 \x01(\x01R\x03ask\"\xd2\x01\n" +
+	"\tWatchlist\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12+\n" +
+	"\x05items\x18\x03 \x03(\v2\x15.broker.WatchlistItemR\x05items\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"G\n" +
+	"\x12WatchlistsResponse\x121\n" +
+	"\n" +
+	"watchlists\x18\x01 \x03(\v2\x11.broker.WatchlistR\n" +
+	"watchlists\"F\n" +
+	"\x16CreateWatchlistRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\asymbols\x18\x02 \x03(\tR\asymbols\"\"\n" +
+	"\x10WatchlistRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"<\n" +
+	"\x16RenameWatchlistRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"C\n" +
+	"\x17WatchlistSymbolsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asymbols\x18\x02 \x03(\tR\asymbols\"F\n" +
+	"\x1cRemoveWatchlistSymbolRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\"\xfc\x02\n" +
+	"\x05Alert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x1c\n" +
+	"\tcondition\x18\x03 \x01(\tR\tcondition\x12\x1c\n" +
+	"\tthreshold\x18\x04 \x01(\x01R\tthreshold\x12\x1a\n" +
+	"\bchannels\x18\x05 \x03(\tR\bchannels\x12\x1f\n" +
+	"\vwebhook_url\x18\x06 \x01(\tR\n" +
+	"webhookUrl\x12\x14\n" +
+	"\x05rearm\x18\a \x01(\tR\x05rearm\x12!\n" +
+	"\fcooldown_sec\x18\b \x01(\x05R\vcooldownSec\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12F\n" +
+	"\x11last_triggered_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0flastTriggeredAt\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xde\x01\n" +
+	"\x12CreateAlertRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1c\n" +
+	"\tcondition\x18\x02 \x01(\tR\tcondition\x12\x1c\n" +
+	"\tthreshold\x18\x03 \x01(\x01R\tthreshold\x12\x1a\n" +
+	"\bchannels\x18\x04 \x03(\tR\bchannels\x12\x1f\n" +
+	"\vwebhook_url\x18\x05 \x01(\tR\n" +
+	"webhookUrl\x12\x14\n" +
+	"\x05rearm\x18\x06 \x01(\tR\x05rearm\x12!\n" +
+	"\fcooldown_sec\x18\a \x01(\x05R\vcooldownSec\"\x1e\n" +
+	"\fAlertRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x0eAlertsResponse\x12%\n" +
+	"\x06alerts\x18\x01 \x03(\v2\r.broker.AlertR\x06alerts\"\xeb\x01\n" +
+	"\n" +
+	"AlertEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\balert_id\x18\x02 \x01(\tR\aalertId\x12\x16\n" +
+	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12\x1c\n" +
+	"\tcondition\x18\x04 \x01(\tR\tcondition\x12\x1c\n" +
+	"\tthreshold\x18\x05 \x01(\x01R\tthreshold\x12\x14\n" +
+	"\x05value\x18\x06 \x01(\x01R\x05value\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x12.\n" +
+	"\x04time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"+\n" +
+	"\x13AlertHistoryRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"B\n" +
+	"\x14AlertHistoryResponse\x12*\n" +
+	"\x06events\x18\x01 \x03(\v2\x12.broker.AlertEventR\x06events\"\x97\x01\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x12\n" +
+	"\x04read\x18\x04 \x01(\bR\x04read\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"7\n" +
+	"\x14NotificationsRequest\x12\x1f\n" +
+	"\vunread_only\x18\x01 \x01(\bR\n" +
+	"unreadOnly\"S\n" +
+	"\x15NotificationsResponse\x12:\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x14.broker.NotificationR\rnotifications\"0\n" +
+	"\x1cMarkNotificationsReadRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"4\n" +
+	"\x16GetMarketStatusRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\"\x8d\x02\n" +
+	"\fMarketStatus\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x18\n" +
+	"\asession\x18\x02 \x01(\tR\asession\x12\x17\n" +
+	"\ais_open\x18\x03 \x01(\bR\x06isOpen\x12\x1f\n" +
+	"\vtrading_day\x18\x04 \x01(\bR\n" +
+	"tradingDay\x12\x19\n" +
+	"\bhalf_day\x18\x05 \x01(\bR\ahalfDay\x127\n" +
+	"\tnext_open\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bnextOpen\x129\n" +
+	"\n" +
+	"next_close\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tnextClose\"H\n" +
+	"\x14MarketStatusResponse\x120\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x14.broker.MarketStatusR\bstatuses\"\x8b\x03\n" +
+	"\x03Lot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x01R\bquantity\x12\x19\n" +
+	"\borig_qty\x18\x05 \x01(\x01R\aorigQty\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x01R\x05price\x12;\n" +
+	"\vacquired_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"acquiredAt\x12\x12\n" +
+	"\x04term\x18\b \x01(\tR\x04term\x12\x1a\n" +
+	"\bexchange\x18\t \x01(\tR\bexchange\x12;\n" +
+	"\vsettle_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"settleDate\x12\x18\n" +
+	"\asettled\x18\v \x01(\bR\asettled\x12\x1a\n" +
+	"\bcurrency\x18\f \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05short\x18\r \x01(\bR\x05short\"(\n" +
+	"\x0eGetLotsRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\"/\n" +
+	"\fLotsResponse\x12\x1f\n" +
+	"\x04lots\x18\x01 \x03(\v2\v.broker.LotR\x04lots\"\x9e\x03\n" +
+	"\tClosedLot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x15\n" +
+	"\x06lot_id\x18\x03 \x01(\tR\x05lotId\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\tR\aorderId\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x01R\bquantity\x12\x1d\n" +
+	"\n" +
+	"cost_price\x18\x06 \x01(\x01R\tcostPrice\x12\x1d\n" +
+	"\n" +
+	"sale_price\x18\a \x01(\x01R\tsalePrice\x12!\n" +
+	"\frealized_pnl\x18\b \x01(\x01R\vrealizedPnl\x12;\n" +
+	"\vacquired_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"acquiredAt\x127\n" +
+	"\tclosed_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x12\x12\n" +
+	"\x04term\x18\v \x01(\tR\x04term\x12\x1a\n" +
+	"\bcurrency\x18\f \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05short\x18\r \x01(\bR\x05short\"m\n" +
+	"\x12ClosedLotsResponse\x122\n" +
+	"\vclosed_lots\x18\x01 \x03(\v2\x11.broker.ClosedLotR\n" +
+	"closedLots\x12#\n" +
+	"\x04card\x18\x02 \x01(\v2\x0f.broker.PnlCardR\x04card\"\x83\x03\n" +
+	"\x0fCorporateAction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x123\n" +
+	"\aex_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06exDate\x12;\n" +
+	"\vrecord_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"recordDate\x12\x1b\n" +
+	"\tratio_new\x18\x06 \x01(\x01R\bratioNew\x12\x1b\n" +
+	"\tratio_old\x18\a \x01(\x01R\bratioOld\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x01R\x06amount\x12\x1d\n" +
+	"\n" +
+	"new_symbol\x18\t \x01(\tR\tnewSymbol\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"applied_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tappliedAt\"5\n" +
+	"\x1bListCorporateActionsRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\"R\n" +
+	"\x1dCreateCorporateActionsRequest\x121\n" +
+	"\aactions\x18\x01 \x03(\v2\x17.broker.CorporateActionR\aactions\"M\n" +
+	"\x18CorporateActionsResponse\x121\n" +
+	"\aactions\x18\x01 \x03(\v2\x17.broker.CorporateActionR\aactions\"\xa3\x03\n" +
+	"\n" +
+	"Adjustment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\taction_id\x18\x02 \x01(\tR\bactionId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06symbol\x18\x04 \x01(\tR\x06symbol\x12\x1d\n" +
+	"\n" +
+	"new_symbol\x18\x05 \x01(\tR\tnewSymbol\x12\x17\n" +
+	"\aold_qty\x18\x06 \x01(\x01R\x06oldQty\x12\x17\n" +
+	"\anew_qty\x18\a \x01(\x01R\x06newQty\x12\"\n" +
+	"\rold_avg_price\x18\b \x01(\x01R\voldAvgPrice\x12\"\n" +
+	"\rnew_avg_price\x18\t \x01(\x01R\vnewAvgPrice\x12\x12\n" +
+	"\x04cash\x18\n" +
+	" \x01(\x01R\x04cash\x12.\n" +
+	"\x04time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1a\n" +
+	"\bcurrency\x18\f \x01(\tR\bcurrency\x12!\n" +
+	"\ffraction_qty\x18\r \x01(\x01R\vfractionQty\x12 \n" +
+	"\fcash_in_lieu\x18\x0e \x01(\x01R\n" +
+	"cashInLieu\"K\n" +
+	"\x13AdjustmentsResponse\x124\n" +
+	"\vadjustments\x18\x01 \x03(\v2\x12.broker.AdjustmentR\vadjustments\"\xdd\x01\n" +
+	"\tCashEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x16\n" +
+	"\x06symbol\x18\x04 \x01(\tR\x06symbol\x12\x1c\n" +
+	"\treference\x18\x05 \x01(\tR\treference\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x12.\n" +
+	"\x04time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\"\x83\x02\n" +
+	"\x12CashLedgerResponse\x12\x18\n" +
+	"\abalance\x18\x01 \x01(\x01R\abalance\x12+\n" +
+	"\aentries\x18\x02 \x03(\v2\x11.broker.CashEntryR\aentries\x12D\n" +
+	"\bbalances\x18\x03 \x03(\v2(.broker.CashLedgerResponse.BalancesEntryR\bbalances\x12#\n" +
+	"\rbase_currency\x18\x04 \x01(\tR\fbaseCurrency\x1a;\n" +
+	"\rBalancesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"u\n" +
+	"\x10SettlementResult\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x01R\x05value\"\xc5\x01\n" +
+	"\rSettlementRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12!\n" +
+	"\ftrading_date\x18\x03 \x01(\tR\vtradingDate\x121\n" +
+	"\x06ran_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05ranAt\x122\n" +
+	"\aresults\x18\x05 \x03(\v2\x18.broker.SettlementResultR\aresults\"1\n" +
+	"\x19ListSettlementRunsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"C\n" +
+	"\x16SettlementRunsResponse\x12)\n" +
+	"\x04runs\x18\x01 \x03(\v2\x15.broker.SettlementRunR\x04runs\"U\n" +
+	"\vCashRequest\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"\xc9\x01\n" +
+	"\x0fSnapshotHolding\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\x12\x1b\n" +
+	"\tavg_price\x18\x03 \x01(\x01R\bavgPrice\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\x01R\x05value\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"base_value\x18\a \x01(\x01R\tbaseValue\"\xbe\x02\n" +
+	"\x11PortfolioSnapshot\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12.\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x123\n" +
+	"\bholdings\x18\x03 \x03(\v2\x17.broker.SnapshotHoldingR\bholdings\x12%\n" +
+	"\x0eholdings_value\x18\x04 \x01(\x01R\rholdingsValue\x12\x12\n" +
+	"\x04cash\x18\x05 \x01(\x01R\x04cash\x12\x1f\n" +
+	"\vtotal_value\x18\x06 \x01(\x01R\n" +
+	"totalValue\x12\x19\n" +
+	"\bnet_flow\x18\a \x01(\x01R\anetFlow\x12\x1d\n" +
+	"\n" +
+	"day_change\x18\b \x01(\x01R\tdayChange\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\";\n" +
+	"\x15PortfolioRangeRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"S\n" +
+	"\x18PortfolioHistoryResponse\x127\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x19.broker.PortfolioSnapshotR\tsnapshots\"\x9e\x04\n" +
+	"\vPerformance\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1f\n" +
+	"\vstart_value\x18\x03 \x01(\x01R\n" +
+	"startValue\x12\x1b\n" +
+	"\tend_value\x18\x04 \x01(\x01R\bendValue\x12\x1b\n" +
+	"\tnet_flows\x18\x05 \x01(\x01R\bnetFlows\x12\x10\n" +
+	"\x03twr\x18\x06 \x01(\x01R\x03twr\x12\x12\n" +
+	"\x04xirr\x18\a \x01(\x01R\x04xirr\x12!\n" +
+	"\fmax_drawdown\x18\b \x01(\x01R\vmaxDrawdown\x12?\n" +
+	"\rdrawdown_peak\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\fdrawdownPeak\x12=\n" +
+	"\fdrawdown_low\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vdrawdownLow\x12\x1d\n" +
+	"\n" +
+	"day_change\x18\v \x01(\x01R\tdayChange\x12$\n" +
+	"\x0eday_change_pct\x18\f \x01(\x01R\fdayChangePct\x12#\n" +
+	"\rcurrent_value\x18\r \x01(\x01R\fcurrentValue\x12%\n" +
+	"\x0esnapshot_count\x18\x0e \x01(\x05R\rsnapshotCount\"\xb0\x01\n" +
+	"\rReportRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x14\n" +
+	"\x05month\x18\x03 \x01(\tR\x05month\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x05 \x01(\tR\x02to\x12\x16\n" +
+	"\x06format\x18\x06 \x01(\tR\x06format\x12%\n" +
+	"\x0efinancial_year\x18\a \x01(\x05R\rfinancialYear\"_\n" +
+	"\n" +
+	"ReportFile\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"<\n" +
+	"\x13CapitalGainsRequest\x12%\n" +
+	"\x0efinancial_year\x18\x01 \x01(\x05R\rfinancialYear\"\xe2\x01\n" +
+	"\x0fInstrumentGains\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\x12\x12\n" +
+	"\x04cost\x18\x03 \x01(\x01R\x04cost\x12\x1a\n" +
+	"\bproceeds\x18\x04 \x01(\x01R\bproceeds\x12&\n" +
+	"\x0fshort_term_gain\x18\x05 \x01(\x01R\rshortTermGain\x12$\n" +
+	"\x0elong_term_gain\x18\x06 \x01(\x01R\flongTermGain\x12\x1d\n" +
+	"\n" +
+	"total_gain\x18\a \x01(\x01R\ttotalGain\"\x9c\x03\n" +
+	"\tGainEntry\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x15\n" +
+	"\x06lot_id\x18\x02 \x01(\tR\x05lotId\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x01R\bquantity\x12;\n" +
+	"\vacquired_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"acquiredAt\x123\n" +
+	"\asold_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06soldAt\x12!\n" +
+	"\fholding_days\x18\a \x01(\x05R\vholdingDays\x12\x1d\n" +
+	"\n" +
+	"cost_price\x18\b \x01(\x01R\tcostPrice\x12\x1d\n" +
+	"\n" +
+	"sale_price\x18\t \x01(\x01R\tsalePrice\x12\x12\n" +
+	"\x04cost\x18\n" +
+	" \x01(\x01R\x04cost\x12\x1a\n" +
+	"\bproceeds\x18\v \x01(\x01R\bproceeds\x12\x12\n" +
+	"\x04gain\x18\f \x01(\x01R\x04gain\x12\x12\n" +
+	"\x04term\x18\r \x01(\tR\x04term\"\xb4\x02\n" +
+	"\x12CapitalGainsReport\x12%\n" +
+	"\x0efinancial_year\x18\x01 \x01(\tR\rfinancialYear\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12&\n" +
+	"\x0fshort_term_gain\x18\x04 \x01(\x01R\rshortTermGain\x12$\n" +
+	"\x0elong_term_gain\x18\x05 \x01(\x01R\flongTermGain\x12\x1d\n" +
+	"\n" +
+	"total_gain\x18\x06 \x01(\x01R\ttotalGain\x129\n" +
+	"\vinstruments\x18\a \x03(\v2\x17.broker.InstrumentGainsR\vinstruments\x12+\n" +
+	"\aentries\x18\b \x03(\v2\x11.broker.GainEntryR\aentries\"\x91\x01\n" +
+	"\x17CalculateChargesRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04side\x18\x02 \x01(\tR\x04side\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x18\n" +
+	"\aproduct\x18\x05 \x01(\tR\aproduct\"4\n" +
+	"\x06Charge\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\"\xb3\x02\n" +
+	"\x0eChargeEstimate\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x18\n" +
+	"\asegment\x18\x02 \x01(\tR\asegment\x12\x18\n" +
+	"\aproduct\x18\x03 \x01(\tR\aproduct\x12\x12\n" +
+	"\x04side\x18\x04 \x01(\tR\x04side\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x01R\bquantity\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x01R\x05price\x12\x14\n" +
+	"\x05value\x18\a \x01(\x01R\x05value\x12(\n" +
+	"\acharges\x18\b \x03(\v2\x0e.broker.ChargeR\acharges\x12\x14\n" +
+	"\x05total\x18\t \x01(\x01R\x05total\x12\x1d\n" +
+	"\n" +
+	"net_amount\x18\n" +
+	" \x01(\x01R\tnetAmount\x12\x1a\n" +
+	"\bcurrency\x18\v \x01(\tR\bcurrency\"\xbf\x01\n" +
+	"\x0fFXRatesResponse\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\x12\x13\n" +
+	"\x05as_of\x18\x02 \x01(\tR\x04asOf\x128\n" +
+	"\x05rates\x18\x03 \x03(\v2\".broker.FXRatesResponse.RatesEntryR\x05rates\x1a8\n" +
+	"\n" +
+	"RatesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"T\n" +
+	"\x16ConvertCurrencyRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\"\xbc\x01\n" +
+	"\fFXConversion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04rate\x18\x05 \x01(\x01R\x04rate\x12\x1c\n" +
+	"\tconverted\x18\x06 \x01(\x01R\tconverted\x12.\n" +
+	"\x04time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"\x8b\x01\n" +
+	"\x14HouseAccountResponse\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12+\n" +
+	"\bholdings\x18\x02 \x03(\v2\x0f.broker.HoldingR\bholdings\x12'\n" +
+	"\apending\x18\x03 \x03(\v2\r.broker.OrderR\apending\"\x91\x01\n" +
+	"\x06Locate\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\x12\x19\n" +
+	"\bfee_rate\x18\x03 \x01(\x01R\afeeRate\x12\x1a\n" +
+	"\bborrowed\x18\x04 \x01(\x01R\bborrowed\x12\x1c\n" +
+	"\tavailable\x18\x05 \x01(\x01R\tavailable\";\n" +
+	"\x0fLocatesResponse\x12(\n" +
+	"\alocates\x18\x01 \x03(\v2\x0e.broker.LocateR\alocates\"=\n" +
+	"\x11SetLocatesRequest\x12(\n" +
+	"\alocates\x18\x01 \x03(\v2\x0e.broker.LocateR\alocates\"\x80\x02\n" +
+	"\rShortPosition\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\x12\x1b\n" +
+	"\tavg_price\x18\x04 \x01(\x01R\bavgPrice\x12\x1d\n" +
+	"\n" +
+	"last_price\x18\x05 \x01(\x01R\tlastPrice\x12%\n" +
+	"\x0eunrealized_pnl\x18\x06 \x01(\x01R\runrealizedPnl\x12\x19\n" +
+	"\bfee_rate\x18\a \x01(\x01R\afeeRate\x12!\n" +
+	"\faccrued_fees\x18\b \x01(\x01R\vaccruedFees\"G\n" +
+	"\x16ShortPositionsResponse\x12-\n" +
+	"\x06shorts\x18\x01 \x03(\v2\x15.broker.ShortPositionR\x06shorts\"E\n" +
+	"\x10SetMarginRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"D\n" +
+	"\x11SetMarginResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06margin\x18\x02 \x01(\bR\x06margin\"R\n" +
+	"\rBasketRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12-\n" +
+	"\x04legs\x18\x02 \x03(\v2\x19.broker.PlaceOrderRequestR\x04legs\"\xab\x01\n" +
+	"\x06Basket\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\torder_ids\x18\x03 \x03(\tR\borderIds\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12%\n" +
+	"\x06orders\x18\x05 \x03(\v2\r.broker.OrderR\x06orders\"F\n" +
+	"\tBasketLeg\x12#\n" +
+	"\x05order\x18\x01 \x01(\v2\r.broker.OrderR\x05order\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x8d\x01\n" +
+	"\vBasketFunds\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04buys\x18\x02 \x01(\x01R\x04buys\x12\x14\n" +
+	"\x05sells\x18\x03 \x01(\x01R\x05sells\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\x01R\brequired\x12\x1c\n" +
+	"\tavailable\x18\x05 \x01(\x01R\tavailable\"\x8a\x01\n" +
+	"\x0eBasketResponse\x12&\n" +
+	"\x06basket\x18\x01 \x01(\v2\x0e.broker.BasketR\x06basket\x12%\n" +
+	"\x04legs\x18\x02 \x03(\v2\x11.broker.BasketLegR\x04legs\x12)\n" +
+	"\x05funds\x18\x03 \x03(\v2\x13.broker.BasketFundsR\x05funds\";\n" +
+	"\x0fBasketsResponse\x12(\n" +
+	"\abaskets\x18\x01 \x03(\v2\x0e.broker.BasketR\abaskets\"\"\n" +
+	"\x10GetBasketRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"A\n" +
+	"\x0fRebalanceTarget\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x01R\x06weight\"_\n" +
+	"\x10RebalanceRequest\x121\n" +
+	"\atargets\x18\x01 \x03(\v2\x17.broker.RebalanceTargetR\atargets\x12\x18\n" +
+	"\aexecute\x18\x02 \x01(\bR\aexecute\"\xdc\x02\n" +
+	"\fRebalanceLeg\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x1f\n" +
+	"\vcurrent_qty\x18\x04 \x01(\x01R\n" +
+	"currentQty\x12#\n" +
+	"\rcurrent_value\x18\x05 \x01(\x01R\fcurrentValue\x12%\n" +
+	"\x0ecurrent_weight\x18\x06 \x01(\x01R\rcurrentWeight\x12#\n" +
+	"\rtarget_weight\x18\a \x01(\x01R\ftargetWeight\x12!\n" +
+	"\ftarget_value\x18\b \x01(\x01R\vtargetValue\x12\x1d\n" +
+	"\n" +
+	"target_qty\x18\t \x01(\x01R\ttargetQty\x12\x12\n" +
+	"\x04side\x18\n" +
+	" \x01(\tR\x04side\x12\x1a\n" +
+	"\bquantity\x18\v \x01(\x01R\bquantity\"\xd3\x01\n" +
+	"\rRebalancePlan\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vtotal_value\x18\x02 \x01(\x01R\n" +
+	"totalValue\x12\x12\n" +
+	"\x04cash\x18\x03 \x01(\x01R\x04cash\x12\x1f\n" +
+	"\vcash_weight\x18\x04 \x01(\x01R\n" +
+	"cashWeight\x12(\n" +
+	"\x04legs\x18\x05 \x03(\v2\x14.broker.RebalanceLegR\x04legs\x12&\n" +
+	"\x06basket\x18\x06 \x01(\v2\x0e.broker.BasketR\x06basket\"\xd3\x02\n" +
+	"\x04Algo\x12\x1a\n" +
+	"\bstrategy\x18\x01 \x01(\tR\bstrategy\x125\n" +
+	"\bstart_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
+	"\x06end_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05endAt\x12#\n" +
+	"\rslice_seconds\x18\x04 \x01(\x05R\fsliceSeconds\x12+\n" +
+	"\x11max_participation\x18\x05 \x01(\x01R\x10maxParticipation\x12\x12\n" +
+	"\x04step\x18\x06 \x01(\x01R\x04step\x12\x1a\n" +
+	"\bschedule\x18\a \x03(\x01R\bschedule\x12\x14\n" +
+	"\x05slice\x18\b \x01(\x05R\x05slice\x12\x19\n" +
+	"\bchild_id\x18\t \x01(\tR\achildId\x12\x12\n" +
+	"\x04note\x18\n" +
+	" \x01(\tR\x04note\"\x97\x03\n" +
+	"\x10AlgoOrderRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04side\x18\x02 \x01(\tR\x04side\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x18\n" +
+	"\aproduct\x18\x05 \x01(\tR\aproduct\x12\x1d\n" +
+	"\n" +
+	"lot_method\x18\x06 \x01(\tR\tlotMethod\x12\x14\n" +
+	"\x05short\x18\a \x01(\bR\x05short\x12\x1a\n" +
+	"\bstrategy\x18\b \x01(\tR\bstrategy\x125\n" +
+	"\bstart_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
+	"\x06end_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x05endAt\x12#\n" +
+	"\rslice_seconds\x18\v \x01(\x05R\fsliceSeconds\x12+\n" +
+	"\x11max_participation\x18\f \x01(\x01R\x10maxParticipation\"\x1d\n" +
+	"\vAlgoOrderID\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"e\n" +
+	"\x11AlgoOrderResponse\x12%\n" +
+	"\x06parent\x18\x01 \x01(\v2\r.broker.OrderR\x06parent\x12)\n" +
+	"\bchildren\x18\x02 \x03(\v2\r.broker.OrderR\bchildren\"p\n" +
+	"\x06Greeks\x12\x14\n" +
+	"\x05delta\x18\x01 \x01(\x01R\x05delta\x12\x14\n" +
+	"\x05gamma\x18\x02 \x01(\x01R\x05gamma\x12\x14\n" +
+	"\x05theta\x18\x03 \x01(\x01R\x05theta\x12\x12\n" +
+	"\x04vega\x18\x04 \x01(\x01R\x04vega\x12\x10\n" +
+	"\x03rho\x18\x05 \x01(\x01R\x03rho\"\xd3\x01\n" +
+	"\vOptionQuote\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x10\n" +
+	"\x03bid\x18\x02 \x01(\x01R\x03bid\x12\x10\n" +
+	"\x03ask\x18\x03 \x01(\x01R\x03ask\x12\x12\n" +
+	"\x04mark\x18\x04 \x01(\x01R\x04mark\x12\x1c\n" +
+	"\tintrinsic\x18\x05 \x01(\x01R\tintrinsic\x12\x0e\n" +
+	"\x02iv\x18\x06 \x01(\x01R\x02iv\x12&\n" +
+	"\x06greeks\x18\a \x01(\v2\x0e.broker.GreeksR\x06greeks\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\b \x01(\x01R\n" +
+	"multiplier\"v\n" +
+	"\fOptionStrike\x12\x16\n" +
+	"\x06strike\x18\x01 \x01(\x01R\x06strike\x12'\n" +
+	"\x04call\x18\x02 \x01(\v2\x13.broker.OptionQuoteR\x04call\x12%\n" +
+	"\x03put\x18\x03 \x01(\v2\x13.broker.OptionQuoteR\x03put\"O\n" +
+	"\x15GetOptionChainRequest\x12\x1e\n" +
+	"\n" +
+	"underlying\x18\x01 \x01(\tR\n" +
+	"underlying\x12\x16\n" +
+	"\x06expiry\x18\x02 \x01(\tR\x06expiry\"\xcb\x02\n" +
+	"\vOptionChain\x12\x1e\n" +
+	"\n" +
+	"underlying\x18\x01 \x01(\tR\n" +
+	"underlying\x122\n" +
+	"\x06expiry\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06expiry\x126\n" +
+	"\bexpiries\x18\x03 \x03(\v2\x1a.google.protobuf.TimestampR\bexpiries\x12\x12\n" +
+	"\x04spot\x18\x04 \x01(\x01R\x04spot\x12\x16\n" +
+	"\x06future\x18\x05 \x01(\tR\x06future\x12!\n" +
+	"\ffuture_price\x18\x06 \x01(\x01R\vfuturePrice\x12\x1d\n" +
+	"\n" +
+	"years_left\x18\a \x01(\x01R\tyearsLeft\x12\x12\n" +
+	"\x04rate\x18\b \x01(\x01R\x04rate\x12.\n" +
+	"\astrikes\x18\t \x03(\v2\x14.broker.OptionStrikeR\astrikes\"\xad\x02\n" +
+	"\x04Fund\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03amc\x18\x03 \x01(\tR\x03amc\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04isin\x18\x05 \x01(\tR\x04isin\x12\x1a\n" +
+	"\bexchange\x18\x06 \x01(\tR\bexchange\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06cutoff\x18\b \x01(\tR\x06cutoff\x12!\n" +
+	"\fmin_purchase\x18\t \x01(\x01R\vminPurchase\x12\x17\n" +
+	"\amin_sip\x18\n" +
+	" \x01(\x01R\x06minSip\x12\x10\n" +
+	"\x03nav\x18\v \x01(\x01R\x03nav\x12\x19\n" +
+	"\bnav_date\x18\f \x01(\tR\anavDate\"3\n" +
+	"\rFundsResponse\x12\"\n" +
+	"\x05funds\x18\x01 \x03(\v2\f.broker.FundR\x05funds\"C\n" +
+	"\x03Nav\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x10\n" +
+	"\x03nav\x18\x03 \x01(\x01R\x03nav\"(\n" +
+	"\x0eGetFundRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\"Q\n" +
+	"\fFundResponse\x12 \n" +
+	"\x04fund\x18\x01 \x01(\v2\f.broker.FundR\x04fund\x12\x1f\n" +
+	"\x04navs\x18\x02 \x03(\v2\v.broker.NavR\x04navs\"2\n" +
+	"\x0fPostNavsRequest\x12\x1f\n" +
+	"\x04navs\x18\x01 \x03(\v2\v.broker.NavR\x04navs\"*\n" +
+	"\x10PostNavsResponse\x12\x16\n" +
+	"\x06stored\x18\x01 \x01(\x05R\x06stored\"l\n" +
+	"\x10FundOrderRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04side\x18\x02 \x01(\tR\x04side\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05units\x18\x04 \x01(\x01R\x05units\"\xff\x02\n" +
+	"\tFundOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04side\x18\x03 \x01(\tR\x04side\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05units\x18\x05 \x01(\x01R\x05units\x12\x10\n" +
+	"\x03nav\x18\x06 \x01(\x01R\x03nav\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"trade_date\x18\b \x01(\tR\ttradeDate\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x15\n" +
+	"\x06sip_id\x18\n" +
+	" \x01(\tR\x05sipId\x12\x12\n" +
+	"\x04note\x18\v \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fprocessed_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vprocessedAt\"\x1d\n" +
+	"\vFundOrderID\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
+	"\x12FundOrdersResponse\x12)\n" +
+	"\x06orders\x18\x01 \x03(\v2\x11.broker.FundOrderR\x06orders\"N\n" +
+	"\n" +
+	"SipRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x10\n" +
+	"\x03day\x18\x03 \x01(\x05R\x03day\"\xc6\x02\n" +
+	"\x03Sip\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x10\n" +
+	"\x03day\x18\x04 \x01(\x05R\x03day\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1b\n" +
+	"\tnext_date\x18\x06 \x01(\tR\bnextDate\x12\"\n" +
+	"\finstallments\x18\a \x01(\x05R\finstallments\x12\x16\n" +
+	"\x06missed\x18\b \x01(\x05R\x06missed\x12\"\n" +
+	"\rlast_order_id\x18\t \x01(\tR\vlastOrderId\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x17\n" +
+	"\x05SipID\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\fSipsResponse\x12\x1f\n" +
+	"\x04sips\x18\x01 \x03(\v2\v.broker.SipR\x04sips\"\xf2\x01\n" +
+	"\x0eStrategyLimits\x121\n" +
+	"\x15max_orders_per_minute\x18\x01 \x01(\x05R\x12maxOrdersPerMinute\x12&\n" +
+	"\x0fmax_open_orders\x18\x02 \x01(\x05R\rmaxOpenOrders\x12&\n" +
+	"\x0fmax_order_value\x18\x03 \x01(\x01R\rmaxOrderValue\x12!\n" +
+	"\fmax_position\x18\x04 \x01(\x01R\vmaxPosition\x12\x19\n" +
+	"\bmax_loss\x18\x05 \x01(\x01R\amaxLoss\x12\x1f\n" +
+	"\vcallback_ms\x18\x06 \x01(\x05R\n" +
+	"callbackMs\"\xcc\x01\n" +
+	"\x10StrategyPosition\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\x12\x1b\n" +
+	"\tavg_price\x18\x03 \x01(\x01R\bavgPrice\x12\x1d\n" +
+	"\n" +
+	"last_price\x18\x04 \x01(\x01R\tlastPrice\x12!\n" +
+	"\frealized_pnl\x18\x05 \x01(\x01R\vrealizedPnl\x12%\n" +
+	"\x0eunrealized_pnl\x18\x06 \x01(\x01R\runrealizedPnl\"\xbe\x05\n" +
+	"\bStrategy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x124\n" +
+	"\x06params\x18\x03 \x03(\v2\x1c.broker.Strategy.ParamsEntryR\x06params\x12\x18\n" +
+	"\asymbols\x18\x04 \x03(\tR\asymbols\x12.\n" +
+	"\x06limits\x18\x05 \x01(\v2\x16.broker.StrategyLimitsR\x06limits\x12#\n" +
+	"\rtimer_seconds\x18\x06 \x01(\x05R\ftimerSeconds\x12\x12\n" +
+	"\x04seed\x18\a \x01(\x03R\x04seed\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\x12\x16\n" +
+	"\x06orders\x18\n" +
+	" \x01(\x05R\x06orders\x12\x1a\n" +
+	"\brejected\x18\v \x01(\x05R\brejected\x12\x14\n" +
+	"\x05fills\x18\f \x01(\x05R\x05fills\x12\x16\n" +
+	"\x06volume\x18\r \x01(\x01R\x06volume\x126\n" +
+	"\tpositions\x18\x0e \x03(\v2\x18.broker.StrategyPositionR\tpositions\x12!\n" +
+	"\frealized_pnl\x18\x0f \x01(\x01R\vrealizedPnl\x12%\n" +
+	"\x0eunrealized_pnl\x18\x10 \x01(\x01R\runrealizedPnl\x12\x10\n" +
+	"\x03pnl\x18\x11 \x01(\x01R\x03pnl\x129\n" +
+	"\n" +
+	"started_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x129\n" +
+	"\n" +
+	"stopped_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\tstoppedAt\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xaa\x02\n" +
+	"\x14StartStrategyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12@\n" +
+	"\x06params\x18\x02 \x03(\v2(.broker.StartStrategyRequest.ParamsEntryR\x06params\x12\x18\n" +
+	"\asymbols\x18\x03 \x03(\tR\asymbols\x12.\n" +
+	"\x06limits\x18\x04 \x01(\v2\x16.broker.StrategyLimitsR\x06limits\x12#\n" +
+	"\rtimer_seconds\x18\x05 \x01(\x05R\ftimerSeconds\x12\x12\n" +
+	"\x04seed\x18\x06 \x01(\x03R\x04seed\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x1c\n" +
+	"\n" +
+	"StrategyID\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"F\n" +
+	"\x12StrategiesResponse\x120\n" +
+	"\n" +
+	"strategies\x18\x01 \x03(\v2\x10.broker.StrategyR\n" +
+	"strategies\"7\n" +
+	"\x15StrategyTypesResponse\x12\x1e\n" +
+	"\n" +
+	"strategies\x18\x01 \x03(\tR\n" +
+	"strategies\"3\n" +
+	"\x19KillAllStrategiesResponse\x12\x16\n" +
+	"\x06killed\x18\x01 \x01(\x05R\x06killed\"\xfa\x01\n" +
+	"\aAccount\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12#\n" +
+	"\rstarting_cash\x18\x03 \x01(\x01R\fstartingCash\x12\x16\n" +
+	"\x06resets\x18\x04 \x01(\x05R\x06resets\x12\x12\n" +
+	"\x04cash\x18\x05 \x01(\x01R\x04cash\x127\n" +
+	"\topened_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bopenedAt\x125\n" +
+	"\breset_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aresetAt\"-\n" +
+	"\x13ResetBalanceRequest\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x01R\x06amount2\xdd:\n" +
 	"\x06Broker\x12B\n" +
 	"\x06Signup\x12\x15.broker.SignupRequest\x1a\r.broker.Empty\"\x12\x82\xd3\xe4\x93\x02\f:\x01*\"\a/signup\x12F\n" +
 	"\x05Login\x12\x14.broker.LoginRequest\x1a\x14.broker.AuthResponse\"\x11\x82\xd3\xe4\x93\x02\v:\x01*\"\x06/login\x12L\n" +
@@ -658,7 +11074,99 @@ const file_broker_proto_rawDesc = "" +
 	"\fGetOrderbook\x12\r.broker.Empty\x1a\x19.broker.OrderbookResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
 	"/orderbook\x12L\n" +
 	"\fGetPositions\x12\r.broker.Empty\x1a\x19.broker.PositionsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
-	"/positionsB4Z2github.com/hahahamid/broker-backend/proto;brokerpbb\x06proto3"
+	"/positions\x12d\n" +
+	"\x0fListInstruments\x12\x1e.broker.ListInstrumentsRequest\x1a\x1b.broker.InstrumentsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/instruments\x12`\n" +
+	"\rGetInstrument\x12\x1c.broker.GetInstrumentRequest\x1a\x12.broker.Instrument\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/instruments/{symbol}\x12o\n" +
+	"\x11SearchInstruments\x12 .broker.SearchInstrumentsRequest\x1a\x1b.broker.InstrumentsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/instruments/search\x12[\n" +
+	"\n" +
+	"GetCandles\x12\x19.broker.GetCandlesRequest\x1a\x17.broker.CandlesResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/candles/{symbol}\x12_\n" +
+	"\rStreamCandles\x12\x1c.broker.StreamCandlesRequest\x1a\x0e.broker.Candle\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/candles/{symbol}/live0\x01\x12u\n" +
+	"\x0eRebuildCandles\x12\x1d.broker.RebuildCandlesRequest\x1a\x1e.broker.RebuildCandlesResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/candles/{symbol}/rebuild\x12J\n" +
+	"\n" +
+	"PlaceOrder\x12\x19.broker.PlaceOrderRequest\x1a\r.broker.Order\"\x12\x82\xd3\xe4\x93\x02\f:\x01*\"\a/orders\x12N\n" +
+	"\vCancelOrder\x12\x1a.broker.CancelOrderRequest\x1a\r.broker.Order\"\x14\x82\xd3\xe4\x93\x02\x0e*\f/orders/{id}\x12]\n" +
+	"\x0eGetMarketDepth\x12\x1d.broker.GetMarketDepthRequest\x1a\x13.broker.MarketDepth\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/depth/{symbol}\x12`\n" +
+	"\x0fSubscribeQuotes\x12\x1e.broker.SubscribeQuotesRequest\x1a\x13.broker.QuoteUpdate\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/quotes/stream0\x01\x12P\n" +
+	"\x0eListWatchlists\x12\r.broker.Empty\x1a\x1a.broker.WatchlistsResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/watchlists\x12U\n" +
+	"\fGetWatchlist\x12\x18.broker.WatchlistRequest\x1a\x11.broker.Watchlist\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/watchlists/{id}\x12\\\n" +
+	"\x0fCreateWatchlist\x12\x1e.broker.CreateWatchlistRequest\x1a\x11.broker.Watchlist\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/watchlists\x12a\n" +
+	"\x0fRenameWatchlist\x12\x1e.broker.RenameWatchlistRequest\x1a\x11.broker.Watchlist\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*2\x10/watchlists/{id}\x12n\n" +
+	"\x13AddWatchlistSymbols\x12\x1f.broker.WatchlistSymbolsRequest\x1a\x11.broker.Watchlist\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/watchlists/{id}/symbols\x12k\n" +
+	"\x10ReorderWatchlist\x12\x1f.broker.WatchlistSymbolsRequest\x1a\x11.broker.Watchlist\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/watchlists/{id}/symbols\x12{\n" +
+	"\x15RemoveWatchlistSymbol\x12$.broker.RemoveWatchlistSymbolRequest\x1a\x11.broker.Watchlist\")\x82\xd3\xe4\x93\x02#*!/watchlists/{id}/symbols/{symbol}\x12T\n" +
+	"\x0fDeleteWatchlist\x12\x18.broker.WatchlistRequest\x1a\r.broker.Empty\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/watchlists/{id}\x12L\n" +
+	"\vCreateAlert\x12\x1a.broker.CreateAlertRequest\x1a\r.broker.Alert\"\x12\x82\xd3\xe4\x93\x02\f:\x01*\"\a/alerts\x12D\n" +
+	"\n" +
+	"ListAlerts\x12\r.broker.Empty\x1a\x16.broker.AlertsResponse\"\x0f\x82\xd3\xe4\x93\x02\t\x12\a/alerts\x12H\n" +
+	"\vDeleteAlert\x12\x14.broker.AlertRequest\x1a\r.broker.Empty\"\x14\x82\xd3\xe4\x93\x02\x0e*\f/alerts/{id}\x12M\n" +
+	"\n" +
+	"RearmAlert\x12\x14.broker.AlertRequest\x1a\r.broker.Alert\"\x1a\x82\xd3\xe4\x93\x02\x14\"\x12/alerts/{id}/rearm\x12e\n" +
+	"\x0fGetAlertHistory\x12\x1b.broker.AlertHistoryRequest\x1a\x1c.broker.AlertHistoryResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/alerts/history\x12h\n" +
+	"\x11ListNotifications\x12\x1c.broker.NotificationsRequest\x1a\x1d.broker.NotificationsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/notifications\x12l\n" +
+	"\x15MarkNotificationsRead\x12$.broker.MarkNotificationsReadRequest\x1a\r.broker.Empty\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/notifications/read\x12g\n" +
+	"\x0fGetMarketStatus\x12\x1e.broker.GetMarketStatusRequest\x1a\x1c.broker.MarketStatusResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/market/status\x12F\n" +
+	"\aGetLots\x12\x16.broker.GetLotsRequest\x1a\x14.broker.LotsResponse\"\r\x82\xd3\xe4\x93\x02\a\x12\x05/lots\x12P\n" +
+	"\rGetClosedLots\x12\r.broker.Empty\x1a\x1a.broker.ClosedLotsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/lots/closed\x12y\n" +
+	"\x14ListCorporateActions\x12#.broker.ListCorporateActionsRequest\x1a .broker.CorporateActionsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/corporate-actions\x12\x86\x01\n" +
+	"\x16CreateCorporateActions\x12%.broker.CreateCorporateActionsRequest\x1a .broker.CorporateActionsResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/admin/corporate-actions\x12R\n" +
+	"\x0eGetAdjustments\x12\r.broker.Empty\x1a\x1b.broker.AdjustmentsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/adjustments\x12I\n" +
+	"\rGetCashLedger\x12\r.broker.Empty\x1a\x1a.broker.CashLedgerResponse\"\r\x82\xd3\xe4\x93\x02\a\x12\x05/cash\x12w\n" +
+	"\x12ListSettlementRuns\x12!.broker.ListSettlementRunsRequest\x1a\x1e.broker.SettlementRunsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/admin/settlement-runs\x12L\n" +
+	"\aDeposit\x12\x13.broker.CashRequest\x1a\x11.broker.CashEntry\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/cash/deposits\x12P\n" +
+	"\bWithdraw\x12\x13.broker.CashRequest\x1a\x11.broker.CashEntry\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/cash/withdrawals\x12r\n" +
+	"\x13GetPortfolioHistory\x12\x1d.broker.PortfolioRangeRequest\x1a .broker.PortfolioHistoryResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/portfolio/history\x12d\n" +
+	"\x0eGetPerformance\x12\x1d.broker.PortfolioRangeRequest\x1a\x13.broker.Performance\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/portfolio/performance\x12O\n" +
+	"\tGetReport\x12\x15.broker.ReportRequest\x1a\x12.broker.ReportFile\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/reports/{kind}\x12f\n" +
+	"\x0fGetCapitalGains\x12\x1b.broker.CapitalGainsRequest\x1a\x1a.broker.CapitalGainsReport\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/tax/capital-gains\x12i\n" +
+	"\x10CalculateCharges\x12\x1f.broker.CalculateChargesRequest\x1a\x16.broker.ChargeEstimate\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/charges/estimate\x12G\n" +
+	"\n" +
+	"GetFXRates\x12\r.broker.Empty\x1a\x17.broker.FXRatesResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/fx/rates\x12c\n" +
+	"\x0fConvertCurrency\x12\x1e.broker.ConvertCurrencyRequest\x1a\x14.broker.FXConversion\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/fx/conversions\x12T\n" +
+	"\x0fGetHouseAccount\x12\r.broker.Empty\x1a\x1c.broker.HouseAccountResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/admin/house\x12G\n" +
+	"\vListLocates\x12\r.broker.Empty\x1a\x17.broker.LocatesResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/locates\x12S\n" +
+	"\x11GetShortPositions\x12\r.broker.Empty\x1a\x1e.broker.ShortPositionsResponse\"\x0f\x82\xd3\xe4\x93\x02\t\x12\a/shorts\x12[\n" +
+	"\n" +
+	"SetLocates\x12\x19.broker.SetLocatesRequest\x1a\x17.broker.LocatesResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\x1a\x0e/admin/locates\x12j\n" +
+	"\tSetMargin\x12\x18.broker.SetMarginRequest\x1a\x19.broker.SetMarginResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/admin/users/{user_id}/margin\x12Q\n" +
+	"\vPlaceBasket\x12\x15.broker.BasketRequest\x1a\x16.broker.BasketResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/baskets\x12]\n" +
+	"\x0eValidateBasket\x12\x15.broker.BasketRequest\x1a\x16.broker.BasketResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/baskets/validate\x12G\n" +
+	"\vListBaskets\x12\r.broker.Empty\x1a\x17.broker.BasketsResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/baskets\x12L\n" +
+	"\tGetBasket\x12\x18.broker.GetBasketRequest\x1a\x0e.broker.Basket\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/baskets/{id}\x12S\n" +
+	"\tRebalance\x12\x18.broker.RebalanceRequest\x1a\x15.broker.RebalancePlan\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/rebalance\x12M\n" +
+	"\x0fCreateAlgoOrder\x12\x18.broker.AlgoOrderRequest\x1a\r.broker.Order\"\x11\x82\xd3\xe4\x93\x02\v:\x01*\"\x06/algos\x12S\n" +
+	"\fGetAlgoOrder\x12\x13.broker.AlgoOrderID\x1a\x19.broker.AlgoOrderResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/algos/{id}\x12O\n" +
+	"\x0ePauseAlgoOrder\x12\x13.broker.AlgoOrderID\x1a\r.broker.Order\"\x19\x82\xd3\xe4\x93\x02\x13\"\x11/algos/{id}/pause\x12Q\n" +
+	"\x0fResumeAlgoOrder\x12\x13.broker.AlgoOrderID\x1a\r.broker.Order\"\x1a\x82\xd3\xe4\x93\x02\x14\"\x12/algos/{id}/resume\x12J\n" +
+	"\x0fCancelAlgoOrder\x12\x13.broker.AlgoOrderID\x1a\r.broker.Order\"\x13\x82\xd3\xe4\x93\x02\r*\v/algos/{id}\x12i\n" +
+	"\x0eGetOptionChain\x12\x1d.broker.GetOptionChainRequest\x1a\x13.broker.OptionChain\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/options/{underlying}/chain\x12A\n" +
+	"\tListFunds\x12\r.broker.Empty\x1a\x15.broker.FundsResponse\"\x0e\x82\xd3\xe4\x93\x02\b\x12\x06/funds\x12P\n" +
+	"\aGetFund\x12\x16.broker.GetFundRequest\x1a\x14.broker.FundResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/funds/{symbol}\x12[\n" +
+	"\bPostNavs\x12\x17.broker.PostNavsRequest\x1a\x18.broker.PostNavsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/admin/funds/navs\x12V\n" +
+	"\x0ePlaceFundOrder\x12\x18.broker.FundOrderRequest\x1a\x11.broker.FundOrder\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/fund-orders\x12Q\n" +
+	"\x0eListFundOrders\x12\r.broker.Empty\x1a\x1a.broker.FundOrdersResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/fund-orders\x12T\n" +
+	"\x0fCancelFundOrder\x12\x13.broker.FundOrderID\x1a\x11.broker.FundOrder\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/fund-orders/{id}\x12>\n" +
+	"\tCreateSip\x12\x12.broker.SipRequest\x1a\v.broker.Sip\"\x10\x82\xd3\xe4\x93\x02\n" +
+	":\x01*\"\x05/sips\x12>\n" +
+	"\bListSips\x12\r.broker.Empty\x1a\x14.broker.SipsResponse\"\r\x82\xd3\xe4\x93\x02\a\x12\x05/sips\x12@\n" +
+	"\bPauseSip\x12\r.broker.SipID\x1a\v.broker.Sip\"\x18\x82\xd3\xe4\x93\x02\x12\"\x10/sips/{id}/pause\x12B\n" +
+	"\tResumeSip\x12\r.broker.SipID\x1a\v.broker.Sip\"\x19\x82\xd3\xe4\x93\x02\x13\"\x11/sips/{id}/resume\x12>\n" +
+	"\aSkipSip\x12\r.broker.SipID\x1a\v.broker.Sip\"\x17\x82\xd3\xe4\x93\x02\x11\"\x0f/sips/{id}/skip\x12;\n" +
+	"\tCancelSip\x12\r.broker.SipID\x1a\v.broker.Sip\"\x12\x82\xd3\xe4\x93\x02\f*\n" +
+	"/sips/{id}\x12Z\n" +
+	"\x11ListStrategyTypes\x12\r.broker.Empty\x1a\x1d.broker.StrategyTypesResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/strategy-types\x12W\n" +
+	"\rStartStrategy\x12\x1c.broker.StartStrategyRequest\x1a\x10.broker.Strategy\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/strategies\x12P\n" +
+	"\x0eListStrategies\x12\r.broker.Empty\x1a\x1a.broker.StrategiesResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/strategies\x12M\n" +
+	"\vGetStrategy\x12\x12.broker.StrategyID\x1a\x10.broker.Strategy\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/strategies/{id}\x12S\n" +
+	"\fStopStrategy\x12\x12.broker.StrategyID\x1a\x10.broker.Strategy\"\x1d\x82\xd3\xe4\x93\x02\x17\"\x15/strategies/{id}/stop\x12S\n" +
+	"\fKillStrategy\x12\x12.broker.StrategyID\x1a\x10.broker.Strategy\"\x1d\x82\xd3\xe4\x93\x02\x17\"\x15/strategies/{id}/kill\x12e\n" +
+	"\x11KillAllStrategies\x12\r.broker.Empty\x1a!.broker.KillAllStrategiesResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\"\x16/admin/strategies/kill\x12>\n" +
+	"\n" +
+	"GetAccount\x12\r.broker.Empty\x1a\x0f.broker.Account\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/account\x12_\n" +
+	"\fResetBalance\x12\x1b.broker.ResetBalanceRequest\x1a\x0f.broker.Account\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/account/reset-balanceB4Z2github.com/hahahamid/broker-backend/proto;brokerpbb\x06proto3"
 
 var (
 	file_broker_proto_rawDescOnce sync.Once
@@ -672,41 +11180,446 @@ func file_broker_proto_rawDescGZIP() []byte {
 	return file_broker_proto_rawDescData
 }
 
-var file_broker_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_broker_proto_msgTypes = make([]protoimpl.MessageInfo, 144)
 var file_broker_proto_goTypes = []any{
-	(*Empty)(nil),             // 0: broker.Empty
-	(*SignupRequest)(nil),     // 1: broker.SignupRequest
-	(*LoginRequest)(nil),      // 2: broker.LoginRequest
-	(*RefreshRequest)(nil),    // 3: broker.RefreshRequest
-	(*AuthResponse)(nil),      // 4: broker.AuthResponse
-	(*Holding)(nil),           // 5: broker.Holding
-	(*HoldingsResponse)(nil),  // 6: broker.HoldingsResponse
-	(*Order)(nil),             // 7: broker.Order
-	(*OrderbookResponse)(nil), // 8: broker.OrderbookResponse
-	(*Position)(nil),          // 9: broker.Position
-	(*PositionsResponse)(nil), // 10: broker.PositionsResponse
+	(*Empty)(nil),                         // 0: broker.Empty
+	(*SignupRequest)(nil),                 // 1: broker.SignupRequest
+	(*LoginRequest)(nil),                  // 2: broker.LoginRequest
+	(*RefreshRequest)(nil),                // 3: broker.RefreshRequest
+	(*AuthResponse)(nil),                  // 4: broker.AuthResponse
+	(*Holding)(nil),                       // 5: broker.Holding
+	(*HoldingsResponse)(nil),              // 6: broker.HoldingsResponse
+	(*Order)(nil),                         // 7: broker.Order
+	(*PnlCard)(nil),                       // 8: broker.PnlCard
+	(*OrderbookResponse)(nil),             // 9: broker.OrderbookResponse
+	(*Position)(nil),                      // 10: broker.Position
+	(*PositionsResponse)(nil),             // 11: broker.PositionsResponse
+	(*Instrument)(nil),                    // 12: broker.Instrument
+	(*ListInstrumentsRequest)(nil),        // 13: broker.ListInstrumentsRequest
+	(*GetInstrumentRequest)(nil),          // 14: broker.GetInstrumentRequest
+	(*SearchInstrumentsRequest)(nil),      // 15: broker.SearchInstrumentsRequest
+	(*InstrumentsResponse)(nil),           // 16: broker.InstrumentsResponse
+	(*Candle)(nil),                        // 17: broker.Candle
+	(*GetCandlesRequest)(nil),             // 18: broker.GetCandlesRequest
+	(*CandlesResponse)(nil),               // 19: broker.CandlesResponse
+	(*StreamCandlesRequest)(nil),          // 20: broker.StreamCandlesRequest
+	(*RebuildCandlesRequest)(nil),         // 21: broker.RebuildCandlesRequest
+	(*RebuildCandlesResponse)(nil),        // 22: broker.RebuildCandlesResponse
+	(*PlaceOrderRequest)(nil),             // 23: broker.PlaceOrderRequest
+	(*CancelOrderRequest)(nil),            // 24: broker.CancelOrderRequest
+	(*PriceLevel)(nil),                    // 25: broker.PriceLevel
+	(*MarketDepth)(nil),                   // 26: broker.MarketDepth
+	(*GetMarketDepthRequest)(nil),         // 27: broker.GetMarketDepthRequest
+	(*SubscribeQuotesRequest)(nil),        // 28: broker.SubscribeQuotesRequest
+	(*QuoteUpdate)(nil),                   // 29: broker.QuoteUpdate
+	(*WatchlistItem)(nil),                 // 30: broker.WatchlistItem
+	(*Watchlist)(nil),                     // 31: broker.Watchlist
+	(*WatchlistsResponse)(nil),            // 32: broker.WatchlistsResponse
+	(*CreateWatchlistRequest)(nil),        // 33: broker.CreateWatchlistRequest
+	(*WatchlistRequest)(nil),              // 34: broker.WatchlistRequest
+	(*RenameWatchlistRequest)(nil),        // 35: broker.RenameWatchlistRequest
+	(*WatchlistSymbolsRequest)(nil),       // 36: broker.WatchlistSymbolsRequest
+	(*RemoveWatchlistSymbolRequest)(nil),  // 37: broker.RemoveWatchlistSymbolRequest
+	(*Alert)(nil),                         // 38: broker.Alert
+	(*CreateAlertRequest)(nil),            // 39: broker.CreateAlertRequest
+	(*AlertRequest)(nil),                  // 40: broker.AlertRequest
+	(*AlertsResponse)(nil),                // 41: broker.AlertsResponse
+	(*AlertEvent)(nil),                    // 42: broker.AlertEvent
+	(*AlertHistoryRequest)(nil),           // 43: broker.AlertHistoryRequest
+	(*AlertHistoryResponse)(nil),          // 44: broker.AlertHistoryResponse
+	(*Notification)(nil),                  // 45: broker.Notification
+	(*NotificationsRequest)(nil),          // 46: broker.NotificationsRequest
+	(*NotificationsResponse)(nil),         // 47: broker.NotificationsResponse
+	(*MarkNotificationsReadRequest)(nil),  // 48: broker.MarkNotificationsReadRequest
+	(*GetMarketStatusRequest)(nil),        // 49: broker.GetMarketStatusRequest
+	(*MarketStatus)(nil),                  // 50: broker.MarketStatus
+	(*MarketStatusResponse)(nil),          // 51: broker.MarketStatusResponse
+	(*Lot)(nil),                           // 52: broker.Lot
+	(*GetLotsRequest)(nil),                // 53: broker.GetLotsRequest
+	(*LotsResponse)(nil),                  // 54: broker.LotsResponse
+	(*ClosedLot)(nil),                     // 55: broker.ClosedLot
+	(*ClosedLotsResponse)(nil),            // 56: broker.ClosedLotsResponse
+	(*CorporateAction)(nil),               // 57: broker.CorporateAction
+	(*ListCorporateActionsRequest)(nil),   // 58: broker.ListCorporateActionsRequest
+	(*CreateCorporateActionsRequest)(nil), // 59: broker.CreateCorporateActionsRequest
+	(*CorporateActionsResponse)(nil),      // 60: broker.CorporateActionsResponse
+	(*Adjustment)(nil),                    // 61: broker.Adjustment
+	(*AdjustmentsResponse)(nil),           // 62: broker.AdjustmentsResponse
+	(*CashEntry)(nil),                     // 63: broker.CashEntry
+	(*CashLedgerResponse)(nil),            // 64: broker.CashLedgerResponse
+	(*SettlementResult)(nil),              // 65: broker.SettlementResult
+	(*SettlementRun)(nil),                 // 66: broker.SettlementRun
+	(*ListSettlementRunsRequest)(nil),     // 67: broker.ListSettlementRunsRequest
+	(*SettlementRunsResponse)(nil),        // 68: broker.SettlementRunsResponse
+	(*CashRequest)(nil),                   // 69: broker.CashRequest
+	(*SnapshotHolding)(nil),               // 70: broker.SnapshotHolding
+	(*PortfolioSnapshot)(nil),             // 71: broker.PortfolioSnapshot
+	(*PortfolioRangeRequest)(nil),         // 72: broker.PortfolioRangeRequest
+	(*PortfolioHistoryResponse)(nil),      // 73: broker.PortfolioHistoryResponse
+	(*Performance)(nil),                   // 74: broker.Performance
+	(*ReportRequest)(nil),                 // 75: broker.ReportRequest
+	(*ReportFile)(nil),                    // 76: broker.ReportFile
+	(*CapitalGainsRequest)(nil),           // 77: broker.CapitalGainsRequest
+	(*InstrumentGains)(nil),               // 78: broker.InstrumentGains
+	(*GainEntry)(nil),                     // 79: broker.GainEntry
+	(*CapitalGainsReport)(nil),            // 80: broker.CapitalGainsReport
+	(*CalculateChargesRequest)(nil),       // 81: broker.CalculateChargesRequest
+	(*Charge)(nil),                        // 82: broker.Charge
+	(*ChargeEstimate)(nil),                // 83: broker.ChargeEstimate
+	(*FXRatesResponse)(nil),               // 84: broker.FXRatesResponse
+	(*ConvertCurrencyRequest)(nil),        // 85: broker.ConvertCurrencyRequest
+	(*FXConversion)(nil),                  // 86: broker.FXConversion
+	(*HouseAccountResponse)(nil),          // 87: broker.HouseAccountResponse
+	(*Locate)(nil),                        // 88: broker.Locate
+	(*LocatesResponse)(nil),               // 89: broker.LocatesResponse
+	(*SetLocatesRequest)(nil),             // 90: broker.SetLocatesRequest
+	(*ShortPosition)(nil),                 // 91: broker.ShortPosition
+	(*ShortPositionsResponse)(nil),        // 92: broker.ShortPositionsResponse
+	(*SetMarginRequest)(nil),              // 93: broker.SetMarginRequest
+	(*SetMarginResponse)(nil),             // 94: broker.SetMarginResponse
+	(*BasketRequest)(nil),                 // 95: broker.BasketRequest
+	(*Basket)(nil),                        // 96: broker.Basket
+	(*BasketLeg)(nil),                     // 97: broker.BasketLeg
+	(*BasketFunds)(nil),                   // 98: broker.BasketFunds
+	(*BasketResponse)(nil),                // 99: broker.BasketResponse
+	(*BasketsResponse)(nil),               // 100: broker.BasketsResponse
+	(*GetBasketRequest)(nil),              // 101: broker.GetBasketRequest
+	(*RebalanceTarget)(nil),               // 102: broker.RebalanceTarget
+	(*RebalanceRequest)(nil),              // 103: broker.RebalanceRequest
+	(*RebalanceLeg)(nil),                  // 104: broker.RebalanceLeg
+	(*RebalancePlan)(nil),                 // 105: broker.RebalancePlan
+	(*Algo)(nil),                          // 106: broker.Algo
+	(*AlgoOrderRequest)(nil),              // 107: broker.AlgoOrderRequest
+	(*AlgoOrderID)(nil),                   // 108: broker.AlgoOrderID
+	(*AlgoOrderResponse)(nil),             // 109: broker.AlgoOrderResponse
+	(*Greeks)(nil),                        // 110: broker.Greeks
+	(*OptionQuote)(nil),                   // 111: broker.OptionQuote
+	(*OptionStrike)(nil),                  // 112: broker.OptionStrike
+	(*GetOptionChainRequest)(nil),         // 113: broker.GetOptionChainRequest
+	(*OptionChain)(nil),                   // 114: broker.OptionChain
+	(*Fund)(nil),                          // 115: broker.Fund
+	(*FundsResponse)(nil),                 // 116: broker.FundsResponse
+	(*Nav)(nil),                           // 117: broker.Nav
+	(*GetFundRequest)(nil),                // 118: broker.GetFundRequest
+	(*FundResponse)(nil),                  // 119: broker.FundResponse
+	(*PostNavsRequest)(nil),               // 120: broker.PostNavsRequest
+	(*PostNavsResponse)(nil),              // 121: broker.PostNavsResponse
+	(*FundOrderRequest)(nil),              // 122: broker.FundOrderRequest
+	(*FundOrder)(nil),                     // 123: broker.FundOrder
+	(*FundOrderID)(nil),                   // 124: broker.FundOrderID
+	(*FundOrdersResponse)(nil),            // 125: broker.FundOrdersResponse
+	(*SipRequest)(nil),                    // 126: broker.SipRequest
+	(*Sip)(nil),                           // 127: broker.Sip
+	(*SipID)(nil),                         // 128: broker.SipID
+	(*SipsResponse)(nil),                  // 129: broker.SipsResponse
+	(*StrategyLimits)(nil),                // 130: broker.StrategyLimits
+	(*StrategyPosition)(nil),              // 131: broker.StrategyPosition
+	(*Strategy)(nil),                      // 132: broker.Strategy
+	(*StartStrategyRequest)(nil),          // 133: broker.StartStrategyRequest
+	(*StrategyID)(nil),                    // 134: broker.StrategyID
+	(*StrategiesResponse)(nil),            // 135: broker.StrategiesResponse
+	(*StrategyTypesResponse)(nil),         // 136: broker.StrategyTypesResponse
+	(*KillAllStrategiesResponse)(nil),     // 137: broker.KillAllStrategiesResponse
+	(*Account)(nil),                       // 138: broker.Account
+	(*ResetBalanceRequest)(nil),           // 139: broker.ResetBalanceRequest
+	nil,                                   // 140: broker.CashLedgerResponse.BalancesEntry
+	nil,                                   // 141: broker.FXRatesResponse.RatesEntry
+	nil,                                   // 142: broker.Strategy.ParamsEntry
+	nil,                                   // 143: broker.StartStrategyRequest.ParamsEntry
+	(*timestamppb.Timestamp)(nil),         // 144: google.protobuf.Timestamp
 }
 var file_broker_proto_depIdxs = []int32{
-	5,  // 0: broker.HoldingsResponse.holdings:type_name -> broker.Holding
-	7,  // 1: broker.OrderbookResponse.orders:type_name -> broker.Order
-	9,  // 2: broker.PositionsResponse.positions:type_name -> broker.Position
-	1,  // 3: broker.Broker.Signup:input_type -> broker.SignupRequest
-	2,  // 4: broker.Broker.Login:input_type -> broker.LoginRequest
-	3,  // 5: broker.Broker.Refresh:input_type -> broker.RefreshRequest
-	0,  // 6: broker.Broker.GetHoldings:input_type -> broker.Empty
-	0,  // 7: broker.Broker.GetOrderbook:input_type -> broker.Empty
-	0,  // 8: broker.Broker.GetPositions:input_type -> broker.Empty
-	0,  // 9: broker.Broker.Signup:output_type -> broker.Empty
-	4,  // 10: broker.Broker.Login:output_type -> broker.AuthResponse
-	4,  // 11: broker.Broker.Refresh:output_type -> broker.AuthResponse
-	6,  // 12: broker.Broker.GetHoldings:output_type -> broker.HoldingsResponse
-	8,  // 13: broker.Broker.GetOrderbook:output_type -> broker.OrderbookResponse
-	10, // 14: broker.Broker.GetPositions:output_type -> broker.PositionsResponse
-	9,  // [9:15] is the sub-list for method output_type
-	3,  // [3:9] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	5,   // 0: broker.HoldingsResponse.holdings:type_name -> broker.Holding
+	144, // 1: broker.Order.created_at:type_name -> google.protobuf.Timestamp
+	144, // 2: broker.Order.expires_at:type_name -> google.protobuf.Timestamp
+	106, // 3: broker.Order.algo:type_name -> broker.Algo
+	7,   // 4: broker.OrderbookResponse.orders:type_name -> broker.Order
+	8,   // 5: broker.OrderbookResponse.card:type_name -> broker.PnlCard
+	10,  // 6: broker.PositionsResponse.positions:type_name -> broker.Position
+	8,   // 7: broker.PositionsResponse.card:type_name -> broker.PnlCard
+	144, // 8: broker.Instrument.expiry:type_name -> google.protobuf.Timestamp
+	12,  // 9: broker.InstrumentsResponse.instruments:type_name -> broker.Instrument
+	144, // 10: broker.Candle.start:type_name -> google.protobuf.Timestamp
+	144, // 11: broker.GetCandlesRequest.from:type_name -> google.protobuf.Timestamp
+	144, // 12: broker.GetCandlesRequest.to:type_name -> google.protobuf.Timestamp
+	17,  // 13: broker.CandlesResponse.candles:type_name -> broker.Candle
+	144, // 14: broker.RebuildCandlesRequest.from:type_name -> google.protobuf.Timestamp
+	144, // 15: broker.RebuildCandlesRequest.to:type_name -> google.protobuf.Timestamp
+	25,  // 16: broker.MarketDepth.bids:type_name -> broker.PriceLevel
+	25,  // 17: broker.MarketDepth.asks:type_name -> broker.PriceLevel
+	25,  // 18: broker.QuoteUpdate.bids:type_name -> broker.PriceLevel
+	25,  // 19: broker.QuoteUpdate.asks:type_name -> broker.PriceLevel
+	144, // 20: broker.QuoteUpdate.time:type_name -> google.protobuf.Timestamp
+	30,  // 21: broker.Watchlist.items:type_name -> broker.WatchlistItem
+	144, // 22: broker.Watchlist.created_at:type_name -> google.protobuf.Timestamp
+	144, // 23: broker.Watchlist.updated_at:type_name -> google.protobuf.Timestamp
+	31,  // 24: broker.WatchlistsResponse.watchlists:type_name -> broker.Watchlist
+	144, // 25: broker.Alert.last_triggered_at:type_name -> google.protobuf.Timestamp
+	144, // 26: broker.Alert.created_at:type_name -> google.protobuf.Timestamp
+	38,  // 27: broker.AlertsResponse.alerts:type_name -> broker.Alert
+	144, // 28: broker.AlertEvent.time:type_name -> google.protobuf.Timestamp
+	42,  // 29: broker.AlertHistoryResponse.events:type_name -> broker.AlertEvent
+	144, // 30: broker.Notification.created_at:type_name -> google.protobuf.Timestamp
+	45,  // 31: broker.NotificationsResponse.notifications:type_name -> broker.Notification
+	144, // 32: broker.MarketStatus.next_open:type_name -> google.protobuf.Timestamp
+	144, // 33: broker.MarketStatus.next_close:type_name -> google.protobuf.Timestamp
+	50,  // 34: broker.MarketStatusResponse.statuses:type_name -> broker.MarketStatus
+	144, // 35: broker.Lot.acquired_at:type_name -> google.protobuf.Timestamp
+	144, // 36: broker.Lot.settle_date:type_name -> google.protobuf.Timestamp
+	52,  // 37: broker.LotsResponse.lots:type_name -> broker.Lot
+	144, // 38: broker.ClosedLot.acquired_at:type_name -> google.protobuf.Timestamp
+	144, // 39: broker.ClosedLot.closed_at:type_name -> google.protobuf.Timestamp
+	55,  // 40: broker.ClosedLotsResponse.closed_lots:type_name -> broker.ClosedLot
+	8,   // 41: broker.ClosedLotsResponse.card:type_name -> broker.PnlCard
+	144, // 42: broker.CorporateAction.ex_date:type_name -> google.protobuf.Timestamp
+	144, // 43: broker.CorporateAction.record_date:type_name -> google.protobuf.Timestamp
+	144, // 44: broker.CorporateAction.applied_at:type_name -> google.protobuf.Timestamp
+	57,  // 45: broker.CreateCorporateActionsRequest.actions:type_name -> broker.CorporateAction
+	57,  // 46: broker.CorporateActionsResponse.actions:type_name -> broker.CorporateAction
+	144, // 47: broker.Adjustment.time:type_name -> google.protobuf.Timestamp
+	61,  // 48: broker.AdjustmentsResponse.adjustments:type_name -> broker.Adjustment
+	144, // 49: broker.CashEntry.time:type_name -> google.protobuf.Timestamp
+	63,  // 50: broker.CashLedgerResponse.entries:type_name -> broker.CashEntry
+	140, // 51: broker.CashLedgerResponse.balances:type_name -> broker.CashLedgerResponse.BalancesEntry
+	144, // 52: broker.SettlementRun.ran_at:type_name -> google.protobuf.Timestamp
+	65,  // 53: broker.SettlementRun.results:type_name -> broker.SettlementResult
+	66,  // 54: broker.SettlementRunsResponse.runs:type_name -> broker.SettlementRun
+	144, // 55: broker.PortfolioSnapshot.time:type_name -> google.protobuf.Timestamp
+	70,  // 56: broker.PortfolioSnapshot.holdings:type_name -> broker.SnapshotHolding
+	71,  // 57: broker.PortfolioHistoryResponse.snapshots:type_name -> broker.PortfolioSnapshot
+	144, // 58: broker.Performance.from:type_name -> google.protobuf.Timestamp
+	144, // 59: broker.Performance.to:type_name -> google.protobuf.Timestamp
+	144, // 60: broker.Performance.drawdown_peak:type_name -> google.protobuf.Timestamp
+	144, // 61: broker.Performance.drawdown_low:type_name -> google.protobuf.Timestamp
+	144, // 62: broker.GainEntry.acquired_at:type_name -> google.protobuf.Timestamp
+	144, // 63: broker.GainEntry.sold_at:type_name -> google.protobuf.Timestamp
+	78,  // 64: broker.CapitalGainsReport.instruments:type_name -> broker.InstrumentGains
+	79,  // 65: broker.CapitalGainsReport.entries:type_name -> broker.GainEntry
+	82,  // 66: broker.ChargeEstimate.charges:type_name -> broker.Charge
+	141, // 67: broker.FXRatesResponse.rates:type_name -> broker.FXRatesResponse.RatesEntry
+	144, // 68: broker.FXConversion.time:type_name -> google.protobuf.Timestamp
+	5,   // 69: broker.HouseAccountResponse.holdings:type_name -> broker.Holding
+	7,   // 70: broker.HouseAccountResponse.pending:type_name -> broker.Order
+	88,  // 71: broker.LocatesResponse.locates:type_name -> broker.Locate
+	88,  // 72: broker.SetLocatesRequest.locates:type_name -> broker.Locate
+	91,  // 73: broker.ShortPositionsResponse.shorts:type_name -> broker.ShortPosition
+	23,  // 74: broker.BasketRequest.legs:type_name -> broker.PlaceOrderRequest
+	144, // 75: broker.Basket.created_at:type_name -> google.protobuf.Timestamp
+	7,   // 76: broker.Basket.orders:type_name -> broker.Order
+	7,   // 77: broker.BasketLeg.order:type_name -> broker.Order
+	96,  // 78: broker.BasketResponse.basket:type_name -> broker.Basket
+	97,  // 79: broker.BasketResponse.legs:type_name -> broker.BasketLeg
+	98,  // 80: broker.BasketResponse.funds:type_name -> broker.BasketFunds
+	96,  // 81: broker.BasketsResponse.baskets:type_name -> broker.Basket
+	102, // 82: broker.RebalanceRequest.targets:type_name -> broker.RebalanceTarget
+	104, // 83: broker.RebalancePlan.legs:type_name -> broker.RebalanceLeg
+	96,  // 84: broker.RebalancePlan.basket:type_name -> broker.Basket
+	144, // 85: broker.Algo.start_at:type_name -> google.protobuf.Timestamp
+	144, // 86: broker.Algo.end_at:type_name -> google.protobuf.Timestamp
+	144, // 87: broker.AlgoOrderRequest.start_at:type_name -> google.protobuf.Timestamp
+	144, // 88: broker.AlgoOrderRequest.end_at:type_name -> google.protobuf.Timestamp
+	7,   // 89: broker.AlgoOrderResponse.parent:type_name -> broker.Order
+	7,   // 90: broker.AlgoOrderResponse.children:type_name -> broker.Order
+	110, // 91: broker.OptionQuote.greeks:type_name -> broker.Greeks
+	111, // 92: broker.OptionStrike.call:type_name -> broker.OptionQuote
+	111, // 93: broker.OptionStrike.put:type_name -> broker.OptionQuote
+	144, // 94: broker.OptionChain.expiry:type_name -> google.protobuf.Timestamp
+	144, // 95: broker.OptionChain.expiries:type_name -> google.protobuf.Timestamp
+	112, // 96: broker.OptionChain.strikes:type_name -> broker.OptionStrike
+	115, // 97: broker.FundsResponse.funds:type_name -> broker.Fund
+	115, // 98: broker.FundResponse.fund:type_name -> broker.Fund
+	117, // 99: broker.FundResponse.navs:type_name -> broker.Nav
+	117, // 100: broker.PostNavsRequest.navs:type_name -> broker.Nav
+	144, // 101: broker.FundOrder.created_at:type_name -> google.protobuf.Timestamp
+	144, // 102: broker.FundOrder.processed_at:type_name -> google.protobuf.Timestamp
+	123, // 103: broker.FundOrdersResponse.orders:type_name -> broker.FundOrder
+	144, // 104: broker.Sip.created_at:type_name -> google.protobuf.Timestamp
+	127, // 105: broker.SipsResponse.sips:type_name -> broker.Sip
+	142, // 106: broker.Strategy.params:type_name -> broker.Strategy.ParamsEntry
+	130, // 107: broker.Strategy.limits:type_name -> broker.StrategyLimits
+	131, // 108: broker.Strategy.positions:type_name -> broker.StrategyPosition
+	144, // 109: broker.Strategy.started_at:type_name -> google.protobuf.Timestamp
+	144, // 110: broker.Strategy.stopped_at:type_name -> google.protobuf.Timestamp
+	143, // 111: broker.StartStrategyRequest.params:type_name -> broker.StartStrategyRequest.ParamsEntry
+	130, // 112: broker.StartStrategyRequest.limits:type_name -> broker.StrategyLimits
+	132, // 113: broker.StrategiesResponse.strategies:type_name -> broker.Strategy
+	144, // 114: broker.Account.opened_at:type_name -> google.protobuf.Timestamp
+	144, // 115: broker.Account.reset_at:type_name -> google.protobuf.Timestamp
+	1,   // 116: broker.Broker.Signup:input_type -> broker.SignupRequest
+	2,   // 117: broker.Broker.Login:input_type -> broker.LoginRequest
+	3,   // 118: broker.Broker.Refresh:input_type -> broker.RefreshRequest
+	0,   // 119: broker.Broker.GetHoldings:input_type -> broker.Empty
+	0,   // 120: broker.Broker.GetOrderbook:input_type -> broker.Empty
+	0,   // 121: broker.Broker.GetPositions:input_type -> broker.Empty
+	13,  // 122: broker.Broker.ListInstruments:input_type -> broker.ListInstrumentsRequest
+	14,  // 123: broker.Broker.GetInstrument:input_type -> broker.GetInstrumentRequest
+	15,  // 124: broker.Broker.SearchInstruments:input_type -> broker.SearchInstrumentsRequest
+	18,  // 125: broker.Broker.GetCandles:input_type -> broker.GetCandlesRequest
+	20,  // 126: broker.Broker.StreamCandles:input_type -> broker.StreamCandlesRequest
+	21,  // 127: broker.Broker.RebuildCandles:input_type -> broker.RebuildCandlesRequest
+	23,  // 128: broker.Broker.PlaceOrder:input_type -> broker.PlaceOrderRequest
+	24,  // 129: broker.Broker.CancelOrder:input_type -> broker.CancelOrderRequest
+	27,  // 130: broker.Broker.GetMarketDepth:input_type -> broker.GetMarketDepthRequest
+	28,  // 131: broker.Broker.SubscribeQuotes:input_type -> broker.SubscribeQuotesRequest
+	0,   // 132: broker.Broker.ListWatchlists:input_type -> broker.Empty
+	34,  // 133: broker.Broker.GetWatchlist:input_type -> broker.WatchlistRequest
+	33,  // 134: broker.Broker.CreateWatchlist:input_type -> broker.CreateWatchlistRequest
+	35,  // 135: broker.Broker.RenameWatchlist:input_type -> broker.RenameWatchlistRequest
+	36,  // 136: broker.Broker.AddWatchlistSymbols:input_type -> broker.WatchlistSymbolsRequest
+	36,  // 137: broker.Broker.ReorderWatchlist:input_type -> broker.WatchlistSymbolsRequest
+	37,  // 138: broker.Broker.RemoveWatchlistSymbol:input_type -> broker.RemoveWatchlistSymbolRequest
+	34,  // 139: broker.Broker.DeleteWatchlist:input_type -> broker.WatchlistRequest
+	39,  // 140: broker.Broker.CreateAlert:input_type -> broker.CreateAlertRequest
+	0,   // 141: broker.Broker.ListAlerts:input_type -> broker.Empty
+	40,  // 142: broker.Broker.DeleteAlert:input_type -> broker.AlertRequest
+	40,  // 143: broker.Broker.RearmAlert:input_type -> broker.AlertRequest
+	43,  // 144: broker.Broker.GetAlertHistory:input_type -> broker.AlertHistoryRequest
+	46,  // 145: broker.Broker.ListNotifications:input_type -> broker.NotificationsRequest
+	48,  // 146: broker.Broker.MarkNotificationsRead:input_type -> broker.MarkNotificationsReadRequest
+	49,  // 147: broker.Broker.GetMarketStatus:input_type -> broker.GetMarketStatusRequest
+	53,  // 148: broker.Broker.GetLots:input_type -> broker.GetLotsRequest
+	0,   // 149: broker.Broker.GetClosedLots:input_type -> broker.Empty
+	58,  // 150: broker.Broker.ListCorporateActions:input_type -> broker.ListCorporateActionsRequest
+	59,  // 151: broker.Broker.CreateCorporateActions:input_type -> broker.CreateCorporateActionsRequest
+	0,   // 152: broker.Broker.GetAdjustments:input_type -> broker.Empty
+	0,   // 153: broker.Broker.GetCashLedger:input_type -> broker.Empty
+	67,  // 154: broker.Broker.ListSettlementRuns:input_type -> broker.ListSettlementRunsRequest
+	69,  // 155: broker.Broker.Deposit:input_type -> broker.CashRequest
+	69,  // 156: broker.Broker.Withdraw:input_type -> broker.CashRequest
+	72,  // 157: broker.Broker.GetPortfolioHistory:input_type -> broker.PortfolioRangeRequest
+	72,  // 158: broker.Broker.GetPerformance:input_type -> broker.PortfolioRangeRequest
+	75,  // 159: broker.Broker.GetReport:input_type -> broker.ReportRequest
+	77,  // 160: broker.Broker.GetCapitalGains:input_type -> broker.CapitalGainsRequest
+	81,  // 161: broker.Broker.CalculateCharges:input_type -> broker.CalculateChargesRequest
+	0,   // 162: broker.Broker.GetFXRates:input_type -> broker.Empty
+	85,  // 163: broker.Broker.ConvertCurrency:input_type -> broker.ConvertCurrencyRequest
+	0,   // 164: broker.Broker.GetHouseAccount:input_type -> broker.Empty
+	0,   // 165: broker.Broker.ListLocates:input_type -> broker.Empty
+	0,   // 166: broker.Broker.GetShortPositions:input_type -> broker.Empty
+	90,  // 167: broker.Broker.SetLocates:input_type -> broker.SetLocatesRequest
+	93,  // 168: broker.Broker.SetMargin:input_type -> broker.SetMarginRequest
+	95,  // 169: broker.Broker.PlaceBasket:input_type -> broker.BasketRequest
+	95,  // 170: broker.Broker.ValidateBasket:input_type -> broker.BasketRequest
+	0,   // 171: broker.Broker.ListBaskets:input_type -> broker.Empty
+	101, // 172: broker.Broker.GetBasket:input_type -> broker.GetBasketRequest
+	103, // 173: broker.Broker.Rebalance:input_type -> broker.RebalanceRequest
+	107, // 174: broker.Broker.CreateAlgoOrder:input_type -> broker.AlgoOrderRequest
+	108, // 175: broker.Broker.GetAlgoOrder:input_type -> broker.AlgoOrderID
+	108, // 176: broker.Broker.PauseAlgoOrder:input_type -> broker.AlgoOrderID
+	108, // 177: broker.Broker.ResumeAlgoOrder:input_type -> broker.AlgoOrderID
+	108, // 178: broker.Broker.CancelAlgoOrder:input_type -> broker.AlgoOrderID
+	113, // 179: broker.Broker.GetOptionChain:input_type -> broker.GetOptionChainRequest
+	0,   // 180: broker.Broker.ListFunds:input_type -> broker.Empty
+	118, // 181: broker.Broker.GetFund:input_type -> broker.GetFundRequest
+	120, // 182: broker.Broker.PostNavs:input_type -> broker.PostNavsRequest
+	122, // 183: broker.Broker.PlaceFundOrder:input_type -> broker.FundOrderRequest
+	0,   // 184: broker.Broker.ListFundOrders:input_type -> broker.Empty
+	124, // 185: broker.Broker.CancelFundOrder:input_type -> broker.FundOrderID
+	126, // 186: broker.Broker.CreateSip:input_type -> broker.SipRequest
+	0,   // 187: broker.Broker.ListSips:input_type -> broker.Empty
+	128, // 188: broker.Broker.PauseSip:input_type -> broker.SipID
+	128, // 189: broker.Broker.ResumeSip:input_type -> broker.SipID
+	128, // 190: broker.Broker.SkipSip:input_type -> broker.SipID
+	128, // 191: broker.Broker.CancelSip:input_type -> broker.SipID
+	0,   // 192: broker.Broker.ListStrategyTypes:input_type -> broker.Empty
+	133, // 193: broker.Broker.StartStrategy:input_type -> broker.StartStrategyRequest
+	0,   // 194: broker.Broker.ListStrategies:input_type -> broker.Empty
+	134, // 195: broker.Broker.GetStrategy:input_type -> broker.StrategyID
+	134, // 196: broker.Broker.StopStrategy:input_type -> broker.StrategyID
+	134, // 197: broker.Broker.KillStrategy:input_type -> broker.StrategyID
+	0,   // 198: broker.Broker.KillAllStrategies:input_type -> broker.Empty
+	0,   // 199: broker.Broker.GetAccount:input_type -> broker.Empty
+	139, // 200: broker.Broker.ResetBalance:input_type -> broker.ResetBalanceRequest
+	0,   // 201: broker.Broker.Signup:output_type -> broker.Empty
+	4,   // 202: broker.Broker.Login:output_type -> broker.AuthResponse
+	4,   // 203: broker.Broker.Refresh:output_type -> broker.AuthResponse
+	6,   // 204: broker.Broker.GetHoldings:output_type -> broker.HoldingsResponse
+	9,   // 205: broker.Broker.GetOrderbook:output_type -> broker.OrderbookResponse
+	11,  // 206: broker.Broker.GetPositions:output_type -> broker.PositionsResponse
+	16,  // 207: broker.Broker.ListInstruments:output_type -> broker.InstrumentsResponse
+	12,  // 208: broker.Broker.GetInstrument:output_type -> broker.Instrument
+	16,  // 209: broker.Broker.SearchInstruments:output_type -> broker.InstrumentsResponse
+	19,  // 210: broker.Broker.GetCandles:output_type -> broker.CandlesResponse
+	17,  // 211: broker.Broker.StreamCandles:output_type -> broker.Candle
+	22,  // 212: broker.Broker.RebuildCandles:output_type -> broker.RebuildCandlesResponse
+	7,   // 213: broker.Broker.PlaceOrder:output_type -> broker.Order
+	7,   // 214: broker.Broker.CancelOrder:output_type -> broker.Order
+	26,  // 215: broker.Broker.GetMarketDepth:output_type -> broker.MarketDepth
+	29,  // 216: broker.Broker.SubscribeQuotes:output_type -> broker.QuoteUpdate
+	32,  // 217: broker.Broker.ListWatchlists:output_type -> broker.WatchlistsResponse
+	31,  // 218: broker.Broker.GetWatchlist:output_type -> broker.Watchlist
+	31,  // 219: broker.Broker.CreateWatchlist:output_type -> broker.Watchlist
+	31,  // 220: broker.Broker.RenameWatchlist:output_type -> broker.Watchlist
+	31,  // 221: broker.Broker.AddWatchlistSymbols:output_type -> broker.Watchlist
+	31,  // 222: broker.Broker.ReorderWatchlist:output_type -> broker.Watchlist
+	31,  // 223: broker.Broker.RemoveWatchlistSymbol:output_type -> broker.Watchlist
+	0,   // 224: broker.Broker.DeleteWatchlist:output_type -> broker.Empty
+	38,  // 225: broker.Broker.CreateAlert:output_type -> broker.Alert
+	41,  // 226: broker.Broker.ListAlerts:output_type -> broker.AlertsResponse
+	0,   // 227: broker.Broker.DeleteAlert:output_type -> broker.Empty
+	38,  // 228: broker.Broker.RearmAlert:output_type -> broker.Alert
+	44,  // 229: broker.Broker.GetAlertHistory:output_type -> broker.AlertHistoryResponse
+	47,  // 230: broker.Broker.ListNotifications:output_type -> broker.NotificationsResponse
+	0,   // 231: broker.Broker.MarkNotificationsRead:output_type -> broker.Empty
+	51,  // 232: broker.Broker.GetMarketStatus:output_type -> broker.MarketStatusResponse
+	54,  // 233: broker.Broker.GetLots:output_type -> broker.LotsResponse
+	56,  // 234: broker.Broker.GetClosedLots:output_type -> broker.ClosedLotsResponse
+	60,  // 235: broker.Broker.ListCorporateActions:output_type -> broker.CorporateActionsResponse
+	60,  // 236: broker.Broker.CreateCorporateActions:output_type -> broker.CorporateActionsResponse
+	62,  // 237: broker.Broker.GetAdjustments:output_type -> broker.AdjustmentsResponse
+	64,  // 238: broker.Broker.GetCashLedger:output_type -> broker.CashLedgerResponse
+	68,  // 239: broker.Broker.ListSettlementRuns:output_type -> broker.SettlementRunsResponse
+	63,  // 240: broker.Broker.Deposit:output_type -> broker.CashEntry
+	63,  // 241: broker.Broker.Withdraw:output_type -> broker.CashEntry
+	73,  // 242: broker.Broker.GetPortfolioHistory:output_type -> broker.PortfolioHistoryResponse
+	74,  // 243: broker.Broker.GetPerformance:output_type -> broker.Performance
+	76,  // 244: broker.Broker.GetReport:output_type -> broker.ReportFile
+	80,  // 245: broker.Broker.GetCapitalGains:output_type -> broker.CapitalGainsReport
+	83,  // 246: broker.Broker.CalculateCharges:output_type -> broker.ChargeEstimate
+	84,  // 247: broker.Broker.GetFXRates:output_type -> broker.FXRatesResponse
+	86,  // 248: broker.Broker.ConvertCurrency:output_type -> broker.FXConversion
+	87,  // 249: broker.Broker.GetHouseAccount:output_type -> broker.HouseAccountResponse
+	89,  // 250: broker.Broker.ListLocates:output_type -> broker.LocatesResponse
+	92,  // 251: broker.Broker.GetShortPositions:output_type -> broker.ShortPositionsResponse
+	89,  // 252: broker.Broker.SetLocates:output_type -> broker.LocatesResponse
+	94,  // 253: broker.Broker.SetMargin:output_type -> broker.SetMarginResponse
+	99,  // 254: broker.Broker.PlaceBasket:output_type -> broker.BasketResponse
+	99,  // 255: broker.Broker.ValidateBasket:output_type -> broker.BasketResponse
+	100, // 256: broker.Broker.ListBaskets:output_type -> broker.BasketsResponse
+	96,  // 257: broker.Broker.GetBasket:output_type -> broker.Basket
+	105, // 258: broker.Broker.Rebalance:output_type -> broker.RebalancePlan
+	7,   // 259: broker.Broker.CreateAlgoOrder:output_type -> broker.Order
+	109, // 260: broker.Broker.GetAlgoOrder:output_type -> broker.AlgoOrderResponse
+	7,   // 261: broker.Broker.PauseAlgoOrder:output_type -> broker.Order
+	7,   // 262: broker.Broker.ResumeAlgoOrder:output_type -> broker.Order
+	7,   // 263: broker.Broker.CancelAlgoOrder:output_type -> broker.Order
+	114, // 264: broker.Broker.GetOptionChain:output_type -> broker.OptionChain
+	116, // 265: broker.Broker.ListFunds:output_type -> broker.FundsResponse
+	119, // 266: broker.Broker.GetFund:output_type -> broker.FundResponse
+	121, // 267: broker.Broker.PostNavs:output_type -> broker.PostNavsResponse
+	123, // 268: broker.Broker.PlaceFundOrder:output_type -> broker.FundOrder
+	125, // 269: broker.Broker.ListFundOrders:output_type -> broker.FundOrdersResponse
+	123, // 270: broker.Broker.CancelFundOrder:output_type -> broker.FundOrder
+	127, // 271: broker.Broker.CreateSip:output_type -> broker.Sip
+	129, // 272: broker.Broker.ListSips:output_type -> broker.SipsResponse
+	127, // 273: broker.Broker.PauseSip:output_type -> broker.Sip
+	127, // 274: broker.Broker.ResumeSip:output_type -> broker.Sip
+	127, // 275: broker.Broker.SkipSip:output_type -> broker.Sip
+	127, // 276: broker.Broker.CancelSip:output_type -> broker.Sip
+	136, // 277: broker.Broker.ListStrategyTypes:output_type -> broker.StrategyTypesResponse
+	132, // 278: broker.Broker.StartStrategy:output_type -> broker.Strategy
+	135, // 279: broker.Broker.ListStrategies:output_type -> broker.StrategiesResponse
+	132, // 280: broker.Broker.GetStrategy:output_type -> broker.Strategy
+	132, // 281: broker.Broker.StopStrategy:output_type -> broker.Strategy
+	132, // 282: broker.Broker.KillStrategy:output_type -> broker.Strategy
+	137, // 283: broker.Broker.KillAllStrategies:output_type -> broker.KillAllStrategiesResponse
+	138, // 284: broker.Broker.GetAccount:output_type -> broker.Account
+	138, // 285: broker.Broker.ResetBalance:output_type -> broker.Account
+	201, // [201:286] is the sub-list for method output_type
+	116, // [116:201] is the sub-list for method input_type
+	116, // [116:116] is the sub-list for extension type_name
+	116, // [116:116] is the sub-list for extension extendee
+	0,   // [0:116] is the sub-list for field type_name
 }
 
 func init() { file_broker_proto_init() }
@@ -720,7 +11633,7 @@ func file_broker_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_broker_proto_rawDesc), len(file_broker_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   144,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
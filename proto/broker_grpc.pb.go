@@ -19,12 +19,91 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Broker_Signup_FullMethodName       = "/broker.Broker/Signup"
-	Broker_Login_FullMethodName        = "/broker.Broker/Login"
-	Broker_Refresh_FullMethodName      = "/broker.Broker/Refresh"
-	Broker_GetHoldings_FullMethodName  = "/broker.Broker/GetHoldings"
-	Broker_GetOrderbook_FullMethodName = "/broker.Broker/GetOrderbook"
-	Broker_GetPositions_FullMethodName = "/broker.Broker/GetPositions"
+	Broker_Signup_FullMethodName                 = "/broker.Broker/Signup"
+	Broker_Login_FullMethodName                  = "/broker.Broker/Login"
+	Broker_Refresh_FullMethodName                = "/broker.Broker/Refresh"
+	Broker_GetHoldings_FullMethodName            = "/broker.Broker/GetHoldings"
+	Broker_GetOrderbook_FullMethodName           = "/broker.Broker/GetOrderbook"
+	Broker_GetPositions_FullMethodName           = "/broker.Broker/GetPositions"
+	Broker_ListInstruments_FullMethodName        = "/broker.Broker/ListInstruments"
+	Broker_GetInstrument_FullMethodName          = "/broker.Broker/GetInstrument"
+	Broker_SearchInstruments_FullMethodName      = "/broker.Broker/SearchInstruments"
+	Broker_GetCandles_FullMethodName             = "/broker.Broker/GetCandles"
+	Broker_StreamCandles_FullMethodName          = "/broker.Broker/StreamCandles"
+	Broker_RebuildCandles_FullMethodName         = "/broker.Broker/RebuildCandles"
+	Broker_PlaceOrder_FullMethodName             = "/broker.Broker/PlaceOrder"
+	Broker_CancelOrder_FullMethodName            = "/broker.Broker/CancelOrder"
+	Broker_GetMarketDepth_FullMethodName         = "/broker.Broker/GetMarketDepth"
+	Broker_SubscribeQuotes_FullMethodName        = "/broker.Broker/SubscribeQuotes"
+	Broker_ListWatchlists_FullMethodName         = "/broker.Broker/ListWatchlists"
+	Broker_GetWatchlist_FullMethodName           = "/broker.Broker/GetWatchlist"
+	Broker_CreateWatchlist_FullMethodName        = "/broker.Broker/CreateWatchlist"
+	Broker_RenameWatchlist_FullMethodName        = "/broker.Broker/RenameWatchlist"
+	Broker_AddWatchlistSymbols_FullMethodName    = "/broker.Broker/AddWatchlistSymbols"
+	Broker_ReorderWatchlist_FullMethodName       = "/broker.Broker/ReorderWatchlist"
+	Broker_RemoveWatchlistSymbol_FullMethodName  = "/broker.Broker/RemoveWatchlistSymbol"
+	Broker_DeleteWatchlist_FullMethodName        = "/broker.Broker/DeleteWatchlist"
+	Broker_CreateAlert_FullMethodName            = "/broker.Broker/CreateAlert"
+	Broker_ListAlerts_FullMethodName             = "/broker.Broker/ListAlerts"
+	Broker_DeleteAlert_FullMethodName            = "/broker.Broker/DeleteAlert"
+	Broker_RearmAlert_FullMethodName             = "/broker.Broker/RearmAlert"
+	Broker_GetAlertHistory_FullMethodName        = "/broker.Broker/GetAlertHistory"
+	Broker_ListNotifications_FullMethodName      = "/broker.Broker/ListNotifications"
+	Broker_MarkNotificationsRead_FullMethodName  = "/broker.Broker/MarkNotificationsRead"
+	Broker_GetMarketStatus_FullMethodName        = "/broker.Broker/GetMarketStatus"
+	Broker_GetLots_FullMethodName                = "/broker.Broker/GetLots"
+	Broker_GetClosedLots_FullMethodName          = "/broker.Broker/GetClosedLots"
+	Broker_ListCorporateActions_FullMethodName   = "/broker.Broker/ListCorporateActions"
+	Broker_CreateCorporateActions_FullMethodName = "/broker.Broker/CreateCorporateActions"
+	Broker_GetAdjustments_FullMethodName         = "/broker.Broker/GetAdjustments"
+	Broker_GetCashLedger_FullMethodName          = "/broker.Broker/GetCashLedger"
+	Broker_ListSettlementRuns_FullMethodName     = "/broker.Broker/ListSettlementRuns"
+	Broker_Deposit_FullMethodName                = "/broker.Broker/Deposit"
+	Broker_Withdraw_FullMethodName               = "/broker.Broker/Withdraw"
+	Broker_GetPortfolioHistory_FullMethodName    = "/broker.Broker/GetPortfolioHistory"
+	Broker_GetPerformance_FullMethodName         = "/broker.Broker/GetPerformance"
+	Broker_GetReport_FullMethodName              = "/broker.Broker/GetReport"
+	Broker_GetCapitalGains_FullMethodName        = "/broker.Broker/GetCapitalGains"
+	Broker_CalculateCharges_FullMethodName       = "/broker.Broker/CalculateCharges"
+	Broker_GetFXRates_FullMethodName             = "/broker.Broker/GetFXRates"
+	Broker_ConvertCurrency_FullMethodName        = "/broker.Broker/ConvertCurrency"
+	Broker_GetHouseAccount_FullMethodName        = "/broker.Broker/GetHouseAccount"
+	Broker_ListLocates_FullMethodName            = "/broker.Broker/ListLocates"
+	Broker_GetShortPositions_FullMethodName      = "/broker.Broker/GetShortPositions"
+	Broker_SetLocates_FullMethodName             = "/broker.Broker/SetLocates"
+	Broker_SetMargin_FullMethodName              = "/broker.Broker/SetMargin"
+	Broker_PlaceBasket_FullMethodName            = "/broker.Broker/PlaceBasket"
+	Broker_ValidateBasket_FullMethodName         = "/broker.Broker/ValidateBasket"
+	Broker_ListBaskets_FullMethodName            = "/broker.Broker/ListBaskets"
+	Broker_GetBasket_FullMethodName              = "/broker.Broker/GetBasket"
+	Broker_Rebalance_FullMethodName              = "/broker.Broker/Rebalance"
+	Broker_CreateAlgoOrder_FullMethodName        = "/broker.Broker/CreateAlgoOrder"
+	Broker_GetAlgoOrder_FullMethodName           = "/broker.Broker/GetAlgoOrder"
+	Broker_PauseAlgoOrder_FullMethodName         = "/broker.Broker/PauseAlgoOrder"
+	Broker_ResumeAlgoOrder_FullMethodName        = "/broker.Broker/ResumeAlgoOrder"
+	Broker_CancelAlgoOrder_FullMethodName        = "/broker.Broker/CancelAlgoOrder"
+	Broker_GetOptionChain_FullMethodName         = "/broker.Broker/GetOptionChain"
+	Broker_ListFunds_FullMethodName              = "/broker.Broker/ListFunds"
+	Broker_GetFund_FullMethodName                = "/broker.Broker/GetFund"
+	Broker_PostNavs_FullMethodName               = "/broker.Broker/PostNavs"
+	Broker_PlaceFundOrder_FullMethodName         = "/broker.Broker/PlaceFundOrder"
+	Broker_ListFundOrders_FullMethodName         = "/broker.Broker/ListFundOrders"
+	Broker_CancelFundOrder_FullMethodName        = "/broker.Broker/CancelFundOrder"
+	Broker_CreateSip_FullMethodName              = "/broker.Broker/CreateSip"
+	Broker_ListSips_FullMethodName               = "/broker.Broker/ListSips"
+	Broker_PauseSip_FullMethodName               = "/broker.Broker/PauseSip"
+	Broker_ResumeSip_FullMethodName              = "/broker.Broker/ResumeSip"
+	Broker_SkipSip_FullMethodName                = "/broker.Broker/SkipSip"
+	Broker_CancelSip_FullMethodName              = "/broker.Broker/CancelSip"
+	Broker_ListStrategyTypes_FullMethodName      = "/broker.Broker/ListStrategyTypes"
+	Broker_StartStrategy_FullMethodName          = "/broker.Broker/StartStrategy"
+	Broker_ListStrategies_FullMethodName         = "/broker.Broker/ListStrategies"
+	Broker_GetStrategy_FullMethodName            = "/broker.Broker/GetStrategy"
+	Broker_StopStrategy_FullMethodName           = "/broker.Broker/StopStrategy"
+	Broker_KillStrategy_FullMethodName           = "/broker.Broker/KillStrategy"
+	Broker_KillAllStrategies_FullMethodName      = "/broker.Broker/KillAllStrategies"
+	Broker_GetAccount_FullMethodName             = "/broker.Broker/GetAccount"
+	Broker_ResetBalance_FullMethodName           = "/broker.Broker/ResetBalance"
 )
 
 // BrokerClient is the client API for Broker service.
@@ -37,6 +116,85 @@ type BrokerClient interface {
 	GetHoldings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HoldingsResponse, error)
 	GetOrderbook(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*OrderbookResponse, error)
 	GetPositions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PositionsResponse, error)
+	ListInstruments(ctx context.Context, in *ListInstrumentsRequest, opts ...grpc.CallOption) (*InstrumentsResponse, error)
+	GetInstrument(ctx context.Context, in *GetInstrumentRequest, opts ...grpc.CallOption) (*Instrument, error)
+	SearchInstruments(ctx context.Context, in *SearchInstrumentsRequest, opts ...grpc.CallOption) (*InstrumentsResponse, error)
+	GetCandles(ctx context.Context, in *GetCandlesRequest, opts ...grpc.CallOption) (*CandlesResponse, error)
+	StreamCandles(ctx context.Context, in *StreamCandlesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Candle], error)
+	RebuildCandles(ctx context.Context, in *RebuildCandlesRequest, opts ...grpc.CallOption) (*RebuildCandlesResponse, error)
+	PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*Order, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*Order, error)
+	GetMarketDepth(ctx context.Context, in *GetMarketDepthRequest, opts ...grpc.CallOption) (*MarketDepth, error)
+	SubscribeQuotes(ctx context.Context, in *SubscribeQuotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QuoteUpdate], error)
+	ListWatchlists(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*WatchlistsResponse, error)
+	GetWatchlist(ctx context.Context, in *WatchlistRequest, opts ...grpc.CallOption) (*Watchlist, error)
+	CreateWatchlist(ctx context.Context, in *CreateWatchlistRequest, opts ...grpc.CallOption) (*Watchlist, error)
+	RenameWatchlist(ctx context.Context, in *RenameWatchlistRequest, opts ...grpc.CallOption) (*Watchlist, error)
+	AddWatchlistSymbols(ctx context.Context, in *WatchlistSymbolsRequest, opts ...grpc.CallOption) (*Watchlist, error)
+	ReorderWatchlist(ctx context.Context, in *WatchlistSymbolsRequest, opts ...grpc.CallOption) (*Watchlist, error)
+	RemoveWatchlistSymbol(ctx context.Context, in *RemoveWatchlistSymbolRequest, opts ...grpc.CallOption) (*Watchlist, error)
+	DeleteWatchlist(ctx context.Context, in *WatchlistRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreateAlert(ctx context.Context, in *CreateAlertRequest, opts ...grpc.CallOption) (*Alert, error)
+	ListAlerts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AlertsResponse, error)
+	DeleteAlert(ctx context.Context, in *AlertRequest, opts ...grpc.CallOption) (*Empty, error)
+	RearmAlert(ctx context.Context, in *AlertRequest, opts ...grpc.CallOption) (*Alert, error)
+	GetAlertHistory(ctx context.Context, in *AlertHistoryRequest, opts ...grpc.CallOption) (*AlertHistoryResponse, error)
+	ListNotifications(ctx context.Context, in *NotificationsRequest, opts ...grpc.CallOption) (*NotificationsResponse, error)
+	MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetMarketStatus(ctx context.Context, in *GetMarketStatusRequest, opts ...grpc.CallOption) (*MarketStatusResponse, error)
+	GetLots(ctx context.Context, in *GetLotsRequest, opts ...grpc.CallOption) (*LotsResponse, error)
+	GetClosedLots(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ClosedLotsResponse, error)
+	ListCorporateActions(ctx context.Context, in *ListCorporateActionsRequest, opts ...grpc.CallOption) (*CorporateActionsResponse, error)
+	CreateCorporateActions(ctx context.Context, in *CreateCorporateActionsRequest, opts ...grpc.CallOption) (*CorporateActionsResponse, error)
+	GetAdjustments(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AdjustmentsResponse, error)
+	GetCashLedger(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CashLedgerResponse, error)
+	ListSettlementRuns(ctx context.Context, in *ListSettlementRunsRequest, opts ...grpc.CallOption) (*SettlementRunsResponse, error)
+	Deposit(ctx context.Context, in *CashRequest, opts ...grpc.CallOption) (*CashEntry, error)
+	Withdraw(ctx context.Context, in *CashRequest, opts ...grpc.CallOption) (*CashEntry, error)
+	GetPortfolioHistory(ctx context.Context, in *PortfolioRangeRequest, opts ...grpc.CallOption) (*PortfolioHistoryResponse, error)
+	GetPerformance(ctx context.Context, in *PortfolioRangeRequest, opts ...grpc.CallOption) (*Performance, error)
+	GetReport(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*ReportFile, error)
+	GetCapitalGains(ctx context.Context, in *CapitalGainsRequest, opts ...grpc.CallOption) (*CapitalGainsReport, error)
+	CalculateCharges(ctx context.Context, in *CalculateChargesRequest, opts ...grpc.CallOption) (*ChargeEstimate, error)
+	GetFXRates(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FXRatesResponse, error)
+	ConvertCurrency(ctx context.Context, in *ConvertCurrencyRequest, opts ...grpc.CallOption) (*FXConversion, error)
+	GetHouseAccount(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HouseAccountResponse, error)
+	ListLocates(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LocatesResponse, error)
+	GetShortPositions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ShortPositionsResponse, error)
+	SetLocates(ctx context.Context, in *SetLocatesRequest, opts ...grpc.CallOption) (*LocatesResponse, error)
+	SetMargin(ctx context.Context, in *SetMarginRequest, opts ...grpc.CallOption) (*SetMarginResponse, error)
+	PlaceBasket(ctx context.Context, in *BasketRequest, opts ...grpc.CallOption) (*BasketResponse, error)
+	ValidateBasket(ctx context.Context, in *BasketRequest, opts ...grpc.CallOption) (*BasketResponse, error)
+	ListBaskets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BasketsResponse, error)
+	GetBasket(ctx context.Context, in *GetBasketRequest, opts ...grpc.CallOption) (*Basket, error)
+	Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalancePlan, error)
+	CreateAlgoOrder(ctx context.Context, in *AlgoOrderRequest, opts ...grpc.CallOption) (*Order, error)
+	GetAlgoOrder(ctx context.Context, in *AlgoOrderID, opts ...grpc.CallOption) (*AlgoOrderResponse, error)
+	PauseAlgoOrder(ctx context.Context, in *AlgoOrderID, opts ...grpc.CallOption) (*Order, error)
+	ResumeAlgoOrder(ctx context.Context, in *AlgoOrderID, opts ...grpc.CallOption) (*Order, error)
+	CancelAlgoOrder(ctx context.Context, in *AlgoOrderID, opts ...grpc.CallOption) (*Order, error)
+	GetOptionChain(ctx context.Context, in *GetOptionChainRequest, opts ...grpc.CallOption) (*OptionChain, error)
+	ListFunds(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FundsResponse, error)
+	GetFund(ctx context.Context, in *GetFundRequest, opts ...grpc.CallOption) (*FundResponse, error)
+	PostNavs(ctx context.Context, in *PostNavsRequest, opts ...grpc.CallOption) (*PostNavsResponse, error)
+	PlaceFundOrder(ctx context.Context, in *FundOrderRequest, opts ...grpc.CallOption) (*FundOrder, error)
+	ListFundOrders(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FundOrdersResponse, error)
+	CancelFundOrder(ctx context.Context, in *FundOrderID, opts ...grpc.CallOption) (*FundOrder, error)
+	CreateSip(ctx context.Context, in *SipRequest, opts ...grpc.CallOption) (*Sip, error)
+	ListSips(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SipsResponse, error)
+	PauseSip(ctx context.Context, in *SipID, opts ...grpc.CallOption) (*Sip, error)
+	ResumeSip(ctx context.Context, in *SipID, opts ...grpc.CallOption) (*Sip, error)
+	SkipSip(ctx context.Context, in *SipID, opts ...grpc.CallOption) (*Sip, error)
+	CancelSip(ctx context.Context, in *SipID, opts ...grpc.CallOption) (*Sip, error)
+	ListStrategyTypes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StrategyTypesResponse, error)
+	StartStrategy(ctx context.Context, in *StartStrategyRequest, opts ...grpc.CallOption) (*Strategy, error)
+	ListStrategies(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StrategiesResponse, error)
+	GetStrategy(ctx context.Context, in *StrategyID, opts ...grpc.CallOption) (*Strategy, error)
+	StopStrategy(ctx context.Context, in *StrategyID, opts ...grpc.CallOption) (*Strategy, error)
+	KillStrategy(ctx context.Context, in *StrategyID, opts ...grpc.CallOption) (*Strategy, error)
+	KillAllStrategies(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*KillAllStrategiesResponse, error)
+	GetAccount(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Account, error)
+	ResetBalance(ctx context.Context, in *ResetBalanceRequest, opts ...grpc.CallOption) (*Account, error)
 }
 
 type brokerClient struct {
@@ -107,6 +265,814 @@ func (c *brokerClient) GetPositions(ctx context.Context, in *Empty, opts ...grpc
 	return out, nil
 }
 
+func (c *brokerClient) ListInstruments(ctx context.Context, in *ListInstrumentsRequest, opts ...grpc.CallOption) (*InstrumentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstrumentsResponse)
+	err := c.cc.Invoke(ctx, Broker_ListInstruments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetInstrument(ctx context.Context, in *GetInstrumentRequest, opts ...grpc.CallOption) (*Instrument, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Instrument)
+	err := c.cc.Invoke(ctx, Broker_GetInstrument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) SearchInstruments(ctx context.Context, in *SearchInstrumentsRequest, opts ...grpc.CallOption) (*InstrumentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstrumentsResponse)
+	err := c.cc.Invoke(ctx, Broker_SearchInstruments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetCandles(ctx context.Context, in *GetCandlesRequest, opts ...grpc.CallOption) (*CandlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CandlesResponse)
+	err := c.cc.Invoke(ctx, Broker_GetCandles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) StreamCandles(ctx context.Context, in *StreamCandlesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Candle], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Broker_ServiceDesc.Streams[0], Broker_StreamCandles_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamCandlesRequest, Candle]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Broker_StreamCandlesClient = grpc.ServerStreamingClient[Candle]
+
+func (c *brokerClient) RebuildCandles(ctx context.Context, in *RebuildCandlesRequest, opts ...grpc.CallOption) (*RebuildCandlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebuildCandlesResponse)
+	err := c.cc.Invoke(ctx, Broker_RebuildCandles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*Order, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Order)
+	err := c.cc.Invoke(ctx, Broker_PlaceOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*Order, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Order)
+	err := c.cc.Invoke(ctx, Broker_CancelOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetMarketDepth(ctx context.Context, in *GetMarketDepthRequest, opts ...grpc.CallOption) (*MarketDepth, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketDepth)
+	err := c.cc.Invoke(ctx, Broker_GetMarketDepth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) SubscribeQuotes(ctx context.Context, in *SubscribeQuotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QuoteUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Broker_ServiceDesc.Streams[1], Broker_SubscribeQuotes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeQuotesRequest, QuoteUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Broker_SubscribeQuotesClient = grpc.ServerStreamingClient[QuoteUpdate]
+
+func (c *brokerClient) ListWatchlists(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*WatchlistsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatchlistsResponse)
+	err := c.cc.Invoke(ctx, Broker_ListWatchlists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetWatchlist(ctx context.Context, in *WatchlistRequest, opts ...grpc.CallOption) (*Watchlist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Watchlist)
+	err := c.cc.Invoke(ctx, Broker_GetWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) CreateWatchlist(ctx context.Context, in *CreateWatchlistRequest, opts ...grpc.CallOption) (*Watchlist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Watchlist)
+	err := c.cc.Invoke(ctx, Broker_CreateWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) RenameWatchlist(ctx context.Context, in *RenameWatchlistRequest, opts ...grpc.CallOption) (*Watchlist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Watchlist)
+	err := c.cc.Invoke(ctx, Broker_RenameWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) AddWatchlistSymbols(ctx context.Context, in *WatchlistSymbolsRequest, opts ...grpc.CallOption) (*Watchlist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Watchlist)
+	err := c.cc.Invoke(ctx, Broker_AddWatchlistSymbols_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) ReorderWatchlist(ctx context.Context, in *WatchlistSymbolsRequest, opts ...grpc.CallOption) (*Watchlist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Watchlist)
+	err := c.cc.Invoke(ctx, Broker_ReorderWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) RemoveWatchlistSymbol(ctx context.Context, in *RemoveWatchlistSymbolRequest, opts ...grpc.CallOption) (*Watchlist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Watchlist)
+	err := c.cc.Invoke(ctx, Broker_RemoveWatchlistSymbol_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) DeleteWatchlist(ctx context.Context, in *WatchlistRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Broker_DeleteWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) CreateAlert(ctx context.Context, in *CreateAlertRequest, opts ...grpc.CallOption) (*Alert, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Alert)
+	err := c.cc.Invoke(ctx, Broker_CreateAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) ListAlerts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlertsResponse)
+	err := c.cc.Invoke(ctx, Broker_ListAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) DeleteAlert(ctx context.Context, in *AlertRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Broker_DeleteAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) RearmAlert(ctx context.Context, in *AlertRequest, opts ...grpc.CallOption) (*Alert, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Alert)
+	err := c.cc.Invoke(ctx, Broker_RearmAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetAlertHistory(ctx context.Context, in *AlertHistoryRequest, opts ...grpc.CallOption) (*AlertHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlertHistoryResponse)
+	err := c.cc.Invoke(ctx, Broker_GetAlertHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) ListNotifications(ctx context.Context, in *NotificationsRequest, opts ...grpc.CallOption) (*NotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationsResponse)
+	err := c.cc.Invoke(ctx, Broker_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Broker_MarkNotificationsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetMarketStatus(ctx context.Context, in *GetMarketStatusRequest, opts ...grpc.CallOption) (*MarketStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketStatusResponse)
+	err := c.cc.Invoke(ctx, Broker_GetMarketStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetLots(ctx context.Context, in *GetLotsRequest, opts ...grpc.CallOption) (*LotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LotsResponse)
+	err := c.cc.Invoke(ctx, Broker_GetLots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetClosedLots(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ClosedLotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClosedLotsResponse)
+	err := c.cc.Invoke(ctx, Broker_GetClosedLots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) ListCorporateActions(ctx context.Context, in *ListCorporateActionsRequest, opts ...grpc.CallOption) (*CorporateActionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CorporateActionsResponse)
+	err := c.cc.Invoke(ctx, Broker_ListCorporateActions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) CreateCorporateActions(ctx context.Context, in *CreateCorporateActionsRequest, opts ...grpc.CallOption) (*CorporateActionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CorporateActionsResponse)
+	err := c.cc.Invoke(ctx, Broker_CreateCorporateActions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetAdjustments(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AdjustmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustmentsResponse)
+	err := c.cc.Invoke(ctx, Broker_GetAdjustments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetCashLedger(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CashLedgerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CashLedgerResponse)
+	err := c.cc.Invoke(ctx, Broker_GetCashLedger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) ListSettlementRuns(ctx context.Context, in *ListSettlementRunsRequest, opts ...grpc.CallOption) (*SettlementRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettlementRunsResponse)
+	err := c.cc.Invoke(ctx, Broker_ListSettlementRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) Deposit(ctx context.Context, in *CashRequest, opts ...grpc.CallOption) (*CashEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CashEntry)
+	err := c.cc.Invoke(ctx, Broker_Deposit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) Withdraw(ctx context.Context, in *CashRequest, opts ...grpc.CallOption) (*CashEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CashEntry)
+	err := c.cc.Invoke(ctx, Broker_Withdraw_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetPortfolioHistory(ctx context.Context, in *PortfolioRangeRequest, opts ...grpc.CallOption) (*PortfolioHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PortfolioHistoryResponse)
+	err := c.cc.Invoke(ctx, Broker_GetPortfolioHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetPerformance(ctx context.Context, in *PortfolioRangeRequest, opts ...grpc.CallOption) (*Performance, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Performance)
+	err := c.cc.Invoke(ctx, Broker_GetPerformance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetReport(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*ReportFile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportFile)
+	err := c.cc.Invoke(ctx, Broker_GetReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetCapitalGains(ctx context.Context, in *CapitalGainsRequest, opts ...grpc.CallOption) (*CapitalGainsReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CapitalGainsReport)
+	err := c.cc.Invoke(ctx, Broker_GetCapitalGains_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) CalculateCharges(ctx context.Context, in *CalculateChargesRequest, opts ...grpc.CallOption) (*ChargeEstimate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChargeEstimate)
+	err := c.cc.Invoke(ctx, Broker_CalculateCharges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetFXRates(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FXRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FXRatesResponse)
+	err := c.cc.Invoke(ctx, Broker_GetFXRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) ConvertCurrency(ctx context.Context, in *ConvertCurrencyRequest, opts ...grpc.CallOption) (*FXConversion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FXConversion)
+	err := c.cc.Invoke(ctx, Broker_ConvertCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetHouseAccount(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HouseAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HouseAccountResponse)
+	err := c.cc.Invoke(ctx, Broker_GetHouseAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) ListLocates(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LocatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LocatesResponse)
+	err := c.cc.Invoke(ctx, Broker_ListLocates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetShortPositions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ShortPositionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShortPositionsResponse)
+	err := c.cc.Invoke(ctx, Broker_GetShortPositions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) SetLocates(ctx context.Context, in *SetLocatesRequest, opts ...grpc.CallOption) (*LocatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LocatesResponse)
+	err := c.cc.Invoke(ctx, Broker_SetLocates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) SetMargin(ctx context.Context, in *SetMarginRequest, opts ...grpc.CallOption) (*SetMarginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMarginResponse)
+	err := c.cc.Invoke(ctx, Broker_SetMargin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) PlaceBasket(ctx context.Context, in *BasketRequest, opts ...grpc.CallOption) (*BasketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BasketResponse)
+	err := c.cc.Invoke(ctx, Broker_PlaceBasket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) ValidateBasket(ctx context.Context, in *BasketRequest, opts ...grpc.CallOption) (*BasketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BasketResponse)
+	err := c.cc.Invoke(ctx, Broker_ValidateBasket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) ListBaskets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BasketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BasketsResponse)
+	err := c.cc.Invoke(ctx, Broker_ListBaskets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetBasket(ctx context.Context, in *GetBasketRequest, opts ...grpc.CallOption) (*Basket, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Basket)
+	err := c.cc.Invoke(ctx, Broker_GetBasket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalancePlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebalancePlan)
+	err := c.cc.Invoke(ctx, Broker_Rebalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) CreateAlgoOrder(ctx context.Context, in *AlgoOrderRequest, opts ...grpc.CallOption) (*Order, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Order)
+	err := c.cc.Invoke(ctx, Broker_CreateAlgoOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetAlgoOrder(ctx context.Context, in *AlgoOrderID, opts ...grpc.CallOption) (*AlgoOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlgoOrderResponse)
+	err := c.cc.Invoke(ctx, Broker_GetAlgoOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) PauseAlgoOrder(ctx context.Context, in *AlgoOrderID, opts ...grpc.CallOption) (*Order, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Order)
+	err := c.cc.Invoke(ctx, Broker_PauseAlgoOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) ResumeAlgoOrder(ctx context.Context, in *AlgoOrderID, opts ...grpc.CallOption) (*Order, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Order)
+	err := c.cc.Invoke(ctx, Broker_ResumeAlgoOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) CancelAlgoOrder(ctx context.Context, in *AlgoOrderID, opts ...grpc.CallOption) (*Order, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Order)
+	err := c.cc.Invoke(ctx, Broker_CancelAlgoOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetOptionChain(ctx context.Context, in *GetOptionChainRequest, opts ...grpc.CallOption) (*OptionChain, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OptionChain)
+	err := c.cc.Invoke(ctx, Broker_GetOptionChain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) ListFunds(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FundsResponse)
+	err := c.cc.Invoke(ctx, Broker_ListFunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetFund(ctx context.Context, in *GetFundRequest, opts ...grpc.CallOption) (*FundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FundResponse)
+	err := c.cc.Invoke(ctx, Broker_GetFund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) PostNavs(ctx context.Context, in *PostNavsRequest, opts ...grpc.CallOption) (*PostNavsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostNavsResponse)
+	err := c.cc.Invoke(ctx, Broker_PostNavs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) PlaceFundOrder(ctx context.Context, in *FundOrderRequest, opts ...grpc.CallOption) (*FundOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FundOrder)
+	err := c.cc.Invoke(ctx, Broker_PlaceFundOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) ListFundOrders(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FundOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FundOrdersResponse)
+	err := c.cc.Invoke(ctx, Broker_ListFundOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) CancelFundOrder(ctx context.Context, in *FundOrderID, opts ...grpc.CallOption) (*FundOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FundOrder)
+	err := c.cc.Invoke(ctx, Broker_CancelFundOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) CreateSip(ctx context.Context, in *SipRequest, opts ...grpc.CallOption) (*Sip, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Sip)
+	err := c.cc.Invoke(ctx, Broker_CreateSip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) ListSips(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SipsResponse)
+	err := c.cc.Invoke(ctx, Broker_ListSips_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) PauseSip(ctx context.Context, in *SipID, opts ...grpc.CallOption) (*Sip, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Sip)
+	err := c.cc.Invoke(ctx, Broker_PauseSip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) ResumeSip(ctx context.Context, in *SipID, opts ...grpc.CallOption) (*Sip, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Sip)
+	err := c.cc.Invoke(ctx, Broker_ResumeSip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) SkipSip(ctx context.Context, in *SipID, opts ...grpc.CallOption) (*Sip, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Sip)
+	err := c.cc.Invoke(ctx, Broker_SkipSip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) CancelSip(ctx context.Context, in *SipID, opts ...grpc.CallOption) (*Sip, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Sip)
+	err := c.cc.Invoke(ctx, Broker_CancelSip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) ListStrategyTypes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StrategyTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StrategyTypesResponse)
+	err := c.cc.Invoke(ctx, Broker_ListStrategyTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) StartStrategy(ctx context.Context, in *StartStrategyRequest, opts ...grpc.CallOption) (*Strategy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Strategy)
+	err := c.cc.Invoke(ctx, Broker_StartStrategy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) ListStrategies(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StrategiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StrategiesResponse)
+	err := c.cc.Invoke(ctx, Broker_ListStrategies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetStrategy(ctx context.Context, in *StrategyID, opts ...grpc.CallOption) (*Strategy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Strategy)
+	err := c.cc.Invoke(ctx, Broker_GetStrategy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) StopStrategy(ctx context.Context, in *StrategyID, opts ...grpc.CallOption) (*Strategy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Strategy)
+	err := c.cc.Invoke(ctx, Broker_StopStrategy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) KillStrategy(ctx context.Context, in *StrategyID, opts ...grpc.CallOption) (*Strategy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Strategy)
+	err := c.cc.Invoke(ctx, Broker_KillStrategy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) KillAllStrategies(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*KillAllStrategiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KillAllStrategiesResponse)
+	err := c.cc.Invoke(ctx, Broker_KillAllStrategies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) GetAccount(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Account, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Account)
+	err := c.cc.Invoke(ctx, Broker_GetAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerClient) ResetBalance(ctx context.Context, in *ResetBalanceRequest, opts ...grpc.CallOption) (*Account, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Account)
+	err := c.cc.Invoke(ctx, Broker_ResetBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BrokerServer is the server API for Broker service.
 // All implementations must embed UnimplementedBrokerServer
 // for forward compatibility.
@@ -117,159 +1083,1883 @@ type BrokerServer interface {
 	GetHoldings(context.Context, *Empty) (*HoldingsResponse, error)
 	GetOrderbook(context.Context, *Empty) (*OrderbookResponse, error)
 	GetPositions(context.Context, *Empty) (*PositionsResponse, error)
+	ListInstruments(context.Context, *ListInstrumentsRequest) (*InstrumentsResponse, error)
+	GetInstrument(context.Context, *GetInstrumentRequest) (*Instrument, error)
+	SearchInstruments(context.Context, *SearchInstrumentsRequest) (*InstrumentsResponse, error)
+	GetCandles(context.Context, *GetCandlesRequest) (*CandlesResponse, error)
+	StreamCandles(*StreamCandlesRequest, grpc.ServerStreamingServer[Candle]) error
+	RebuildCandles(context.Context, *RebuildCandlesRequest) (*RebuildCandlesResponse, error)
+	PlaceOrder(context.Context, *PlaceOrderRequest) (*Order, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*Order, error)
+	GetMarketDepth(context.Context, *GetMarketDepthRequest) (*MarketDepth, error)
+	SubscribeQuotes(*SubscribeQuotesRequest, grpc.ServerStreamingServer[QuoteUpdate]) error
+	ListWatchlists(context.Context, *Empty) (*WatchlistsResponse, error)
+	GetWatchlist(context.Context, *WatchlistRequest) (*Watchlist, error)
+	CreateWatchlist(context.Context, *CreateWatchlistRequest) (*Watchlist, error)
+	RenameWatchlist(context.Context, *RenameWatchlistRequest) (*Watchlist, error)
+	AddWatchlistSymbols(context.Context, *WatchlistSymbolsRequest) (*Watchlist, error)
+	ReorderWatchlist(context.Context, *WatchlistSymbolsRequest) (*Watchlist, error)
+	RemoveWatchlistSymbol(context.Context, *RemoveWatchlistSymbolRequest) (*Watchlist, error)
+	DeleteWatchlist(context.Context, *WatchlistRequest) (*Empty, error)
+	CreateAlert(context.Context, *CreateAlertRequest) (*Alert, error)
+	ListAlerts(context.Context, *Empty) (*AlertsResponse, error)
+	DeleteAlert(context.Context, *AlertRequest) (*Empty, error)
+	RearmAlert(context.Context, *AlertRequest) (*Alert, error)
+	GetAlertHistory(context.Context, *AlertHistoryRequest) (*AlertHistoryResponse, error)
+	ListNotifications(context.Context, *NotificationsRequest) (*NotificationsResponse, error)
+	MarkNotificationsRead(context.Context, *MarkNotificationsReadRequest) (*Empty, error)
+	GetMarketStatus(context.Context, *GetMarketStatusRequest) (*MarketStatusResponse, error)
+	GetLots(context.Context, *GetLotsRequest) (*LotsResponse, error)
+	GetClosedLots(context.Context, *Empty) (*ClosedLotsResponse, error)
+	ListCorporateActions(context.Context, *ListCorporateActionsRequest) (*CorporateActionsResponse, error)
+	CreateCorporateActions(context.Context, *CreateCorporateActionsRequest) (*CorporateActionsResponse, error)
+	GetAdjustments(context.Context, *Empty) (*AdjustmentsResponse, error)
+	GetCashLedger(context.Context, *Empty) (*CashLedgerResponse, error)
+	ListSettlementRuns(context.Context, *ListSettlementRunsRequest) (*SettlementRunsResponse, error)
+	Deposit(context.Context, *CashRequest) (*CashEntry, error)
+	Withdraw(context.Context, *CashRequest) (*CashEntry, error)
+	GetPortfolioHistory(context.Context, *PortfolioRangeRequest) (*PortfolioHistoryResponse, error)
+	GetPerformance(context.Context, *PortfolioRangeRequest) (*Performance, error)
+	GetReport(context.Context, *ReportRequest) (*ReportFile, error)
+	GetCapitalGains(context.Context, *CapitalGainsRequest) (*CapitalGainsReport, error)
+	CalculateCharges(context.Context, *CalculateChargesRequest) (*ChargeEstimate, error)
+	GetFXRates(context.Context, *Empty) (*FXRatesResponse, error)
+	ConvertCurrency(context.Context, *ConvertCurrencyRequest) (*FXConversion, error)
+	GetHouseAccount(context.Context, *Empty) (*HouseAccountResponse, error)
+	ListLocates(context.Context, *Empty) (*LocatesResponse, error)
+	GetShortPositions(context.Context, *Empty) (*ShortPositionsResponse, error)
+	SetLocates(context.Context, *SetLocatesRequest) (*LocatesResponse, error)
+	SetMargin(context.Context, *SetMarginRequest) (*SetMarginResponse, error)
+	PlaceBasket(context.Context, *BasketRequest) (*BasketResponse, error)
+	ValidateBasket(context.Context, *BasketRequest) (*BasketResponse, error)
+	ListBaskets(context.Context, *Empty) (*BasketsResponse, error)
+	GetBasket(context.Context, *GetBasketRequest) (*Basket, error)
+	Rebalance(context.Context, *RebalanceRequest) (*RebalancePlan, error)
+	CreateAlgoOrder(context.Context, *AlgoOrderRequest) (*Order, error)
+	GetAlgoOrder(context.Context, *AlgoOrderID) (*AlgoOrderResponse, error)
+	PauseAlgoOrder(context.Context, *AlgoOrderID) (*Order, error)
+	ResumeAlgoOrder(context.Context, *AlgoOrderID) (*Order, error)
+	CancelAlgoOrder(context.Context, *AlgoOrderID) (*Order, error)
+	GetOptionChain(context.Context, *GetOptionChainRequest) (*OptionChain, error)
+	ListFunds(context.Context, *Empty) (*FundsResponse, error)
+	GetFund(context.Context, *GetFundRequest) (*FundResponse, error)
+	PostNavs(context.Context, *PostNavsRequest) (*PostNavsResponse, error)
+	PlaceFundOrder(context.Context, *FundOrderRequest) (*FundOrder, error)
+	ListFundOrders(context.Context, *Empty) (*FundOrdersResponse, error)
+	CancelFundOrder(context.Context, *FundOrderID) (*FundOrder, error)
+	CreateSip(context.Context, *SipRequest) (*Sip, error)
+	ListSips(context.Context, *Empty) (*SipsResponse, error)
+	PauseSip(context.Context, *SipID) (*Sip, error)
+	ResumeSip(context.Context, *SipID) (*Sip, error)
+	SkipSip(context.Context, *SipID) (*Sip, error)
+	CancelSip(context.Context, *SipID) (*Sip, error)
+	ListStrategyTypes(context.Context, *Empty) (*StrategyTypesResponse, error)
+	StartStrategy(context.Context, *StartStrategyRequest) (*Strategy, error)
+	ListStrategies(context.Context, *Empty) (*StrategiesResponse, error)
+	GetStrategy(context.Context, *StrategyID) (*Strategy, error)
+	StopStrategy(context.Context, *StrategyID) (*Strategy, error)
+	KillStrategy(context.Context, *StrategyID) (*Strategy, error)
+	KillAllStrategies(context.Context, *Empty) (*KillAllStrategiesResponse, error)
+	GetAccount(context.Context, *Empty) (*Account, error)
+	ResetBalance(context.Context, *ResetBalanceRequest) (*Account, error)
+	mustEmbedUnimplementedBrokerServer()
+}
+
+// UnimplementedBrokerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBrokerServer struct{}
+
+func (UnimplementedBrokerServer) Signup(context.Context, *SignupRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Signup not implemented")
+}
+func (UnimplementedBrokerServer) Login(context.Context, *LoginRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedBrokerServer) Refresh(context.Context, *RefreshRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+}
+func (UnimplementedBrokerServer) GetHoldings(context.Context, *Empty) (*HoldingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHoldings not implemented")
+}
+func (UnimplementedBrokerServer) GetOrderbook(context.Context, *Empty) (*OrderbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbook not implemented")
+}
+func (UnimplementedBrokerServer) GetPositions(context.Context, *Empty) (*PositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPositions not implemented")
+}
+func (UnimplementedBrokerServer) ListInstruments(context.Context, *ListInstrumentsRequest) (*InstrumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInstruments not implemented")
+}
+func (UnimplementedBrokerServer) GetInstrument(context.Context, *GetInstrumentRequest) (*Instrument, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstrument not implemented")
+}
+func (UnimplementedBrokerServer) SearchInstruments(context.Context, *SearchInstrumentsRequest) (*InstrumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchInstruments not implemented")
+}
+func (UnimplementedBrokerServer) GetCandles(context.Context, *GetCandlesRequest) (*CandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandles not implemented")
+}
+func (UnimplementedBrokerServer) StreamCandles(*StreamCandlesRequest, grpc.ServerStreamingServer[Candle]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamCandles not implemented")
+}
+func (UnimplementedBrokerServer) RebuildCandles(context.Context, *RebuildCandlesRequest) (*RebuildCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildCandles not implemented")
+}
+func (UnimplementedBrokerServer) PlaceOrder(context.Context, *PlaceOrderRequest) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+}
+func (UnimplementedBrokerServer) CancelOrder(context.Context, *CancelOrderRequest) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedBrokerServer) GetMarketDepth(context.Context, *GetMarketDepthRequest) (*MarketDepth, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketDepth not implemented")
+}
+func (UnimplementedBrokerServer) SubscribeQuotes(*SubscribeQuotesRequest, grpc.ServerStreamingServer[QuoteUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeQuotes not implemented")
+}
+func (UnimplementedBrokerServer) ListWatchlists(context.Context, *Empty) (*WatchlistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWatchlists not implemented")
+}
+func (UnimplementedBrokerServer) GetWatchlist(context.Context, *WatchlistRequest) (*Watchlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWatchlist not implemented")
+}
+func (UnimplementedBrokerServer) CreateWatchlist(context.Context, *CreateWatchlistRequest) (*Watchlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWatchlist not implemented")
+}
+func (UnimplementedBrokerServer) RenameWatchlist(context.Context, *RenameWatchlistRequest) (*Watchlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameWatchlist not implemented")
+}
+func (UnimplementedBrokerServer) AddWatchlistSymbols(context.Context, *WatchlistSymbolsRequest) (*Watchlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWatchlistSymbols not implemented")
+}
+func (UnimplementedBrokerServer) ReorderWatchlist(context.Context, *WatchlistSymbolsRequest) (*Watchlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderWatchlist not implemented")
+}
+func (UnimplementedBrokerServer) RemoveWatchlistSymbol(context.Context, *RemoveWatchlistSymbolRequest) (*Watchlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWatchlistSymbol not implemented")
+}
+func (UnimplementedBrokerServer) DeleteWatchlist(context.Context, *WatchlistRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWatchlist not implemented")
+}
+func (UnimplementedBrokerServer) CreateAlert(context.Context, *CreateAlertRequest) (*Alert, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlert not implemented")
+}
+func (UnimplementedBrokerServer) ListAlerts(context.Context, *Empty) (*AlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlerts not implemented")
+}
+func (UnimplementedBrokerServer) DeleteAlert(context.Context, *AlertRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlert not implemented")
+}
+func (UnimplementedBrokerServer) RearmAlert(context.Context, *AlertRequest) (*Alert, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RearmAlert not implemented")
+}
+func (UnimplementedBrokerServer) GetAlertHistory(context.Context, *AlertHistoryRequest) (*AlertHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlertHistory not implemented")
+}
+func (UnimplementedBrokerServer) ListNotifications(context.Context, *NotificationsRequest) (*NotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedBrokerServer) MarkNotificationsRead(context.Context, *MarkNotificationsReadRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationsRead not implemented")
+}
+func (UnimplementedBrokerServer) GetMarketStatus(context.Context, *GetMarketStatusRequest) (*MarketStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketStatus not implemented")
+}
+func (UnimplementedBrokerServer) GetLots(context.Context, *GetLotsRequest) (*LotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLots not implemented")
+}
+func (UnimplementedBrokerServer) GetClosedLots(context.Context, *Empty) (*ClosedLotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClosedLots not implemented")
+}
+func (UnimplementedBrokerServer) ListCorporateActions(context.Context, *ListCorporateActionsRequest) (*CorporateActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCorporateActions not implemented")
+}
+func (UnimplementedBrokerServer) CreateCorporateActions(context.Context, *CreateCorporateActionsRequest) (*CorporateActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCorporateActions not implemented")
+}
+func (UnimplementedBrokerServer) GetAdjustments(context.Context, *Empty) (*AdjustmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdjustments not implemented")
+}
+func (UnimplementedBrokerServer) GetCashLedger(context.Context, *Empty) (*CashLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCashLedger not implemented")
+}
+func (UnimplementedBrokerServer) ListSettlementRuns(context.Context, *ListSettlementRunsRequest) (*SettlementRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSettlementRuns not implemented")
+}
+func (UnimplementedBrokerServer) Deposit(context.Context, *CashRequest) (*CashEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (UnimplementedBrokerServer) Withdraw(context.Context, *CashRequest) (*CashEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (UnimplementedBrokerServer) GetPortfolioHistory(context.Context, *PortfolioRangeRequest) (*PortfolioHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioHistory not implemented")
+}
+func (UnimplementedBrokerServer) GetPerformance(context.Context, *PortfolioRangeRequest) (*Performance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPerformance not implemented")
+}
+func (UnimplementedBrokerServer) GetReport(context.Context, *ReportRequest) (*ReportFile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReport not implemented")
+}
+func (UnimplementedBrokerServer) GetCapitalGains(context.Context, *CapitalGainsRequest) (*CapitalGainsReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapitalGains not implemented")
+}
+func (UnimplementedBrokerServer) CalculateCharges(context.Context, *CalculateChargesRequest) (*ChargeEstimate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateCharges not implemented")
+}
+func (UnimplementedBrokerServer) GetFXRates(context.Context, *Empty) (*FXRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFXRates not implemented")
+}
+func (UnimplementedBrokerServer) ConvertCurrency(context.Context, *ConvertCurrencyRequest) (*FXConversion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCurrency not implemented")
+}
+func (UnimplementedBrokerServer) GetHouseAccount(context.Context, *Empty) (*HouseAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHouseAccount not implemented")
+}
+func (UnimplementedBrokerServer) ListLocates(context.Context, *Empty) (*LocatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLocates not implemented")
+}
+func (UnimplementedBrokerServer) GetShortPositions(context.Context, *Empty) (*ShortPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShortPositions not implemented")
+}
+func (UnimplementedBrokerServer) SetLocates(context.Context, *SetLocatesRequest) (*LocatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLocates not implemented")
+}
+func (UnimplementedBrokerServer) SetMargin(context.Context, *SetMarginRequest) (*SetMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMargin not implemented")
+}
+func (UnimplementedBrokerServer) PlaceBasket(context.Context, *BasketRequest) (*BasketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBasket not implemented")
+}
+func (UnimplementedBrokerServer) ValidateBasket(context.Context, *BasketRequest) (*BasketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateBasket not implemented")
+}
+func (UnimplementedBrokerServer) ListBaskets(context.Context, *Empty) (*BasketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBaskets not implemented")
+}
+func (UnimplementedBrokerServer) GetBasket(context.Context, *GetBasketRequest) (*Basket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBasket not implemented")
+}
+func (UnimplementedBrokerServer) Rebalance(context.Context, *RebalanceRequest) (*RebalancePlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rebalance not implemented")
+}
+func (UnimplementedBrokerServer) CreateAlgoOrder(context.Context, *AlgoOrderRequest) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlgoOrder not implemented")
+}
+func (UnimplementedBrokerServer) GetAlgoOrder(context.Context, *AlgoOrderID) (*AlgoOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlgoOrder not implemented")
+}
+func (UnimplementedBrokerServer) PauseAlgoOrder(context.Context, *AlgoOrderID) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseAlgoOrder not implemented")
+}
+func (UnimplementedBrokerServer) ResumeAlgoOrder(context.Context, *AlgoOrderID) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeAlgoOrder not implemented")
+}
+func (UnimplementedBrokerServer) CancelAlgoOrder(context.Context, *AlgoOrderID) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAlgoOrder not implemented")
+}
+func (UnimplementedBrokerServer) GetOptionChain(context.Context, *GetOptionChainRequest) (*OptionChain, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOptionChain not implemented")
+}
+func (UnimplementedBrokerServer) ListFunds(context.Context, *Empty) (*FundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFunds not implemented")
+}
+func (UnimplementedBrokerServer) GetFund(context.Context, *GetFundRequest) (*FundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFund not implemented")
+}
+func (UnimplementedBrokerServer) PostNavs(context.Context, *PostNavsRequest) (*PostNavsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostNavs not implemented")
+}
+func (UnimplementedBrokerServer) PlaceFundOrder(context.Context, *FundOrderRequest) (*FundOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceFundOrder not implemented")
+}
+func (UnimplementedBrokerServer) ListFundOrders(context.Context, *Empty) (*FundOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFundOrders not implemented")
+}
+func (UnimplementedBrokerServer) CancelFundOrder(context.Context, *FundOrderID) (*FundOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFundOrder not implemented")
+}
+func (UnimplementedBrokerServer) CreateSip(context.Context, *SipRequest) (*Sip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSip not implemented")
+}
+func (UnimplementedBrokerServer) ListSips(context.Context, *Empty) (*SipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSips not implemented")
+}
+func (UnimplementedBrokerServer) PauseSip(context.Context, *SipID) (*Sip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSip not implemented")
+}
+func (UnimplementedBrokerServer) ResumeSip(context.Context, *SipID) (*Sip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSip not implemented")
+}
+func (UnimplementedBrokerServer) SkipSip(context.Context, *SipID) (*Sip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipSip not implemented")
+}
+func (UnimplementedBrokerServer) CancelSip(context.Context, *SipID) (*Sip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSip not implemented")
+}
+func (UnimplementedBrokerServer) ListStrategyTypes(context.Context, *Empty) (*StrategyTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStrategyTypes not implemented")
+}
+func (UnimplementedBrokerServer) StartStrategy(context.Context, *StartStrategyRequest) (*Strategy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartStrategy not implemented")
+}
+func (UnimplementedBrokerServer) ListStrategies(context.Context, *Empty) (*StrategiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStrategies not implemented")
+}
+func (UnimplementedBrokerServer) GetStrategy(context.Context, *StrategyID) (*Strategy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStrategy not implemented")
+}
+func (UnimplementedBrokerServer) StopStrategy(context.Context, *StrategyID) (*Strategy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopStrategy not implemented")
+}
+func (UnimplementedBrokerServer) KillStrategy(context.Context, *StrategyID) (*Strategy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillStrategy not implemented")
+}
+func (UnimplementedBrokerServer) KillAllStrategies(context.Context, *Empty) (*KillAllStrategiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillAllStrategies not implemented")
+}
+func (UnimplementedBrokerServer) GetAccount(context.Context, *Empty) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedBrokerServer) ResetBalance(context.Context, *ResetBalanceRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetBalance not implemented")
+}
+func (UnimplementedBrokerServer) mustEmbedUnimplementedBrokerServer() {}
+func (UnimplementedBrokerServer) testEmbeddedByValue()                {}
+
+// UnsafeBrokerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BrokerServer will
+// result in compilation errors.
+type UnsafeBrokerServer interface {
 	mustEmbedUnimplementedBrokerServer()
 }
 
-// UnimplementedBrokerServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedBrokerServer struct{}
+func RegisterBrokerServer(s grpc.ServiceRegistrar, srv BrokerServer) {
+	// If the following call pancis, it indicates UnimplementedBrokerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Broker_ServiceDesc, srv)
+}
+
+func _Broker_Signup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).Signup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_Signup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).Signup(ctx, req.(*SignupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).Refresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_Refresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).Refresh(ctx, req.(*RefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetHoldings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetHoldings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetHoldings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetHoldings(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetOrderbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetOrderbook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetOrderbook(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetPositions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetPositions(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_ListInstruments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInstrumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).ListInstruments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_ListInstruments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).ListInstruments(ctx, req.(*ListInstrumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetInstrument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstrumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetInstrument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetInstrument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetInstrument(ctx, req.(*GetInstrumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_SearchInstruments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchInstrumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).SearchInstruments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_SearchInstruments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).SearchInstruments(ctx, req.(*SearchInstrumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetCandles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetCandles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetCandles(ctx, req.(*GetCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_StreamCandles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamCandlesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BrokerServer).StreamCandles(m, &grpc.GenericServerStream[StreamCandlesRequest, Candle]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Broker_StreamCandlesServer = grpc.ServerStreamingServer[Candle]
+
+func _Broker_RebuildCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildCandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).RebuildCandles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_RebuildCandles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).RebuildCandles(ctx, req.(*RebuildCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).PlaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_PlaceOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).PlaceOrder(ctx, req.(*PlaceOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetMarketDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetMarketDepth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetMarketDepth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetMarketDepth(ctx, req.(*GetMarketDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_SubscribeQuotes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeQuotesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BrokerServer).SubscribeQuotes(m, &grpc.GenericServerStream[SubscribeQuotesRequest, QuoteUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Broker_SubscribeQuotesServer = grpc.ServerStreamingServer[QuoteUpdate]
+
+func _Broker_ListWatchlists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).ListWatchlists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_ListWatchlists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).ListWatchlists(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetWatchlist(ctx, req.(*WatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_CreateWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).CreateWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_CreateWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).CreateWatchlist(ctx, req.(*CreateWatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_RenameWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameWatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).RenameWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_RenameWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).RenameWatchlist(ctx, req.(*RenameWatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_AddWatchlistSymbols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchlistSymbolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).AddWatchlistSymbols(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_AddWatchlistSymbols_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).AddWatchlistSymbols(ctx, req.(*WatchlistSymbolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_ReorderWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchlistSymbolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).ReorderWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_ReorderWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).ReorderWatchlist(ctx, req.(*WatchlistSymbolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_RemoveWatchlistSymbol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWatchlistSymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).RemoveWatchlistSymbol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_RemoveWatchlistSymbol_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).RemoveWatchlistSymbol(ctx, req.(*RemoveWatchlistSymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_DeleteWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).DeleteWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_DeleteWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).DeleteWatchlist(ctx, req.(*WatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_CreateAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).CreateAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_CreateAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).CreateAlert(ctx, req.(*CreateAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_ListAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).ListAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_ListAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).ListAlerts(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_DeleteAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).DeleteAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_DeleteAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).DeleteAlert(ctx, req.(*AlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_RearmAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).RearmAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_RearmAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).RearmAlert(ctx, req.(*AlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetAlertHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetAlertHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetAlertHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetAlertHistory(ctx, req.(*AlertHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).ListNotifications(ctx, req.(*NotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_MarkNotificationsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).MarkNotificationsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_MarkNotificationsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).MarkNotificationsRead(ctx, req.(*MarkNotificationsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetMarketStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetMarketStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetMarketStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetMarketStatus(ctx, req.(*GetMarketStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetLots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetLots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetLots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetLots(ctx, req.(*GetLotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetClosedLots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetClosedLots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetClosedLots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetClosedLots(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_ListCorporateActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCorporateActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).ListCorporateActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_ListCorporateActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).ListCorporateActions(ctx, req.(*ListCorporateActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_CreateCorporateActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCorporateActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).CreateCorporateActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_CreateCorporateActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).CreateCorporateActions(ctx, req.(*CreateCorporateActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetAdjustments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetAdjustments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetAdjustments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetAdjustments(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetCashLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetCashLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetCashLedger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetCashLedger(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_ListSettlementRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSettlementRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).ListSettlementRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_ListSettlementRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).ListSettlementRuns(ctx, req.(*ListSettlementRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_Deposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).Deposit(ctx, req.(*CashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_Withdraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).Withdraw(ctx, req.(*CashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetPortfolioHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortfolioRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetPortfolioHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetPortfolioHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetPortfolioHistory(ctx, req.(*PortfolioRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortfolioRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetPerformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetPerformance(ctx, req.(*PortfolioRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetReport(ctx, req.(*ReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetCapitalGains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapitalGainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetCapitalGains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetCapitalGains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetCapitalGains(ctx, req.(*CapitalGainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_CalculateCharges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateChargesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).CalculateCharges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_CalculateCharges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).CalculateCharges(ctx, req.(*CalculateChargesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetFXRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetFXRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetFXRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetFXRates(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_ConvertCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).ConvertCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_ConvertCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).ConvertCurrency(ctx, req.(*ConvertCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetHouseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetHouseAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetHouseAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetHouseAccount(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_ListLocates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).ListLocates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_ListLocates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).ListLocates(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetShortPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetShortPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetShortPositions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetShortPositions(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_SetLocates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLocatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).SetLocates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_SetLocates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).SetLocates(ctx, req.(*SetLocatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_SetMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMarginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).SetMargin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_SetMargin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).SetMargin(ctx, req.(*SetMarginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_PlaceBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BasketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).PlaceBasket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_PlaceBasket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).PlaceBasket(ctx, req.(*BasketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_ValidateBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BasketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).ValidateBasket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_ValidateBasket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).ValidateBasket(ctx, req.(*BasketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_ListBaskets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).ListBaskets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_ListBaskets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).ListBaskets(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBasketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetBasket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetBasket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetBasket(ctx, req.(*GetBasketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedBrokerServer) Signup(context.Context, *SignupRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Signup not implemented")
+func _Broker_Rebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).Rebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_Rebalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).Rebalance(ctx, req.(*RebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBrokerServer) Login(context.Context, *LoginRequest) (*AuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+
+func _Broker_CreateAlgoOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlgoOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).CreateAlgoOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_CreateAlgoOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).CreateAlgoOrder(ctx, req.(*AlgoOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBrokerServer) Refresh(context.Context, *RefreshRequest) (*AuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+
+func _Broker_GetAlgoOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlgoOrderID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetAlgoOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetAlgoOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetAlgoOrder(ctx, req.(*AlgoOrderID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBrokerServer) GetHoldings(context.Context, *Empty) (*HoldingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHoldings not implemented")
+
+func _Broker_PauseAlgoOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlgoOrderID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).PauseAlgoOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_PauseAlgoOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).PauseAlgoOrder(ctx, req.(*AlgoOrderID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_ResumeAlgoOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlgoOrderID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).ResumeAlgoOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_ResumeAlgoOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).ResumeAlgoOrder(ctx, req.(*AlgoOrderID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_CancelAlgoOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlgoOrderID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).CancelAlgoOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_CancelAlgoOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).CancelAlgoOrder(ctx, req.(*AlgoOrderID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetOptionChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOptionChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetOptionChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetOptionChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetOptionChain(ctx, req.(*GetOptionChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_ListFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).ListFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_ListFunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).ListFunds(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_GetFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).GetFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_GetFund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).GetFund(ctx, req.(*GetFundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_PostNavs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostNavsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).PostNavs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_PostNavs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).PostNavs(ctx, req.(*PostNavsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_PlaceFundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).PlaceFundOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_PlaceFundOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).PlaceFundOrder(ctx, req.(*FundOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_ListFundOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).ListFundOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_ListFundOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).ListFundOrders(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_CancelFundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundOrderID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).CancelFundOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_CancelFundOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).CancelFundOrder(ctx, req.(*FundOrderID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_CreateSip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).CreateSip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_CreateSip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).CreateSip(ctx, req.(*SipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_ListSips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).ListSips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_ListSips_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).ListSips(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_PauseSip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SipID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).PauseSip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_PauseSip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).PauseSip(ctx, req.(*SipID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_ResumeSip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SipID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).ResumeSip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_ResumeSip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).ResumeSip(ctx, req.(*SipID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Broker_SkipSip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SipID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).SkipSip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_SkipSip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).SkipSip(ctx, req.(*SipID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBrokerServer) GetOrderbook(context.Context, *Empty) (*OrderbookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbook not implemented")
+
+func _Broker_CancelSip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SipID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).CancelSip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_CancelSip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).CancelSip(ctx, req.(*SipID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBrokerServer) GetPositions(context.Context, *Empty) (*PositionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPositions not implemented")
+
+func _Broker_ListStrategyTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).ListStrategyTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_ListStrategyTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).ListStrategyTypes(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBrokerServer) mustEmbedUnimplementedBrokerServer() {}
-func (UnimplementedBrokerServer) testEmbeddedByValue()                {}
 
-// UnsafeBrokerServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to BrokerServer will
-// result in compilation errors.
-type UnsafeBrokerServer interface {
-	mustEmbedUnimplementedBrokerServer()
+func _Broker_StartStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServer).StartStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_StartStrategy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).StartStrategy(ctx, req.(*StartStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterBrokerServer(s grpc.ServiceRegistrar, srv BrokerServer) {
-	// If the following call pancis, it indicates UnimplementedBrokerServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _Broker_ListStrategies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&Broker_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(BrokerServer).ListStrategies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Broker_ListStrategies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServer).ListStrategies(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Broker_Signup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignupRequest)
+func _Broker_GetStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StrategyID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BrokerServer).Signup(ctx, in)
+		return srv.(BrokerServer).GetStrategy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Broker_Signup_FullMethodName,
+		FullMethod: Broker_GetStrategy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BrokerServer).Signup(ctx, req.(*SignupRequest))
+		return srv.(BrokerServer).GetStrategy(ctx, req.(*StrategyID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Broker_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _Broker_StopStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StrategyID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BrokerServer).Login(ctx, in)
+		return srv.(BrokerServer).StopStrategy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Broker_Login_FullMethodName,
+		FullMethod: Broker_StopStrategy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BrokerServer).Login(ctx, req.(*LoginRequest))
+		return srv.(BrokerServer).StopStrategy(ctx, req.(*StrategyID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Broker_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefreshRequest)
+func _Broker_KillStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StrategyID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BrokerServer).Refresh(ctx, in)
+		return srv.(BrokerServer).KillStrategy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Broker_Refresh_FullMethodName,
+		FullMethod: Broker_KillStrategy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BrokerServer).Refresh(ctx, req.(*RefreshRequest))
+		return srv.(BrokerServer).KillStrategy(ctx, req.(*StrategyID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Broker_GetHoldings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Broker_KillAllStrategies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BrokerServer).GetHoldings(ctx, in)
+		return srv.(BrokerServer).KillAllStrategies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Broker_GetHoldings_FullMethodName,
+		FullMethod: Broker_KillAllStrategies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BrokerServer).GetHoldings(ctx, req.(*Empty))
+		return srv.(BrokerServer).KillAllStrategies(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Broker_GetOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Broker_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BrokerServer).GetOrderbook(ctx, in)
+		return srv.(BrokerServer).GetAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Broker_GetOrderbook_FullMethodName,
+		FullMethod: Broker_GetAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BrokerServer).GetOrderbook(ctx, req.(*Empty))
+		return srv.(BrokerServer).GetAccount(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Broker_GetPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Broker_ResetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetBalanceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BrokerServer).GetPositions(ctx, in)
+		return srv.(BrokerServer).ResetBalance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Broker_GetPositions_FullMethodName,
+		FullMethod: Broker_ResetBalance_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BrokerServer).GetPositions(ctx, req.(*Empty))
+		return srv.(BrokerServer).ResetBalance(ctx, req.(*ResetBalanceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -305,7 +2995,326 @@ var Broker_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPositions",
 			Handler:    _Broker_GetPositions_Handler,
 		},
+		{
+			MethodName: "ListInstruments",
+			Handler:    _Broker_ListInstruments_Handler,
+		},
+		{
+			MethodName: "GetInstrument",
+			Handler:    _Broker_GetInstrument_Handler,
+		},
+		{
+			MethodName: "SearchInstruments",
+			Handler:    _Broker_SearchInstruments_Handler,
+		},
+		{
+			MethodName: "GetCandles",
+			Handler:    _Broker_GetCandles_Handler,
+		},
+		{
+			MethodName: "RebuildCandles",
+			Handler:    _Broker_RebuildCandles_Handler,
+		},
+		{
+			MethodName: "PlaceOrder",
+			Handler:    _Broker_PlaceOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Broker_CancelOrder_Handler,
+		},
+		{
+			MethodName: "GetMarketDepth",
+			Handler:    _Broker_GetMarketDepth_Handler,
+		},
+		{
+			MethodName: "ListWatchlists",
+			Handler:    _Broker_ListWatchlists_Handler,
+		},
+		{
+			MethodName: "GetWatchlist",
+			Handler:    _Broker_GetWatchlist_Handler,
+		},
+		{
+			MethodName: "CreateWatchlist",
+			Handler:    _Broker_CreateWatchlist_Handler,
+		},
+		{
+			MethodName: "RenameWatchlist",
+			Handler:    _Broker_RenameWatchlist_Handler,
+		},
+		{
+			MethodName: "AddWatchlistSymbols",
+			Handler:    _Broker_AddWatchlistSymbols_Handler,
+		},
+		{
+			MethodName: "ReorderWatchlist",
+			Handler:    _Broker_ReorderWatchlist_Handler,
+		},
+		{
+			MethodName: "RemoveWatchlistSymbol",
+			Handler:    _Broker_RemoveWatchlistSymbol_Handler,
+		},
+		{
+			MethodName: "DeleteWatchlist",
+			Handler:    _Broker_DeleteWatchlist_Handler,
+		},
+		{
+			MethodName: "CreateAlert",
+			Handler:    _Broker_CreateAlert_Handler,
+		},
+		{
+			MethodName: "ListAlerts",
+			Handler:    _Broker_ListAlerts_Handler,
+		},
+		{
+			MethodName: "DeleteAlert",
+			Handler:    _Broker_DeleteAlert_Handler,
+		},
+		{
+			MethodName: "RearmAlert",
+			Handler:    _Broker_RearmAlert_Handler,
+		},
+		{
+			MethodName: "GetAlertHistory",
+			Handler:    _Broker_GetAlertHistory_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _Broker_ListNotifications_Handler,
+		},
+		{
+			MethodName: "MarkNotificationsRead",
+			Handler:    _Broker_MarkNotificationsRead_Handler,
+		},
+		{
+			MethodName: "GetMarketStatus",
+			Handler:    _Broker_GetMarketStatus_Handler,
+		},
+		{
+			MethodName: "GetLots",
+			Handler:    _Broker_GetLots_Handler,
+		},
+		{
+			MethodName: "GetClosedLots",
+			Handler:    _Broker_GetClosedLots_Handler,
+		},
+		{
+			MethodName: "ListCorporateActions",
+			Handler:    _Broker_ListCorporateActions_Handler,
+		},
+		{
+			MethodName: "CreateCorporateActions",
+			Handler:    _Broker_CreateCorporateActions_Handler,
+		},
+		{
+			MethodName: "GetAdjustments",
+			Handler:    _Broker_GetAdjustments_Handler,
+		},
+		{
+			MethodName: "GetCashLedger",
+			Handler:    _Broker_GetCashLedger_Handler,
+		},
+		{
+			MethodName: "ListSettlementRuns",
+			Handler:    _Broker_ListSettlementRuns_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _Broker_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _Broker_Withdraw_Handler,
+		},
+		{
+			MethodName: "GetPortfolioHistory",
+			Handler:    _Broker_GetPortfolioHistory_Handler,
+		},
+		{
+			MethodName: "GetPerformance",
+			Handler:    _Broker_GetPerformance_Handler,
+		},
+		{
+			MethodName: "GetReport",
+			Handler:    _Broker_GetReport_Handler,
+		},
+		{
+			MethodName: "GetCapitalGains",
+			Handler:    _Broker_GetCapitalGains_Handler,
+		},
+		{
+			MethodName: "CalculateCharges",
+			Handler:    _Broker_CalculateCharges_Handler,
+		},
+		{
+			MethodName: "GetFXRates",
+			Handler:    _Broker_GetFXRates_Handler,
+		},
+		{
+			MethodName: "ConvertCurrency",
+			Handler:    _Broker_ConvertCurrency_Handler,
+		},
+		{
+			MethodName: "GetHouseAccount",
+			Handler:    _Broker_GetHouseAccount_Handler,
+		},
+		{
+			MethodName: "ListLocates",
+			Handler:    _Broker_ListLocates_Handler,
+		},
+		{
+			MethodName: "GetShortPositions",
+			Handler:    _Broker_GetShortPositions_Handler,
+		},
+		{
+			MethodName: "SetLocates",
+			Handler:    _Broker_SetLocates_Handler,
+		},
+		{
+			MethodName: "SetMargin",
+			Handler:    _Broker_SetMargin_Handler,
+		},
+		{
+			MethodName: "PlaceBasket",
+			Handler:    _Broker_PlaceBasket_Handler,
+		},
+		{
+			MethodName: "ValidateBasket",
+			Handler:    _Broker_ValidateBasket_Handler,
+		},
+		{
+			MethodName: "ListBaskets",
+			Handler:    _Broker_ListBaskets_Handler,
+		},
+		{
+			MethodName: "GetBasket",
+			Handler:    _Broker_GetBasket_Handler,
+		},
+		{
+			MethodName: "Rebalance",
+			Handler:    _Broker_Rebalance_Handler,
+		},
+		{
+			MethodName: "CreateAlgoOrder",
+			Handler:    _Broker_CreateAlgoOrder_Handler,
+		},
+		{
+			MethodName: "GetAlgoOrder",
+			Handler:    _Broker_GetAlgoOrder_Handler,
+		},
+		{
+			MethodName: "PauseAlgoOrder",
+			Handler:    _Broker_PauseAlgoOrder_Handler,
+		},
+		{
+			MethodName: "ResumeAlgoOrder",
+			Handler:    _Broker_ResumeAlgoOrder_Handler,
+		},
+		{
+			MethodName: "CancelAlgoOrder",
+			Handler:    _Broker_CancelAlgoOrder_Handler,
+		},
+		{
+			MethodName: "GetOptionChain",
+			Handler:    _Broker_GetOptionChain_Handler,
+		},
+		{
+			MethodName: "ListFunds",
+			Handler:    _Broker_ListFunds_Handler,
+		},
+		{
+			MethodName: "GetFund",
+			Handler:    _Broker_GetFund_Handler,
+		},
+		{
+			MethodName: "PostNavs",
+			Handler:    _Broker_PostNavs_Handler,
+		},
+		{
+			MethodName: "PlaceFundOrder",
+			Handler:    _Broker_PlaceFundOrder_Handler,
+		},
+		{
+			MethodName: "ListFundOrders",
+			Handler:    _Broker_ListFundOrders_Handler,
+		},
+		{
+			MethodName: "CancelFundOrder",
+			Handler:    _Broker_CancelFundOrder_Handler,
+		},
+		{
+			MethodName: "CreateSip",
+			Handler:    _Broker_CreateSip_Handler,
+		},
+		{
+			MethodName: "ListSips",
+			Handler:    _Broker_ListSips_Handler,
+		},
+		{
+			MethodName: "PauseSip",
+			Handler:    _Broker_PauseSip_Handler,
+		},
+		{
+			MethodName: "ResumeSip",
+			Handler:    _Broker_ResumeSip_Handler,
+		},
+		{
+			MethodName: "SkipSip",
+			Handler:    _Broker_SkipSip_Handler,
+		},
+		{
+			MethodName: "CancelSip",
+			Handler:    _Broker_CancelSip_Handler,
+		},
+		{
+			MethodName: "ListStrategyTypes",
+			Handler:    _Broker_ListStrategyTypes_Handler,
+		},
+		{
+			MethodName: "StartStrategy",
+			Handler:    _Broker_StartStrategy_Handler,
+		},
+		{
+			MethodName: "ListStrategies",
+			Handler:    _Broker_ListStrategies_Handler,
+		},
+		{
+			MethodName: "GetStrategy",
+			Handler:    _Broker_GetStrategy_Handler,
+		},
+		{
+			MethodName: "StopStrategy",
+			Handler:    _Broker_StopStrategy_Handler,
+		},
+		{
+			MethodName: "KillStrategy",
+			Handler:    _Broker_KillStrategy_Handler,
+		},
+		{
+			MethodName: "KillAllStrategies",
+			Handler:    _Broker_KillAllStrategies_Handler,
+		},
+		{
+			MethodName: "GetAccount",
+			Handler:    _Broker_GetAccount_Handler,
+		},
+		{
+			MethodName: "ResetBalance",
+			Handler:    _Broker_ResetBalance_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamCandles",
+			Handler:       _Broker_StreamCandles_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeQuotes",
+			Handler:       _Broker_SubscribeQuotes_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "broker.proto",
 }